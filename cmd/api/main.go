@@ -33,6 +33,8 @@ func startServers(
 	coord resilience.ShutdownCoordinator,
 ) {
 	// Create Public HTTP server
+	// WriteTimeout bounds ordinary responses; GET /api/v1/users:export clears
+	// its own deadlines via http.ResponseController so long dumps can finish.
 	publicAddr := fmt.Sprintf(":%d", cfg.Port)
 	publicSrv := &http.Server{
 		Addr:              publicAddr,
@@ -0,0 +1,185 @@
+//go:build !integration
+
+package apikey
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+)
+
+// mockAPIKeyRepository is a test double for domain.APIKeyRepository.
+type mockAPIKeyRepository struct {
+	keys        map[domain.ID]domain.APIKey
+	getError    error
+	createError error
+	listError   error
+	revokeError error
+	touchError  error
+	touched     []domain.ID
+	// touchTenants holds the tenant of the context of each TouchLastUsed call.
+	touchTenants []string
+}
+
+func (m *mockAPIKeyRepository) Create(_ context.Context, _ domain.Querier, key *domain.APIKey) error {
+	if m.createError != nil {
+		return m.createError
+	}
+	if m.keys == nil {
+		m.keys = make(map[domain.ID]domain.APIKey)
+	}
+	m.keys[key.ID] = *key
+	return nil
+}
+
+func (m *mockAPIKeyRepository) GetByID(_ context.Context, _ domain.Querier, id domain.ID) (*domain.APIKey, error) {
+	if m.getError != nil {
+		return nil, m.getError
+	}
+	key, ok := m.keys[id]
+	if !ok {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	return &key, nil
+}
+
+func (m *mockAPIKeyRepository) GetByHash(_ context.Context, _ domain.Querier, keyHash string) (*domain.APIKey, error) {
+	if m.getError != nil {
+		return nil, m.getError
+	}
+	for _, key := range m.keys {
+		if key.KeyHash == keyHash {
+			return &key, nil
+		}
+	}
+	return nil, domain.ErrAPIKeyNotFound
+}
+
+func (m *mockAPIKeyRepository) List(_ context.Context, _ domain.Querier) ([]domain.APIKey, error) {
+	if m.listError != nil {
+		return nil, m.listError
+	}
+	keys := make([]domain.APIKey, 0, len(m.keys))
+	for _, key := range m.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID > keys[j].ID })
+	return keys, nil
+}
+
+func (m *mockAPIKeyRepository) Revoke(_ context.Context, _ domain.Querier, id domain.ID, revokedAt time.Time) error {
+	if m.revokeError != nil {
+		return m.revokeError
+	}
+	key, ok := m.keys[id]
+	if !ok {
+		return domain.ErrAPIKeyNotFound
+	}
+	if key.RevokedAt == nil {
+		key.RevokedAt = &revokedAt
+	}
+	m.keys[id] = key
+	return nil
+}
+
+func (m *mockAPIKeyRepository) SetExpiresAt(_ context.Context, _ domain.Querier, id domain.ID, expiresAt time.Time) error {
+	key, ok := m.keys[id]
+	if !ok {
+		return domain.ErrAPIKeyNotFound
+	}
+	key.ExpiresAt = &expiresAt
+	m.keys[id] = key
+	return nil
+}
+
+func (m *mockAPIKeyRepository) TouchLastUsed(ctx context.Context, _ domain.Querier, id domain.ID, usedAt time.Time) error {
+	if m.touchError != nil {
+		return m.touchError
+	}
+	m.touched = append(m.touched, id)
+	m.touchTenants = append(m.touchTenants, sharedctx.GetTenantID(ctx))
+	key := m.keys[id]
+	key.LastUsedAt = &usedAt
+	m.keys[id] = key
+	return nil
+}
+
+// mockIDGenerator is a test double for domain.IDGenerator that returns ids in
+// order.
+type mockIDGenerator struct {
+	ids []domain.ID
+}
+
+func (m *mockIDGenerator) NewID() domain.ID {
+	id := m.ids[0]
+	m.ids = m.ids[1:]
+	return id
+}
+
+var fixedNow = time.Date(2026, 1, 12, 9, 30, 0, 0, time.UTC)
+
+const (
+	testKeyID    = domain.ID("0195f0c4-0000-7000-8000-000000000001")
+	testNewKeyID = domain.ID("0195f0c4-0000-7000-8000-000000000002")
+)
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func authCtx(subjectID, role string) context.Context {
+	return app.SetAuthContext(context.Background(), &app.AuthContext{
+		SubjectID: subjectID,
+		Role:      role,
+	})
+}
+
+func adminCtx() context.Context {
+	return authCtx("admin-id", app.RoleAdmin)
+}
+
+// requireAppError asserts that err is an AppError with the given code.
+func requireAppError(t *testing.T, err error, code string) *app.AppError {
+	t.Helper()
+	var appErr *app.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, code, appErr.Code)
+	return appErr
+}
+
+func TestNewKey(t *testing.T) {
+	key, secret, err := newKey(testKeyID, "partner", app.RoleUser, []string{"users:read"}, "admin-id", fixedNow, nil)
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, KeyPrefix))
+	assert.Len(t, secret, len(KeyPrefix)+43)
+	assert.Equal(t, secret[:displayPrefixLength], key.Prefix)
+	assert.Equal(t, digest(secret), key.KeyHash)
+	assert.Len(t, key.KeyHash, 64)
+	assert.NotContains(t, key.KeyHash, secret)
+
+	_, other, err := newKey(testKeyID, "partner", app.RoleUser, []string{"users:read"}, "admin-id", fixedNow, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+}
+
+func TestMapRepoError(t *testing.T) {
+	appErr := requireAppError(t, mapRepoError("Op", "msg", domain.ErrAPIKeyNotFound), app.CodeAPIKeyNotFound)
+	assert.ErrorIs(t, appErr, domain.ErrAPIKeyNotFound)
+
+	dbErr := errors.New("db down")
+	appErr = requireAppError(t, mapRepoError("Op", "msg", dbErr), app.CodeInternalError)
+	assert.ErrorIs(t, appErr, dbErr)
+}
@@ -0,0 +1,92 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// DefaultLastUsedInterval is how often LastUsedAt of a key is updated when no
+// interval is configured.
+const DefaultLastUsedInterval = time.Minute
+
+// OpAuthenticateAPIKey is the operation name for Authenticator.
+const OpAuthenticateAPIKey = "AuthenticateAPIKey"
+
+// Authenticator resolves API keys presented by callers.
+type Authenticator struct {
+	repo     domain.APIKeyRepository
+	db       domain.Querier
+	interval time.Duration
+	now      func() time.Time
+	log      *logger.Logger
+}
+
+// NewAuthenticator creates a new instance of Authenticator. interval bounds
+// how often a key's LastUsedAt is written; a non-positive value uses
+// DefaultLastUsedInterval.
+func NewAuthenticator(repo domain.APIKeyRepository, db domain.Querier, interval time.Duration, log *logger.Logger) *Authenticator {
+	if interval <= 0 {
+		interval = DefaultLastUsedInterval
+	}
+	return &Authenticator{
+		repo:     repo,
+		db:       db,
+		interval: interval,
+		now:      time.Now,
+		log:      log.With("usecase", OpAuthenticateAPIKey),
+	}
+}
+
+// Authenticate returns the active key matching secret and records its use.
+// Returns AppError with Code=UNAUTHORIZED for an unknown, revoked or expired
+// key, and INTERNAL_ERROR if the key cannot be looked up.
+func (a *Authenticator) Authenticate(ctx context.Context, secret string) (domain.APIKey, error) {
+	if !strings.HasPrefix(secret, KeyPrefix) {
+		return domain.APIKey{}, unauthorized()
+	}
+
+	key, err := a.repo.GetByHash(ctx, a.db, digest(secret))
+	if errors.Is(err, domain.ErrAPIKeyNotFound) {
+		return domain.APIKey{}, unauthorized()
+	}
+	if err != nil {
+		return domain.APIKey{}, internalError(OpAuthenticateAPIKey, "Failed to look up API key", err)
+	}
+
+	now := a.now().UTC()
+	if !key.Active(now) {
+		logger.FromContext(ctx, a.log).InfoContext(ctx, "inactive api key presented", "keyId", key.ID)
+		return domain.APIKey{}, unauthorized()
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= a.interval {
+		// The key was looked up across tenants; it is updated within its own.
+		ctx := sharedctx.SetTenantID(ctx, key.TenantID)
+		// Failing to record the use must not fail the request.
+		if err := a.repo.TouchLastUsed(ctx, a.db, key.ID, now); err != nil {
+			logger.FromContext(ctx, a.log).WarnContext(ctx, "failed to update api key last use",
+				"keyId", key.ID,
+				"error", err,
+			)
+		} else {
+			key.LastUsedAt = &now
+		}
+	}
+
+	return *key, nil
+}
+
+func unauthorized() error {
+	return &app.AppError{
+		Op:      OpAuthenticateAPIKey,
+		Code:    app.CodeUnauthorized,
+		Message: "Invalid API key",
+	}
+}
@@ -0,0 +1,114 @@
+//go:build !integration
+
+package apikey
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+const testSecret = "ak_0123456789abcdefghijklmnopqrstuvwxyzABCDEFG"
+
+func newTestAuthenticator(key domain.APIKey) (*Authenticator, *mockAPIKeyRepository) {
+	key.KeyHash = digest(testSecret)
+	repo := &mockAPIKeyRepository{keys: map[domain.ID]domain.APIKey{key.ID: key}}
+	a := NewAuthenticator(repo, testutil.Querier{}, time.Minute, discardLogger())
+	a.now = func() time.Time { return fixedNow }
+	return a, repo
+}
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	a, repo := newTestAuthenticator(domain.APIKey{ID: testKeyID, Role: app.RoleUser, TenantID: "tenant-a"})
+
+	key, err := a.Authenticate(context.Background(), testSecret)
+
+	require.NoError(t, err)
+	assert.Equal(t, testKeyID, key.ID)
+	assert.Equal(t, app.RoleUser, key.Role)
+	assert.Equal(t, "tenant-a", key.TenantID)
+	require.NotNil(t, key.LastUsedAt)
+	assert.Equal(t, fixedNow, *key.LastUsedAt)
+	assert.Equal(t, []domain.ID{testKeyID}, repo.touched)
+	assert.Equal(t, []string{"tenant-a"}, repo.touchTenants, "the key is updated within its tenant")
+}
+
+func TestAuthenticator_Authenticate_LastUsedInterval(t *testing.T) {
+	tests := []struct {
+		name      string
+		lastUsed  time.Time
+		wantTouch bool
+	}{
+		{name: "within interval", lastUsed: fixedNow.Add(-30 * time.Second)},
+		{name: "interval elapsed", lastUsed: fixedNow.Add(-time.Minute), wantTouch: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lastUsed := tt.lastUsed
+			a, repo := newTestAuthenticator(domain.APIKey{ID: testKeyID, LastUsedAt: &lastUsed})
+
+			_, err := a.Authenticate(context.Background(), testSecret)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTouch, len(repo.touched) == 1)
+		})
+	}
+}
+
+func TestAuthenticator_Authenticate_TouchErrorIsIgnored(t *testing.T) {
+	a, repo := newTestAuthenticator(domain.APIKey{ID: testKeyID})
+	repo.touchError = errors.New("db down")
+
+	key, err := a.Authenticate(context.Background(), testSecret)
+
+	require.NoError(t, err)
+	assert.Equal(t, testKeyID, key.ID)
+	assert.Nil(t, key.LastUsedAt)
+}
+
+func TestAuthenticator_Authenticate_Rejects(t *testing.T) {
+	revokedAt := fixedNow.Add(-time.Hour)
+	expiredAt := fixedNow.Add(-time.Second)
+
+	tests := []struct {
+		name   string
+		key    domain.APIKey
+		secret string
+	}{
+		{name: "missing prefix", key: domain.APIKey{ID: testKeyID}, secret: testSecret[len(KeyPrefix):]},
+		{name: "unknown key", key: domain.APIKey{ID: testKeyID}, secret: testSecret + "x"},
+		{name: "revoked key", key: domain.APIKey{ID: testKeyID, RevokedAt: &revokedAt}, secret: testSecret},
+		{name: "expired key", key: domain.APIKey{ID: testKeyID, ExpiresAt: &expiredAt}, secret: testSecret},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, repo := newTestAuthenticator(tt.key)
+
+			_, err := a.Authenticate(context.Background(), tt.secret)
+
+			requireAppError(t, err, app.CodeUnauthorized)
+			assert.Empty(t, repo.touched)
+		})
+	}
+}
+
+func TestAuthenticator_Authenticate_LookupError(t *testing.T) {
+	a, repo := newTestAuthenticator(domain.APIKey{ID: testKeyID})
+	dbErr := errors.New("db down")
+	repo.getError = dbErr
+
+	_, err := a.Authenticate(context.Background(), testSecret)
+
+	appErr := requireAppError(t, err, app.CodeInternalError)
+	assert.ErrorIs(t, appErr, dbErr)
+}
@@ -0,0 +1,140 @@
+package apikey
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// CreateAPIKeyRequest represents the input data for creating an API key.
+type CreateAPIKeyRequest struct {
+	// Name describes the key, e.g. the partner integration using it.
+	Name string
+	// Role is the role of callers authenticated with the key: "user" or "admin".
+	Role string
+	// Scopes are the scopes granted to callers authenticated with the key,
+	// named like the registered permissions without ":self" variants.
+	Scopes []string
+	// ExpiresAt is when the key stops authenticating. Zero means never.
+	ExpiresAt time.Time
+	// RequestID correlates this operation with the HTTP request.
+	// Transport layer extracts from context and passes here.
+	RequestID string
+	// ActorID identifies who is performing this action.
+	// Transport layer extracts from JWT claims and passes here.
+	ActorID domain.ID
+}
+
+// CreateAPIKeyResponse represents the created key.
+type CreateAPIKeyResponse struct {
+	Key domain.APIKey
+	// Secret is the generated key. It is not stored and cannot be retrieved
+	// again.
+	Secret string
+}
+
+// OpCreateAPIKey is the operation name for CreateAPIKey use case.
+const OpCreateAPIKey = "CreateAPIKey"
+
+// CreateAPIKeyUseCase creates an API key. Requires api_keys:write.
+type CreateAPIKeyUseCase struct {
+	repo         domain.APIKeyRepository
+	auditService *audit.AuditService
+	txManager    domain.TxManager
+	idGen        domain.IDGenerator
+	now          func() time.Time
+	authz        app.Authorizer
+	log          *logger.Logger
+}
+
+// NewCreateAPIKeyUseCase creates a new instance of CreateAPIKeyUseCase.
+func NewCreateAPIKeyUseCase(
+	repo domain.APIKeyRepository,
+	auditService *audit.AuditService,
+	txManager domain.TxManager,
+	idGen domain.IDGenerator,
+	authz app.Authorizer,
+	log *logger.Logger,
+) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		repo:         repo,
+		auditService: auditService,
+		txManager:    txManager,
+		idGen:        idGen,
+		now:          time.Now,
+		authz:        authz,
+		log:          log.With("usecase", OpCreateAPIKey),
+	}
+}
+
+// Execute creates the key and records an api_key.created audit event in the
+// same transaction.
+// Returns AppError with Code=INSUFFICIENT_PERMISSIONS without api_keys:write
+// and VALIDATION_ERROR for an empty or too long name, an unknown role, no or
+// unknown scopes or an expiry in the past.
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, req CreateAPIKeyRequest) (CreateAPIKeyResponse, error) {
+	authCtx, err := app.RequirePermission(ctx, uc.authz, OpCreateAPIKey, app.PermAPIKeysWrite, keyResource(""))
+	if err != nil {
+		return CreateAPIKeyResponse{}, err
+	}
+
+	name, msg := validateName(req.Name)
+	if msg != "" {
+		return CreateAPIKeyResponse{}, validationError(OpCreateAPIKey, msg)
+	}
+	role := strings.ToLower(strings.TrimSpace(req.Role))
+	if role != app.RoleUser && role != app.RoleAdmin {
+		return CreateAPIKeyResponse{}, validationError(OpCreateAPIKey, "role must be user or admin")
+	}
+	scopes, err := app.ParseScopes(req.Scopes)
+	if err != nil {
+		return CreateAPIKeyResponse{}, validationError(OpCreateAPIKey, err.Error())
+	}
+
+	now := uc.now().UTC()
+	var expiresAt *time.Time
+	if !req.ExpiresAt.IsZero() {
+		if !req.ExpiresAt.After(now) {
+			return CreateAPIKeyResponse{}, validationError(OpCreateAPIKey, "expiresAt must be in the future")
+		}
+		t := req.ExpiresAt.UTC()
+		expiresAt = &t
+	}
+
+	key, secret, err := newKey(uc.idGen.NewID(), name, role, scopes, string(req.ActorID), now, expiresAt)
+	if err != nil {
+		return CreateAPIKeyResponse{}, internalError(OpCreateAPIKey, "Failed to generate API key", err)
+	}
+
+	if err := uc.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		if err := uc.repo.Create(ctx, tx, &key); err != nil {
+			return internalError(OpCreateAPIKey, "Failed to create API key", err)
+		}
+		if err := uc.auditService.Record(ctx, tx, audit.AuditEventInput{
+			EventType:  domain.EventAPIKeyCreated,
+			ActorID:    req.ActorID,
+			EntityType: "api_key",
+			EntityID:   key.ID,
+			Payload:    keyPayload(key),
+			RequestID:  req.RequestID,
+		}); err != nil {
+			return internalError(OpCreateAPIKey, "Failed to record audit event", err)
+		}
+		return nil
+	}); err != nil {
+		return CreateAPIKeyResponse{}, err
+	}
+
+	logger.FromContext(ctx, uc.log).InfoContext(ctx, "api key created",
+		"actorId", authCtx.SubjectID,
+		"keyId", key.ID,
+		"role", key.Role,
+	)
+
+	return CreateAPIKeyResponse{Key: key, Secret: secret}, nil
+}
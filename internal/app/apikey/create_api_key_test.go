@@ -0,0 +1,177 @@
+//go:build !integration
+
+package apikey
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+type createAPIKeyFixture struct {
+	uc    *CreateAPIKeyUseCase
+	repo  *mockAPIKeyRepository
+	audit *testutil.AuditEvents
+}
+
+func newCreateAPIKeyFixture(t *testing.T) createAPIKeyFixture {
+	repo := &mockAPIKeyRepository{}
+	auditRepo, events := testutil.RecordAuditEvents(t)
+	idGen := &mockIDGenerator{ids: []domain.ID{testKeyID, "audit-1"}}
+	auditService := audit.NewAuditService(auditRepo, testutil.Redactor{}, idGen)
+	uc := NewCreateAPIKeyUseCase(repo, auditService, testutil.TxManager{}, idGen, testutil.NewAuthorizer(discardLogger()), discardLogger())
+	uc.now = func() time.Time { return fixedNow }
+	return createAPIKeyFixture{uc: uc, repo: repo, audit: events}
+}
+
+func TestCreateAPIKeyUseCase_Execute(t *testing.T) {
+	f := newCreateAPIKeyFixture(t)
+	expiresAt := fixedNow.Add(30 * 24 * time.Hour)
+
+	resp, err := f.uc.Execute(adminCtx(), CreateAPIKeyRequest{
+		Name:      "  partner-x ",
+		Role:      "User",
+		Scopes:    []string{"users:read", " audit:read"},
+		ExpiresAt: expiresAt,
+		RequestID: "req-1",
+		ActorID:   "admin-id",
+	})
+
+	require.NoError(t, err)
+	want := domain.APIKey{
+		ID:        testKeyID,
+		Name:      "partner-x",
+		Prefix:    resp.Secret[:displayPrefixLength],
+		KeyHash:   digest(resp.Secret),
+		Role:      app.RoleUser,
+		Scopes:    []string{"users:read", "audit:read"},
+		CreatedBy: "admin-id",
+		CreatedAt: fixedNow,
+		ExpiresAt: &expiresAt,
+	}
+	assert.Equal(t, want, resp.Key)
+	assert.Equal(t, want, f.repo.keys[testKeyID])
+
+	require.Len(t, f.audit.Events, 1)
+	event := f.audit.Events[0]
+	assert.Equal(t, domain.EventAPIKeyCreated, event.EventType)
+	assert.Equal(t, "api_key", event.EntityType)
+	assert.Equal(t, testKeyID, event.EntityID)
+	assert.Equal(t, domain.ID("admin-id"), event.ActorID)
+	assert.Equal(t, "req-1", event.RequestID)
+	assert.NotContains(t, string(event.Payload), resp.Secret, "the key must not be audited")
+	assert.NotContains(t, string(event.Payload), resp.Key.KeyHash, "the key hash must not be audited")
+}
+
+func TestCreateAPIKeyUseCase_Execute_WithoutExpiry(t *testing.T) {
+	f := newCreateAPIKeyFixture(t)
+
+	resp, err := f.uc.Execute(adminCtx(), CreateAPIKeyRequest{Name: "partner", Role: app.RoleAdmin, Scopes: []string{"users:read"}})
+
+	require.NoError(t, err)
+	assert.Nil(t, resp.Key.ExpiresAt)
+	assert.Equal(t, app.RoleAdmin, resp.Key.Role)
+}
+
+func TestCreateAPIKeyUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		req            CreateAPIKeyRequest
+		createError    error
+		auditError     error
+		wantCode       string
+		wantMessageSub string
+	}{
+		{
+			name:     "no auth context",
+			ctx:      context.Background(),
+			req:      CreateAPIKeyRequest{Name: "partner", Role: app.RoleUser},
+			wantCode: app.CodeForbidden,
+		},
+		{
+			name:     "non-admin",
+			ctx:      authCtx("user-id", app.RoleUser),
+			req:      CreateAPIKeyRequest{Name: "partner", Role: app.RoleUser},
+			wantCode: app.CodeInsufficientPermissions,
+		},
+		{
+			name:           "blank name",
+			req:            CreateAPIKeyRequest{Name: "   ", Role: app.RoleUser},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "name",
+		},
+		{
+			name:           "name too long",
+			req:            CreateAPIKeyRequest{Name: strings.Repeat("a", maxKeyNameLength+1), Role: app.RoleUser},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "name",
+		},
+		{
+			name:           "unknown role",
+			req:            CreateAPIKeyRequest{Name: "partner", Role: "superuser"},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "role",
+		},
+		{
+			name:           "no scopes",
+			req:            CreateAPIKeyRequest{Name: "partner", Role: app.RoleUser},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "scope",
+		},
+		{
+			name:           "unknown scope",
+			req:            CreateAPIKeyRequest{Name: "partner", Role: app.RoleUser, Scopes: []string{"users:read:self"}},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "scope",
+		},
+		{
+			name:           "expiry in the past",
+			req:            CreateAPIKeyRequest{Name: "partner", Role: app.RoleUser, Scopes: []string{"users:read"}, ExpiresAt: fixedNow},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "expiresAt",
+		},
+		{
+			name:        "repository error",
+			req:         CreateAPIKeyRequest{Name: "partner", Role: app.RoleUser, Scopes: []string{"users:read"}},
+			createError: errors.New("db down"),
+			wantCode:    app.CodeInternalError,
+		},
+		{
+			name:       "audit error",
+			req:        CreateAPIKeyRequest{Name: "partner", Role: app.RoleUser, Scopes: []string{"users:read"}},
+			auditError: errors.New("db down"),
+			wantCode:   app.CodeInternalError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCreateAPIKeyFixture(t)
+			f.repo.createError = tt.createError
+			f.audit.Err = tt.auditError
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = adminCtx()
+			}
+
+			_, err := f.uc.Execute(ctx, tt.req)
+
+			appErr := requireAppError(t, err, tt.wantCode)
+			assert.Contains(t, appErr.Message, tt.wantMessageSub)
+			if tt.createError != nil {
+				assert.Empty(t, f.audit.Events)
+			}
+		})
+	}
+}
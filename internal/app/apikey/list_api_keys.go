@@ -0,0 +1,50 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// ListAPIKeysResponse represents all API keys, newest first.
+type ListAPIKeysResponse struct {
+	Keys []domain.APIKey
+}
+
+// OpListAPIKeys is the operation name for ListAPIKeys use case.
+const OpListAPIKeys = "ListAPIKeys"
+
+// ListAPIKeysUseCase lists API keys, including revoked and expired ones.
+// Requires api_keys:read.
+type ListAPIKeysUseCase struct {
+	repo  domain.APIKeyRepository
+	db    domain.Querier
+	authz app.Authorizer
+	log   *logger.Logger
+}
+
+// NewListAPIKeysUseCase creates a new instance of ListAPIKeysUseCase.
+func NewListAPIKeysUseCase(repo domain.APIKeyRepository, db domain.Querier, authz app.Authorizer, log *logger.Logger) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{
+		repo:  repo,
+		db:    db,
+		authz: authz,
+		log:   log.With("usecase", OpListAPIKeys),
+	}
+}
+
+// Execute returns all keys.
+// Returns AppError with Code=INSUFFICIENT_PERMISSIONS without api_keys:read.
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context) (ListAPIKeysResponse, error) {
+	if _, err := app.RequirePermission(ctx, uc.authz, OpListAPIKeys, app.PermAPIKeysRead, keyResource("")); err != nil {
+		return ListAPIKeysResponse{}, err
+	}
+
+	keys, err := uc.repo.List(ctx, uc.db)
+	if err != nil {
+		return ListAPIKeysResponse{}, internalError(OpListAPIKeys, "Failed to list API keys", err)
+	}
+	return ListAPIKeysResponse{Keys: keys}, nil
+}
@@ -0,0 +1,48 @@
+//go:build !integration
+
+package apikey
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+func TestListAPIKeysUseCase_Execute(t *testing.T) {
+	repo := &mockAPIKeyRepository{keys: map[domain.ID]domain.APIKey{
+		testKeyID:    {ID: testKeyID, Name: "old"},
+		testNewKeyID: {ID: testNewKeyID, Name: "new"},
+	}}
+	uc := NewListAPIKeysUseCase(repo, testutil.Querier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+	resp, err := uc.Execute(adminCtx())
+
+	require.NoError(t, err)
+	require.Len(t, resp.Keys, 2)
+	assert.Equal(t, testNewKeyID, resp.Keys[0].ID)
+	assert.Equal(t, testKeyID, resp.Keys[1].ID)
+}
+
+func TestListAPIKeysUseCase_Execute_Errors(t *testing.T) {
+	t.Run("non-admin", func(t *testing.T) {
+		uc := NewListAPIKeysUseCase(&mockAPIKeyRepository{}, testutil.Querier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+		_, err := uc.Execute(authCtx("user-id", app.RoleUser))
+
+		requireAppError(t, err, app.CodeInsufficientPermissions)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		uc := NewListAPIKeysUseCase(&mockAPIKeyRepository{listError: errors.New("db down")}, testutil.Querier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+		_, err := uc.Execute(adminCtx())
+
+		requireAppError(t, err, app.CodeInternalError)
+	})
+}
@@ -0,0 +1,115 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// RevokeAPIKeyRequest represents the input data for revoking an API key.
+type RevokeAPIKeyRequest struct {
+	// ID is the key to revoke.
+	ID domain.ID
+	// RequestID correlates this operation with the HTTP request.
+	// Transport layer extracts from context and passes here.
+	RequestID string
+	// ActorID identifies who is performing this action.
+	// Transport layer extracts from JWT claims and passes here.
+	ActorID domain.ID
+}
+
+// RevokeAPIKeyResponse represents the revoked key.
+type RevokeAPIKeyResponse struct {
+	Key domain.APIKey
+}
+
+// OpRevokeAPIKey is the operation name for RevokeAPIKey use case.
+const OpRevokeAPIKey = "RevokeAPIKey"
+
+// RevokeAPIKeyUseCase revokes an API key so it no longer authenticates.
+// Requires api_keys:write.
+type RevokeAPIKeyUseCase struct {
+	repo         domain.APIKeyRepository
+	auditService *audit.AuditService
+	txManager    domain.TxManager
+	now          func() time.Time
+	authz        app.Authorizer
+	log          *logger.Logger
+}
+
+// NewRevokeAPIKeyUseCase creates a new instance of RevokeAPIKeyUseCase.
+func NewRevokeAPIKeyUseCase(
+	repo domain.APIKeyRepository,
+	auditService *audit.AuditService,
+	txManager domain.TxManager,
+	authz app.Authorizer,
+	log *logger.Logger,
+) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		repo:         repo,
+		auditService: auditService,
+		txManager:    txManager,
+		now:          time.Now,
+		authz:        authz,
+		log:          log.With("usecase", OpRevokeAPIKey),
+	}
+}
+
+// Execute revokes the key and records an api_key.revoked audit event in the
+// same transaction. Revoking a revoked key is a no-op that returns the key
+// unchanged.
+// Returns AppError with Code=INSUFFICIENT_PERMISSIONS without api_keys:write
+// and API_KEY_NOT_FOUND if there is no such key.
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, req RevokeAPIKeyRequest) (RevokeAPIKeyResponse, error) {
+	authCtx, err := app.RequirePermission(ctx, uc.authz, OpRevokeAPIKey, app.PermAPIKeysWrite, keyResource(req.ID))
+	if err != nil {
+		return RevokeAPIKeyResponse{}, err
+	}
+
+	var key domain.APIKey
+	revoked := false
+	if err := uc.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		k, err := uc.repo.GetByID(ctx, tx, req.ID)
+		if err != nil {
+			return mapRepoError(OpRevokeAPIKey, "Failed to get API key", err)
+		}
+		key = *k
+		if key.RevokedAt != nil {
+			return nil
+		}
+
+		now := uc.now().UTC()
+		if err := uc.repo.Revoke(ctx, tx, key.ID, now); err != nil {
+			return mapRepoError(OpRevokeAPIKey, "Failed to revoke API key", err)
+		}
+		key.RevokedAt = &now
+		revoked = true
+
+		if err := uc.auditService.Record(ctx, tx, audit.AuditEventInput{
+			EventType:  domain.EventAPIKeyRevoked,
+			ActorID:    req.ActorID,
+			EntityType: "api_key",
+			EntityID:   key.ID,
+			Payload:    keyPayload(key),
+			RequestID:  req.RequestID,
+		}); err != nil {
+			return internalError(OpRevokeAPIKey, "Failed to record audit event", err)
+		}
+		return nil
+	}); err != nil {
+		return RevokeAPIKeyResponse{}, err
+	}
+
+	if revoked {
+		logger.FromContext(ctx, uc.log).InfoContext(ctx, "api key revoked",
+			"actorId", authCtx.SubjectID,
+			"keyId", key.ID,
+		)
+	}
+
+	return RevokeAPIKeyResponse{Key: key}, nil
+}
@@ -0,0 +1,101 @@
+//go:build !integration
+
+package apikey
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+type revokeAPIKeyFixture struct {
+	uc    *RevokeAPIKeyUseCase
+	repo  *mockAPIKeyRepository
+	audit *testutil.AuditEvents
+}
+
+func newRevokeAPIKeyFixture(t *testing.T) revokeAPIKeyFixture {
+	repo := &mockAPIKeyRepository{keys: map[domain.ID]domain.APIKey{
+		testKeyID: {ID: testKeyID, Name: "partner", Prefix: "ak_abcdefgh", KeyHash: "hash", Role: app.RoleUser},
+	}}
+	auditRepo, events := testutil.RecordAuditEvents(t)
+	auditService := audit.NewAuditService(auditRepo, testutil.Redactor{}, &mockIDGenerator{ids: []domain.ID{"audit-1"}})
+	uc := NewRevokeAPIKeyUseCase(repo, auditService, testutil.TxManager{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+	uc.now = func() time.Time { return fixedNow }
+	return revokeAPIKeyFixture{uc: uc, repo: repo, audit: events}
+}
+
+func TestRevokeAPIKeyUseCase_Execute(t *testing.T) {
+	f := newRevokeAPIKeyFixture(t)
+
+	resp, err := f.uc.Execute(adminCtx(), RevokeAPIKeyRequest{ID: testKeyID, RequestID: "req-1", ActorID: "admin-id"})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Key.RevokedAt)
+	assert.Equal(t, fixedNow, *resp.Key.RevokedAt)
+	assert.Equal(t, fixedNow, *f.repo.keys[testKeyID].RevokedAt)
+
+	require.Len(t, f.audit.Events, 1)
+	event := f.audit.Events[0]
+	assert.Equal(t, domain.EventAPIKeyRevoked, event.EventType)
+	assert.Equal(t, "api_key", event.EntityType)
+	assert.Equal(t, testKeyID, event.EntityID)
+	assert.Equal(t, domain.ID("admin-id"), event.ActorID)
+}
+
+func TestRevokeAPIKeyUseCase_Execute_AlreadyRevoked(t *testing.T) {
+	f := newRevokeAPIKeyFixture(t)
+	revokedAt := fixedNow.Add(-time.Hour)
+	key := f.repo.keys[testKeyID]
+	key.RevokedAt = &revokedAt
+	f.repo.keys[testKeyID] = key
+
+	resp, err := f.uc.Execute(adminCtx(), RevokeAPIKeyRequest{ID: testKeyID, ActorID: "admin-id"})
+
+	require.NoError(t, err)
+	assert.Equal(t, revokedAt, *resp.Key.RevokedAt)
+	assert.Empty(t, f.audit.Events, "revoking a revoked key must not be audited again")
+}
+
+func TestRevokeAPIKeyUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		nonAdmin    bool
+		id          domain.ID
+		getError    error
+		revokeError error
+		auditError  error
+		wantCode    string
+	}{
+		{name: "non-admin", nonAdmin: true, id: testKeyID, wantCode: app.CodeInsufficientPermissions},
+		{name: "not found", id: testNewKeyID, wantCode: app.CodeAPIKeyNotFound},
+		{name: "get error", id: testKeyID, getError: errors.New("db down"), wantCode: app.CodeInternalError},
+		{name: "revoke error", id: testKeyID, revokeError: errors.New("db down"), wantCode: app.CodeInternalError},
+		{name: "audit error", id: testKeyID, auditError: errors.New("db down"), wantCode: app.CodeInternalError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newRevokeAPIKeyFixture(t)
+			f.repo.getError = tt.getError
+			f.repo.revokeError = tt.revokeError
+			f.audit.Err = tt.auditError
+			ctx := adminCtx()
+			if tt.nonAdmin {
+				ctx = authCtx("user-id", app.RoleUser)
+			}
+
+			_, err := f.uc.Execute(ctx, RevokeAPIKeyRequest{ID: tt.id, ActorID: "admin-id"})
+
+			requireAppError(t, err, tt.wantCode)
+		})
+	}
+}
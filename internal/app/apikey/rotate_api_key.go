@@ -0,0 +1,157 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// DefaultRotationGrace is how long a rotated key keeps authenticating when no
+// grace period is configured.
+const DefaultRotationGrace = 24 * time.Hour
+
+// RotateAPIKeyRequest represents the input data for rotating an API key.
+type RotateAPIKeyRequest struct {
+	// ID is the key to replace.
+	ID domain.ID
+	// RequestID correlates this operation with the HTTP request.
+	// Transport layer extracts from context and passes here.
+	RequestID string
+	// ActorID identifies who is performing this action.
+	// Transport layer extracts from JWT claims and passes here.
+	ActorID domain.ID
+}
+
+// RotateAPIKeyResponse represents the new key and the key it replaces.
+type RotateAPIKeyResponse struct {
+	Key domain.APIKey
+	// Secret is the generated key. It is not stored and cannot be retrieved
+	// again.
+	Secret string
+	// Replaced is the rotated key, which expires at the end of the grace period.
+	Replaced domain.APIKey
+}
+
+// OpRotateAPIKey is the operation name for RotateAPIKey use case.
+const OpRotateAPIKey = "RotateAPIKey"
+
+// RotateAPIKeyUseCase replaces an API key with a new one of the same name,
+// role and scopes. The old key keeps authenticating for a grace period so the partner
+// can switch over without downtime. Requires api_keys:write.
+type RotateAPIKeyUseCase struct {
+	repo         domain.APIKeyRepository
+	auditService *audit.AuditService
+	txManager    domain.TxManager
+	idGen        domain.IDGenerator
+	grace        time.Duration
+	now          func() time.Time
+	authz        app.Authorizer
+	log          *logger.Logger
+}
+
+// NewRotateAPIKeyUseCase creates a new instance of RotateAPIKeyUseCase.
+// grace is how long the old key stays valid; zero ends it immediately and a
+// negative value uses DefaultRotationGrace.
+func NewRotateAPIKeyUseCase(
+	repo domain.APIKeyRepository,
+	auditService *audit.AuditService,
+	txManager domain.TxManager,
+	idGen domain.IDGenerator,
+	grace time.Duration,
+	authz app.Authorizer,
+	log *logger.Logger,
+) *RotateAPIKeyUseCase {
+	if grace < 0 {
+		grace = DefaultRotationGrace
+	}
+	return &RotateAPIKeyUseCase{
+		repo:         repo,
+		auditService: auditService,
+		txManager:    txManager,
+		idGen:        idGen,
+		grace:        grace,
+		now:          time.Now,
+		authz:        authz,
+		log:          log.With("usecase", OpRotateAPIKey),
+	}
+}
+
+// Execute creates the new key, shortens the old key's expiry to the end of
+// the grace period, and records api_key.rotated for the old key and
+// api_key.created for the new one, all in one transaction.
+// Returns AppError with Code=INSUFFICIENT_PERMISSIONS without api_keys:write,
+// API_KEY_NOT_FOUND if there is no such key and VALIDATION_ERROR if it is
+// revoked or expired.
+func (uc *RotateAPIKeyUseCase) Execute(ctx context.Context, req RotateAPIKeyRequest) (RotateAPIKeyResponse, error) {
+	authCtx, err := app.RequirePermission(ctx, uc.authz, OpRotateAPIKey, app.PermAPIKeysWrite, keyResource(req.ID))
+	if err != nil {
+		return RotateAPIKeyResponse{}, err
+	}
+
+	var resp RotateAPIKeyResponse
+	if err := uc.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		old, err := uc.repo.GetByID(ctx, tx, req.ID)
+		if err != nil {
+			return mapRepoError(OpRotateAPIKey, "Failed to get API key", err)
+		}
+		now := uc.now().UTC()
+		if !old.Active(now) {
+			return validationError(OpRotateAPIKey, "api key is revoked or expired")
+		}
+
+		key, secret, err := newKey(uc.idGen.NewID(), old.Name, old.Role, old.Scopes, string(req.ActorID), now, nil)
+		if err != nil {
+			return internalError(OpRotateAPIKey, "Failed to generate API key", err)
+		}
+		if err := uc.repo.Create(ctx, tx, &key); err != nil {
+			return internalError(OpRotateAPIKey, "Failed to create API key", err)
+		}
+
+		expiresAt := now.Add(uc.grace)
+		if old.ExpiresAt != nil && old.ExpiresAt.Before(expiresAt) {
+			expiresAt = *old.ExpiresAt
+		}
+		if err := uc.repo.SetExpiresAt(ctx, tx, old.ID, expiresAt); err != nil {
+			return mapRepoError(OpRotateAPIKey, "Failed to expire API key", err)
+		}
+		old.ExpiresAt = &expiresAt
+
+		if err := uc.auditService.Record(ctx, tx, audit.AuditEventInput{
+			EventType:  domain.EventAPIKeyRotated,
+			ActorID:    req.ActorID,
+			EntityType: "api_key",
+			EntityID:   old.ID,
+			Payload:    map[string]any{"replacedBy": string(key.ID), "expiresAt": expiresAt},
+			RequestID:  req.RequestID,
+		}); err != nil {
+			return internalError(OpRotateAPIKey, "Failed to record audit event", err)
+		}
+		if err := uc.auditService.Record(ctx, tx, audit.AuditEventInput{
+			EventType:  domain.EventAPIKeyCreated,
+			ActorID:    req.ActorID,
+			EntityType: "api_key",
+			EntityID:   key.ID,
+			Payload:    keyPayload(key),
+			RequestID:  req.RequestID,
+		}); err != nil {
+			return internalError(OpRotateAPIKey, "Failed to record audit event", err)
+		}
+
+		resp = RotateAPIKeyResponse{Key: key, Secret: secret, Replaced: *old}
+		return nil
+	}); err != nil {
+		return RotateAPIKeyResponse{}, err
+	}
+
+	logger.FromContext(ctx, uc.log).InfoContext(ctx, "api key rotated",
+		"actorId", authCtx.SubjectID,
+		"keyId", resp.Key.ID,
+		"replacedKeyId", resp.Replaced.ID,
+	)
+
+	return resp, nil
+}
@@ -0,0 +1,125 @@
+//go:build !integration
+
+package apikey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+type rotateAPIKeyFixture struct {
+	uc    *RotateAPIKeyUseCase
+	repo  *mockAPIKeyRepository
+	audit *testutil.AuditEvents
+}
+
+func newRotateAPIKeyFixture(t *testing.T, old domain.APIKey) rotateAPIKeyFixture {
+	repo := &mockAPIKeyRepository{keys: map[domain.ID]domain.APIKey{old.ID: old}}
+	auditRepo, events := testutil.RecordAuditEvents(t)
+	idGen := &mockIDGenerator{ids: []domain.ID{testNewKeyID, "audit-1", "audit-2"}}
+	auditService := audit.NewAuditService(auditRepo, testutil.Redactor{}, idGen)
+	uc := NewRotateAPIKeyUseCase(repo, auditService, testutil.TxManager{}, idGen, time.Hour, testutil.NewAuthorizer(discardLogger()), discardLogger())
+	uc.now = func() time.Time { return fixedNow }
+	return rotateAPIKeyFixture{uc: uc, repo: repo, audit: events}
+}
+
+func testOldKey() domain.APIKey {
+	return domain.APIKey{
+		ID:        testKeyID,
+		Name:      "partner",
+		Prefix:    "ak_abcdefgh",
+		KeyHash:   "old-hash",
+		Role:      app.RoleAdmin,
+		Scopes:    []string{"users:read"},
+		CreatedBy: "someone",
+		CreatedAt: fixedNow.Add(-90 * 24 * time.Hour),
+	}
+}
+
+func TestRotateAPIKeyUseCase_Execute(t *testing.T) {
+	f := newRotateAPIKeyFixture(t, testOldKey())
+
+	resp, err := f.uc.Execute(adminCtx(), RotateAPIKeyRequest{ID: testKeyID, RequestID: "req-1", ActorID: "admin-id"})
+
+	require.NoError(t, err)
+	graceEnd := fixedNow.Add(time.Hour)
+
+	assert.Equal(t, testNewKeyID, resp.Key.ID)
+	assert.Equal(t, "partner", resp.Key.Name)
+	assert.Equal(t, app.RoleAdmin, resp.Key.Role)
+	assert.Equal(t, []string{"users:read"}, resp.Key.Scopes)
+	assert.Equal(t, "admin-id", resp.Key.CreatedBy)
+	assert.Nil(t, resp.Key.ExpiresAt)
+	assert.Equal(t, digest(resp.Secret), resp.Key.KeyHash)
+	assert.Equal(t, resp.Key, f.repo.keys[testNewKeyID])
+
+	assert.Equal(t, testKeyID, resp.Replaced.ID)
+	require.NotNil(t, resp.Replaced.ExpiresAt)
+	assert.Equal(t, graceEnd, *resp.Replaced.ExpiresAt)
+	assert.Equal(t, graceEnd, *f.repo.keys[testKeyID].ExpiresAt)
+
+	require.Len(t, f.audit.Events, 2)
+	assert.Equal(t, domain.EventAPIKeyRotated, f.audit.Events[0].EventType)
+	assert.Equal(t, testKeyID, f.audit.Events[0].EntityID)
+	assert.Contains(t, string(f.audit.Events[0].Payload), string(testNewKeyID))
+	assert.Equal(t, domain.EventAPIKeyCreated, f.audit.Events[1].EventType)
+	assert.Equal(t, testNewKeyID, f.audit.Events[1].EntityID)
+}
+
+func TestRotateAPIKeyUseCase_Execute_KeepsEarlierExpiry(t *testing.T) {
+	old := testOldKey()
+	expiresAt := fixedNow.Add(10 * time.Minute)
+	old.ExpiresAt = &expiresAt
+	f := newRotateAPIKeyFixture(t, old)
+
+	resp, err := f.uc.Execute(adminCtx(), RotateAPIKeyRequest{ID: testKeyID, ActorID: "admin-id"})
+
+	require.NoError(t, err)
+	assert.Equal(t, expiresAt, *resp.Replaced.ExpiresAt)
+}
+
+func TestRotateAPIKeyUseCase_Execute_Errors(t *testing.T) {
+	revokedAt := fixedNow.Add(-time.Minute)
+	expiredAt := fixedNow.Add(-time.Minute)
+
+	tests := []struct {
+		name     string
+		nonAdmin bool
+		id       domain.ID
+		modify   func(*domain.APIKey)
+		wantCode string
+	}{
+		{name: "non-admin", nonAdmin: true, id: testKeyID, wantCode: app.CodeInsufficientPermissions},
+		{name: "not found", id: testNewKeyID, wantCode: app.CodeAPIKeyNotFound},
+		{name: "revoked", id: testKeyID, modify: func(k *domain.APIKey) { k.RevokedAt = &revokedAt }, wantCode: app.CodeValidationError},
+		{name: "expired", id: testKeyID, modify: func(k *domain.APIKey) { k.ExpiresAt = &expiredAt }, wantCode: app.CodeValidationError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := testOldKey()
+			if tt.modify != nil {
+				tt.modify(&old)
+			}
+			f := newRotateAPIKeyFixture(t, old)
+			ctx := adminCtx()
+			if tt.nonAdmin {
+				ctx = authCtx("user-id", app.RoleUser)
+			}
+
+			_, err := f.uc.Execute(ctx, RotateAPIKeyRequest{ID: tt.id, ActorID: "admin-id"})
+
+			requireAppError(t, err, tt.wantCode)
+			assert.Len(t, f.repo.keys, 1, "no key may be created")
+			assert.Empty(t, f.audit.Events)
+		})
+	}
+}
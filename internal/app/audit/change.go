@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// AuditChangeInput represents the input data for recording an update as a
+// before/after diff. Fields match AuditEventInput, with Before and After in
+// place of Payload.
+type AuditChangeInput struct {
+	// EventType describes what happened, in "entity.action" format.
+	// Use domain constants like domain.EventUserUpdated.
+	EventType string
+
+	// ActorID identifies who performed the action.
+	// Empty for system-initiated or unauthenticated operations.
+	ActorID domain.ID
+
+	// EntityType identifies the type of entity affected (e.g., "user", "order").
+	EntityType string
+
+	// EntityID identifies which specific entity was affected.
+	EntityID domain.ID
+
+	// Before and After are the entity before and after the change, usually
+	// two values of the same struct type. Both are marshaled to JSON, so
+	// fields are named by their JSON keys; they should marshal to objects.
+	Before any
+	After  any
+
+	// RequestID correlates this event with the originating HTTP request.
+	RequestID string
+}
+
+// ChangePayload is the payload recorded by RecordChange.
+type ChangePayload struct {
+	// Changes lists the changed fields, sorted by Field.
+	Changes []FieldChange `json:"changes"`
+}
+
+// FieldChange is one changed field of a ChangePayload. Field is the JSON key,
+// with nested object keys joined by "."; Old and New are null when the field
+// was absent. PII fields carry their redacted values, so with full redaction a
+// changed email shows up as changed from "[REDACTED]" to "[REDACTED]".
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+// RecordChange persists an audit event whose payload is the diff between
+// input.Before and input.After (a ChangePayload) within the provided
+// transaction. Fields are compared before redaction and reported with their
+// redacted values, so a change to a PII field is recorded without its value.
+// An update that changed nothing is recorded with an empty Changes list.
+func (s *AuditService) RecordChange(ctx context.Context, q domain.Querier, input AuditChangeInput) error {
+	op := "AuditService.RecordChange"
+
+	before, err := toJSONValue(input.Before)
+	if err != nil {
+		return &app.AppError{Op: op, Code: app.CodeInternalError, Message: "Failed to marshal before value", Err: err}
+	}
+	after, err := toJSONValue(input.After)
+	if err != nil {
+		return &app.AppError{Op: op, Code: app.CodeInternalError, Message: "Failed to marshal after value", Err: err}
+	}
+
+	changes := diffJSON(nil, before, after, s.redactor.Redact(before), s.redactor.Redact(after), []FieldChange{})
+	slices.SortFunc(changes, func(a, b FieldChange) int { return strings.Compare(a.Field, b.Field) })
+
+	payload, err := json.Marshal(ChangePayload{Changes: changes})
+	if err != nil {
+		return &app.AppError{Op: op, Code: app.CodeInternalError, Message: "Failed to marshal payload", Err: err}
+	}
+
+	return s.store(ctx, q, op, AuditEventInput{
+		EventType:  input.EventType,
+		ActorID:    input.ActorID,
+		EntityType: input.EntityType,
+		EntityID:   input.EntityID,
+		RequestID:  input.RequestID,
+	}, payload)
+}
+
+// toJSONValue converts v to its generic JSON form (map[string]any, []any,
+// string, float64, bool or nil), the form the redactor understands.
+func toJSONValue(v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// diffJSON appends the changes between before and after at path to changes.
+// Objects are compared key by key; any other change, including one inside an
+// array, is reported for the value as a whole. redBefore and redAfter are the
+// values after redaction, and supply the reported values: a PII key's object
+// is redacted as a whole, so it is only descended into while the redacted
+// values are objects too.
+func diffJSON(path []string, before, after, redBefore, redAfter any, changes []FieldChange) []FieldChange {
+	oldObj, ok1 := before.(map[string]any)
+	newObj, ok2 := after.(map[string]any)
+	redOldObj, ok3 := redBefore.(map[string]any)
+	redNewObj, ok4 := redAfter.(map[string]any)
+	if ok1 && ok2 && ok3 && ok4 {
+		keys := make(map[string]struct{}, len(oldObj)+len(newObj))
+		for k := range oldObj {
+			keys[k] = struct{}{}
+		}
+		for k := range newObj {
+			keys[k] = struct{}{}
+		}
+		for k := range keys {
+			changes = diffJSON(append(path, k), oldObj[k], newObj[k], redOldObj[k], redNewObj[k], changes)
+		}
+		return changes
+	}
+
+	if reflect.DeepEqual(before, after) {
+		return changes
+	}
+	return append(changes, FieldChange{Field: strings.Join(path, "."), Old: redBefore, New: redAfter})
+}
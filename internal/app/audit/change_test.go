@@ -0,0 +1,175 @@
+//go:build !integration
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/redact"
+)
+
+type changeTestProfile struct {
+	Email   string            `json:"email"`
+	Name    string            `json:"name"`
+	Address map[string]string `json:"address,omitempty"`
+	Tags    []string          `json:"tags,omitempty"`
+}
+
+func recordChangePayload(t *testing.T, redactor domain.Redactor, before, after any) ChangePayload {
+	t.Helper()
+	repo := newMockAuditEventRepository()
+	svc := NewAuditService(repo, redactor, newMockIDGenerator())
+
+	err := svc.RecordChange(context.Background(), &mockQuerier{}, AuditChangeInput{
+		EventType:  domain.EventUserUpdated,
+		ActorID:    "actor-1",
+		EntityType: "user",
+		EntityID:   "user-1",
+		Before:     before,
+		After:      after,
+		RequestID:  "req-1",
+	})
+	require.NoError(t, err)
+	require.Len(t, repo.events, 1)
+
+	event := repo.events[0]
+	assert.Equal(t, domain.ID("audit-1"), event.ID)
+	assert.Equal(t, domain.EventUserUpdated, event.EventType)
+	assert.Equal(t, domain.ID("actor-1"), event.ActorID)
+	assert.Equal(t, domain.ID("user-1"), event.EntityID)
+	assert.Equal(t, "req-1", event.RequestID)
+
+	var payload ChangePayload
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	return payload
+}
+
+func TestAuditService_RecordChange(t *testing.T) {
+	base := changeTestProfile{
+		Email:   "old@example.com",
+		Name:    "Sarah",
+		Address: map[string]string{"city": "Jakarta", "zip": "10110"},
+		Tags:    []string{"a"},
+	}
+
+	tests := []struct {
+		name   string
+		change func(*changeTestProfile)
+		want   []FieldChange
+	}{
+		{
+			name:   "changed field",
+			change: func(p *changeTestProfile) { p.Name = "Sara" },
+			want:   []FieldChange{{Field: "name", Old: "Sarah", New: "Sara"}},
+		},
+		{
+			name:   "nested field",
+			change: func(p *changeTestProfile) { p.Address = map[string]string{"city": "Bandung", "zip": "10110"} },
+			want:   []FieldChange{{Field: "address.city", Old: "Jakarta", New: "Bandung"}},
+		},
+		{
+			name:   "added and removed fields",
+			change: func(p *changeTestProfile) { p.Address = map[string]string{"city": "Jakarta", "street": "Jl. Sudirman"} },
+			want: []FieldChange{
+				{Field: "address.street", Old: nil, New: "Jl. Sudirman"},
+				{Field: "address.zip", Old: "10110", New: nil},
+			},
+		},
+		{
+			name:   "array changed as a whole",
+			change: func(p *changeTestProfile) { p.Tags = []string{"a", "b"} },
+			want:   []FieldChange{{Field: "tags", Old: []any{"a"}, New: []any{"a", "b"}}},
+		},
+		{
+			name: "changes sorted by field",
+			change: func(p *changeTestProfile) {
+				p.Name = "Sara"
+				p.Address = map[string]string{"city": "Bandung", "zip": "10110"}
+			},
+			want: []FieldChange{
+				{Field: "address.city", Old: "Jakarta", New: "Bandung"},
+				{Field: "name", Old: "Sarah", New: "Sara"},
+			},
+		},
+		{
+			name:   "nothing changed",
+			change: func(*changeTestProfile) {},
+			want:   []FieldChange{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := base
+			tt.change(&after)
+
+			payload := recordChangePayload(t, newMockRedactor(), base, after)
+
+			assert.Equal(t, tt.want, payload.Changes)
+		})
+	}
+}
+
+func TestAuditService_RecordChange_RedactsPII(t *testing.T) {
+	redactor := redact.NewPIIRedactor(domain.RedactorConfig{EmailMode: domain.EmailModeFull})
+	before := changeTestProfile{Email: "old@example.com", Name: "Sarah"}
+	after := changeTestProfile{Email: "new@example.com", Name: "Sarah"}
+
+	payload := recordChangePayload(t, redactor, before, after)
+
+	// The change is recorded, the values are not.
+	assert.Equal(t, []FieldChange{
+		{Field: "email", Old: redact.RedactedValue, New: redact.RedactedValue},
+	}, payload.Changes)
+}
+
+func TestAuditService_RecordChange_Errors(t *testing.T) {
+	t.Run("unmarshalable value", func(t *testing.T) {
+		repo := newMockAuditEventRepository()
+		svc := NewAuditService(repo, newMockRedactor(), newMockIDGenerator())
+
+		err := svc.RecordChange(context.Background(), &mockQuerier{}, AuditChangeInput{
+			EventType:  domain.EventUserUpdated,
+			EntityType: "user",
+			EntityID:   "user-1",
+			Before:     map[string]any{"bad": make(chan int)},
+			After:      map[string]any{},
+		})
+
+		requireAppError(t, err, app.CodeInternalError)
+		assert.Empty(t, repo.events)
+	})
+
+	t.Run("invalid event", func(t *testing.T) {
+		svc := NewAuditService(newMockAuditEventRepository(), newMockRedactor(), newMockIDGenerator())
+
+		err := svc.RecordChange(context.Background(), &mockQuerier{}, AuditChangeInput{
+			EventType: domain.EventUserUpdated,
+			EntityID:  "user-1",
+		})
+
+		requireAppError(t, err, app.CodeValidationError)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := newMockAuditEventRepository()
+		repo.createError = errors.New("database error")
+		svc := NewAuditService(repo, newMockRedactor(), newMockIDGenerator())
+
+		err := svc.RecordChange(context.Background(), &mockQuerier{}, AuditChangeInput{
+			EventType:  domain.EventUserUpdated,
+			EntityType: "user",
+			EntityID:   "user-1",
+		})
+
+		requireAppError(t, err, app.CodeInternalError)
+	})
+}
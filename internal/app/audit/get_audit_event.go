@@ -0,0 +1,76 @@
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// GetAuditEventRequest represents the input data for getting an audit event by ID.
+type GetAuditEventRequest struct {
+	ID domain.ID
+}
+
+// GetAuditEventResponse represents the result of getting an audit event.
+type GetAuditEventResponse struct {
+	Event domain.AuditEvent
+}
+
+// OpGetAuditEvent is the operation name for GetAuditEvent use case.
+const OpGetAuditEvent = "GetAuditEvent"
+
+// GetAuditEventUseCase retrieves a single audit event. Requires audit:read.
+type GetAuditEventUseCase struct {
+	repo  domain.AuditEventRepository
+	db    domain.Querier
+	authz app.Authorizer
+	log   *logger.Logger
+}
+
+// NewGetAuditEventUseCase creates a new instance of GetAuditEventUseCase.
+func NewGetAuditEventUseCase(repo domain.AuditEventRepository, db domain.Querier, authz app.Authorizer, log *logger.Logger) *GetAuditEventUseCase {
+	return &GetAuditEventUseCase{
+		repo:  repo,
+		db:    db,
+		authz: authz,
+		log:   log.With("usecase", OpGetAuditEvent),
+	}
+}
+
+// Execute retrieves an audit event by ID.
+// Returns AppError with Code=INSUFFICIENT_PERMISSIONS without audit:read,
+// and Code=AUDIT_NOT_FOUND if the event doesn't exist.
+func (uc *GetAuditEventUseCase) Execute(ctx context.Context, req GetAuditEventRequest) (GetAuditEventResponse, error) {
+	if _, err := app.RequirePermission(ctx, uc.authz, OpGetAuditEvent, app.PermAuditRead, eventResource(req.ID)); err != nil {
+		return GetAuditEventResponse{}, err
+	}
+
+	event, err := uc.repo.GetByID(ctx, uc.db, req.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAuditEventNotFound) {
+			return GetAuditEventResponse{}, &app.AppError{
+				Op:      OpGetAuditEvent,
+				Code:    app.CodeAuditEventNotFound,
+				Message: "Audit event not found",
+				Err:     err,
+			}
+		}
+		return GetAuditEventResponse{}, &app.AppError{
+			Op:      OpGetAuditEvent,
+			Code:    app.CodeInternalError,
+			Message: "Failed to get audit event",
+			Err:     err,
+		}
+	}
+
+	return GetAuditEventResponse{Event: *event}, nil
+}
+
+// eventResource returns the authorization resource for the audit event with
+// id; an empty id stands for the audit log.
+func eventResource(id domain.ID) app.Resource {
+	return app.Resource{Type: "audit_event", ID: string(id)}
+}
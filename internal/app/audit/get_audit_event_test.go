@@ -0,0 +1,87 @@
+//go:build !integration
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+const testEventID = domain.ID("0195f0c4-0000-7000-8000-0000000000a1")
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func authCtx(subjectID, role string) context.Context {
+	return app.SetAuthContext(context.Background(), &app.AuthContext{
+		SubjectID: subjectID,
+		Role:      role,
+	})
+}
+
+func adminCtx() context.Context {
+	return authCtx("admin-id", app.RoleAdmin)
+}
+
+// requireAppError asserts that err is an AppError with the given code.
+func requireAppError(t *testing.T, err error, code string) {
+	t.Helper()
+	var appErr *app.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, code, appErr.Code)
+}
+
+func TestGetAuditEventUseCase_Execute(t *testing.T) {
+	repo := newMockAuditEventRepository()
+	repo.events = append(repo.events, &domain.AuditEvent{
+		ID:        testEventID,
+		EventType: domain.EventUserCreated,
+		Payload:   []byte(`{"email":"[REDACTED]"}`),
+		Timestamp: time.Date(2026, 1, 14, 9, 0, 0, 0, time.UTC),
+	})
+	uc := NewGetAuditEventUseCase(repo, &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+	resp, err := uc.Execute(adminCtx(), GetAuditEventRequest{ID: testEventID})
+
+	require.NoError(t, err)
+	assert.Equal(t, testEventID, resp.Event.ID)
+	assert.Equal(t, domain.EventUserCreated, resp.Event.EventType)
+}
+
+func TestGetAuditEventUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		getError error
+		wantCode string
+	}{
+		{name: "non-admin", ctx: authCtx("user-id", app.RoleUser), wantCode: app.CodeInsufficientPermissions},
+		{name: "no auth context", ctx: context.Background(), wantCode: app.CodeForbidden},
+		{name: "not found", ctx: adminCtx(), wantCode: app.CodeAuditEventNotFound},
+		{name: "repository error", ctx: adminCtx(), getError: errors.New("db down"), wantCode: app.CodeInternalError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockAuditEventRepository()
+			repo.getError = tt.getError
+			uc := NewGetAuditEventUseCase(repo, &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+			_, err := uc.Execute(tt.ctx, GetAuditEventRequest{ID: testEventID})
+
+			requireAppError(t, err, tt.wantCode)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// ListAuditEventsRequest represents the input data for listing audit events.
+// Without a Cursor the list starts at the newest matching event. A Cursor is
+// only valid with the same Filter as the page that issued it.
+type ListAuditEventsRequest struct {
+	Filter   domain.AuditEventFilter
+	PageSize int
+	Cursor   *domain.Cursor
+}
+
+// ListAuditEventsResponse represents one page of audit events, newest first.
+// NextCursor and PrevCursor are nil when there is no page in that direction.
+type ListAuditEventsResponse struct {
+	Events     []domain.AuditEvent
+	PageSize   int
+	NextCursor *domain.Cursor
+	PrevCursor *domain.Cursor
+}
+
+// OpListAuditEvents is the operation name for ListAuditEvents use case.
+const OpListAuditEvents = "ListAuditEvents"
+
+// ListAuditEventsUseCase lists audit events by filter. Requires audit:read.
+// Lists are paginated by cursor only: the audit log grows without bound, so
+// neither offsets nor total counts are offered.
+type ListAuditEventsUseCase struct {
+	repo  domain.AuditEventRepository
+	db    domain.Querier
+	authz app.Authorizer
+	log   *logger.Logger
+}
+
+// NewListAuditEventsUseCase creates a new instance of ListAuditEventsUseCase.
+func NewListAuditEventsUseCase(repo domain.AuditEventRepository, db domain.Querier, authz app.Authorizer, log *logger.Logger) *ListAuditEventsUseCase {
+	return &ListAuditEventsUseCase{
+		repo:  repo,
+		db:    db,
+		authz: authz,
+		log:   log.With("usecase", OpListAuditEvents),
+	}
+}
+
+// Execute returns one page of the events matching req.Filter.
+// Returns AppError with Code=INSUFFICIENT_PERMISSIONS without audit:read.
+func (uc *ListAuditEventsUseCase) Execute(ctx context.Context, req ListAuditEventsRequest) (ListAuditEventsResponse, error) {
+	if _, err := app.RequirePermission(ctx, uc.authz, OpListAuditEvents, app.PermAuditRead, eventResource("")); err != nil {
+		return ListAuditEventsResponse{}, err
+	}
+
+	params := domain.ListParams{PageSize: req.PageSize, Cursor: req.Cursor}
+	events, err := uc.repo.List(ctx, uc.db, req.Filter, params)
+	if err != nil {
+		return ListAuditEventsResponse{}, &app.AppError{
+			Op:      OpListAuditEvents,
+			Code:    app.CodeInternalError,
+			Message: "Failed to list audit events",
+			Err:     err,
+		}
+	}
+
+	var hasPrev, hasNext bool
+	switch {
+	case !params.IsKeyset():
+		// The first page: the repository probed one event beyond it.
+		if len(events) > params.Limit() {
+			events, hasNext = events[:params.Limit()], true
+		}
+	case params.Cursor.Backward:
+		events, hasPrev = domain.TrimKeysetPage(events, params)
+		hasNext = true
+	default:
+		events, hasNext = domain.TrimKeysetPage(events, params)
+		hasPrev = true
+	}
+
+	resp := ListAuditEventsResponse{Events: events, PageSize: params.Limit()}
+	if len(events) > 0 {
+		if hasPrev {
+			first := events[0]
+			resp.PrevCursor = &domain.Cursor{Time: first.Timestamp, ID: first.ID, Backward: true}
+		}
+		if hasNext {
+			last := events[len(events)-1]
+			resp.NextCursor = &domain.Cursor{Time: last.Timestamp, ID: last.ID}
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,118 @@
+//go:build !integration
+
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+// testEvents returns n events in list order (newest first).
+func testEvents(n int) []domain.AuditEvent {
+	base := time.Date(2026, 1, 14, 9, 0, 0, 0, time.UTC)
+	events := make([]domain.AuditEvent, n)
+	for i := range events {
+		events[i] = domain.AuditEvent{
+			ID:        domain.ID(fmt.Sprintf("event-%d", i)),
+			EventType: domain.EventUserUpdated,
+			Timestamp: base.Add(-time.Duration(i) * time.Minute),
+		}
+	}
+	return events
+}
+
+func TestListAuditEventsUseCase_Execute(t *testing.T) {
+	events := testEvents(3)
+
+	tests := []struct {
+		name     string
+		cursor   *domain.Cursor
+		result   []domain.AuditEvent
+		wantIDs  []domain.ID
+		wantNext *domain.Cursor
+		wantPrev *domain.Cursor
+	}{
+		{
+			name:     "first page with more events",
+			result:   events,
+			wantIDs:  []domain.ID{"event-0", "event-1"},
+			wantNext: &domain.Cursor{Time: events[1].Timestamp, ID: "event-1"},
+		},
+		{
+			name:    "only page",
+			result:  events[:2],
+			wantIDs: []domain.ID{"event-0", "event-1"},
+		},
+		{
+			name:     "forward cursor on the last page",
+			cursor:   &domain.Cursor{Time: events[0].Timestamp, ID: "event-0"},
+			result:   events[1:],
+			wantIDs:  []domain.ID{"event-1", "event-2"},
+			wantPrev: &domain.Cursor{Time: events[1].Timestamp, ID: "event-1", Backward: true},
+		},
+		{
+			name:     "backward cursor on the first page",
+			cursor:   &domain.Cursor{Time: events[2].Timestamp, ID: "event-2", Backward: true},
+			result:   events[:2],
+			wantIDs:  []domain.ID{"event-0", "event-1"},
+			wantNext: &domain.Cursor{Time: events[1].Timestamp, ID: "event-1"},
+		},
+		{
+			name:    "empty",
+			result:  []domain.AuditEvent{},
+			wantIDs: []domain.ID{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockAuditEventRepository()
+			repo.listResult = tt.result
+			uc := NewListAuditEventsUseCase(repo, &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+			filter := domain.AuditEventFilter{EventType: "user.*"}
+
+			resp, err := uc.Execute(adminCtx(), ListAuditEventsRequest{Filter: filter, PageSize: 2, Cursor: tt.cursor})
+
+			require.NoError(t, err)
+			ids := make([]domain.ID, 0, len(resp.Events))
+			for _, e := range resp.Events {
+				ids = append(ids, e.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantNext, resp.NextCursor)
+			assert.Equal(t, tt.wantPrev, resp.PrevCursor)
+			assert.Equal(t, 2, resp.PageSize)
+			assert.Equal(t, filter, repo.listFilter)
+			assert.Equal(t, domain.ListParams{PageSize: 2, Cursor: tt.cursor}, repo.listParams)
+		})
+	}
+}
+
+func TestListAuditEventsUseCase_Execute_Errors(t *testing.T) {
+	t.Run("non-admin", func(t *testing.T) {
+		uc := NewListAuditEventsUseCase(newMockAuditEventRepository(), &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+		_, err := uc.Execute(authCtx("user-id", app.RoleUser), ListAuditEventsRequest{})
+
+		requireAppError(t, err, app.CodeInsufficientPermissions)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := newMockAuditEventRepository()
+		repo.listError = errors.New("db down")
+		uc := NewListAuditEventsUseCase(repo, &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+		_, err := uc.Execute(adminCtx(), ListAuditEventsRequest{})
+
+		requireAppError(t, err, app.CodeInternalError)
+	})
+}
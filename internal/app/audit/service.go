@@ -7,8 +7,15 @@
 //
 // The audit package provides the AuditService which handles:
 //   - Recording audit events with automatic PII redaction
+//   - Recording updates as before/after diffs (RecordChange)
 //   - Querying audit events by entity
 //
+// GetAuditEventUseCase and ListAuditEventsUseCase serve the admin audit log
+// endpoints, filtering events by entity, actor, event type, request and time.
+//
+// The repository appends every recorded event to its tenant's hash chain;
+// VerifyAuditChainUseCase walks the chain and reports the first broken link.
+//
 // # Adding Audit Events to a New Module
 //
 // Follow these steps to integrate audit events into your module:
@@ -159,6 +166,12 @@ func (s *AuditService) Record(ctx context.Context, q domain.Querier, input Audit
 		}
 	}
 
+	return s.store(ctx, q, op, input, payload)
+}
+
+// store validates and persists an event with its final, redacted payload.
+// input.Payload is ignored.
+func (s *AuditService) store(ctx context.Context, q domain.Querier, op string, input AuditEventInput, payload []byte) error {
 	// Create domain event (requestID and actorID come from input, not context)
 	event := &domain.AuditEvent{
 		ID:         s.idGen.NewID(),
@@ -196,6 +209,7 @@ func (s *AuditService) Record(ctx context.Context, q domain.Querier, input Audit
 
 // ListByEntity retrieves audit events for a specific entity.
 // Results are ordered by timestamp DESC (newest first).
+// Accepts the same keyset cursors as user listings via params.Cursor.
 func (s *AuditService) ListByEntity(
 	ctx context.Context,
 	q domain.Querier,
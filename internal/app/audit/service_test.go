@@ -83,6 +83,13 @@ type mockAuditEventRepository struct {
 	listResult  []domain.AuditEvent
 	listCount   int
 	listError   error
+	getError    error
+	chainError  error
+	anchor      *domain.AuditChainAnchor
+	anchorError error
+	// listFilter and listParams hold the arguments of the last List call.
+	listFilter domain.AuditEventFilter
+	listParams domain.ListParams
 }
 
 func newMockAuditEventRepository() *mockAuditEventRepository {
@@ -116,6 +123,44 @@ func (m *mockAuditEventRepository) ListByEntityID(_ context.Context, _ domain.Qu
 	return result, len(result), nil
 }
 
+func (m *mockAuditEventRepository) GetByID(_ context.Context, _ domain.Querier, id domain.ID) (*domain.AuditEvent, error) {
+	if m.getError != nil {
+		return nil, m.getError
+	}
+	for _, e := range m.events {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return nil, domain.ErrAuditEventNotFound
+}
+
+func (m *mockAuditEventRepository) List(_ context.Context, _ domain.Querier, filter domain.AuditEventFilter, params domain.ListParams) ([]domain.AuditEvent, error) {
+	m.listFilter, m.listParams = filter, params
+	if m.listError != nil {
+		return nil, m.listError
+	}
+	return m.listResult, nil
+}
+
+func (m *mockAuditEventRepository) ListChain(_ context.Context, _ domain.Querier, afterSeq int64, limit int) ([]domain.AuditEvent, error) {
+	if m.chainError != nil {
+		return nil, m.chainError
+	}
+	// m.events are kept in chain order.
+	result := []domain.AuditEvent{}
+	for _, e := range m.events {
+		if e.ChainSeq > afterSeq && len(result) < limit {
+			result = append(result, *e)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockAuditEventRepository) GetChainAnchor(_ context.Context, _ domain.Querier) (*domain.AuditChainAnchor, error) {
+	return m.anchor, m.anchorError
+}
+
 // -- Tests --
 
 func TestNewAuditService(t *testing.T) {
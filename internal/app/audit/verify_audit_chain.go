@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// verifyBatchSize is the number of events read per query while walking the chain.
+const verifyBatchSize = 1000
+
+// VerifyAuditChainRequest represents the input for verifying the audit log.
+// The chain of the caller's tenant is verified.
+type VerifyAuditChainRequest struct{}
+
+// VerifyAuditChainResponse represents the result of walking the hash chain.
+// AnchorSeq is the position verification started after: the last event
+// removed by retention, or 0. HeadSeq and HeadHash identify the last event
+// that verified (the anchor if none did); Break is nil when the whole chain
+// verified.
+type VerifyAuditChainResponse struct {
+	AnchorSeq int64
+	HeadSeq   int64
+	HeadHash  []byte
+	Break     *domain.AuditChainBreak
+}
+
+// OpVerifyAuditChain is the operation name for VerifyAuditChain use case.
+const OpVerifyAuditChain = "VerifyAuditChain"
+
+// VerifyAuditChainUseCase walks the audit log hash chain from its first event,
+// or from its anchor once retention removed older events, and reports the
+// first broken link. Requires audit:read.
+type VerifyAuditChainUseCase struct {
+	repo  domain.AuditEventRepository
+	db    domain.Querier
+	authz app.Authorizer
+	log   *logger.Logger
+}
+
+// NewVerifyAuditChainUseCase creates a new instance of VerifyAuditChainUseCase.
+func NewVerifyAuditChainUseCase(repo domain.AuditEventRepository, db domain.Querier, authz app.Authorizer, log *logger.Logger) *VerifyAuditChainUseCase {
+	return &VerifyAuditChainUseCase{
+		repo:  repo,
+		db:    db,
+		authz: authz,
+		log:   log.With("usecase", OpVerifyAuditChain),
+	}
+}
+
+// Execute verifies every chained event, oldest first. Events appended while
+// the walk runs are verified too.
+// Returns AppError with Code=INSUFFICIENT_PERMISSIONS without audit:read.
+// A broken chain is a result, not an error.
+func (uc *VerifyAuditChainUseCase) Execute(ctx context.Context, _ VerifyAuditChainRequest) (VerifyAuditChainResponse, error) {
+	if _, err := app.RequirePermission(ctx, uc.authz, OpVerifyAuditChain, app.PermAuditRead, eventResource("")); err != nil {
+		return VerifyAuditChainResponse{}, err
+	}
+
+	anchor, err := uc.repo.GetChainAnchor(ctx, uc.db)
+	if err != nil {
+		return VerifyAuditChainResponse{}, &app.AppError{
+			Op:      OpVerifyAuditChain,
+			Code:    app.CodeInternalError,
+			Message: "Failed to read audit chain anchor",
+			Err:     err,
+		}
+	}
+
+	verifier := domain.NewAuditChainVerifier(anchor)
+	anchorSeq := verifier.Seq()
+	var brk *domain.AuditChainBreak
+	for brk == nil {
+		events, err := uc.repo.ListChain(ctx, uc.db, verifier.Seq(), verifyBatchSize)
+		if err != nil {
+			return VerifyAuditChainResponse{}, &app.AppError{
+				Op:      OpVerifyAuditChain,
+				Code:    app.CodeInternalError,
+				Message: "Failed to read audit events",
+				Err:     err,
+			}
+		}
+		for _, e := range events {
+			if brk = verifier.Check(e); brk != nil {
+				break
+			}
+		}
+		if len(events) < verifyBatchSize {
+			break
+		}
+	}
+
+	if brk != nil {
+		logger.FromContext(ctx, uc.log).WarnContext(ctx, "audit chain broken",
+			"seq", brk.Seq,
+			"eventId", brk.EventID,
+			"reason", brk.Reason,
+		)
+	}
+
+	return VerifyAuditChainResponse{
+		AnchorSeq: anchorSeq,
+		HeadSeq:   verifier.Seq(),
+		HeadHash:  verifier.Hash(),
+		Break:     brk,
+	}, nil
+}
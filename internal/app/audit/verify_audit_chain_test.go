@@ -0,0 +1,142 @@
+//go:build !integration
+
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+// chainedRepo returns a repository holding a valid chain of n events.
+func chainedRepo(n int) *mockAuditEventRepository {
+	repo := newMockAuditEventRepository()
+	base := time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC)
+	prev := domain.AuditChainGenesis()
+	for i := range n {
+		e := &domain.AuditEvent{
+			ID:         domain.ID(fmt.Sprintf("event-%d", i+1)),
+			EventType:  domain.EventUserCreated,
+			EntityType: "user",
+			EntityID:   "user-1",
+			Payload:    []byte(`{}`),
+			Timestamp:  base.Add(time.Duration(i) * time.Millisecond),
+			ChainSeq:   int64(i + 1),
+			PrevHash:   prev,
+		}
+		e.Hash = e.ChainHash()
+		prev = e.Hash
+		repo.events = append(repo.events, e)
+	}
+	return repo
+}
+
+func TestVerifyAuditChainUseCase_Execute(t *testing.T) {
+	t.Run("valid chain across batches", func(t *testing.T) {
+		n := 2*verifyBatchSize + 1
+		repo := chainedRepo(n)
+		uc := NewVerifyAuditChainUseCase(repo, &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+		resp, err := uc.Execute(adminCtx(), VerifyAuditChainRequest{})
+
+		require.NoError(t, err)
+		assert.Nil(t, resp.Break)
+		assert.Equal(t, int64(n), resp.HeadSeq)
+		assert.Equal(t, repo.events[n-1].Hash, resp.HeadHash)
+	})
+
+	t.Run("empty chain", func(t *testing.T) {
+		uc := NewVerifyAuditChainUseCase(newMockAuditEventRepository(), &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+		resp, err := uc.Execute(adminCtx(), VerifyAuditChainRequest{})
+
+		require.NoError(t, err)
+		assert.Nil(t, resp.Break)
+		assert.Zero(t, resp.HeadSeq)
+		assert.Nil(t, resp.HeadHash)
+	})
+
+	t.Run("chain with anchor", func(t *testing.T) {
+		repo := chainedRepo(5)
+		repo.anchor = &domain.AuditChainAnchor{Seq: repo.events[1].ChainSeq, Hash: repo.events[1].Hash}
+		repo.events = repo.events[2:] // removed by retention
+		uc := NewVerifyAuditChainUseCase(repo, &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+		resp, err := uc.Execute(adminCtx(), VerifyAuditChainRequest{})
+
+		require.NoError(t, err)
+		assert.Nil(t, resp.Break)
+		assert.Equal(t, int64(2), resp.AnchorSeq)
+		assert.Equal(t, int64(5), resp.HeadSeq)
+	})
+
+	t.Run("anchor without later events", func(t *testing.T) {
+		repo := newMockAuditEventRepository()
+		repo.anchor = &domain.AuditChainAnchor{Seq: 7, Hash: domain.AuditChainGenesis()}
+		uc := NewVerifyAuditChainUseCase(repo, &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+		resp, err := uc.Execute(adminCtx(), VerifyAuditChainRequest{})
+
+		require.NoError(t, err)
+		assert.Nil(t, resp.Break)
+		assert.Equal(t, int64(7), resp.AnchorSeq)
+		assert.Equal(t, int64(7), resp.HeadSeq)
+		assert.Equal(t, repo.anchor.Hash, resp.HeadHash)
+	})
+
+	t.Run("tampered event in a later batch", func(t *testing.T) {
+		repo := chainedRepo(verifyBatchSize + 10)
+		tampered := repo.events[verifyBatchSize+4]
+		tampered.EventType = domain.EventUserDeleted
+		uc := NewVerifyAuditChainUseCase(repo, &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+		resp, err := uc.Execute(adminCtx(), VerifyAuditChainRequest{})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp.Break)
+		assert.Equal(t, domain.AuditChainBreak{
+			Seq:     tampered.ChainSeq,
+			EventID: tampered.ID,
+			Reason:  domain.AuditChainHashMismatch,
+		}, *resp.Break)
+		assert.Equal(t, tampered.ChainSeq-1, resp.HeadSeq)
+	})
+}
+
+func TestVerifyAuditChainUseCase_Execute_Errors(t *testing.T) {
+	t.Run("non-admin", func(t *testing.T) {
+		uc := NewVerifyAuditChainUseCase(chainedRepo(1), &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+		_, err := uc.Execute(authCtx("user-id", app.RoleUser), VerifyAuditChainRequest{})
+
+		requireAppError(t, err, app.CodeInsufficientPermissions)
+	})
+
+	t.Run("anchor error", func(t *testing.T) {
+		repo := chainedRepo(1)
+		repo.anchorError = errors.New("db down")
+		uc := NewVerifyAuditChainUseCase(repo, &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+		_, err := uc.Execute(adminCtx(), VerifyAuditChainRequest{})
+
+		requireAppError(t, err, app.CodeInternalError)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := chainedRepo(1)
+		repo.chainError = errors.New("db down")
+		uc := NewVerifyAuditChainUseCase(repo, &mockQuerier{}, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+		_, err := uc.Execute(adminCtx(), VerifyAuditChainRequest{})
+
+		requireAppError(t, err, app.CodeInternalError)
+	})
+}
@@ -22,6 +22,12 @@ var ErrNoAuthContext = errors.New("no authentication context")
 type AuthContext struct {
 	SubjectID string // From claims.Subject (user ID)
 	Role      string // Role for authorization
+	// Scopes granted by the token's scope claims. Nil means the credentials
+	// carry no scope claim (see Unscoped); they are then granted no scope
+	// unless route-level scope checks are configured to allow them.
+	Scopes []string
+	// TenantID is the tenant the caller belongs to; data access is scoped to it.
+	TenantID string
 }
 
 // authContextKey is the unexported type for the context key to prevent collisions.
@@ -55,6 +61,27 @@ func (ac *AuthContext) HasRole(role string) bool {
 	return ac != nil && ac.Role == role
 }
 
+// HasScope checks if the auth context is granted the specified scope.
+// Credentials without scope claims are granted no scope.
+func (ac *AuthContext) HasScope(scope string) bool {
+	if ac == nil {
+		return false
+	}
+	for _, granted := range ac.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Unscoped reports whether the credentials carry no scope claim at all, such
+// as API keys and tokens issued without scope or scp. An empty scope claim is
+// not unscoped.
+func (ac *AuthContext) Unscoped() bool {
+	return ac != nil && ac.Scopes == nil
+}
+
 // IsAdmin checks if the auth context has admin role.
 func (ac *AuthContext) IsAdmin() bool {
 	return ac.HasRole(RoleAdmin)
@@ -209,3 +209,52 @@ func TestAuthContext_IsUser(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthContext_HasScope(t *testing.T) {
+	tests := []struct {
+		name     string
+		authCtx  *AuthContext
+		expected bool
+	}{
+		{
+			name:     "nil auth context returns false",
+			authCtx:  nil,
+			expected: false,
+		},
+		{
+			name:     "no scope claims grant nothing",
+			authCtx:  &AuthContext{SubjectID: "user-123", Role: RoleUser},
+			expected: false,
+		},
+		{
+			name:     "granted scope returns true",
+			authCtx:  &AuthContext{SubjectID: "user-123", Role: RoleUser, Scopes: []string{"jobs:read", "users:read"}},
+			expected: true,
+		},
+		{
+			name:     "missing scope returns false",
+			authCtx:  &AuthContext{SubjectID: "user-123", Role: RoleUser, Scopes: []string{"jobs:read"}},
+			expected: false,
+		},
+		{
+			name:     "empty scope claims grant nothing",
+			authCtx:  &AuthContext{SubjectID: "user-123", Role: RoleUser, Scopes: []string{}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.authCtx.HasScope("users:read")
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestAuthContext_Unscoped(t *testing.T) {
+	var nilCtx *AuthContext
+	assert.False(t, nilCtx.Unscoped())
+	assert.True(t, (&AuthContext{SubjectID: "user-123"}).Unscoped())
+	assert.False(t, (&AuthContext{SubjectID: "user-123", Scopes: []string{}}).Unscoped(), "an empty scope claim is a claim")
+	assert.False(t, (&AuthContext{SubjectID: "user-123", Scopes: []string{"users:read"}}).Unscoped())
+}
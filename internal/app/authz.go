@@ -0,0 +1,285 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// Permission names an action on a kind of resource, as "<resource>:<action>".
+// A permission with the ":self" suffix grants the action only on resources
+// owned by the actor, e.g. users:read:self lets users read their own profile.
+type Permission string
+
+// selfSuffix marks a permission restricted to the actor's own resources.
+const selfSuffix = ":self"
+
+// Permission registry. Use cases authorize against these; role mappings may
+// only grant registered permissions.
+const (
+	PermUsersRead         Permission = "users:read"
+	PermUsersReadSelf     Permission = "users:read:self"
+	PermUsersWrite        Permission = "users:write"
+	PermUsersWriteSelf    Permission = "users:write:self"
+	PermUsersRestore      Permission = "users:restore"
+	PermUsersPurge        Permission = "users:purge"
+	PermUsersImport       Permission = "users:import"
+	PermUsersExport       Permission = "users:export"
+	PermJobsRead          Permission = "jobs:read"
+	PermJobsReadSelf      Permission = "jobs:read:self"
+	PermAuditRead         Permission = "audit:read"
+	PermTokensRevoke      Permission = "tokens:revoke"
+	PermOAuthClientsWrite Permission = "oauth_clients:write"
+	PermAPIKeysRead       Permission = "api_keys:read"
+	PermAPIKeysWrite      Permission = "api_keys:write"
+)
+
+// registeredPermissions lists every known permission.
+var registeredPermissions = []Permission{
+	PermUsersRead, PermUsersReadSelf, PermUsersWrite, PermUsersWriteSelf,
+	PermUsersRestore, PermUsersPurge, PermUsersImport, PermUsersExport,
+	PermJobsRead, PermJobsReadSelf,
+	PermAuditRead,
+	PermTokensRevoke,
+	PermOAuthClientsWrite,
+	PermAPIKeysRead, PermAPIKeysWrite,
+}
+
+// Permissions returns all registered permissions.
+func Permissions() []Permission {
+	return append([]Permission(nil), registeredPermissions...)
+}
+
+// Registered reports whether p is a registered permission.
+func (p Permission) Registered() bool {
+	for _, registered := range registeredPermissions {
+		if p == registered {
+			return true
+		}
+	}
+	return false
+}
+
+// Self returns the ":self" variant of p.
+func (p Permission) Self() Permission {
+	return p + selfSuffix
+}
+
+// ParseScopes returns the scopes named by names, trimmed and without
+// duplicates, for granting them to a credential. Scopes are the registered
+// permissions without ":self" variants: routes check scopes before use cases
+// check ownership. It fails on unknown scopes and when names is empty.
+func ParseScopes(names []string) ([]string, error) {
+	scopes := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		scope := strings.TrimSpace(name)
+		if perm := Permission(scope); !perm.Registered() || strings.HasSuffix(scope, selfSuffix) {
+			return nil, fmt.Errorf("unknown scope %q", name)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+	return scopes, nil
+}
+
+// ErrPermissionDenied indicates that the actor's role does not grant the
+// requested action on the resource.
+var ErrPermissionDenied = errors.New("permission denied")
+
+// Resource identifies what an action is performed on.
+type Resource struct {
+	// Type is the kind of resource, e.g. "user". Used for logging.
+	Type string
+	// ID identifies the resource; empty for collections.
+	ID string
+	// OwnerID is the subject owning the resource, checked by ":self"
+	// permissions. Empty if the resource has no owner.
+	OwnerID string
+}
+
+// Authorizer decides whether an actor may perform an action on a resource.
+// Use cases call it at the start of Execute, before any DB calls.
+type Authorizer interface {
+	// Authorize returns nil if actor may perform action on resource. It
+	// returns ErrNoAuthContext if actor is nil or lacks a subject or role
+	// (fail-closed), and ErrPermissionDenied if actor's role does not grant
+	// action, or only grants its ":self" variant and actor does not own
+	// resource.
+	Authorize(ctx context.Context, actor *AuthContext, action Permission, resource Resource) error
+}
+
+// RequirePermission authorizes the AuthContext of ctx to perform action on
+// resource and returns it. Denials are returned as an AppError for op with
+// Code=FORBIDDEN when no usable auth context is present, or
+// Code=INSUFFICIENT_PERMISSIONS otherwise.
+func RequirePermission(ctx context.Context, authz Authorizer, op string, action Permission, resource Resource) (*AuthContext, error) {
+	actor := GetAuthContext(ctx)
+	if err := authz.Authorize(ctx, actor, action, resource); err != nil {
+		code := CodeInsufficientPermissions
+		if errors.Is(err, ErrNoAuthContext) {
+			code = CodeForbidden
+		}
+		return nil, &AppError{
+			Op:      op,
+			Code:    code,
+			Message: "Access denied",
+			Err:     err,
+		}
+	}
+	return actor, nil
+}
+
+// RolePermissions maps a role to the permissions it grants.
+type RolePermissions map[string][]Permission
+
+// DefaultRolePermissions returns the built-in roles: admins may do
+// everything, users may read and change their own profile and read the jobs
+// they started.
+func DefaultRolePermissions() RolePermissions {
+	return RolePermissions{
+		RoleAdmin: Permissions(),
+		RoleUser:  {PermUsersReadSelf, PermUsersWriteSelf, PermJobsReadSelf},
+	}
+}
+
+// ParseRolePermissions returns DefaultRolePermissions with the roles in
+// overrides replaced by the given permission names. Role names are trimmed and
+// lower-cased like the role claims they are matched against. It fails on
+// unregistered permissions.
+func ParseRolePermissions(overrides map[string][]string) (RolePermissions, error) {
+	roles := DefaultRolePermissions()
+
+	names := make([]string, 0, len(overrides))
+	for role := range overrides {
+		names = append(names, role)
+	}
+	sort.Strings(names)
+
+	for _, role := range names {
+		normalized := strings.ToLower(strings.TrimSpace(role))
+		if normalized == "" {
+			return nil, errors.New("role name must not be empty")
+		}
+		perms := make([]Permission, 0, len(overrides[role]))
+		for _, name := range overrides[role] {
+			perm := Permission(strings.TrimSpace(name))
+			if !perm.Registered() {
+				return nil, fmt.Errorf("role %q: unknown permission %q", normalized, name)
+			}
+			perms = append(perms, perm)
+		}
+		roles[normalized] = perms
+	}
+	return roles, nil
+}
+
+// RoleAuthorizer is the Authorizer granting each actor the permissions of
+// its role. Every decision is logged for audit purposes.
+type RoleAuthorizer struct {
+	roles map[string]map[Permission]bool
+	log   *logger.Logger
+}
+
+// NewRoleAuthorizer creates a RoleAuthorizer for roles.
+func NewRoleAuthorizer(roles RolePermissions, log *logger.Logger) *RoleAuthorizer {
+	sets := make(map[string]map[Permission]bool, len(roles))
+	for role, perms := range roles {
+		set := make(map[Permission]bool, len(perms))
+		for _, perm := range perms {
+			set[perm] = true
+		}
+		sets[role] = set
+	}
+	return &RoleAuthorizer{roles: sets, log: log}
+}
+
+// Authorize implements Authorizer.
+func (a *RoleAuthorizer) Authorize(ctx context.Context, actor *AuthContext, action Permission, resource Resource) error {
+	log := logger.FromContext(ctx, a.log)
+
+	if actor == nil || strings.TrimSpace(actor.Role) == "" || strings.TrimSpace(actor.SubjectID) == "" {
+		log.WarnContext(ctx, "authorization denied: no auth context or invalid credentials",
+			"action", action,
+			"resourceType", resource.Type,
+			"resourceId", resource.ID,
+		)
+		return ErrNoAuthContext
+	}
+
+	granted, known := a.roles[actor.Role]
+	if !known {
+		log.WarnContext(ctx, "authorization denied: unknown role",
+			"actorId", actor.SubjectID,
+			"role", actor.Role,
+			"action", action,
+			"resourceType", resource.Type,
+			"resourceId", resource.ID,
+		)
+		return ErrPermissionDenied
+	}
+
+	owner := resource.OwnerID != "" && resource.OwnerID == actor.SubjectID
+	allowed := granted[action] || (owner && granted[action.Self()])
+	if !allowed {
+		log.WarnContext(ctx, "authorization denied: missing permission",
+			"actorId", actor.SubjectID,
+			"role", actor.Role,
+			"action", action,
+			"resourceType", resource.Type,
+			"resourceId", resource.ID,
+		)
+		return ErrPermissionDenied
+	}
+
+	log.DebugContext(ctx, "authorization granted",
+		"actorId", actor.SubjectID,
+		"role", actor.Role,
+		"action", action,
+		"resourceType", resource.Type,
+		"resourceId", resource.ID,
+	)
+	return nil
+}
+
+// NoAuthAuthorizer is the Authorizer for deployments without authentication
+// (JWT_ENABLED=false), where requests never carry an AuthContext. It lets
+// such requests perform the actions it was created with on collections
+// (resources without an ID) and leaves every other decision to next, so
+// operations on single records stay fail-closed.
+type NoAuthAuthorizer struct {
+	next      Authorizer
+	anonymous map[Permission]bool
+	log       *logger.Logger
+}
+
+// NewNoAuthAuthorizer creates a NoAuthAuthorizer granting anonymous on
+// collections to requests without an AuthContext.
+func NewNoAuthAuthorizer(next Authorizer, anonymous []Permission, log *logger.Logger) *NoAuthAuthorizer {
+	set := make(map[Permission]bool, len(anonymous))
+	for _, perm := range anonymous {
+		set[perm] = true
+	}
+	return &NoAuthAuthorizer{next: next, anonymous: set, log: log}
+}
+
+// Authorize implements Authorizer.
+func (a *NoAuthAuthorizer) Authorize(ctx context.Context, actor *AuthContext, action Permission, resource Resource) error {
+	if actor != nil || resource.ID != "" || !a.anonymous[action] {
+		return a.next.Authorize(ctx, actor, action, resource)
+	}
+	logger.FromContext(ctx, a.log).DebugContext(ctx, "authorization granted: authentication disabled",
+		"action", action,
+		"resourceType", resource.Type,
+	)
+	return nil
+}
@@ -0,0 +1,268 @@
+//go:build !integration
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRoleAuthorizer(roles RolePermissions, buf *bytes.Buffer) *RoleAuthorizer {
+	return NewRoleAuthorizer(roles, slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+}
+
+func TestPermissions_AreRegistered(t *testing.T) {
+	for _, perm := range Permissions() {
+		assert.True(t, perm.Registered(), perm)
+	}
+	assert.False(t, Permission("users:delete").Registered())
+	assert.Equal(t, PermUsersReadSelf, PermUsersRead.Self())
+}
+
+func TestRoleAuthorizer_Authorize(t *testing.T) {
+	own := Resource{Type: "user", ID: "user-1", OwnerID: "user-1"}
+	other := Resource{Type: "user", ID: "user-2", OwnerID: "user-2"}
+
+	tests := []struct {
+		name     string
+		actor    *AuthContext
+		action   Permission
+		resource Resource
+		wantErr  error
+		wantLog  string
+	}{
+		{
+			name:     "admin reads any user",
+			actor:    &AuthContext{SubjectID: "admin-1", Role: RoleAdmin},
+			action:   PermUsersRead,
+			resource: other,
+			wantLog:  "authorization granted",
+		},
+		{
+			name:     "user reads own profile with self permission",
+			actor:    &AuthContext{SubjectID: "user-1", Role: RoleUser},
+			action:   PermUsersRead,
+			resource: own,
+		},
+		{
+			name:     "self permission does not cover other users",
+			actor:    &AuthContext{SubjectID: "user-1", Role: RoleUser},
+			action:   PermUsersRead,
+			resource: other,
+			wantErr:  ErrPermissionDenied,
+			wantLog:  "authorization denied: missing permission",
+		},
+		{
+			name:     "self permission needs an owner",
+			actor:    &AuthContext{SubjectID: "user-1", Role: RoleUser},
+			action:   PermUsersRead,
+			resource: Resource{Type: "user"},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "user lacks admin permission",
+			actor:    &AuthContext{SubjectID: "user-1", Role: RoleUser},
+			action:   PermUsersPurge,
+			resource: Resource{Type: "user"},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "unknown role",
+			actor:    &AuthContext{SubjectID: "user-1", Role: "power-user"},
+			action:   PermUsersRead,
+			resource: own,
+			wantErr:  ErrPermissionDenied,
+			wantLog:  "authorization denied: unknown role",
+		},
+		{
+			name:     "no auth context",
+			action:   PermUsersRead,
+			resource: own,
+			wantErr:  ErrNoAuthContext,
+			wantLog:  "authorization denied: no auth context or invalid credentials",
+		},
+		{
+			name:     "empty subject",
+			actor:    &AuthContext{SubjectID: " ", Role: RoleAdmin},
+			action:   PermUsersRead,
+			resource: own,
+			wantErr:  ErrNoAuthContext,
+		},
+		{
+			name:     "empty role",
+			actor:    &AuthContext{SubjectID: "user-1"},
+			action:   PermUsersRead,
+			resource: own,
+			wantErr:  ErrNoAuthContext,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			authz := newTestRoleAuthorizer(DefaultRolePermissions(), &buf)
+
+			err := authz.Authorize(context.Background(), tt.actor, tt.action, tt.resource)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantLog != "" {
+				assert.Contains(t, buf.String(), tt.wantLog)
+				assert.Contains(t, buf.String(), string(tt.action))
+			}
+		})
+	}
+}
+
+func TestRequirePermission(t *testing.T) {
+	var buf bytes.Buffer
+	authz := newTestRoleAuthorizer(DefaultRolePermissions(), &buf)
+	resource := Resource{Type: "user", ID: "user-2", OwnerID: "user-2"}
+
+	t.Run("granted returns the auth context", func(t *testing.T) {
+		ctx := SetAuthContext(context.Background(), &AuthContext{SubjectID: "admin-1", Role: RoleAdmin})
+
+		actor, err := RequirePermission(ctx, authz, "Op", PermUsersWrite, resource)
+
+		require.NoError(t, err)
+		assert.Equal(t, "admin-1", actor.SubjectID)
+	})
+
+	t.Run("denied is INSUFFICIENT_PERMISSIONS", func(t *testing.T) {
+		ctx := SetAuthContext(context.Background(), &AuthContext{SubjectID: "user-1", Role: RoleUser})
+
+		actor, err := RequirePermission(ctx, authz, "Op", PermUsersWrite, resource)
+
+		var appErr *AppError
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, CodeInsufficientPermissions, appErr.Code)
+		assert.Equal(t, "Op", appErr.Op)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+		assert.Nil(t, actor)
+	})
+
+	t.Run("no auth context is FORBIDDEN", func(t *testing.T) {
+		actor, err := RequirePermission(context.Background(), authz, "Op", PermUsersWrite, resource)
+
+		var appErr *AppError
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, CodeForbidden, appErr.Code)
+		assert.ErrorIs(t, err, ErrNoAuthContext)
+		assert.Nil(t, actor)
+	})
+}
+
+func TestParseRolePermissions(t *testing.T) {
+	t.Run("overrides replace and add roles", func(t *testing.T) {
+		roles, err := ParseRolePermissions(map[string][]string{
+			" Auditor ": {"audit:read", " users:read "},
+			"user":      {"users:read:self"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []Permission{PermAuditRead, PermUsersRead}, roles["auditor"])
+		assert.Equal(t, []Permission{PermUsersReadSelf}, roles[RoleUser])
+		assert.Equal(t, Permissions(), roles[RoleAdmin], "roles not listed keep their defaults")
+	})
+
+	t.Run("no overrides returns the defaults", func(t *testing.T) {
+		roles, err := ParseRolePermissions(nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, DefaultRolePermissions(), roles)
+	})
+
+	t.Run("unknown permission", func(t *testing.T) {
+		_, err := ParseRolePermissions(map[string][]string{"auditor": {"audit:write"}})
+
+		assert.ErrorContains(t, err, `unknown permission "audit:write"`)
+	})
+
+	t.Run("empty role", func(t *testing.T) {
+		_, err := ParseRolePermissions(map[string][]string{" ": {"audit:read"}})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestParseScopes(t *testing.T) {
+	t.Run("trims and drops duplicates", func(t *testing.T) {
+		scopes, err := ParseScopes([]string{" users:read ", "audit:read", "users:read"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"users:read", "audit:read"}, scopes)
+	})
+
+	t.Run("unknown scope", func(t *testing.T) {
+		_, err := ParseScopes([]string{"users:delete"})
+
+		assert.ErrorContains(t, err, `unknown scope "users:delete"`)
+	})
+
+	t.Run("self permissions are not scopes", func(t *testing.T) {
+		_, err := ParseScopes([]string{"users:read:self"})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("no scopes", func(t *testing.T) {
+		_, err := ParseScopes(nil)
+
+		assert.ErrorContains(t, err, "at least one scope is required")
+	})
+}
+
+func TestRoleAuthorizer_ConfiguredRole(t *testing.T) {
+	roles, err := ParseRolePermissions(map[string][]string{"auditor": {"audit:read", "users:read"}})
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	authz := newTestRoleAuthorizer(roles, &buf)
+	auditor := &AuthContext{SubjectID: "auditor-1", Role: "auditor"}
+
+	assert.NoError(t, authz.Authorize(context.Background(), auditor, PermAuditRead, Resource{Type: "audit_event"}))
+	assert.NoError(t, authz.Authorize(context.Background(), auditor, PermUsersRead, Resource{Type: "user", ID: "user-1", OwnerID: "user-1"}))
+	assert.ErrorIs(t, authz.Authorize(context.Background(), auditor, PermUsersWrite, Resource{Type: "user", ID: "user-1", OwnerID: "user-1"}), ErrPermissionDenied)
+}
+
+func TestNoAuthAuthorizer_Authorize(t *testing.T) {
+	var buf bytes.Buffer
+	authz := NewNoAuthAuthorizer(newTestRoleAuthorizer(DefaultRolePermissions(), &buf),
+		[]Permission{PermUsersRead, PermUsersWrite}, slog.New(slog.NewJSONHandler(&buf, nil)))
+	users := Resource{Type: "user"}
+	one := Resource{Type: "user", ID: "user-1", OwnerID: "user-1"}
+	member := &AuthContext{SubjectID: "user-2", Role: RoleUser}
+
+	tests := []struct {
+		name     string
+		actor    *AuthContext
+		action   Permission
+		resource Resource
+		wantErr  error
+	}{
+		{name: "anonymous lists users", action: PermUsersRead, resource: users},
+		{name: "anonymous creates users", action: PermUsersWrite, resource: users},
+		{name: "anonymous other collection action", action: PermUsersExport, resource: users, wantErr: ErrNoAuthContext},
+		{name: "anonymous single record", action: PermUsersRead, resource: one, wantErr: ErrNoAuthContext},
+		{name: "authenticated actor keeps its role", actor: member, action: PermUsersRead, resource: users, wantErr: ErrPermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authz.Authorize(context.Background(), tt.actor, tt.action, tt.resource)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
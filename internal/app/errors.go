@@ -16,16 +16,35 @@ import (
 // They are used in API responses and client integrations.
 const (
 	// User domain codes.
-	CodeUserNotFound = string(domainerrors.ErrCodeUserNotFound) // "ERR_USER_NOT_FOUND"
-	CodeEmailExists  = string(domainerrors.ErrCodeEmailExists)  // "ERR_USER_EMAIL_EXISTS"
+	CodeUserNotFound    = string(domainerrors.ErrCodeUserNotFound)        // "ERR_USER_NOT_FOUND"
+	CodeEmailExists     = string(domainerrors.ErrCodeEmailExists)         // "ERR_USER_EMAIL_EXISTS"
+	CodeVersionMismatch = string(domainerrors.ErrCodeUserVersionMismatch) // "ERR_USER_VERSION_MISMATCH"
+
+	// Job codes.
+	CodeJobNotFound = string(domainerrors.ErrCodeJobNotFound) // "ERR_JOB_NOT_FOUND"
+
+	// Authentication codes.
+	CodeInvalidCredentials = string(domainerrors.ErrCodeInvalidCredentials) // "ERR_INVALID_CREDENTIALS"
+
+	// API key codes.
+	CodeAPIKeyNotFound = string(domainerrors.ErrCodeAPIKeyNotFound) // "ERR_API_KEY_NOT_FOUND"
+
+	// Audit codes.
+	CodeAuditEventNotFound = string(domainerrors.ErrCodeAuditNotFound) // "ERR_AUDIT_NOT_FOUND"
 
 	// General codes.
-	CodeValidationError   = string(domainerrors.ErrCodeValidation)   // "ERR_VALIDATION"
-	CodeUnauthorized      = string(domainerrors.ErrCodeUnauthorized) // "ERR_UNAUTHORIZED"
-	CodeForbidden         = string(domainerrors.ErrCodeForbidden)    // "ERR_FORBIDDEN"
-	CodeInternalError     = string(domainerrors.ErrCodeInternal)     // "ERR_INTERNAL"
-	CodeRequestTooLarge   = "ERR_REQUEST_TOO_LARGE"                  // App-specific (no domain equivalent)
-	CodeRateLimitExceeded = "ERR_RATE_LIMIT_EXCEEDED"                // App-specific (no domain equivalent)
+	CodeValidationError         = string(domainerrors.ErrCodeValidation)              // "ERR_VALIDATION"
+	CodeUnauthorized            = string(domainerrors.ErrCodeUnauthorized)            // "ERR_UNAUTHORIZED"
+	CodeForbidden               = string(domainerrors.ErrCodeForbidden)               // "ERR_FORBIDDEN"
+	CodeInsufficientPermissions = string(domainerrors.ErrCodeInsufficientPermissions) // "ERR_INSUFFICIENT_PERMISSIONS"
+	CodeInternalError           = string(domainerrors.ErrCodeInternal)                // "ERR_INTERNAL"
+	CodeRequestTooLarge         = "ERR_REQUEST_TOO_LARGE"                             // App-specific (no domain equivalent)
+	CodeRateLimitExceeded       = "ERR_RATE_LIMIT_EXCEEDED"                           // App-specific (no domain equivalent)
+	CodePreconditionRequired    = "ERR_PRECONDITION_REQUIRED"                         // App-specific (no domain equivalent)
+	CodeBatchAborted            = "ERR_BATCH_ABORTED"                                 // App-specific (no domain equivalent)
+	CodeNotAcceptable           = "ERR_NOT_ACCEPTABLE"                                // App-specific (no domain equivalent)
+	CodeTooManyAuthFailures     = "ERR_TOO_MANY_AUTH_FAILURES"                        // App-specific (no domain equivalent)
+	CodeAccountLocked           = "ERR_ACCOUNT_LOCKED"                                // App-specific (no domain equivalent)
 )
 
 // AppError represents an application-layer error with machine-readable code.
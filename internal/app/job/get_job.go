@@ -0,0 +1,96 @@
+// Package job provides use cases for inspecting background jobs.
+// Jobs are created and run by the use cases of the feature they belong to,
+// e.g. user imports; this package only reports on them.
+package job
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// GetJobRequest represents the input data for getting a job by ID.
+type GetJobRequest struct {
+	ID domain.ID
+}
+
+// GetJobResponse represents the result of getting a job.
+type GetJobResponse struct {
+	Job domain.Job
+}
+
+// OpGetJob is the operation name for GetJob use case.
+const OpGetJob = "GetJob"
+
+// GetJobUseCase handles the business logic for retrieving a job's status.
+// Requires jobs:read, or jobs:read:self for the jobs the caller created.
+type GetJobUseCase struct {
+	jobRepo domain.JobRepository
+	db      domain.Querier
+	authz   app.Authorizer
+	log     *logger.Logger
+}
+
+// NewGetJobUseCase creates a new instance of GetJobUseCase.
+func NewGetJobUseCase(jobRepo domain.JobRepository, db domain.Querier, authz app.Authorizer, log *logger.Logger) *GetJobUseCase {
+	return &GetJobUseCase{
+		jobRepo: jobRepo,
+		db:      db,
+		authz:   authz,
+		log:     log.With("usecase", OpGetJob),
+	}
+}
+
+// Execute retrieves a job by ID.
+// Returns AppError with Code=FORBIDDEN if no auth context is present
+// (fail-closed) and Code=JOB_NOT_FOUND if the job doesn't exist or the
+// caller may not read it, so job IDs of other users cannot be probed.
+func (uc *GetJobUseCase) Execute(ctx context.Context, req GetJobRequest) (GetJobResponse, error) {
+	authCtx := app.GetAuthContext(ctx)
+	if authCtx == nil || strings.TrimSpace(authCtx.Role) == "" || strings.TrimSpace(authCtx.SubjectID) == "" {
+		logger.FromContext(ctx, uc.log).WarnContext(ctx, "authorization denied: no auth context or invalid credentials",
+			"resourceId", req.ID,
+		)
+		return GetJobResponse{}, &app.AppError{
+			Op:      OpGetJob,
+			Code:    app.CodeForbidden,
+			Message: "Access denied",
+			Err:     app.ErrNoAuthContext,
+		}
+	}
+
+	job, err := uc.jobRepo.GetByID(ctx, uc.db, req.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrJobNotFound) {
+			return GetJobResponse{}, notFound(err)
+		}
+		return GetJobResponse{}, &app.AppError{
+			Op:      OpGetJob,
+			Code:    app.CodeInternalError,
+			Message: "Failed to get job",
+			Err:     err,
+		}
+	}
+
+	// The owner is only known once the job is loaded; denials are reported as
+	// not found.
+	resource := app.Resource{Type: "job", ID: string(job.ID), OwnerID: string(job.CreatedBy)}
+	if err := uc.authz.Authorize(ctx, authCtx, app.PermJobsRead, resource); err != nil {
+		return GetJobResponse{}, notFound(nil)
+	}
+
+	return GetJobResponse{Job: *job}, nil
+}
+
+func notFound(err error) error {
+	return &app.AppError{
+		Op:      OpGetJob,
+		Code:    app.CodeJobNotFound,
+		Message: "Job not found",
+		Err:     err,
+	}
+}
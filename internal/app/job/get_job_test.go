@@ -0,0 +1,132 @@
+//go:build !integration
+
+package job
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+// mockJobRepository is a test double for domain.JobRepository.
+type mockJobRepository struct {
+	jobs     map[domain.ID]domain.Job
+	getError error
+}
+
+func (m *mockJobRepository) Create(_ context.Context, _ domain.Querier, job *domain.Job) error {
+	m.jobs[job.ID] = *job
+	return nil
+}
+
+func (m *mockJobRepository) GetByID(_ context.Context, _ domain.Querier, id domain.ID) (*domain.Job, error) {
+	if m.getError != nil {
+		return nil, m.getError
+	}
+	job, ok := m.jobs[id]
+	if !ok {
+		return nil, domain.ErrJobNotFound
+	}
+	return &job, nil
+}
+
+func (m *mockJobRepository) ClaimNext(_ context.Context, _ domain.Querier, _ domain.JobType, _ time.Time) (*domain.Job, error) {
+	return nil, domain.ErrJobNotFound
+}
+
+func (m *mockJobRepository) UpdateProgress(_ context.Context, _ domain.Querier, _ *domain.Job, _ time.Time) error {
+	return nil
+}
+
+// mockQuerier is a test double for domain.Querier.
+type mockQuerier struct{}
+
+func (m *mockQuerier) Exec(_ context.Context, _ string, _ ...any) (any, error)  { return nil, nil }
+func (m *mockQuerier) Query(_ context.Context, _ string, _ ...any) (any, error) { return nil, nil }
+func (m *mockQuerier) QueryRow(_ context.Context, _ string, _ ...any) any       { return nil }
+
+func authCtx(subjectID, role string) context.Context {
+	return app.SetAuthContext(context.Background(), &app.AuthContext{
+		SubjectID: subjectID,
+		Role:      role,
+	})
+}
+
+func TestGetJobUseCase_Execute(t *testing.T) {
+	stored := domain.Job{
+		ID:        "job-1",
+		Type:      domain.JobTypeUserImport,
+		Status:    domain.JobStatusRunning,
+		CreatedBy: "creator-id",
+		TotalRows: 10,
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		id       domain.ID
+		getError error
+		wantCode string
+		wantLog  string
+	}{
+		{name: "admin reads any job", ctx: authCtx("admin-id", app.RoleAdmin), id: "job-1"},
+		{name: "creator reads own job", ctx: authCtx("creator-id", app.RoleUser), id: "job-1"},
+		{
+			name:     "other user gets not found",
+			ctx:      authCtx("other-id", app.RoleUser),
+			id:       "job-1",
+			wantCode: app.CodeJobNotFound,
+			wantLog:  "authorization denied: missing permission",
+		},
+		{name: "missing job", ctx: authCtx("admin-id", app.RoleAdmin), id: "job-2", wantCode: app.CodeJobNotFound},
+		{
+			name:     "no auth context",
+			ctx:      context.Background(),
+			id:       "job-1",
+			wantCode: app.CodeForbidden,
+			wantLog:  "no auth context",
+		},
+		{
+			name:     "repository error",
+			ctx:      authCtx("admin-id", app.RoleAdmin),
+			id:       "job-1",
+			getError: errors.New("db down"),
+			wantCode: app.CodeInternalError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockJobRepository{jobs: map[domain.ID]domain.Job{stored.ID: stored}, getError: tt.getError}
+			var buf bytes.Buffer
+			log := slog.New(slog.NewJSONHandler(&buf, nil))
+			uc := NewGetJobUseCase(repo, &mockQuerier{}, testutil.NewAuthorizer(log), log)
+
+			resp, err := uc.Execute(tt.ctx, GetJobRequest{ID: tt.id})
+
+			if tt.wantCode == "" {
+				require.NoError(t, err)
+				assert.Equal(t, stored, resp.Job)
+				return
+			}
+			var appErr *app.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, tt.wantCode, appErr.Code)
+			assert.Equal(t, OpGetJob, appErr.Op)
+			assert.Empty(t, resp.Job.ID)
+			if tt.wantLog != "" {
+				assert.Contains(t, buf.String(), tt.wantLog)
+			}
+		})
+	}
+}
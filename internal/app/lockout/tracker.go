@@ -0,0 +1,372 @@
+// Package lockout slows down credential guessing: it counts failed
+// authentication attempts per client IP and per subject and locks a key out
+// once it fails too often.
+package lockout
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/metrics"
+)
+
+// Defaults for a zero Config field.
+const (
+	DefaultMaxFailures = 5
+	DefaultWindow      = 15 * time.Minute
+	DefaultBaseLockout = time.Minute
+	DefaultMaxLockout  = time.Hour
+	DefaultMaxEntries  = 100000
+)
+
+// OpCheck is the operation name of errors returned by Tracker.Check.
+const OpCheck = "AuthLockout"
+
+// KeyType is what a Key counts failures of.
+type KeyType string
+
+const (
+	// KeyTypeIP counts the failures of a client IP, whatever credentials it tries.
+	KeyTypeIP KeyType = "ip"
+	// KeyTypeSubject counts the failures of one identity, e.g. an OAuth client
+	// or a token subject, from whichever IP.
+	KeyTypeSubject KeyType = "subject"
+)
+
+// Key identifies whose failures are counted. ID is opaque to the Tracker; the
+// transport layer derives it from the request like the rate limiter's keys.
+type Key struct {
+	Type KeyType
+	ID   string
+}
+
+// Config configures a Tracker.
+type Config struct {
+	// MaxFailures is the number of failures within Window that locks a key out.
+	MaxFailures int
+	// Window is the period failures are counted in, starting with the first.
+	Window time.Duration
+	// BaseLockout is how long the first lockout of a key lasts. Each further
+	// lockout of the key doubles it, up to MaxLockout.
+	BaseLockout time.Duration
+	// MaxLockout caps the lockout duration. A key that stays clean for
+	// MaxLockout after its last lockout starts over at BaseLockout.
+	MaxLockout time.Duration
+	// MaxEntries caps the number of keys tracked at once. When it is reached,
+	// the key that failed least recently is forgotten to make room, unless it
+	// is still locked out; then the new key is not tracked.
+	MaxEntries int
+}
+
+// LockedError is the cause of the AppError returned for a locked out key.
+type LockedError struct {
+	Key Key
+	// RetryAfter is how long the lockout still lasts.
+	RetryAfter time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("%s %q locked out for %s", e.Key.Type, e.Key.ID, e.RetryAfter)
+}
+
+// RetryAfterSeconds returns the whole seconds, rounded up, a locked out
+// caller must wait; it is meant for the Retry-After header.
+func (e *LockedError) RetryAfterSeconds() int {
+	return retryAfterSeconds(e.RetryAfter)
+}
+
+// Tracker counts authentication failures in process, so each instance locks
+// keys out on its own. It is safe for concurrent use.
+//
+// A key that reaches MaxFailures is locked out for an exponentially growing
+// duration, and at most MaxEntries keys are tracked; the lockout is logged, counted in the metrics and recorded as an
+// auth.lockout audit event. A failed audit write is logged and does not
+// prevent the lockout.
+type Tracker struct {
+	cfg          Config
+	auditService *audit.AuditService
+	db           domain.Querier
+	idGen        domain.IDGenerator
+	metrics      metrics.LockoutMetrics
+	now          func() time.Time
+	log          *logger.Logger
+
+	mu      sync.Mutex
+	entries map[Key]*entry
+	// recent orders the keys of entries by their last failure, most recent
+	// first, so the oldest can be evicted when MaxEntries is reached.
+	recent    *list.List
+	lastSweep time.Time
+	// full is set while new keys are refused, so that is logged once.
+	full bool
+}
+
+// entry is the failure state of one key.
+type entry struct {
+	// elem is the element of the key in Tracker.recent.
+	elem        *list.Element
+	failures    int
+	windowStart time.Time
+	// lockouts counts the consecutive lockouts; it sets the next duration.
+	lockouts    int
+	lockedUntil time.Time
+}
+
+// NewTracker creates a Tracker. Non-positive Config fields use the defaults.
+// m may be nil.
+func NewTracker(
+	cfg Config,
+	auditService *audit.AuditService,
+	db domain.Querier,
+	idGen domain.IDGenerator,
+	m metrics.LockoutMetrics,
+	log *logger.Logger,
+) *Tracker {
+	if cfg.MaxFailures <= 0 {
+		cfg.MaxFailures = DefaultMaxFailures
+	}
+	if cfg.Window <= 0 {
+		cfg.Window = DefaultWindow
+	}
+	if cfg.BaseLockout <= 0 {
+		cfg.BaseLockout = DefaultBaseLockout
+	}
+	if cfg.MaxLockout <= 0 {
+		cfg.MaxLockout = DefaultMaxLockout
+	}
+	if cfg.MaxLockout < cfg.BaseLockout {
+		cfg.MaxLockout = cfg.BaseLockout
+	}
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = DefaultMaxEntries
+	}
+	return &Tracker{
+		cfg:          cfg,
+		auditService: auditService,
+		db:           db,
+		idGen:        idGen,
+		metrics:      m,
+		now:          time.Now,
+		log:          log.With("component", "auth_lockout"),
+		entries:      make(map[Key]*entry),
+		recent:       list.New(),
+	}
+}
+
+// Check returns an error if one of keys is locked out. The error is an
+// AppError with Code=TOO_MANY_AUTH_FAILURES for an IP key and
+// Code=ACCOUNT_LOCKED for a subject key, wrapping a *LockedError.
+func (t *Tracker) Check(ctx context.Context, keys ...Key) error {
+	now := t.now()
+
+	t.mu.Lock()
+	var locked *LockedError
+	for _, key := range keys {
+		e, ok := t.entries[key]
+		if !ok || !now.Before(e.lockedUntil) {
+			continue
+		}
+		locked = &LockedError{Key: key, RetryAfter: e.lockedUntil.Sub(now)}
+		break
+	}
+	t.mu.Unlock()
+
+	if locked == nil {
+		return nil
+	}
+	if t.metrics != nil {
+		t.metrics.IncLockoutRejected(string(locked.Key.Type))
+	}
+	logger.FromContext(ctx, t.log).DebugContext(ctx, "auth rejected: locked out",
+		"keyType", locked.Key.Type,
+		"retryAfter", locked.RetryAfter,
+	)
+
+	code := app.CodeTooManyAuthFailures
+	if locked.Key.Type == KeyTypeSubject {
+		code = app.CodeAccountLocked
+	}
+	return &app.AppError{
+		Op:      OpCheck,
+		Code:    code,
+		Message: fmt.Sprintf("Too many failed authentication attempts. Try again in %d seconds", locked.RetryAfterSeconds()),
+		Err:     locked,
+	}
+}
+
+// Failure records a failed authentication attempt for each of keys and locks
+// out the keys reaching MaxFailures.
+func (t *Tracker) Failure(ctx context.Context, keys ...Key) {
+	now := t.now()
+	var lockouts []lockoutEvent
+
+	t.mu.Lock()
+	t.sweep(now)
+	for _, key := range keys {
+		if key.ID == "" {
+			continue
+		}
+		e, ok := t.entries[key]
+		if !ok {
+			if e = t.add(ctx, key, now); e == nil {
+				continue
+			}
+		} else {
+			t.recent.MoveToFront(e.elem)
+		}
+		if now.Before(e.lockedUntil) {
+			// Attempts of a locked out key are rejected before they are made.
+			continue
+		}
+		if e.lockouts > 0 && now.Sub(e.lockedUntil) >= t.cfg.MaxLockout {
+			e.lockouts = 0
+		}
+		if e.failures == 0 || now.Sub(e.windowStart) >= t.cfg.Window {
+			e.failures = 0
+			e.windowStart = now
+		}
+		e.failures++
+		if t.metrics != nil {
+			t.metrics.IncAuthFailure(string(key.Type))
+		}
+		if e.failures < t.cfg.MaxFailures {
+			continue
+		}
+
+		e.lockouts++
+		duration := t.lockoutDuration(e.lockouts)
+		e.lockedUntil = now.Add(duration)
+		lockouts = append(lockouts, lockoutEvent{key: key, failures: e.failures, lockouts: e.lockouts, duration: duration, until: e.lockedUntil})
+		e.failures = 0
+	}
+	t.mu.Unlock()
+
+	for _, l := range lockouts {
+		t.recordLockout(ctx, l)
+	}
+}
+
+// Success forgets the failures of keys, e.g. of a subject that authenticated
+// with its credentials. A running lockout is not lifted.
+func (t *Tracker) Success(_ context.Context, keys ...Key) {
+	now := t.now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, key := range keys {
+		if e, ok := t.entries[key]; ok && !now.Before(e.lockedUntil) {
+			t.remove(key, e)
+		}
+	}
+}
+
+// lockoutDuration returns the duration of the nth consecutive lockout.
+func (t *Tracker) lockoutDuration(n int) time.Duration {
+	duration := t.cfg.BaseLockout
+	for i := 1; i < n && duration < t.cfg.MaxLockout; i++ {
+		duration *= 2
+	}
+	return min(duration, t.cfg.MaxLockout)
+}
+
+// add starts tracking key. When MaxEntries keys are tracked, the one that
+// failed least recently is evicted first; if it is still locked out, key is
+// not tracked and nil is returned. The caller must hold t.mu.
+func (t *Tracker) add(ctx context.Context, key Key, now time.Time) *entry {
+	if len(t.entries) >= t.cfg.MaxEntries {
+		oldest := t.recent.Back().Value.(Key)
+		e := t.entries[oldest]
+		if now.Before(e.lockedUntil) {
+			if !t.full {
+				t.full = true
+				logger.FromContext(ctx, t.log).WarnContext(ctx, "auth failures not tracked: too many keys locked out",
+					"maxEntries", t.cfg.MaxEntries,
+				)
+			}
+			return nil
+		}
+		t.remove(oldest, e)
+	}
+	t.full = false
+	e := &entry{elem: t.recent.PushFront(key)}
+	t.entries[key] = e
+	return e
+}
+
+// remove stops tracking key. The caller must hold t.mu.
+func (t *Tracker) remove(key Key, e *entry) {
+	t.recent.Remove(e.elem)
+	delete(t.entries, key)
+}
+
+// sweep drops the entries that no longer affect any decision, at most once per
+// Window. The caller must hold t.mu.
+func (t *Tracker) sweep(now time.Time) {
+	if now.Sub(t.lastSweep) < t.cfg.Window {
+		return
+	}
+	t.lastSweep = now
+	for key, e := range t.entries {
+		windowOver := e.failures == 0 || now.Sub(e.windowStart) >= t.cfg.Window
+		if windowOver && now.Sub(e.lockedUntil) >= t.cfg.MaxLockout {
+			t.remove(key, e)
+		}
+	}
+}
+
+// lockoutEvent describes a key that was just locked out.
+type lockoutEvent struct {
+	key      Key
+	failures int
+	lockouts int
+	duration time.Duration
+	until    time.Time
+}
+
+// recordLockout logs, counts and audits a lockout.
+func (t *Tracker) recordLockout(ctx context.Context, l lockoutEvent) {
+	log := logger.FromContext(ctx, t.log)
+	log.WarnContext(ctx, "auth locked out after repeated failures",
+		"keyType", l.key.Type,
+		"key", l.key.ID,
+		"failures", l.failures,
+		"lockouts", l.lockouts,
+		"duration", l.duration,
+	)
+	if t.metrics != nil {
+		t.metrics.IncLockout(string(l.key.Type))
+	}
+
+	if t.auditService == nil {
+		return
+	}
+	if err := t.auditService.Record(ctx, t.db, audit.AuditEventInput{
+		EventType:  domain.EventAuthLockout,
+		EntityType: "auth_lockout",
+		EntityID:   t.idGen.NewID(),
+		Payload: map[string]any{
+			"keyType":     string(l.key.Type),
+			"key":         l.key.ID,
+			"failures":    l.failures,
+			"lockouts":    l.lockouts,
+			"lockedUntil": l.until.UTC(),
+		},
+		RequestID: sharedctx.GetRequestID(ctx),
+	}); err != nil {
+		log.ErrorContext(ctx, "failed to record auth lockout audit event", "error", err)
+	}
+}
+
+// retryAfterSeconds rounds d up to whole seconds, as sent in Retry-After.
+func retryAfterSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
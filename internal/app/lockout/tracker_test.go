@@ -0,0 +1,328 @@
+//go:build !integration
+
+package lockout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// mockIDGenerator is a test double for domain.IDGenerator.
+type mockIDGenerator struct{}
+
+func (m *mockIDGenerator) NewID() domain.ID { return "lockout-1" }
+
+// mockAuditEventRepository is a test double for domain.AuditEventRepository.
+type mockAuditEventRepository struct {
+	events      []*domain.AuditEvent
+	createError error
+}
+
+func (m *mockAuditEventRepository) Create(_ context.Context, _ domain.Querier, event *domain.AuditEvent) error {
+	if m.createError != nil {
+		return m.createError
+	}
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *mockAuditEventRepository) ListByEntityID(_ context.Context, _ domain.Querier, _ string, _ domain.ID, _ domain.ListParams) ([]domain.AuditEvent, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockAuditEventRepository) GetByID(_ context.Context, _ domain.Querier, _ domain.ID) (*domain.AuditEvent, error) {
+	return nil, domain.ErrAuditEventNotFound
+}
+
+func (m *mockAuditEventRepository) List(_ context.Context, _ domain.Querier, _ domain.AuditEventFilter, _ domain.ListParams) ([]domain.AuditEvent, error) {
+	return nil, nil
+}
+
+func (m *mockAuditEventRepository) ListChain(_ context.Context, _ domain.Querier, _ int64, _ int) ([]domain.AuditEvent, error) {
+	return nil, nil
+}
+
+func (m *mockAuditEventRepository) GetChainAnchor(_ context.Context, _ domain.Querier) (*domain.AuditChainAnchor, error) {
+	return nil, nil
+}
+
+// mockRedactor is a test double for domain.Redactor.
+type mockRedactor struct{}
+
+func (m *mockRedactor) RedactMap(data map[string]any) map[string]any { return data }
+func (m *mockRedactor) Redact(data any) any                          { return data }
+
+// recordingMetrics records the lockout metrics by key type.
+type recordingMetrics struct {
+	failures, lockouts, rejected map[string]int
+}
+
+func newRecordingMetrics() *recordingMetrics {
+	return &recordingMetrics{failures: map[string]int{}, lockouts: map[string]int{}, rejected: map[string]int{}}
+}
+
+func (m *recordingMetrics) IncAuthFailure(keyType string)     { m.failures[keyType]++ }
+func (m *recordingMetrics) IncLockout(keyType string)         { m.lockouts[keyType]++ }
+func (m *recordingMetrics) IncLockoutRejected(keyType string) { m.rejected[keyType]++ }
+
+var (
+	fixedNow   = time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	ipKey      = Key{Type: KeyTypeIP, ID: "ip:192.0.2.1"}
+	subjectKey = Key{Type: KeyTypeSubject, ID: "user:client-1"}
+)
+
+type trackerFixture struct {
+	tracker   *Tracker
+	auditRepo *mockAuditEventRepository
+	metrics   *recordingMetrics
+	now       *time.Time
+}
+
+// newTrackerFixture returns a tracker locking out after 3 failures in 10
+// minutes, for 1, 2, 4 and at most 5 minutes.
+func newTrackerFixture() trackerFixture {
+	auditRepo := &mockAuditEventRepository{}
+	m := newRecordingMetrics()
+	auditService := audit.NewAuditService(auditRepo, &mockRedactor{}, &mockIDGenerator{})
+	tracker := NewTracker(Config{
+		MaxFailures: 3,
+		Window:      10 * time.Minute,
+		BaseLockout: time.Minute,
+		MaxLockout:  5 * time.Minute,
+	}, auditService, nil, &mockIDGenerator{}, m, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	now := fixedNow
+	tracker.now = func() time.Time { return now }
+	return trackerFixture{tracker: tracker, auditRepo: auditRepo, metrics: m, now: &now}
+}
+
+func (f trackerFixture) fail(n int, keys ...Key) {
+	for i := 0; i < n; i++ {
+		f.tracker.Failure(context.Background(), keys...)
+	}
+}
+
+func (f trackerFixture) advance(d time.Duration) { *f.now = f.now.Add(d) }
+
+// retryAfter returns how long key is still locked out, or 0.
+func (f trackerFixture) retryAfter(t *testing.T, key Key) time.Duration {
+	t.Helper()
+	err := f.tracker.Check(context.Background(), key)
+	if err == nil {
+		return 0
+	}
+	var locked *LockedError
+	require.ErrorAs(t, err, &locked)
+	assert.Equal(t, key, locked.Key)
+	return locked.RetryAfter
+}
+
+func TestTracker_LocksOutAfterMaxFailures(t *testing.T) {
+	f := newTrackerFixture()
+
+	f.fail(2, ipKey)
+	assert.NoError(t, f.tracker.Check(context.Background(), ipKey))
+
+	f.fail(1, ipKey)
+	assert.Equal(t, time.Minute, f.retryAfter(t, ipKey))
+	assert.NoError(t, f.tracker.Check(context.Background(), subjectKey), "other keys are not locked out")
+
+	f.advance(40 * time.Second)
+	assert.Equal(t, 20*time.Second, f.retryAfter(t, ipKey))
+
+	f.advance(20 * time.Second)
+	assert.NoError(t, f.tracker.Check(context.Background(), ipKey))
+}
+
+func TestTracker_CountsFailuresWithinWindow(t *testing.T) {
+	f := newTrackerFixture()
+
+	f.fail(2, ipKey)
+	f.advance(10 * time.Minute)
+	f.fail(2, ipKey)
+
+	assert.NoError(t, f.tracker.Check(context.Background(), ipKey), "failures of an expired window are forgotten")
+
+	f.fail(1, ipKey)
+	assert.Equal(t, time.Minute, f.retryAfter(t, ipKey))
+}
+
+func TestTracker_LockoutGrowsExponentially(t *testing.T) {
+	f := newTrackerFixture()
+
+	for _, want := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute} {
+		f.fail(3, ipKey)
+		assert.Equal(t, want, f.retryAfter(t, ipKey))
+		f.advance(want)
+	}
+
+	// Clean for MaxLockout after the last lockout: start over.
+	f.advance(5 * time.Minute)
+	f.fail(3, ipKey)
+	assert.Equal(t, time.Minute, f.retryAfter(t, ipKey))
+}
+
+func TestTracker_FailuresWhileLockedOutAreIgnored(t *testing.T) {
+	f := newTrackerFixture()
+
+	f.fail(3, ipKey)
+	f.fail(10, ipKey)
+
+	assert.Equal(t, time.Minute, f.retryAfter(t, ipKey))
+	assert.Equal(t, 1, f.metrics.lockouts["ip"])
+	assert.Equal(t, 3, f.metrics.failures["ip"])
+}
+
+func TestTracker_SuccessForgetsFailures(t *testing.T) {
+	f := newTrackerFixture()
+
+	f.fail(2, subjectKey)
+	f.tracker.Success(context.Background(), subjectKey)
+	f.fail(2, subjectKey)
+	assert.NoError(t, f.tracker.Check(context.Background(), subjectKey))
+
+	f.fail(1, subjectKey)
+	f.tracker.Success(context.Background(), subjectKey)
+	assert.Equal(t, time.Minute, f.retryAfter(t, subjectKey), "a running lockout is not lifted")
+}
+
+func TestTracker_Check(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      Key
+		wantCode string
+	}{
+		{name: "client IP", key: ipKey, wantCode: app.CodeTooManyAuthFailures},
+		{name: "subject", key: subjectKey, wantCode: app.CodeAccountLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTrackerFixture()
+			f.fail(3, tt.key)
+			f.advance(30*time.Second + time.Millisecond)
+
+			err := f.tracker.Check(context.Background(), Key{Type: KeyTypeIP, ID: "ip:192.0.2.99"}, tt.key)
+
+			var appErr *app.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, OpCheck, appErr.Op)
+			assert.Equal(t, tt.wantCode, appErr.Code)
+			assert.Contains(t, appErr.Message, "30 seconds")
+			var locked *LockedError
+			require.ErrorAs(t, err, &locked)
+			assert.Equal(t, 30, locked.RetryAfterSeconds())
+			assert.Equal(t, 1, f.metrics.rejected[string(tt.key.Type)])
+		})
+	}
+}
+
+func TestTracker_RecordsLockout(t *testing.T) {
+	f := newTrackerFixture()
+
+	f.fail(3, ipKey, subjectKey)
+
+	assert.Equal(t, 3, f.metrics.failures["ip"])
+	assert.Equal(t, 3, f.metrics.failures["subject"])
+	assert.Equal(t, 1, f.metrics.lockouts["ip"])
+	assert.Equal(t, 1, f.metrics.lockouts["subject"])
+
+	require.Len(t, f.auditRepo.events, 2)
+	event := f.auditRepo.events[1]
+	assert.Equal(t, domain.EventAuthLockout, event.EventType)
+	assert.Equal(t, "auth_lockout", event.EntityType)
+	assert.Equal(t, domain.ID("lockout-1"), event.EntityID)
+	assert.Empty(t, event.ActorID)
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	assert.Equal(t, "subject", payload["keyType"])
+	assert.Equal(t, "user:client-1", payload["key"])
+	assert.EqualValues(t, 3, payload["failures"])
+	assert.EqualValues(t, 1, payload["lockouts"])
+	assert.Equal(t, fixedNow.Add(time.Minute).Format(time.RFC3339), payload["lockedUntil"])
+}
+
+func TestTracker_AuditFailureDoesNotPreventLockout(t *testing.T) {
+	f := newTrackerFixture()
+	f.auditRepo.createError = errors.New("db down")
+
+	f.fail(3, ipKey)
+
+	assert.Equal(t, time.Minute, f.retryAfter(t, ipKey))
+}
+
+func TestTracker_SweepsStaleEntries(t *testing.T) {
+	f := newTrackerFixture()
+
+	f.fail(1, subjectKey)
+	f.advance(8 * time.Minute)
+	f.fail(3, ipKey)
+	f.advance(2 * time.Minute)
+	f.fail(1, Key{Type: KeyTypeIP, ID: "ip:192.0.2.2"})
+
+	f.tracker.mu.Lock()
+	defer f.tracker.mu.Unlock()
+	assert.NotContains(t, f.tracker.entries, subjectKey)
+	assert.Contains(t, f.tracker.entries, ipKey, "kept while a new lockout would still grow")
+}
+
+func TestTracker_EvictsLeastRecentlyFailedWhenFull(t *testing.T) {
+	f := newTrackerFixture()
+	f.tracker.cfg.MaxEntries = 2
+	otherIP := Key{Type: KeyTypeIP, ID: "ip:192.0.2.2"}
+
+	f.fail(2, ipKey)
+	f.fail(1, subjectKey)
+	f.fail(1, ipKey)
+	f.fail(1, otherIP)
+
+	assert.Equal(t, time.Minute, f.retryAfter(t, ipKey))
+	f.tracker.mu.Lock()
+	defer f.tracker.mu.Unlock()
+	assert.Len(t, f.tracker.entries, 2)
+	assert.Contains(t, f.tracker.entries, otherIP)
+	assert.NotContains(t, f.tracker.entries, subjectKey, "failed least recently")
+}
+
+func TestTracker_RefusesNewKeysWhileFullOfLockouts(t *testing.T) {
+	f := newTrackerFixture()
+	f.tracker.cfg.MaxEntries = 1
+
+	f.fail(3, ipKey)
+	f.fail(3, subjectKey)
+
+	assert.Equal(t, time.Minute, f.retryAfter(t, ipKey), "a lockout is not evicted")
+	assert.Zero(t, f.retryAfter(t, subjectKey))
+
+	f.advance(time.Minute)
+	f.fail(3, subjectKey)
+
+	assert.Equal(t, time.Minute, f.retryAfter(t, subjectKey), "tracked once the lockout is over")
+	f.tracker.mu.Lock()
+	defer f.tracker.mu.Unlock()
+	assert.NotContains(t, f.tracker.entries, ipKey)
+}
+
+func TestNewTracker_Defaults(t *testing.T) {
+	tracker := NewTracker(Config{}, nil, nil, nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	assert.Equal(t, Config{
+		MaxFailures: DefaultMaxFailures,
+		Window:      DefaultWindow,
+		BaseLockout: DefaultBaseLockout,
+		MaxLockout:  DefaultMaxLockout,
+		MaxEntries:  DefaultMaxEntries,
+	}, tracker.cfg)
+}
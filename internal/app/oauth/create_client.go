@@ -0,0 +1,182 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// maxClientNameLength bounds OAuthClient.Name.
+const maxClientNameLength = 100
+
+// secretBytes is the entropy of generated client secrets.
+const secretBytes = 32
+
+// CreateClientRequest represents the input data for registering an OAuth client.
+type CreateClientRequest struct {
+	// Name describes the client, e.g. the calling service.
+	Name string
+	// Role is the role claim of the client's tokens: "user" or "admin".
+	Role string
+	// Scopes are the scopes the client may be issued, named like the
+	// registered permissions without ":self" variants.
+	Scopes []string
+	// RequestID correlates this operation with the HTTP request.
+	// Transport layer extracts from context and passes here.
+	RequestID string
+	// ActorID identifies who is performing this action.
+	// Transport layer extracts from JWT claims and passes here.
+	ActorID domain.ID
+}
+
+// CreateClientResponse represents the registered client.
+type CreateClientResponse struct {
+	Client domain.OAuthClient
+	// Secret is the generated client secret. It is not stored and cannot be
+	// retrieved again.
+	Secret string
+}
+
+// OpCreateClient is the operation name for CreateClient use case.
+const OpCreateClient = "CreateClient"
+
+// CreateClientUseCase registers an OAuth client with a generated secret.
+// Requires oauth_clients:write.
+type CreateClientUseCase struct {
+	repo         domain.OAuthClientRepository
+	hasher       domain.SecretHasher
+	auditService *audit.AuditService
+	txManager    domain.TxManager
+	idGen        domain.IDGenerator
+	now          func() time.Time
+	authz        app.Authorizer
+	log          *logger.Logger
+}
+
+// NewCreateClientUseCase creates a new instance of CreateClientUseCase.
+func NewCreateClientUseCase(
+	repo domain.OAuthClientRepository,
+	hasher domain.SecretHasher,
+	auditService *audit.AuditService,
+	txManager domain.TxManager,
+	idGen domain.IDGenerator,
+	authz app.Authorizer,
+	log *logger.Logger,
+) *CreateClientUseCase {
+	return &CreateClientUseCase{
+		repo:         repo,
+		hasher:       hasher,
+		auditService: auditService,
+		txManager:    txManager,
+		idGen:        idGen,
+		now:          time.Now,
+		authz:        authz,
+		log:          log.With("usecase", OpCreateClient),
+	}
+}
+
+// Execute registers the client and records an oauth_client.created audit
+// event in the same transaction.
+// Returns AppError with Code=INSUFFICIENT_PERMISSIONS without
+// oauth_clients:write and VALIDATION_ERROR for an empty or too long name, an
+// unknown role or no or unknown scopes.
+func (uc *CreateClientUseCase) Execute(ctx context.Context, req CreateClientRequest) (CreateClientResponse, error) {
+	authCtx, err := app.RequirePermission(ctx, uc.authz, OpCreateClient, app.PermOAuthClientsWrite, app.Resource{Type: "oauth_client"})
+	if err != nil {
+		return CreateClientResponse{}, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxClientNameLength {
+		return CreateClientResponse{}, validationError(fmt.Sprintf("name must be 1 to %d characters", maxClientNameLength))
+	}
+	role := strings.ToLower(strings.TrimSpace(req.Role))
+	if role != app.RoleUser && role != app.RoleAdmin {
+		return CreateClientResponse{}, validationError("role must be user or admin")
+	}
+	scopes, err := app.ParseScopes(req.Scopes)
+	if err != nil {
+		return CreateClientResponse{}, validationError(err.Error())
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return CreateClientResponse{}, internalError("Failed to generate client secret", err)
+	}
+	hash, err := uc.hasher.Hash(secret)
+	if err != nil {
+		return CreateClientResponse{}, internalError("Failed to hash client secret", err)
+	}
+
+	client := domain.OAuthClient{
+		ID:         uc.idGen.NewID(),
+		Name:       name,
+		SecretHash: hash,
+		Role:       role,
+		Scopes:     scopes,
+		CreatedBy:  string(req.ActorID),
+		CreatedAt:  uc.now().UTC(),
+	}
+
+	if err := uc.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		if err := uc.repo.Create(ctx, tx, &client); err != nil {
+			return internalError("Failed to create client", err)
+		}
+		if err := uc.auditService.Record(ctx, tx, audit.AuditEventInput{
+			EventType:  domain.EventOAuthClientCreated,
+			ActorID:    req.ActorID,
+			EntityType: "oauth_client",
+			EntityID:   client.ID,
+			Payload:    map[string]any{"name": client.Name, "role": client.Role, "scopes": client.Scopes},
+			RequestID:  req.RequestID,
+		}); err != nil {
+			return internalError("Failed to record audit event", err)
+		}
+		return nil
+	}); err != nil {
+		return CreateClientResponse{}, err
+	}
+
+	logger.FromContext(ctx, uc.log).InfoContext(ctx, "oauth client created",
+		"actorId", authCtx.SubjectID,
+		"clientId", client.ID,
+		"role", client.Role,
+	)
+
+	return CreateClientResponse{Client: client, Secret: secret}, nil
+}
+
+// generateSecret returns a random URL-safe client secret.
+func generateSecret() (string, error) {
+	b := make([]byte, secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func validationError(msg string) error {
+	return &app.AppError{
+		Op:      OpCreateClient,
+		Code:    app.CodeValidationError,
+		Message: msg,
+	}
+}
+
+func internalError(msg string, err error) error {
+	return &app.AppError{
+		Op:      OpCreateClient,
+		Code:    app.CodeInternalError,
+		Message: msg,
+		Err:     err,
+	}
+}
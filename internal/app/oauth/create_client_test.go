@@ -0,0 +1,176 @@
+//go:build !integration
+
+package oauth
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+func authCtx(subjectID, role string) context.Context {
+	return app.SetAuthContext(context.Background(), &app.AuthContext{
+		SubjectID: subjectID,
+		Role:      role,
+	})
+}
+
+type createClientFixture struct {
+	uc    *CreateClientUseCase
+	repo  *mockOAuthClientRepository
+	audit *testutil.AuditEvents
+}
+
+func newCreateClientFixture(t *testing.T) createClientFixture {
+	repo := &mockOAuthClientRepository{}
+	auditRepo, events := testutil.RecordAuditEvents(t)
+	idGen := &mockIDGenerator{id: testClientID}
+	auditService := audit.NewAuditService(auditRepo, testutil.Redactor{}, idGen)
+	uc := NewCreateClientUseCase(repo, &mockSecretHasher{}, auditService, testutil.TxManager{}, idGen, testutil.NewAuthorizer(discardLogger()), discardLogger())
+	uc.now = func() time.Time { return fixedNow }
+	return createClientFixture{uc: uc, repo: repo, audit: events}
+}
+
+func TestCreateClientUseCase_Execute(t *testing.T) {
+	f := newCreateClientFixture(t)
+
+	resp, err := f.uc.Execute(authCtx("admin-id", app.RoleAdmin), CreateClientRequest{
+		Name:      "  billing-service ",
+		Role:      "Admin",
+		Scopes:    []string{"users:read", "users:write"},
+		RequestID: "req-1",
+		ActorID:   "admin-id",
+	})
+
+	require.NoError(t, err)
+	secret, err := base64.RawURLEncoding.DecodeString(resp.Secret)
+	require.NoError(t, err)
+	assert.Len(t, secret, secretBytes)
+
+	want := domain.OAuthClient{
+		ID:         testClientID,
+		Name:       "billing-service",
+		SecretHash: "hashed:" + resp.Secret,
+		Role:       app.RoleAdmin,
+		Scopes:     []string{"users:read", "users:write"},
+		CreatedBy:  "admin-id",
+		CreatedAt:  fixedNow,
+	}
+	assert.Equal(t, want, resp.Client)
+	assert.Equal(t, want, f.repo.clients[testClientID])
+
+	require.Len(t, f.audit.Events, 1)
+	event := f.audit.Events[0]
+	assert.Equal(t, domain.EventOAuthClientCreated, event.EventType)
+	assert.Equal(t, "oauth_client", event.EntityType)
+	assert.Equal(t, testClientID, event.EntityID)
+	assert.Equal(t, domain.ID("admin-id"), event.ActorID)
+	assert.NotContains(t, string(event.Payload), resp.Secret, "the secret must not be audited")
+	assert.NotContains(t, string(event.Payload), "hashed:", "the secret hash must not be audited")
+}
+
+func TestCreateClientUseCase_Execute_SecretsAreUnique(t *testing.T) {
+	f := newCreateClientFixture(t)
+	ctx := authCtx("admin-id", app.RoleAdmin)
+
+	first, err := f.uc.Execute(ctx, CreateClientRequest{Name: "a", Role: app.RoleUser, Scopes: []string{"users:read"}})
+	require.NoError(t, err)
+	second, err := f.uc.Execute(ctx, CreateClientRequest{Name: "b", Role: app.RoleUser, Scopes: []string{"users:read"}})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.Secret, second.Secret)
+}
+
+func TestCreateClientUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		req            CreateClientRequest
+		createError    error
+		auditError     error
+		wantCode       string
+		wantMessageSub string
+	}{
+		{
+			name:     "no auth context",
+			ctx:      context.Background(),
+			req:      CreateClientRequest{Name: "svc", Role: app.RoleUser},
+			wantCode: app.CodeForbidden,
+		},
+		{
+			name:     "non-admin",
+			ctx:      authCtx("user-id", app.RoleUser),
+			req:      CreateClientRequest{Name: "svc", Role: app.RoleUser},
+			wantCode: app.CodeInsufficientPermissions,
+		},
+		{
+			name:           "blank name",
+			req:            CreateClientRequest{Name: "   ", Role: app.RoleUser},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "name",
+		},
+		{
+			name:           "name too long",
+			req:            CreateClientRequest{Name: strings.Repeat("a", maxClientNameLength+1), Role: app.RoleUser},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "name",
+		},
+		{
+			name:           "unknown role",
+			req:            CreateClientRequest{Name: "svc", Role: "superuser"},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "role",
+		},
+		{
+			name:           "unknown scope",
+			req:            CreateClientRequest{Name: "svc", Role: app.RoleUser, Scopes: []string{"users:delete"}},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "scope",
+		},
+		{
+			name:        "repository error",
+			req:         CreateClientRequest{Name: "svc", Role: app.RoleUser, Scopes: []string{"users:read"}},
+			createError: errors.New("db down"),
+			wantCode:    app.CodeInternalError,
+		},
+		{
+			name:       "audit error",
+			req:        CreateClientRequest{Name: "svc", Role: app.RoleUser, Scopes: []string{"users:read"}},
+			auditError: errors.New("db down"),
+			wantCode:   app.CodeInternalError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCreateClientFixture(t)
+			f.repo.createError = tt.createError
+			f.audit.Err = tt.auditError
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = authCtx("admin-id", app.RoleAdmin)
+			}
+
+			_, err := f.uc.Execute(ctx, tt.req)
+
+			var appErr *app.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, tt.wantCode, appErr.Code)
+			assert.Contains(t, appErr.Message, tt.wantMessageSub)
+			if tt.createError != nil {
+				assert.Empty(t, f.audit.Events)
+			}
+		})
+	}
+}
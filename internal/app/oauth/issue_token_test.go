@@ -0,0 +1,274 @@
+//go:build !integration
+
+package oauth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+// mockOAuthClientRepository is a test double for domain.OAuthClientRepository.
+type mockOAuthClientRepository struct {
+	clients     map[domain.ID]domain.OAuthClient
+	getError    error
+	createError error
+}
+
+func (m *mockOAuthClientRepository) Create(_ context.Context, _ domain.Querier, client *domain.OAuthClient) error {
+	if m.createError != nil {
+		return m.createError
+	}
+	if m.clients == nil {
+		m.clients = make(map[domain.ID]domain.OAuthClient)
+	}
+	m.clients[client.ID] = *client
+	return nil
+}
+
+func (m *mockOAuthClientRepository) GetByID(_ context.Context, _ domain.Querier, id domain.ID) (*domain.OAuthClient, error) {
+	if m.getError != nil {
+		return nil, m.getError
+	}
+	client, ok := m.clients[id]
+	if !ok {
+		return nil, domain.ErrOAuthClientNotFound
+	}
+	return &client, nil
+}
+
+// mockSecretHasher is a test double for domain.SecretHasher that "hashes" by
+// prefixing the secret and records the hashes verified against.
+type mockSecretHasher struct {
+	verified []string
+}
+
+func (m *mockSecretHasher) Hash(secret string) (string, error) { return "hashed:" + secret, nil }
+
+func (m *mockSecretHasher) Verify(hash, secret string) bool {
+	m.verified = append(m.verified, hash)
+	return hash == "hashed:"+secret
+}
+
+// mockSigner is a test double for domain.AccessTokenSigner.
+type mockSigner struct {
+	signed []domain.AccessToken
+	err    error
+}
+
+func (m *mockSigner) Sign(token domain.AccessToken) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	m.signed = append(m.signed, token)
+	return "signed." + token.ID, nil
+}
+
+// mockIDGenerator is a test double for domain.IDGenerator.
+type mockIDGenerator struct {
+	id domain.ID
+}
+
+func (m *mockIDGenerator) NewID() domain.ID { return m.id }
+
+var fixedNow = time.Date(2026, 1, 11, 9, 30, 0, 0, time.UTC)
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+const (
+	testClientID = domain.ID("0195f0c4-0000-7000-8000-000000000001")
+	testSecret   = "s3cret"
+)
+
+type issueTokenFixture struct {
+	uc     *IssueTokenUseCase
+	repo   *mockOAuthClientRepository
+	hasher *mockSecretHasher
+	signer *mockSigner
+}
+
+func newIssueTokenFixture() issueTokenFixture {
+	repo := &mockOAuthClientRepository{clients: map[domain.ID]domain.OAuthClient{
+		testClientID: {ID: testClientID, Name: "billing", SecretHash: "hashed:" + testSecret, Role: app.RoleUser, TenantID: "tenant-a", Scopes: []string{"users:read", "users:write"}},
+	}}
+	hasher := &mockSecretHasher{}
+	signer := &mockSigner{}
+	uc := NewIssueTokenUseCase(repo, testutil.Querier{}, hasher, signer, &mockIDGenerator{id: "jti-1"}, 10*time.Minute, discardLogger())
+	uc.now = func() time.Time { return fixedNow.Add(400 * time.Millisecond) }
+	return issueTokenFixture{uc: uc, repo: repo, hasher: hasher, signer: signer}
+}
+
+func TestIssueTokenUseCase_Execute(t *testing.T) {
+	f := newIssueTokenFixture()
+
+	resp, err := f.uc.Execute(context.Background(), IssueTokenRequest{ClientID: string(testClientID), ClientSecret: testSecret})
+
+	require.NoError(t, err)
+	want := domain.AccessToken{
+		ID:        "jti-1",
+		Subject:   string(testClientID),
+		Role:      app.RoleUser,
+		TenantID:  "tenant-a",
+		Scopes:    []string{"users:read", "users:write"},
+		IssuedAt:  fixedNow,
+		ExpiresAt: fixedNow.Add(10 * time.Minute),
+	}
+	assert.Equal(t, want, resp.Token)
+	assert.Equal(t, "signed.jti-1", resp.AccessToken)
+	assert.Equal(t, []domain.AccessToken{want}, f.signer.signed)
+}
+
+func TestIssueTokenUseCase_Execute_Scope(t *testing.T) {
+	tests := []struct {
+		name         string
+		scope        string
+		clientScopes []string
+		wantScopes   []string
+		wantCode     string
+	}{
+		{name: "subset of the client's scopes", scope: " users:read  users:read ", clientScopes: []string{"users:read", "users:write"}, wantScopes: []string{"users:read"}},
+		{name: "scope not granted", scope: "users:read audit:read", clientScopes: []string{"users:read"}, wantCode: app.CodeValidationError},
+		{name: "client without scopes", clientScopes: nil, wantScopes: nil},
+		{name: "client without scopes requesting one", scope: "users:read", clientScopes: nil, wantCode: app.CodeValidationError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newIssueTokenFixture()
+			client := f.repo.clients[testClientID]
+			client.Scopes = tt.clientScopes
+			f.repo.clients[testClientID] = client
+
+			resp, err := f.uc.Execute(context.Background(), IssueTokenRequest{ClientID: string(testClientID), ClientSecret: testSecret, Scope: tt.scope})
+
+			if tt.wantCode != "" {
+				var appErr *app.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				assert.Empty(t, f.signer.signed)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantScopes, resp.Token.Scopes)
+		})
+	}
+}
+
+func TestIssueTokenUseCase_Execute_DefaultTTL(t *testing.T) {
+	f := newIssueTokenFixture()
+	uc := NewIssueTokenUseCase(f.repo, testutil.Querier{}, f.hasher, f.signer, &mockIDGenerator{id: "jti-1"}, 0, discardLogger())
+
+	resp, err := uc.Execute(context.Background(), IssueTokenRequest{ClientID: string(testClientID), ClientSecret: testSecret})
+
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTokenTTL, resp.Token.ExpiresAt.Sub(resp.Token.IssuedAt))
+}
+
+func TestIssueTokenUseCase_Execute_InvalidCredentials(t *testing.T) {
+	disabledAt := fixedNow.Add(-time.Hour)
+
+	tests := []struct {
+		name         string
+		req          IssueTokenRequest
+		disabled     bool
+		wantVerified int
+	}{
+		{name: "missing client_id", req: IssueTokenRequest{ClientSecret: testSecret}},
+		{name: "missing secret", req: IssueTokenRequest{ClientID: string(testClientID)}},
+		{name: "unknown client", req: IssueTokenRequest{ClientID: "unknown", ClientSecret: testSecret}, wantVerified: 1},
+		{name: "wrong secret", req: IssueTokenRequest{ClientID: string(testClientID), ClientSecret: "wrong"}, wantVerified: 1},
+		{name: "disabled client", req: IssueTokenRequest{ClientID: string(testClientID), ClientSecret: testSecret}, disabled: true, wantVerified: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newIssueTokenFixture()
+			if tt.disabled {
+				client := f.repo.clients[testClientID]
+				client.DisabledAt = &disabledAt
+				f.repo.clients[testClientID] = client
+			}
+
+			_, err := f.uc.Execute(context.Background(), tt.req)
+
+			var appErr *app.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, app.CodeInvalidCredentials, appErr.Code)
+			assert.Equal(t, "Invalid client credentials", appErr.Message)
+			assert.Len(t, f.hasher.verified, tt.wantVerified, "a secret is verified exactly once per known client_id")
+			assert.Empty(t, f.signer.signed)
+		})
+	}
+}
+
+func TestIssueTokenUseCase_Execute_UnknownClientVerifiesDummyHash(t *testing.T) {
+	f := newIssueTokenFixture()
+
+	_, err := f.uc.Execute(context.Background(), IssueTokenRequest{ClientID: "unknown", ClientSecret: testSecret})
+
+	require.Error(t, err)
+	require.Len(t, f.hasher.verified, 1)
+	assert.Equal(t, f.uc.dummyHash, f.hasher.verified[0])
+	assert.NotEmpty(t, f.uc.dummyHash)
+}
+
+func TestIssueTokenUseCase_Execute_InternalErrors(t *testing.T) {
+	t.Run("repository error", func(t *testing.T) {
+		f := newIssueTokenFixture()
+		f.repo.getError = errors.New("connection refused")
+
+		_, err := f.uc.Execute(context.Background(), IssueTokenRequest{ClientID: string(testClientID), ClientSecret: testSecret})
+
+		var appErr *app.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, app.CodeInternalError, appErr.Code)
+		assert.Empty(t, f.hasher.verified)
+	})
+
+	t.Run("signer error", func(t *testing.T) {
+		f := newIssueTokenFixture()
+		f.signer.err = errors.New("no signing key")
+
+		_, err := f.uc.Execute(context.Background(), IssueTokenRequest{ClientID: string(testClientID), ClientSecret: testSecret})
+
+		var appErr *app.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, app.CodeInternalError, appErr.Code)
+		assert.Equal(t, "Failed to issue token", appErr.Message)
+	})
+}
+
+func TestIssueTokenUseCase_ClientExists(t *testing.T) {
+	tests := []struct {
+		name     string
+		clientID string
+		getError error
+		want     bool
+	}{
+		{name: "registered client", clientID: string(testClientID), want: true},
+		{name: "unknown client", clientID: "unknown", want: false},
+		{name: "empty client_id", clientID: " ", want: false},
+		{name: "lookup error", clientID: "unknown", getError: errors.New("connection refused"), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newIssueTokenFixture()
+			f.repo.getError = tt.getError
+
+			assert.Equal(t, tt.want, f.uc.ClientExists(context.Background(), tt.clientID))
+		})
+	}
+}
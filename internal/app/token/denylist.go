@@ -0,0 +1,175 @@
+// Package token provides use cases for managing issued access tokens.
+package token
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// DefaultDenylistTTL is how long a loaded denylist is used before it is reloaded.
+const DefaultDenylistTTL = 10 * time.Second
+
+// Denylist answers whether a token has been revoked from an in-process copy of
+// all active revocations, reloaded at most once per TTL. Revocations made on
+// another instance therefore take effect within one TTL; those made through
+// this instance's RevokeTokensUseCase take effect immediately.
+//
+// A failed reload keeps the previous copy: the database is the only place
+// revocations are written, so it being unreachable delays nothing that could
+// have been revoked meanwhile. Only if no copy was ever loaded does IsRevoked
+// return an error.
+type Denylist struct {
+	repo domain.TokenRevocationRepository
+	db   domain.Querier
+	ttl  time.Duration
+	now  func() time.Time
+	log  *logger.Logger
+
+	mu       sync.RWMutex
+	set      *revocationSet
+	loadedAt time.Time
+
+	// refreshMu serializes reloads so concurrent requests trigger one query.
+	refreshMu sync.Mutex
+}
+
+// NewDenylist creates a Denylist that reloads from repo every ttl.
+// A non-positive ttl uses DefaultDenylistTTL. Nothing is loaded until the
+// first IsRevoked call.
+func NewDenylist(repo domain.TokenRevocationRepository, db domain.Querier, ttl time.Duration, log *logger.Logger) *Denylist {
+	if ttl <= 0 {
+		ttl = DefaultDenylistTTL
+	}
+	return &Denylist{
+		repo: repo,
+		db:   db,
+		ttl:  ttl,
+		now:  time.Now,
+		log:  log.With("component", "token_denylist"),
+	}
+}
+
+// IsRevoked reports whether a token with the given tenant_id, jti, sub and
+// iat claims has been revoked. Only revocations of tenantID apply. jti may be
+// empty; a zero issuedAt means the token has no iat claim, which a subject
+// revocation treats as issued before its cutoff.
+func (d *Denylist) IsRevoked(ctx context.Context, tenantID, jti, subject string, issuedAt time.Time) (bool, error) {
+	set, err := d.current(ctx)
+	if err != nil {
+		return false, err
+	}
+	return set.revokes(tenantID, jti, subject, issuedAt), nil
+}
+
+// Add makes revocation effective on this instance without waiting for a reload.
+func (d *Denylist) Add(revocation domain.TokenRevocation) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.set != nil {
+		d.set = d.set.with(revocation)
+	}
+}
+
+func (d *Denylist) current(ctx context.Context) (*revocationSet, error) {
+	if set, fresh := d.cached(); fresh {
+		return set, nil
+	}
+
+	d.refreshMu.Lock()
+	defer d.refreshMu.Unlock()
+	// Another request may have reloaded while we waited.
+	set, fresh := d.cached()
+	if fresh {
+		return set, nil
+	}
+
+	revocations, err := d.repo.ListActive(ctx, d.db)
+	if err != nil {
+		if set == nil {
+			return nil, fmt.Errorf("load token denylist: %w", err)
+		}
+		logger.FromContext(ctx, d.log).WarnContext(ctx, "token denylist reload failed, keeping previous list", "error", err)
+	} else {
+		set = newRevocationSet(revocations)
+	}
+
+	d.mu.Lock()
+	d.set = set
+	d.loadedAt = d.now()
+	d.mu.Unlock()
+	return set, nil
+}
+
+// cached returns the loaded set and whether it is younger than the TTL.
+func (d *Denylist) cached() (*revocationSet, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.set, d.set != nil && d.now().Sub(d.loadedAt) < d.ttl
+}
+
+// revocationKey identifies a jti or subject within a tenant.
+type revocationKey struct {
+	tenantID string
+	value    string
+}
+
+// revocationSet indexes revocations for constant-time lookups. It is never
+// modified after creation, so it can be read without locking.
+type revocationSet struct {
+	byJTI map[revocationKey]domain.TokenRevocation
+	// bySubject keeps the revocation with the latest cutoff per subject.
+	bySubject map[revocationKey]domain.TokenRevocation
+}
+
+func newRevocationSet(revocations []domain.TokenRevocation) *revocationSet {
+	s := &revocationSet{
+		byJTI:     make(map[revocationKey]domain.TokenRevocation),
+		bySubject: make(map[revocationKey]domain.TokenRevocation),
+	}
+	for _, r := range revocations {
+		s.add(r)
+	}
+	return s
+}
+
+func (s *revocationSet) add(r domain.TokenRevocation) {
+	if r.JTI != "" {
+		s.byJTI[revocationKey{r.TenantID, r.JTI}] = r
+		return
+	}
+	key := revocationKey{r.TenantID, r.Subject}
+	if prev, ok := s.bySubject[key]; !ok || r.IssuedBefore.After(prev.IssuedBefore) {
+		s.bySubject[key] = r
+	}
+}
+
+// with returns a copy of s that also contains r.
+func (s *revocationSet) with(r domain.TokenRevocation) *revocationSet {
+	c := &revocationSet{
+		byJTI:     make(map[revocationKey]domain.TokenRevocation, len(s.byJTI)+1),
+		bySubject: make(map[revocationKey]domain.TokenRevocation, len(s.bySubject)+1),
+	}
+	for k, v := range s.byJTI {
+		c.byJTI[k] = v
+	}
+	for k, v := range s.bySubject {
+		c.bySubject[k] = v
+	}
+	c.add(r)
+	return c
+}
+
+func (s *revocationSet) revokes(tenantID, jti, subject string, issuedAt time.Time) bool {
+	if jti != "" {
+		if r, ok := s.byJTI[revocationKey{tenantID, jti}]; ok && r.Revokes(tenantID, jti, subject, issuedAt) {
+			return true
+		}
+	}
+	r, ok := s.bySubject[revocationKey{tenantID, subject}]
+	return ok && r.Revokes(tenantID, jti, subject, issuedAt)
+}
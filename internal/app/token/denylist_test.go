@@ -0,0 +1,181 @@
+//go:build !integration
+
+package token
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+// mockRevocationRepository is a test double for domain.TokenRevocationRepository.
+type mockRevocationRepository struct {
+	revocations []domain.TokenRevocation
+	listCalls   int
+	listError   error
+	createError error
+}
+
+func (m *mockRevocationRepository) Create(_ context.Context, _ domain.Querier, revocation *domain.TokenRevocation) error {
+	if m.createError != nil {
+		return m.createError
+	}
+	m.revocations = append(m.revocations, *revocation)
+	return nil
+}
+
+func (m *mockRevocationRepository) ListActive(_ context.Context, _ domain.Querier) ([]domain.TokenRevocation, error) {
+	m.listCalls++
+	if m.listError != nil {
+		return nil, m.listError
+	}
+	return append([]domain.TokenRevocation(nil), m.revocations...), nil
+}
+
+func (m *mockRevocationRepository) DeleteExpired(_ context.Context, _ domain.Querier) (int64, error) {
+	return 0, nil
+}
+
+var fixedNow = time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+// newTestDenylist returns a Denylist with a TTL of one minute and a clock
+// controlled through the returned pointer.
+func newTestDenylist(repo *mockRevocationRepository) (*Denylist, *time.Time) {
+	now := fixedNow
+	d := NewDenylist(repo, testutil.Querier{}, time.Minute, discardLogger())
+	d.now = func() time.Time { return now }
+	return d, &now
+}
+
+func TestDenylist_IsRevoked(t *testing.T) {
+	repo := &mockRevocationRepository{revocations: []domain.TokenRevocation{
+		{TenantID: testTenantID, JTI: "jti-1"},
+		{TenantID: testTenantID, Subject: "user-1", IssuedBefore: fixedNow.Add(-time.Hour)},
+		{TenantID: testTenantID, Subject: "user-1", IssuedBefore: fixedNow.Add(-2 * time.Hour)},
+	}}
+	d, _ := newTestDenylist(repo)
+
+	tests := []struct {
+		name     string
+		tenantID string
+		jti      string
+		subject  string
+		issuedAt time.Time
+		want     bool
+	}{
+		{name: "revoked jti", jti: "jti-1", subject: "user-2", issuedAt: fixedNow, want: true},
+		{name: "other jti", jti: "jti-2", subject: "user-2", issuedAt: fixedNow},
+		{name: "subject before latest cutoff", jti: "jti-2", subject: "user-1", issuedAt: fixedNow.Add(-90 * time.Minute), want: true},
+		{name: "subject after latest cutoff", jti: "jti-2", subject: "user-1", issuedAt: fixedNow.Add(-time.Minute)},
+		{name: "subject without iat", subject: "user-1", want: true},
+		{name: "jti of another tenant", tenantID: "tenant-b", jti: "jti-1", subject: "user-2", issuedAt: fixedNow},
+		{name: "subject of another tenant", tenantID: "tenant-b", subject: "user-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantID := tt.tenantID
+			if tenantID == "" {
+				tenantID = testTenantID
+			}
+			revoked, err := d.IsRevoked(context.Background(), tenantID, tt.jti, tt.subject, tt.issuedAt)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, revoked)
+		})
+	}
+	assert.Equal(t, 1, repo.listCalls, "lookups within the TTL must reuse the loaded list")
+}
+
+func TestDenylist_ReloadsAfterTTL(t *testing.T) {
+	repo := &mockRevocationRepository{}
+	d, now := newTestDenylist(repo)
+
+	revoked, err := d.IsRevoked(context.Background(), testTenantID, "jti-1", "user-1", fixedNow)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	// Written by another instance.
+	repo.revocations = append(repo.revocations, domain.TokenRevocation{TenantID: testTenantID, JTI: "jti-1"})
+
+	*now = fixedNow.Add(30 * time.Second)
+	revoked, err = d.IsRevoked(context.Background(), testTenantID, "jti-1", "user-1", fixedNow)
+	require.NoError(t, err)
+	assert.False(t, revoked, "the cached list is used within the TTL")
+
+	*now = fixedNow.Add(time.Minute)
+	revoked, err = d.IsRevoked(context.Background(), testTenantID, "jti-1", "user-1", fixedNow)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	assert.Equal(t, 2, repo.listCalls)
+}
+
+func TestDenylist_ReloadFailure(t *testing.T) {
+	t.Run("never loaded", func(t *testing.T) {
+		repo := &mockRevocationRepository{listError: errors.New("db down")}
+		d, _ := newTestDenylist(repo)
+
+		_, err := d.IsRevoked(context.Background(), testTenantID, "jti-1", "user-1", fixedNow)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("keeps previous list", func(t *testing.T) {
+		repo := &mockRevocationRepository{revocations: []domain.TokenRevocation{{TenantID: testTenantID, JTI: "jti-1"}}}
+		d, now := newTestDenylist(repo)
+		_, err := d.IsRevoked(context.Background(), testTenantID, "jti-1", "user-1", fixedNow)
+		require.NoError(t, err)
+
+		repo.listError = errors.New("db down")
+		*now = fixedNow.Add(2 * time.Minute)
+		revoked, err := d.IsRevoked(context.Background(), testTenantID, "jti-1", "user-1", fixedNow)
+		require.NoError(t, err)
+		assert.True(t, revoked)
+
+		// The failed reload is not retried on every request.
+		_, err = d.IsRevoked(context.Background(), testTenantID, "jti-1", "user-1", fixedNow)
+		require.NoError(t, err)
+		assert.Equal(t, 2, repo.listCalls)
+	})
+}
+
+func TestDenylist_Add(t *testing.T) {
+	repo := &mockRevocationRepository{}
+	d, _ := newTestDenylist(repo)
+
+	// Before the first load there is nothing to update; the load will see it.
+	d.Add(domain.TokenRevocation{TenantID: testTenantID, JTI: "jti-0"})
+
+	_, err := d.IsRevoked(context.Background(), testTenantID, "", "user-1", fixedNow)
+	require.NoError(t, err)
+
+	d.Add(domain.TokenRevocation{TenantID: testTenantID, JTI: "jti-1"})
+	d.Add(domain.TokenRevocation{TenantID: testTenantID, Subject: "user-1", IssuedBefore: fixedNow})
+
+	revoked, err := d.IsRevoked(context.Background(), testTenantID, "jti-1", "user-2", fixedNow)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	revoked, err = d.IsRevoked(context.Background(), testTenantID, "jti-2", "user-1", fixedNow.Add(-time.Second))
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	assert.Equal(t, 1, repo.listCalls)
+}
+
+func TestNewDenylist_DefaultTTL(t *testing.T) {
+	d := NewDenylist(&mockRevocationRepository{}, testutil.Querier{}, 0, discardLogger())
+
+	assert.Equal(t, DefaultDenylistTTL, d.ttl)
+}
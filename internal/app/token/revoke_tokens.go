@@ -0,0 +1,213 @@
+package token
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// DefaultRevocationRetention is how long a revocation is kept when the expiry
+// of the revoked tokens is not known.
+const DefaultRevocationRetention = 24 * time.Hour
+
+// RevokeTokensRequest represents the input data for revoking access tokens.
+// Exactly one of JTI and Subject must be set.
+type RevokeTokensRequest struct {
+	// JTI revokes the single token with this jti claim.
+	JTI string
+	// Subject revokes every token of this subject issued before IssuedBefore.
+	Subject string
+	// IssuedBefore is the cutoff for a Subject revocation. Zero means now.
+	IssuedBefore time.Time
+	// ExpiresAt is when the revoked tokens expire, e.g. the exp claim of the
+	// token named by JTI. Zero means the configured retention after the
+	// revocation (JTI) or after IssuedBefore (Subject).
+	ExpiresAt time.Time
+	// RequestID correlates this operation with the HTTP request.
+	// Transport layer extracts from context and passes here.
+	RequestID string
+	// ActorID identifies who is performing this action.
+	// Transport layer extracts from JWT claims and passes here.
+	ActorID domain.ID
+}
+
+// RevokeTokensResponse represents the stored revocation.
+type RevokeTokensResponse struct {
+	Revocation domain.TokenRevocation
+}
+
+// OpRevokeTokens is the operation name for RevokeTokens use case.
+const OpRevokeTokens = "RevokeTokens"
+
+// RevokeTokensUseCase adds tokens to the denylist checked by the JWT middleware.
+// Requires tokens:revoke.
+type RevokeTokensUseCase struct {
+	repo         domain.TokenRevocationRepository
+	auditService *audit.AuditService
+	txManager    domain.TxManager
+	idGen        domain.IDGenerator
+	denylist     *Denylist
+	retention    time.Duration
+	now          func() time.Time
+	authz        app.Authorizer
+	log          *logger.Logger
+}
+
+// NewRevokeTokensUseCase creates a new instance of RevokeTokensUseCase.
+// retention should be at least the longest token lifetime; a non-positive
+// value uses DefaultRevocationRetention. denylist, if not nil, enforces new
+// revocations on this instance immediately.
+func NewRevokeTokensUseCase(
+	repo domain.TokenRevocationRepository,
+	auditService *audit.AuditService,
+	txManager domain.TxManager,
+	idGen domain.IDGenerator,
+	denylist *Denylist,
+	retention time.Duration,
+	authz app.Authorizer,
+	log *logger.Logger,
+) *RevokeTokensUseCase {
+	if retention <= 0 {
+		retention = DefaultRevocationRetention
+	}
+	return &RevokeTokensUseCase{
+		repo:         repo,
+		auditService: auditService,
+		txManager:    txManager,
+		idGen:        idGen,
+		denylist:     denylist,
+		retention:    retention,
+		now:          time.Now,
+		authz:        authz,
+		log:          log.With("usecase", OpRevokeTokens),
+	}
+}
+
+// Execute stores the revocation and records a token.revoked audit event in
+// the same transaction. The revocation belongs to the caller's tenant and only
+// revokes tokens of that tenant.
+// Returns AppError with Code=INSUFFICIENT_PERMISSIONS without tokens:revoke and VALIDATION_ERROR if
+// not exactly one of JTI and Subject is set, IssuedBefore is in the future or
+// ExpiresAt is not.
+func (uc *RevokeTokensUseCase) Execute(ctx context.Context, req RevokeTokensRequest) (RevokeTokensResponse, error) {
+	authCtx, err := app.RequirePermission(ctx, uc.authz, OpRevokeTokens, app.PermTokensRevoke, app.Resource{Type: "token"})
+	if err != nil {
+		return RevokeTokensResponse{}, err
+	}
+
+	revocation, err := uc.newRevocation(req)
+	if err != nil {
+		return RevokeTokensResponse{}, err
+	}
+	revocation.TenantID = sharedctx.GetTenantID(ctx)
+
+	if err := uc.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		if err := uc.repo.Create(ctx, tx, &revocation); err != nil {
+			return &app.AppError{
+				Op:      OpRevokeTokens,
+				Code:    app.CodeInternalError,
+				Message: "Failed to revoke tokens",
+				Err:     err,
+			}
+		}
+
+		payload := map[string]any{"expiresAt": revocation.ExpiresAt}
+		if revocation.JTI != "" {
+			payload["jti"] = revocation.JTI
+		} else {
+			payload["subject"] = revocation.Subject
+			payload["issuedBefore"] = revocation.IssuedBefore
+		}
+		if err := uc.auditService.Record(ctx, tx, audit.AuditEventInput{
+			EventType:  domain.EventTokenRevoked,
+			ActorID:    req.ActorID,
+			EntityType: "token_revocation",
+			EntityID:   revocation.ID,
+			Payload:    payload,
+			RequestID:  req.RequestID,
+		}); err != nil {
+			return &app.AppError{
+				Op:      OpRevokeTokens,
+				Code:    app.CodeInternalError,
+				Message: "Failed to record audit event",
+				Err:     err,
+			}
+		}
+		return nil
+	}); err != nil {
+		return RevokeTokensResponse{}, err
+	}
+
+	if uc.denylist != nil {
+		uc.denylist.Add(revocation)
+	}
+
+	logger.FromContext(ctx, uc.log).InfoContext(ctx, "tokens revoked",
+		"actorId", authCtx.SubjectID,
+		"revocationId", revocation.ID,
+		"byJti", revocation.JTI != "",
+		"subject", revocation.Subject,
+	)
+
+	return RevokeTokensResponse{Revocation: revocation}, nil
+}
+
+// newRevocation validates req and fills in the defaults.
+func (uc *RevokeTokensUseCase) newRevocation(req RevokeTokensRequest) (domain.TokenRevocation, error) {
+	now := uc.now().UTC()
+	req.JTI = strings.TrimSpace(req.JTI)
+	req.Subject = strings.TrimSpace(req.Subject)
+
+	if (req.JTI == "") == (req.Subject == "") {
+		return domain.TokenRevocation{}, validationError("exactly one of jti and subject must be set")
+	}
+
+	revocation := domain.TokenRevocation{
+		ID:        uc.idGen.NewID(),
+		JTI:       req.JTI,
+		Subject:   req.Subject,
+		ExpiresAt: req.ExpiresAt.UTC(),
+		RevokedBy: string(req.ActorID),
+		RequestID: req.RequestID,
+		CreatedAt: now,
+	}
+
+	if req.JTI != "" {
+		if !req.IssuedBefore.IsZero() {
+			return domain.TokenRevocation{}, validationError("issuedBefore applies only to subject revocations")
+		}
+		if revocation.ExpiresAt.IsZero() {
+			revocation.ExpiresAt = now.Add(uc.retention)
+		}
+	} else {
+		revocation.IssuedBefore = req.IssuedBefore.UTC()
+		if revocation.IssuedBefore.IsZero() {
+			revocation.IssuedBefore = now
+		}
+		if revocation.IssuedBefore.After(now) {
+			return domain.TokenRevocation{}, validationError("issuedBefore must not be in the future")
+		}
+		if revocation.ExpiresAt.IsZero() {
+			revocation.ExpiresAt = revocation.IssuedBefore.Add(uc.retention)
+		}
+	}
+
+	if !revocation.ExpiresAt.After(now) {
+		return domain.TokenRevocation{}, validationError("expiresAt must be in the future")
+	}
+	return revocation, nil
+}
+
+func validationError(msg string) error {
+	return &app.AppError{
+		Op:      OpRevokeTokens,
+		Code:    app.CodeValidationError,
+		Message: msg,
+	}
+}
@@ -0,0 +1,239 @@
+//go:build !integration
+
+package token
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+// mockIDGenerator is a test double for domain.IDGenerator.
+type mockIDGenerator struct{}
+
+func (m *mockIDGenerator) NewID() domain.ID { return "revocation-1" }
+
+// testTenantID is the tenant of the callers and revocations in these tests.
+const testTenantID = "tenant-a"
+
+func authCtx(subjectID, role string) context.Context {
+	ctx := sharedctx.SetTenantID(context.Background(), testTenantID)
+	return app.SetAuthContext(ctx, &app.AuthContext{
+		SubjectID: subjectID,
+		Role:      role,
+		TenantID:  testTenantID,
+	})
+}
+
+type revokeTokensFixture struct {
+	uc       *RevokeTokensUseCase
+	repo     *mockRevocationRepository
+	audit    *testutil.AuditEvents
+	denylist *Denylist
+}
+
+func newRevokeTokensFixture(t *testing.T) revokeTokensFixture {
+	repo := &mockRevocationRepository{}
+	auditRepo, events := testutil.RecordAuditEvents(t)
+	denylist, _ := newTestDenylist(repo)
+	auditService := audit.NewAuditService(auditRepo, testutil.Redactor{}, &mockIDGenerator{})
+	uc := NewRevokeTokensUseCase(repo, auditService, testutil.TxManager{}, &mockIDGenerator{}, denylist, time.Hour, testutil.NewAuthorizer(discardLogger()), discardLogger())
+	uc.now = func() time.Time { return fixedNow }
+	return revokeTokensFixture{uc: uc, repo: repo, audit: events, denylist: denylist}
+}
+
+func TestRevokeTokensUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name string
+		req  RevokeTokensRequest
+		want domain.TokenRevocation
+	}{
+		{
+			name: "by jti with default expiry",
+			req:  RevokeTokensRequest{JTI: " jti-1 "},
+			want: domain.TokenRevocation{JTI: "jti-1", ExpiresAt: fixedNow.Add(time.Hour)},
+		},
+		{
+			name: "by jti with token expiry",
+			req:  RevokeTokensRequest{JTI: "jti-1", ExpiresAt: fixedNow.Add(5 * time.Minute)},
+			want: domain.TokenRevocation{JTI: "jti-1", ExpiresAt: fixedNow.Add(5 * time.Minute)},
+		},
+		{
+			name: "by subject issued before now",
+			req:  RevokeTokensRequest{Subject: "user-1"},
+			want: domain.TokenRevocation{Subject: "user-1", IssuedBefore: fixedNow, ExpiresAt: fixedNow.Add(time.Hour)},
+		},
+		{
+			name: "by subject with cutoff",
+			req:  RevokeTokensRequest{Subject: "user-1", IssuedBefore: fixedNow.Add(-30 * time.Minute)},
+			want: domain.TokenRevocation{
+				Subject:      "user-1",
+				IssuedBefore: fixedNow.Add(-30 * time.Minute),
+				ExpiresAt:    fixedNow.Add(30 * time.Minute),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newRevokeTokensFixture(t)
+			tt.req.ActorID = "admin-id"
+			tt.req.RequestID = "req-1"
+
+			resp, err := f.uc.Execute(authCtx("admin-id", app.RoleAdmin), tt.req)
+
+			require.NoError(t, err)
+			want := tt.want
+			want.ID = "revocation-1"
+			want.TenantID = testTenantID
+			want.RevokedBy = "admin-id"
+			want.RequestID = "req-1"
+			want.CreatedAt = fixedNow
+			assert.Equal(t, want, resp.Revocation)
+			assert.Equal(t, []domain.TokenRevocation{want}, f.repo.revocations)
+
+			require.Len(t, f.audit.Events, 1)
+			event := f.audit.Events[0]
+			assert.Equal(t, domain.EventTokenRevoked, event.EventType)
+			assert.Equal(t, "token_revocation", event.EntityType)
+			assert.Equal(t, domain.ID("revocation-1"), event.EntityID)
+			assert.Equal(t, domain.ID("admin-id"), event.ActorID)
+
+			revoked, err := f.denylist.IsRevoked(context.Background(), testTenantID, "jti-1", "user-1", fixedNow.Add(-time.Hour))
+			require.NoError(t, err)
+			assert.True(t, revoked)
+		})
+	}
+}
+
+func TestRevokeTokensUseCase_Execute_EnforcedWithoutReload(t *testing.T) {
+	f := newRevokeTokensFixture(t)
+	revoked, err := f.denylist.IsRevoked(context.Background(), testTenantID, "jti-1", "user-1", fixedNow)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	_, err = f.uc.Execute(authCtx("admin-id", app.RoleAdmin), RevokeTokensRequest{JTI: "jti-1"})
+	require.NoError(t, err)
+
+	revoked, err = f.denylist.IsRevoked(context.Background(), testTenantID, "jti-1", "user-1", fixedNow)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	assert.Equal(t, 1, f.repo.listCalls)
+}
+
+func TestRevokeTokensUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		req            RevokeTokensRequest
+		createError    error
+		auditError     error
+		wantCode       string
+		wantMessageSub string
+	}{
+		{
+			name:     "no auth context",
+			ctx:      context.Background(),
+			req:      RevokeTokensRequest{JTI: "jti-1"},
+			wantCode: app.CodeForbidden,
+		},
+		{
+			name:     "non-admin",
+			ctx:      authCtx("user-id", app.RoleUser),
+			req:      RevokeTokensRequest{JTI: "jti-1"},
+			wantCode: app.CodeInsufficientPermissions,
+		},
+		{
+			name:           "neither jti nor subject",
+			req:            RevokeTokensRequest{JTI: "  "},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "exactly one",
+		},
+		{
+			name:           "both jti and subject",
+			req:            RevokeTokensRequest{JTI: "jti-1", Subject: "user-1"},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "exactly one",
+		},
+		{
+			name:           "issuedBefore with jti",
+			req:            RevokeTokensRequest{JTI: "jti-1", IssuedBefore: fixedNow},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "issuedBefore",
+		},
+		{
+			name:           "issuedBefore in the future",
+			req:            RevokeTokensRequest{Subject: "user-1", IssuedBefore: fixedNow.Add(time.Minute)},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "issuedBefore",
+		},
+		{
+			name:           "expiresAt in the past",
+			req:            RevokeTokensRequest{JTI: "jti-1", ExpiresAt: fixedNow},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "expiresAt",
+		},
+		{
+			name:           "subject revocation already expired",
+			req:            RevokeTokensRequest{Subject: "user-1", IssuedBefore: fixedNow.Add(-2 * time.Hour)},
+			wantCode:       app.CodeValidationError,
+			wantMessageSub: "expiresAt",
+		},
+		{
+			name:        "repository error",
+			req:         RevokeTokensRequest{JTI: "jti-1"},
+			createError: errors.New("db down"),
+			wantCode:    app.CodeInternalError,
+		},
+		{
+			name:       "audit error",
+			req:        RevokeTokensRequest{JTI: "jti-1"},
+			auditError: errors.New("db down"),
+			wantCode:   app.CodeInternalError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newRevokeTokensFixture(t)
+			f.repo.createError = tt.createError
+			f.audit.Err = tt.auditError
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = authCtx("admin-id", app.RoleAdmin)
+			}
+			// Load the denylist first: the mock transaction does not roll back
+			// the stored revocation, so a reload would see it.
+			_, err := f.denylist.IsRevoked(context.Background(), testTenantID, "", "", fixedNow)
+			require.NoError(t, err)
+
+			_, err = f.uc.Execute(ctx, tt.req)
+
+			var appErr *app.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, tt.wantCode, appErr.Code)
+			assert.Equal(t, OpRevokeTokens, appErr.Op)
+			assert.Contains(t, appErr.Message, tt.wantMessageSub)
+
+			revoked, err := f.denylist.IsRevoked(context.Background(), testTenantID, "jti-1", "user-1", fixedNow)
+			require.NoError(t, err)
+			assert.False(t, revoked, "failed revocations must not reach the denylist")
+		})
+	}
+}
+
+func TestNewRevokeTokensUseCase_DefaultRetention(t *testing.T) {
+	uc := NewRevokeTokensUseCase(&mockRevocationRepository{}, nil, testutil.TxManager{}, &mockIDGenerator{}, nil, 0, testutil.NewAuthorizer(discardLogger()), discardLogger())
+
+	assert.Equal(t, DefaultRevocationRetention, uc.retention)
+}
@@ -0,0 +1,20 @@
+package user
+
+import (
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// resourceTypeUser is the app.Resource type of users.
+const resourceTypeUser = "user"
+
+// userResource returns the authorization resource for the user with id.
+// Users own their own record, so ":self" permissions such as users:read:self
+// apply when id is the actor's subject.
+func userResource(id domain.ID) app.Resource {
+	return app.Resource{Type: resourceTypeUser, ID: string(id), OwnerID: string(id)}
+}
+
+// usersResource is the authorization resource for operations on the whole
+// user collection (create, list, purge, import, export).
+var usersResource = app.Resource{Type: resourceTypeUser}
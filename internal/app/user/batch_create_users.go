@@ -0,0 +1,203 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// BatchMode selects how BatchCreateUsersUseCase handles item failures.
+type BatchMode string
+
+const (
+	// BatchModeAtomic creates all items in a single transaction.
+	// If any item fails, nothing is created.
+	BatchModeAtomic BatchMode = "atomic"
+
+	// BatchModeBestEffort creates each item in its own transaction.
+	// A failed item does not affect the others.
+	BatchModeBestEffort BatchMode = "bestEffort"
+)
+
+// Valid reports whether m is a known batch mode.
+func (m BatchMode) Valid() bool {
+	return m == BatchModeAtomic || m == BatchModeBestEffort
+}
+
+// DefaultBatchMaxItems is the default upper bound on items per batch.
+const DefaultBatchMaxItems = 500
+
+// BatchCreateUsersRequest represents the input data for creating users in bulk.
+type BatchCreateUsersRequest struct {
+	// Items are created in order; each is handled like a CreateUserRequest.
+	Items []CreateUserRequest
+	Mode  BatchMode
+}
+
+// BatchCreateUserResult is the outcome of a single batch item.
+// Exactly one of User and Err is meaningful: Err is nil if the user was created.
+type BatchCreateUserResult struct {
+	User domain.User
+	Err  error
+}
+
+// BatchCreateUsersResponse represents the result of a batch create.
+type BatchCreateUsersResponse struct {
+	// Results has one entry per request item, in request order.
+	Results []BatchCreateUserResult
+	// Created is the number of users created.
+	Created int
+}
+
+// OpBatchCreateUsers is the operation name for BatchCreateUsers use case.
+const OpBatchCreateUsers = "BatchCreateUsers"
+
+// errBatchItemFailed rolls back an atomic batch after an item has failed;
+// the item's own error is already recorded in its result.
+var errBatchItemFailed = errors.New("batch item failed")
+
+// BatchCreateUsersUseCase creates many users in one call.
+// Every item goes through the same validation, persistence and audit steps as
+// CreateUserUseCase; per-item failures are reported in the results rather than
+// failing the call.
+type BatchCreateUsersUseCase struct {
+	createUC *CreateUserUseCase
+	maxItems int
+}
+
+// NewBatchCreateUsersUseCase creates a new instance of BatchCreateUsersUseCase.
+// A non-positive maxItems falls back to DefaultBatchMaxItems.
+func NewBatchCreateUsersUseCase(createUC *CreateUserUseCase, maxItems int) *BatchCreateUsersUseCase {
+	if maxItems <= 0 {
+		maxItems = DefaultBatchMaxItems
+	}
+	return &BatchCreateUsersUseCase{
+		createUC: createUC,
+		maxItems: maxItems,
+	}
+}
+
+// Execute creates the users in req.Items.
+// Authorization: requires users:write, checked once for the whole batch.
+// Returns AppError with Code=FORBIDDEN or INSUFFICIENT_PERMISSIONS on denial,
+// and with Code=VALIDATION_ERROR if the batch is empty, too large or has an
+// unknown mode. Item failures are returned in the response: in atomic
+// mode the failing items carry their own error and every other item an error
+// with Code=BATCH_ABORTED.
+func (uc *BatchCreateUsersUseCase) Execute(ctx context.Context, req BatchCreateUsersRequest) (BatchCreateUsersResponse, error) {
+	if _, err := app.RequirePermission(ctx, uc.createUC.authz, OpBatchCreateUsers, app.PermUsersWrite, usersResource); err != nil {
+		return BatchCreateUsersResponse{}, err
+	}
+	if len(req.Items) == 0 || len(req.Items) > uc.maxItems {
+		return BatchCreateUsersResponse{}, &app.AppError{
+			Op:      OpBatchCreateUsers,
+			Code:    app.CodeValidationError,
+			Message: fmt.Sprintf("Batch must contain between 1 and %d items", uc.maxItems),
+		}
+	}
+	if !req.Mode.Valid() {
+		return BatchCreateUsersResponse{}, &app.AppError{
+			Op:      OpBatchCreateUsers,
+			Code:    app.CodeValidationError,
+			Message: fmt.Sprintf("Unknown batch mode %q", req.Mode),
+		}
+	}
+
+	var results []BatchCreateUserResult
+	if req.Mode == BatchModeAtomic {
+		results = uc.createAtomic(ctx, req.Items)
+	} else {
+		results = uc.createBestEffort(ctx, req.Items)
+	}
+
+	resp := BatchCreateUsersResponse{Results: results}
+	for _, r := range results {
+		if r.Err == nil {
+			resp.Created++
+		}
+	}
+	return resp, nil
+}
+
+// createBestEffort creates each item in its own transaction, so a failed item
+// (e.g. a duplicate email) cannot abort the transaction of the others.
+func (uc *BatchCreateUsersUseCase) createBestEffort(ctx context.Context, items []CreateUserRequest) []BatchCreateUserResult {
+	results := make([]BatchCreateUserResult, len(items))
+	for i, item := range items {
+		resp, err := uc.createUC.create(ctx, item)
+		results[i] = BatchCreateUserResult{User: resp.User, Err: err}
+	}
+	return results
+}
+
+// createAtomic validates every item before opening a single transaction for
+// all inserts, so invalid input is reported for all items at once.
+func (uc *BatchCreateUsersUseCase) createAtomic(ctx context.Context, items []CreateUserRequest) []BatchCreateUserResult {
+	results := make([]BatchCreateUserResult, len(items))
+	users := make([]*domain.User, len(items))
+	failed := -1
+	for i, item := range items {
+		user, err := uc.createUC.newUser(item)
+		if err != nil {
+			results[i].Err = err
+			if failed < 0 {
+				failed = i
+			}
+			continue
+		}
+		users[i] = user
+	}
+	if failed >= 0 {
+		return abortBatch(results, failed)
+	}
+
+	err := uc.createUC.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		for i, user := range users {
+			if err := uc.createUC.insert(ctx, tx, user, items[i]); err != nil {
+				results[i].Err = err
+				failed = i
+				return errBatchItemFailed
+			}
+		}
+		return nil
+	})
+	if failed >= 0 {
+		return abortBatch(results, failed)
+	}
+	if err != nil {
+		// The transaction itself failed (e.g. on commit): nothing was created.
+		for i := range results {
+			results[i].Err = &app.AppError{
+				Op:      OpBatchCreateUsers,
+				Code:    app.CodeInternalError,
+				Message: "Failed to create users",
+				Err:     err,
+			}
+		}
+		return results
+	}
+
+	for i, user := range users {
+		results[i].User = *user
+	}
+	return results
+}
+
+// abortBatch marks every item without an error of its own as aborted because
+// of the item at index failed.
+func abortBatch(results []BatchCreateUserResult, failed int) []BatchCreateUserResult {
+	for i := range results {
+		if results[i].Err != nil {
+			continue
+		}
+		results[i] = BatchCreateUserResult{Err: &app.AppError{
+			Op:      OpBatchCreateUsers,
+			Code:    app.CodeBatchAborted,
+			Message: fmt.Sprintf("Not created because item %d failed and the batch is atomic", failed),
+		}}
+	}
+	return results
+}
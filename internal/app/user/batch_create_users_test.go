@@ -0,0 +1,208 @@
+//go:build !integration
+
+package user
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+// uniqueEmailRepository rejects duplicate emails like the users table does.
+type uniqueEmailRepository struct {
+	*mockUserRepository
+}
+
+func (m *uniqueEmailRepository) Create(ctx context.Context, q domain.Querier, user *domain.User) error {
+	for _, u := range m.users {
+		if u.Email == user.Email {
+			return domain.ErrEmailAlreadyExists
+		}
+	}
+	return m.mockUserRepository.Create(ctx, q, user)
+}
+
+// rollbackTxManager restores the repository's users, and the audit events if
+// audit is set, when fn fails, and counts the transactions it opened.
+type rollbackTxManager struct {
+	repo  *mockUserRepository
+	audit *mockAuditEventRepository
+	txs   int
+	// tenants holds the tenant of the context of each transaction.
+	tenants []string
+}
+
+func (m *rollbackTxManager) WithTx(ctx context.Context, fn func(tx domain.Querier) error) error {
+	m.txs++
+	m.tenants = append(m.tenants, sharedctx.GetTenantID(ctx))
+	snapshot := maps.Clone(m.repo.users)
+	var events []*domain.AuditEvent
+	if m.audit != nil {
+		events = slices.Clone(m.audit.events)
+	}
+	if err := fn(&mockQuerier{}); err != nil {
+		m.repo.users = snapshot
+		if m.audit != nil {
+			m.audit.events = events
+		}
+		return err
+	}
+	return nil
+}
+
+func newBatchCreateUsersUseCase(t *testing.T, maxItems int) (*BatchCreateUsersUseCase, *uniqueEmailRepository, *rollbackTxManager) {
+	t.Helper()
+
+	repo := &uniqueEmailRepository{newMockUserRepository()}
+	tx := &rollbackTxManager{repo: repo.mockUserRepository}
+	auditSvc, _ := newMockAuditService()
+	createUC := NewCreateUserUseCase(repo, auditSvc, newMockIDGenerator(), tx, &mockQuerier{}, testutil.NewAuthorizer(slog.Default()))
+	return NewBatchCreateUsersUseCase(createUC, maxItems), repo, tx
+}
+
+func batchItems(emails ...string) []CreateUserRequest {
+	items := make([]CreateUserRequest, len(emails))
+	for i, email := range emails {
+		items[i] = CreateUserRequest{FirstName: "Batch", LastName: "User", Email: email}
+	}
+	return items
+}
+
+func TestBatchCreateUsersUseCase_Execute_Atomic(t *testing.T) {
+	t.Run("creates all items in one transaction", func(t *testing.T) {
+		uc, repo, tx := newBatchCreateUsersUseCase(t, 10)
+
+		resp, err := uc.Execute(adminCtx(), BatchCreateUsersRequest{
+			Items: batchItems("a@example.com", "b@example.com"),
+			Mode:  BatchModeAtomic,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, resp.Created)
+		require.Len(t, resp.Results, 2)
+		assert.Equal(t, "a@example.com", resp.Results[0].User.Email)
+		assert.Equal(t, "b@example.com", resp.Results[1].User.Email)
+		assert.Len(t, repo.users, 2)
+		assert.Equal(t, 1, tx.txs)
+	})
+
+	t.Run("duplicate email rolls back the batch", func(t *testing.T) {
+		uc, repo, _ := newBatchCreateUsersUseCase(t, 10)
+
+		resp, err := uc.Execute(adminCtx(), BatchCreateUsersRequest{
+			Items: batchItems("a@example.com", "b@example.com", "a@example.com"),
+			Mode:  BatchModeAtomic,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, resp.Created)
+		assert.Empty(t, repo.users)
+
+		var appErr *app.AppError
+		require.ErrorAs(t, resp.Results[2].Err, &appErr)
+		assert.Equal(t, app.CodeEmailExists, appErr.Code)
+		for _, i := range []int{0, 1} {
+			require.ErrorAs(t, resp.Results[i].Err, &appErr)
+			assert.Equal(t, app.CodeBatchAborted, appErr.Code)
+			assert.Contains(t, appErr.Message, "item 2")
+		}
+	})
+
+	t.Run("invalid items are reported before any insert", func(t *testing.T) {
+		uc, repo, tx := newBatchCreateUsersUseCase(t, 10)
+
+		resp, err := uc.Execute(adminCtx(), BatchCreateUsersRequest{
+			Items: batchItems("a@example.com", "", " "),
+			Mode:  BatchModeAtomic,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, repo.users)
+		assert.Zero(t, tx.txs)
+
+		var appErr *app.AppError
+		require.ErrorAs(t, resp.Results[0].Err, &appErr)
+		assert.Equal(t, app.CodeBatchAborted, appErr.Code)
+		assert.ErrorIs(t, resp.Results[1].Err, domain.ErrInvalidEmail)
+		assert.ErrorIs(t, resp.Results[2].Err, domain.ErrInvalidEmail)
+	})
+}
+
+func TestBatchCreateUsersUseCase_Execute_BestEffort(t *testing.T) {
+	uc, repo, tx := newBatchCreateUsersUseCase(t, 10)
+
+	resp, err := uc.Execute(adminCtx(), BatchCreateUsersRequest{
+		Items: batchItems("a@example.com", "", "a@example.com", "b@example.com"),
+		Mode:  BatchModeBestEffort,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Created)
+	assert.Len(t, repo.users, 2)
+	assert.Equal(t, 3, tx.txs, "each valid item gets its own transaction")
+
+	assert.NoError(t, resp.Results[0].Err)
+	assert.ErrorIs(t, resp.Results[1].Err, domain.ErrInvalidEmail)
+	assert.ErrorIs(t, resp.Results[2].Err, domain.ErrEmailAlreadyExists)
+	assert.NoError(t, resp.Results[3].Err)
+	assert.Equal(t, "b@example.com", resp.Results[3].User.Email)
+}
+
+func TestBatchCreateUsersUseCase_Execute_InvalidRequest(t *testing.T) {
+	uc, _, _ := newBatchCreateUsersUseCase(t, 2)
+
+	tests := []struct {
+		name string
+		req  BatchCreateUsersRequest
+	}{
+		{name: "empty", req: BatchCreateUsersRequest{Mode: BatchModeAtomic}},
+		{name: "too many items", req: BatchCreateUsersRequest{Items: batchItems("a@x.io", "b@x.io", "c@x.io"), Mode: BatchModeAtomic}},
+		{name: "unknown mode", req: BatchCreateUsersRequest{Items: batchItems("a@x.io"), Mode: "parallel"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(adminCtx(), tt.req)
+
+			var appErr *app.AppError
+			require.True(t, errors.As(err, &appErr))
+			assert.Equal(t, app.CodeValidationError, appErr.Code)
+		})
+	}
+}
+
+func TestBatchCreateUsersUseCase_Execute_Authorization(t *testing.T) {
+	for _, mode := range []BatchMode{BatchModeAtomic, BatchModeBestEffort} {
+		t.Run(string(mode), func(t *testing.T) {
+			uc, repo, tx := newBatchCreateUsersUseCase(t, 10)
+
+			_, err := uc.Execute(userCtx("user-1", app.RoleUser), BatchCreateUsersRequest{
+				Items: batchItems("a@x.io", "b@x.io"),
+				Mode:  mode,
+			})
+
+			var appErr *app.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, app.CodeInsufficientPermissions, appErr.Code)
+			assert.Equal(t, OpBatchCreateUsers, appErr.Op)
+			assert.Zero(t, tx.txs, "no transaction must be opened")
+			assert.Empty(t, repo.users)
+		})
+	}
+}
+
+func TestNewBatchCreateUsersUseCase_DefaultMaxItems(t *testing.T) {
+	uc := NewBatchCreateUsersUseCase(nil, 0)
+	assert.Equal(t, DefaultBatchMaxItems, uc.maxItems)
+}
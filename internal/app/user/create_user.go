@@ -32,6 +32,9 @@ type CreateUserResponse struct {
 	User domain.User
 }
 
+// OpCreateUser is the operation name for CreateUser use case.
+const OpCreateUser = "CreateUser"
+
 // CreateUserUseCase handles the business logic for creating a new user.
 type CreateUserUseCase struct {
 	userRepo     domain.UserRepository
@@ -39,6 +42,7 @@ type CreateUserUseCase struct {
 	idGen        domain.IDGenerator
 	txManager    domain.TxManager
 	db           domain.Querier
+	authz        app.Authorizer
 }
 
 // NewCreateUserUseCase creates a new instance of CreateUserUseCase.
@@ -48,6 +52,7 @@ func NewCreateUserUseCase(
 	idGen domain.IDGenerator,
 	txManager domain.TxManager,
 	db domain.Querier,
+	authz app.Authorizer,
 ) *CreateUserUseCase {
 	return &CreateUserUseCase{
 		userRepo:     userRepo,
@@ -55,13 +60,42 @@ func NewCreateUserUseCase(
 		idGen:        idGen,
 		txManager:    txManager,
 		db:           db,
+		authz:        authz,
 	}
 }
 
 // Execute processes the create user request.
 // It validates the input and creates the user.
-// Returns AppError with appropriate Code for domain errors.
+// Authorization: requires users:write; creating users is not a ":self" action.
+// Returns AppError with Code=FORBIDDEN or INSUFFICIENT_PERMISSIONS on denial,
+// and with the appropriate Code for domain errors.
 func (uc *CreateUserUseCase) Execute(ctx context.Context, req CreateUserRequest) (CreateUserResponse, error) {
+	if _, err := app.RequirePermission(ctx, uc.authz, OpCreateUser, app.PermUsersWrite, usersResource); err != nil {
+		return CreateUserResponse{}, err
+	}
+	return uc.create(ctx, req)
+}
+
+// create validates and inserts the user of req without authorizing the caller.
+func (uc *CreateUserUseCase) create(ctx context.Context, req CreateUserRequest) (CreateUserResponse, error) {
+	user, err := uc.newUser(req)
+	if err != nil {
+		return CreateUserResponse{}, err
+	}
+
+	// Execute logic within a transaction
+	if err := uc.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		return uc.insert(ctx, tx, user, req)
+	}); err != nil {
+		return CreateUserResponse{}, err
+	}
+
+	return CreateUserResponse{User: *user}, nil
+}
+
+// newUser builds the user entity for req and validates it using domain rules.
+// Returns AppError with Code=VALIDATION_ERROR if the user is invalid.
+func (uc *CreateUserUseCase) newUser(req CreateUserRequest) (*domain.User, error) {
 	// Create a new user entity with generated ID
 	id := req.ID
 	if id.IsEmpty() {
@@ -79,58 +113,57 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, req CreateUserRequest)
 
 	// Validate the user entity using domain rules
 	if err := user.Validate(); err != nil {
-		return CreateUserResponse{}, &app.AppError{
-			Op:      "CreateUser",
+		return nil, &app.AppError{
+			Op:      OpCreateUser,
 			Code:    app.CodeValidationError,
 			Message: "Validation failed",
 			Err:     err,
 		}
 	}
 
-	// Execute logic within a transaction
-	if err := uc.txManager.WithTx(ctx, func(tx domain.Querier) error {
-		// Create the user in the repository
-		if err := uc.userRepo.Create(ctx, tx, user); err != nil {
-			if errors.Is(err, domain.ErrEmailAlreadyExists) {
-				return &app.AppError{
-					Op:      "CreateUser",
-					Code:    app.CodeEmailExists,
-					Message: "Email already exists",
-					Err:     err,
-				}
-			}
+	return user, nil
+}
+
+// insert creates the user and records its audit event using tx,
+// which must be a transaction so both are committed together.
+func (uc *CreateUserUseCase) insert(ctx context.Context, tx domain.Querier, user *domain.User, req CreateUserRequest) error {
+	// Create the user in the repository
+	if err := uc.userRepo.Create(ctx, tx, user); err != nil {
+		if errors.Is(err, domain.ErrEmailAlreadyExists) {
 			return &app.AppError{
-				Op:      "CreateUser",
-				Code:    app.CodeInternalError,
-				Message: "Failed to create user",
+				Op:      OpCreateUser,
+				Code:    app.CodeEmailExists,
+				Message: "Email already exists",
 				Err:     err,
 			}
 		}
-
-		// Record audit event (same transaction context)
-		// RequestID and ActorID come from request struct (passed by transport layer)
-		auditInput := audit.AuditEventInput{
-			EventType:  domain.EventUserCreated,
-			ActorID:    req.ActorID,
-			EntityType: "user",
-			EntityID:   user.ID,
-			Payload:    user,
-			RequestID:  req.RequestID,
+		return &app.AppError{
+			Op:      OpCreateUser,
+			Code:    app.CodeInternalError,
+			Message: "Failed to create user",
+			Err:     err,
 		}
+	}
 
-		if err := uc.auditService.Record(ctx, tx, auditInput); err != nil {
-			return &app.AppError{
-				Op:      "CreateUser",
-				Code:    app.CodeInternalError,
-				Message: "Failed to record audit event",
-				Err:     err,
-			}
-		}
+	// Record audit event (same transaction context)
+	// RequestID and ActorID come from request struct (passed by transport layer)
+	auditInput := audit.AuditEventInput{
+		EventType:  domain.EventUserCreated,
+		ActorID:    req.ActorID,
+		EntityType: "user",
+		EntityID:   user.ID,
+		Payload:    user,
+		RequestID:  req.RequestID,
+	}
 
-		return nil
-	}); err != nil {
-		return CreateUserResponse{}, err
+	if err := uc.auditService.Record(ctx, tx, auditInput); err != nil {
+		return &app.AppError{
+			Op:      OpCreateUser,
+			Code:    app.CodeInternalError,
+			Message: "Failed to record audit event",
+			Err:     err,
+		}
 	}
 
-	return CreateUserResponse{User: *user}, nil
+	return nil
 }
@@ -3,8 +3,12 @@
 package user
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"log/slog"
+	"maps"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
@@ -15,6 +19,7 @@ import (
 	"github.com/iruldev/golang-api-hexagonal/internal/app"
 	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
 	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
 )
 
 // mockIDGenerator is a test double for domain.IDGenerator.
@@ -41,13 +46,20 @@ func (m *mockQuerier) QueryRow(_ context.Context, _ string, _ ...any) any
 
 // mockUserRepository is a test double for domain.UserRepository.
 type mockUserRepository struct {
-	users       map[domain.ID]domain.User
-	createError error
+	users        map[domain.ID]domain.User
+	deleted      map[domain.ID]domain.User
+	createError  error
+	updateError  error
+	deleteError  error
+	restoreError error
+	purgeError   error
+	exportError  error
 }
 
 func newMockUserRepository() *mockUserRepository {
 	return &mockUserRepository{
-		users: make(map[domain.ID]domain.User),
+		users:   make(map[domain.ID]domain.User),
+		deleted: make(map[domain.ID]domain.User),
 	}
 }
 
@@ -59,6 +71,7 @@ func (m *mockUserRepository) Create(_ context.Context, _ domain.Querier, user *d
 	now := time.Unix(0, 0).UTC()
 	user.CreatedAt = now
 	user.UpdatedAt = now
+	user.Version = 1
 
 	m.users[user.ID] = *user
 	return nil
@@ -72,7 +85,7 @@ func (m *mockUserRepository) GetByID(_ context.Context, _ domain.Querier, id dom
 	return &user, nil
 }
 
-func (m *mockUserRepository) List(_ context.Context, _ domain.Querier, _ domain.ListParams) ([]domain.User, int, error) {
+func (m *mockUserRepository) List(_ context.Context, _ domain.Querier, _ domain.UserFilter, _ domain.ListParams) ([]domain.User, int, error) {
 	users := make([]domain.User, 0, len(m.users))
 	for _, u := range m.users {
 		users = append(users, u)
@@ -80,6 +93,89 @@ func (m *mockUserRepository) List(_ context.Context, _ domain.Querier, _ domain.
 	return users, len(users), nil
 }
 
+func (m *mockUserRepository) Update(_ context.Context, _ domain.Querier, user *domain.User) error {
+	if m.updateError != nil {
+		return m.updateError
+	}
+	stored, exists := m.users[user.ID]
+	if !exists {
+		return domain.ErrUserNotFound
+	}
+	if stored.Version != user.Version {
+		return domain.ErrUserVersionMismatch
+	}
+	user.Version++
+	m.users[user.ID] = *user
+	return nil
+}
+
+func (m *mockUserRepository) Delete(_ context.Context, _ domain.Querier, id domain.ID, version int64) error {
+	if m.deleteError != nil {
+		return m.deleteError
+	}
+	user, exists := m.users[id]
+	if !exists {
+		return domain.ErrUserNotFound
+	}
+	if user.Version != version {
+		return domain.ErrUserVersionMismatch
+	}
+	user.Version++
+	now := time.Now().UTC()
+	user.DeletedAt = &now
+	m.deleted[id] = user
+	delete(m.users, id)
+	return nil
+}
+
+func (m *mockUserRepository) Restore(_ context.Context, _ domain.Querier, id domain.ID) error {
+	if m.restoreError != nil {
+		return m.restoreError
+	}
+	user, exists := m.deleted[id]
+	if !exists {
+		return domain.ErrUserNotFound
+	}
+	user.DeletedAt = nil
+	user.Version++
+	m.users[id] = user
+	delete(m.deleted, id)
+	return nil
+}
+
+func (m *mockUserRepository) PurgeDeleted(_ context.Context, _ domain.Querier, before time.Time) ([]domain.ID, error) {
+	if m.purgeError != nil {
+		return nil, m.purgeError
+	}
+	var ids []domain.ID
+	for id, u := range m.deleted {
+		if u.DeletedAt.Before(before) {
+			ids = append(ids, id)
+			delete(m.deleted, id)
+		}
+	}
+	return ids, nil
+}
+
+func (m *mockUserRepository) Export(ctx context.Context, _ domain.Querier, fn func(domain.User) error) error {
+	if m.exportError != nil {
+		return m.exportError
+	}
+	users := slices.Collect(maps.Values(m.users))
+	slices.SortFunc(users, func(a, b domain.User) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+	for _, u := range users {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // mockAuditService is a simplified test double for audit.AuditService.
 // Since AuditService is a concrete type, we create a real one with mock dependencies.
 type mockAuditDeps struct {
@@ -120,6 +216,22 @@ func (m *mockAuditEventRepository) ListByEntityID(_ context.Context, _ domain.Qu
 	return nil, 0, nil
 }
 
+func (m *mockAuditEventRepository) GetByID(_ context.Context, _ domain.Querier, _ domain.ID) (*domain.AuditEvent, error) {
+	return nil, domain.ErrAuditEventNotFound
+}
+
+func (m *mockAuditEventRepository) List(_ context.Context, _ domain.Querier, _ domain.AuditEventFilter, _ domain.ListParams) ([]domain.AuditEvent, error) {
+	return nil, nil
+}
+
+func (m *mockAuditEventRepository) ListChain(_ context.Context, _ domain.Querier, _ int64, _ int) ([]domain.AuditEvent, error) {
+	return nil, nil
+}
+
+func (m *mockAuditEventRepository) GetChainAnchor(_ context.Context, _ domain.Querier) (*domain.AuditChainAnchor, error) {
+	return nil, nil
+}
+
 // mockRedactor is a test double for domain.Redactor.
 type mockRedactor struct{}
 
@@ -244,8 +356,8 @@ func TestCreateUserUseCase_Execute(t *testing.T) {
 			mockTx := &mockTxManager{}
 			tt.setupMock(mockRepo)
 
-			useCase := NewCreateUserUseCase(mockRepo, mockAudit, mockIDGen, mockTx, mockDB)
-			resp, err := useCase.Execute(context.Background(), tt.req)
+			useCase := NewCreateUserUseCase(mockRepo, mockAudit, mockIDGen, mockTx, mockDB, testutil.NewAuthorizer(slog.Default()))
+			resp, err := useCase.Execute(adminCtx(), tt.req)
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -277,7 +389,7 @@ func TestCreateUserUseCase_Execute_AuditEventRecorded(t *testing.T) {
 		mockAudit, deps := newMockAuditService()
 		mockTx := &mockTxManager{}
 
-		useCase := NewCreateUserUseCase(mockRepo, mockAudit, mockIDGen, mockTx, mockDB)
+		useCase := NewCreateUserUseCase(mockRepo, mockAudit, mockIDGen, mockTx, mockDB, testutil.NewAuthorizer(slog.Default()))
 		req := CreateUserRequest{
 			FirstName: "John",
 			LastName:  "Doe",
@@ -286,7 +398,7 @@ func TestCreateUserUseCase_Execute_AuditEventRecorded(t *testing.T) {
 			ActorID:   domain.ID("actor-456"),
 		}
 
-		resp, err := useCase.Execute(context.Background(), req)
+		resp, err := useCase.Execute(adminCtx(), req)
 
 		require.NoError(t, err)
 		assert.False(t, resp.User.ID.IsEmpty())
@@ -310,7 +422,7 @@ func TestCreateUserUseCase_Execute_AuditEventRecorded(t *testing.T) {
 		deps.repo.createError = errors.New("audit database error")
 		mockTx := &mockTxManager{}
 
-		useCase := NewCreateUserUseCase(mockRepo, mockAudit, mockIDGen, mockTx, mockDB)
+		useCase := NewCreateUserUseCase(mockRepo, mockAudit, mockIDGen, mockTx, mockDB, testutil.NewAuthorizer(slog.Default()))
 		req := CreateUserRequest{
 			FirstName: "John",
 			LastName:  "Doe",
@@ -319,7 +431,7 @@ func TestCreateUserUseCase_Execute_AuditEventRecorded(t *testing.T) {
 			ActorID:   domain.ID("actor-456"),
 		}
 
-		_, err := useCase.Execute(context.Background(), req)
+		_, err := useCase.Execute(adminCtx(), req)
 
 		require.Error(t, err)
 		var appErr *app.AppError
@@ -329,13 +441,42 @@ func TestCreateUserUseCase_Execute_AuditEventRecorded(t *testing.T) {
 	})
 }
 
+func TestCreateUserUseCase_Execute_Authorization(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode string
+	}{
+		{name: "user role cannot create users", ctx: userCtx("user-1", app.RoleUser), wantCode: app.CodeInsufficientPermissions},
+		{name: "missing auth context", ctx: context.Background(), wantCode: app.CodeForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockUserRepository()
+			tx := &rollbackTxManager{repo: repo}
+			auditSvc, _ := newMockAuditService()
+			useCase := NewCreateUserUseCase(repo, auditSvc, newMockIDGenerator(), tx, &mockQuerier{}, testutil.NewAuthorizer(slog.Default()))
+
+			_, err := useCase.Execute(tt.ctx, CreateUserRequest{FirstName: "John", LastName: "Doe", Email: "john@example.com"})
+
+			var appErr *app.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, tt.wantCode, appErr.Code)
+			assert.Equal(t, OpCreateUser, appErr.Op)
+			assert.Zero(t, tx.txs, "no transaction must be opened")
+			assert.Empty(t, repo.users)
+		})
+	}
+}
+
 func TestNewCreateUserUseCase(t *testing.T) {
 	mockRepo := newMockUserRepository()
 	mockIDGen := newMockIDGenerator()
 	mockDB := &mockQuerier{}
 	mockAudit, _ := newMockAuditService()
 	mockTx := &mockTxManager{}
-	useCase := NewCreateUserUseCase(mockRepo, mockAudit, mockIDGen, mockTx, mockDB)
+	useCase := NewCreateUserUseCase(mockRepo, mockAudit, mockIDGen, mockTx, mockDB, testutil.NewAuthorizer(slog.Default()))
 
 	assert.NotNil(t, useCase)
 	assert.Equal(t, mockRepo, useCase.userRepo)
@@ -0,0 +1,110 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// DeleteUserRequest represents the input data for deleting a user.
+type DeleteUserRequest struct {
+	ID domain.ID
+	// Version is the user version the client last read (If-Match).
+	// The delete is rejected with VERSION_MISMATCH if the user has changed since.
+	Version int64
+	// RequestID correlates this operation with the HTTP request.
+	// Transport layer extracts from context and passes here.
+	RequestID string
+	// ActorID identifies who is performing this action.
+	// Transport layer extracts from JWT claims and passes here.
+	ActorID domain.ID
+}
+
+// OpDeleteUser is the operation name for DeleteUser use case.
+const OpDeleteUser = "DeleteUser"
+
+// DeleteUserUseCase handles the business logic for deleting a user.
+// Deletes are soft: the row is kept until purged by PurgeDeletedUsersUseCase
+// and can be restored by an admin in the meantime.
+// Authorization requires users:write, or users:write:self for the caller's
+// own account.
+type DeleteUserUseCase struct {
+	userRepo     domain.UserRepository
+	auditService *audit.AuditService
+	txManager    domain.TxManager
+	authz        app.Authorizer
+	log          *logger.Logger
+}
+
+// NewDeleteUserUseCase creates a new instance of DeleteUserUseCase.
+func NewDeleteUserUseCase(
+	userRepo domain.UserRepository,
+	auditService *audit.AuditService,
+	txManager domain.TxManager,
+	authz app.Authorizer,
+	log *logger.Logger,
+) *DeleteUserUseCase {
+	return &DeleteUserUseCase{
+		userRepo:     userRepo,
+		auditService: auditService,
+		txManager:    txManager,
+		authz:        authz,
+		log:          log.With("usecase", OpDeleteUser),
+	}
+}
+
+// Execute soft-deletes a user by ID.
+// The delete and its audit event happen in a single transaction.
+// Returns AppError with Code=FORBIDDEN or INSUFFICIENT_PERMISSIONS on authorization failure,
+// USER_NOT_FOUND if the user doesn't exist and VERSION_MISMATCH if req.Version is stale.
+func (uc *DeleteUserUseCase) Execute(ctx context.Context, req DeleteUserRequest) error {
+	if _, err := app.RequirePermission(ctx, uc.authz, OpDeleteUser, app.PermUsersWrite, userResource(req.ID)); err != nil {
+		return err
+	}
+
+	return uc.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		// Load the user first so the audit payload captures what was removed.
+		user, err := uc.userRepo.GetByID(ctx, tx, req.ID)
+		if err != nil {
+			return mapUserRepoError(OpDeleteUser, "Failed to get user", err)
+		}
+		if user == nil {
+			return &app.AppError{
+				Op:      OpDeleteUser,
+				Code:    app.CodeInternalError,
+				Message: "Failed to get user",
+				Err:     errors.New("user repository returned nil user without error"),
+			}
+		}
+		if err := checkVersion(OpDeleteUser, user.Version, req.Version); err != nil {
+			return err
+		}
+
+		if err := uc.userRepo.Delete(ctx, tx, req.ID, req.Version); err != nil {
+			return mapUserRepoError(OpDeleteUser, "Failed to delete user", err)
+		}
+
+		// Record audit event (same transaction context)
+		if err := uc.auditService.Record(ctx, tx, audit.AuditEventInput{
+			EventType:  domain.EventUserDeleted,
+			ActorID:    req.ActorID,
+			EntityType: "user",
+			EntityID:   req.ID,
+			Payload:    user,
+			RequestID:  req.RequestID,
+		}); err != nil {
+			return &app.AppError{
+				Op:      OpDeleteUser,
+				Code:    app.CodeInternalError,
+				Message: "Failed to record audit event",
+				Err:     err,
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,123 @@
+//go:build !integration
+
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+func TestDeleteUserUseCase_Execute(t *testing.T) {
+	repoErr := errors.New("database error")
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		req        DeleteUserRequest
+		setupMock  func(*mockUserRepository)
+		wantCode   string
+		wantErr    error
+		wantLogMsg string
+	}{
+		{
+			name:      "user deletes own account",
+			ctx:       userCtx("my-user-id", app.RoleUser),
+			req:       DeleteUserRequest{ID: "my-user-id", Version: 1},
+			setupMock: func(m *mockUserRepository) { seedUser(m, "my-user-id") },
+		},
+		{
+			name:      "admin can delete any user",
+			ctx:       userCtx("admin-id", app.RoleAdmin),
+			req:       DeleteUserRequest{ID: "other-user-id", Version: 1},
+			setupMock: func(m *mockUserRepository) { seedUser(m, "other-user-id") },
+		},
+		{
+			name:       "user cannot delete other user",
+			ctx:        userCtx("my-user-id", app.RoleUser),
+			req:        DeleteUserRequest{ID: "other-user-id", Version: 1},
+			setupMock:  func(m *mockUserRepository) { seedUser(m, "other-user-id") },
+			wantCode:   app.CodeInsufficientPermissions,
+			wantLogMsg: "authorization denied: missing permission",
+		},
+		{
+			name:       "unknown role is forbidden even when subject matches",
+			ctx:        userCtx("my-user-id", "power-user"),
+			req:        DeleteUserRequest{ID: "my-user-id", Version: 1},
+			setupMock:  func(m *mockUserRepository) { seedUser(m, "my-user-id") },
+			wantCode:   app.CodeInsufficientPermissions,
+			wantLogMsg: "authorization denied: unknown role",
+		},
+		{
+			name:      "missing user returns USER_NOT_FOUND",
+			ctx:       userCtx("admin-id", app.RoleAdmin),
+			req:       DeleteUserRequest{ID: "missing-id", Version: 1},
+			setupMock: func(_ *mockUserRepository) {},
+			wantCode:  app.CodeUserNotFound,
+			wantErr:   domain.ErrUserNotFound,
+		},
+		{
+			name:      "stale version returns VERSION_MISMATCH",
+			ctx:       userCtx("my-user-id", app.RoleUser),
+			req:       DeleteUserRequest{ID: "my-user-id", Version: 2},
+			setupMock: func(m *mockUserRepository) { seedUser(m, "my-user-id") },
+			wantCode:  app.CodeVersionMismatch,
+			wantErr:   domain.ErrUserVersionMismatch,
+		},
+		{
+			name: "repository delete error returns INTERNAL_ERROR",
+			ctx:  userCtx("my-user-id", app.RoleUser),
+			req:  DeleteUserRequest{ID: "my-user-id", Version: 1},
+			setupMock: func(m *mockUserRepository) {
+				seedUser(m, "my-user-id")
+				m.deleteError = repoErr
+			},
+			wantCode: app.CodeInternalError,
+			wantErr:  repoErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newMockUserRepository()
+			mockAudit, deps := newMockAuditService()
+			tt.setupMock(mockRepo)
+
+			var buf bytes.Buffer
+			log := newTestLogger(&buf)
+			useCase := NewDeleteUserUseCase(mockRepo, mockAudit, &mockTxManager{}, testutil.NewAuthorizer(log), log)
+			err := useCase.Execute(tt.ctx, tt.req)
+
+			if tt.wantLogMsg != "" {
+				assert.Contains(t, buf.String(), tt.wantLogMsg, "expected log message not found")
+			}
+
+			if tt.wantCode != "" {
+				require.Error(t, err)
+				var appErr *app.AppError
+				require.True(t, errors.As(err, &appErr), "expected AppError, got %T", err)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				if tt.wantErr != nil {
+					assert.True(t, errors.Is(err, tt.wantErr), "expected wrapped error %v, got %v", tt.wantErr, appErr.Err)
+				}
+				assert.Empty(t, deps.repo.events, "no audit event expected on failure")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotContains(t, mockRepo.users, tt.req.ID)
+			assert.Contains(t, mockRepo.deleted, tt.req.ID, "delete must be soft")
+			require.Len(t, deps.repo.events, 1)
+			assert.Equal(t, domain.EventUserDeleted, deps.repo.events[0].EventType)
+			assert.Equal(t, tt.req.ID, deps.repo.events[0].EntityID)
+		})
+	}
+}
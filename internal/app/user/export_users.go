@@ -0,0 +1,94 @@
+package user
+
+import (
+	"context"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// ExportUsersRequest represents the input data for exporting all users.
+type ExportUsersRequest struct {
+	// RequestID correlates this operation with the HTTP request.
+	// Transport layer extracts from context and passes here.
+	RequestID string
+}
+
+// ExportUsersResponse represents the result of an export.
+type ExportUsersResponse struct {
+	// Count is the number of users passed to the export callback.
+	Count int
+}
+
+// OpExportUsers is the operation name for ExportUsers use case.
+const OpExportUsers = "ExportUsers"
+
+// ExportUsersUseCase streams every active user to a caller-provided sink,
+// e.g. an HTTP response, without loading the whole table into memory.
+// Requires users:export.
+type ExportUsersUseCase struct {
+	userRepo  domain.UserRepository
+	txManager domain.TxManager
+	authz     app.Authorizer
+	log       *logger.Logger
+}
+
+// NewExportUsersUseCase creates a new instance of ExportUsersUseCase.
+func NewExportUsersUseCase(
+	userRepo domain.UserRepository,
+	txManager domain.TxManager,
+	authz app.Authorizer,
+	log *logger.Logger,
+) *ExportUsersUseCase {
+	return &ExportUsersUseCase{
+		userRepo:  userRepo,
+		txManager: txManager,
+		authz:     authz,
+		log:       log.With("usecase", OpExportUsers),
+	}
+}
+
+// Execute calls fn for every active user, oldest first, inside a single
+// transaction so the export is a consistent snapshot.
+// Authorization is checked before fn is first called. Returns AppError with
+// Code=INSUFFICIENT_PERMISSIONS without users:export and INTERNAL_ERROR if reading fails or fn
+// returns an error (e.g. the client disconnected); the export stops at the
+// first error.
+func (uc *ExportUsersUseCase) Execute(ctx context.Context, req ExportUsersRequest, fn func(domain.User) error) (ExportUsersResponse, error) {
+	authCtx, err := app.RequirePermission(ctx, uc.authz, OpExportUsers, app.PermUsersExport, usersResource)
+	if err != nil {
+		return ExportUsersResponse{}, err
+	}
+
+	count := 0
+	if err := uc.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		return uc.userRepo.Export(ctx, tx, func(u domain.User) error {
+			if err := fn(u); err != nil {
+				return err
+			}
+			count++
+			return nil
+		})
+	}); err != nil {
+		logger.FromContext(ctx, uc.log).WarnContext(ctx, "user export aborted",
+			"actorId", authCtx.SubjectID,
+			"requestId", req.RequestID,
+			"exported", count,
+			"err", err,
+		)
+		return ExportUsersResponse{Count: count}, &app.AppError{
+			Op:      OpExportUsers,
+			Code:    app.CodeInternalError,
+			Message: "Failed to export users",
+			Err:     err,
+		}
+	}
+
+	logger.FromContext(ctx, uc.log).InfoContext(ctx, "users exported",
+		"actorId", authCtx.SubjectID,
+		"requestId", req.RequestID,
+		"exported", count,
+	)
+	return ExportUsersResponse{Count: count}, nil
+}
@@ -0,0 +1,128 @@
+//go:build !integration
+
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+func TestExportUsersUseCase_Execute(t *testing.T) {
+	seed := func(m *mockUserRepository) {
+		for i, id := range []domain.ID{"user-b", "user-a", "user-c"} {
+			u := seedUser(m, id)
+			u.CreatedAt = time.Unix(int64(i), 0).UTC()
+			m.users[id] = u
+		}
+	}
+
+	t.Run("streams every active user oldest first", func(t *testing.T) {
+		mockRepo := newMockUserRepository()
+		seed(mockRepo)
+		seedDeletedUser(mockRepo, "deleted-id", time.Now())
+
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		uc := NewExportUsersUseCase(mockRepo, &mockTxManager{}, testutil.NewAuthorizer(log), log)
+
+		var got []domain.ID
+		resp, err := uc.Execute(userCtx("admin-id", app.RoleAdmin), ExportUsersRequest{RequestID: "req-1"}, func(u domain.User) error {
+			got = append(got, u.ID)
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, resp.Count)
+		assert.Equal(t, []domain.ID{"user-b", "user-a", "user-c"}, got)
+		assert.Contains(t, buf.String(), "users exported")
+	})
+
+	t.Run("non-admin is forbidden before any row is read", func(t *testing.T) {
+		mockRepo := newMockUserRepository()
+		seed(mockRepo)
+
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		uc := NewExportUsersUseCase(mockRepo, &mockTxManager{}, testutil.NewAuthorizer(log), log)
+
+		called := false
+		_, err := uc.Execute(userCtx("user-a", app.RoleUser), ExportUsersRequest{}, func(domain.User) error {
+			called = true
+			return nil
+		})
+
+		var appErr *app.AppError
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, app.CodeInsufficientPermissions, appErr.Code)
+		assert.False(t, called, "no user must be exported")
+	})
+
+	t.Run("sink error stops the export", func(t *testing.T) {
+		mockRepo := newMockUserRepository()
+		seed(mockRepo)
+		sinkErr := errors.New("client went away")
+
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		uc := NewExportUsersUseCase(mockRepo, &mockTxManager{}, testutil.NewAuthorizer(log), log)
+
+		calls := 0
+		resp, err := uc.Execute(userCtx("admin-id", app.RoleAdmin), ExportUsersRequest{}, func(domain.User) error {
+			calls++
+			if calls == 2 {
+				return sinkErr
+			}
+			return nil
+		})
+
+		var appErr *app.AppError
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, app.CodeInternalError, appErr.Code)
+		assert.ErrorIs(t, err, sinkErr)
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, 1, resp.Count)
+		assert.Contains(t, buf.String(), "user export aborted")
+	})
+
+	t.Run("canceled context stops the export", func(t *testing.T) {
+		mockRepo := newMockUserRepository()
+		seed(mockRepo)
+
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		uc := NewExportUsersUseCase(mockRepo, &mockTxManager{}, testutil.NewAuthorizer(log), log)
+
+		ctx, cancel := context.WithCancel(userCtx("admin-id", app.RoleAdmin))
+		_, err := uc.Execute(ctx, ExportUsersRequest{}, func(domain.User) error {
+			cancel()
+			return nil
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("repository error returns INTERNAL_ERROR", func(t *testing.T) {
+		mockRepo := newMockUserRepository()
+		mockRepo.exportError = errors.New("database error")
+
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		uc := NewExportUsersUseCase(mockRepo, &mockTxManager{}, testutil.NewAuthorizer(log), log)
+
+		_, err := uc.Execute(userCtx("admin-id", app.RoleAdmin), ExportUsersRequest{}, func(domain.User) error { return nil })
+
+		var appErr *app.AppError
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, app.CodeInternalError, appErr.Code)
+	})
+}
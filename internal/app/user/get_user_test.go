@@ -16,6 +16,7 @@ import (
 
 	"github.com/iruldev/golang-api-hexagonal/internal/app"
 	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
 )
 
 // mockQuerierGetUser is a test double for domain.Querier.
@@ -64,7 +65,7 @@ func (m *mockUserRepositoryGetUser) GetByID(_ context.Context, _ domain.Querier,
 	return &user, nil
 }
 
-func (m *mockUserRepositoryGetUser) List(_ context.Context, _ domain.Querier, _ domain.ListParams) ([]domain.User, int, error) {
+func (m *mockUserRepositoryGetUser) List(_ context.Context, _ domain.Querier, _ domain.UserFilter, _ domain.ListParams) ([]domain.User, int, error) {
 	users := make([]domain.User, 0, len(m.users))
 	for _, u := range m.users {
 		users = append(users, u)
@@ -72,6 +73,34 @@ func (m *mockUserRepositoryGetUser) List(_ context.Context, _ domain.Querier, _
 	return users, len(users), nil
 }
 
+func (m *mockUserRepositoryGetUser) Update(_ context.Context, _ domain.Querier, user *domain.User) error {
+	if _, exists := m.users[user.ID]; !exists {
+		return domain.ErrUserNotFound
+	}
+	m.users[user.ID] = *user
+	return nil
+}
+
+func (m *mockUserRepositoryGetUser) Delete(_ context.Context, _ domain.Querier, id domain.ID, _ int64) error {
+	if _, exists := m.users[id]; !exists {
+		return domain.ErrUserNotFound
+	}
+	delete(m.users, id)
+	return nil
+}
+
+func (m *mockUserRepositoryGetUser) Restore(_ context.Context, _ domain.Querier, _ domain.ID) error {
+	return domain.ErrUserNotFound
+}
+
+func (m *mockUserRepositoryGetUser) PurgeDeleted(_ context.Context, _ domain.Querier, _ time.Time) ([]domain.ID, error) {
+	return nil, nil
+}
+
+func (m *mockUserRepositoryGetUser) Export(_ context.Context, _ domain.Querier, _ func(domain.User) error) error {
+	return nil
+}
+
 func TestGetUserUseCase_Execute(t *testing.T) {
 	repoErr := errors.New("database connection failed")
 
@@ -128,7 +157,7 @@ func TestGetUserUseCase_Execute(t *testing.T) {
 			mockDB := &mockQuerierGetUser{}
 			tt.setupMock(mockRepo)
 
-			useCase := NewGetUserUseCase(mockRepo, mockDB, slog.Default())
+			useCase := NewGetUserUseCase(mockRepo, mockDB, testutil.NewAuthorizer(slog.Default()), slog.Default())
 			// Use admin auth context for these tests (admin can access any user)
 			ctx := app.SetAuthContext(context.Background(), &app.AuthContext{
 				SubjectID: "admin-user",
@@ -157,7 +186,7 @@ func TestGetUserUseCase_Execute(t *testing.T) {
 func TestNewGetUserUseCase(t *testing.T) {
 	mockRepo := newMockUserRepositoryGetUser()
 	mockDB := &mockQuerierGetUser{}
-	useCase := NewGetUserUseCase(mockRepo, mockDB, slog.Default())
+	useCase := NewGetUserUseCase(mockRepo, mockDB, testutil.NewAuthorizer(slog.Default()), slog.Default())
 
 	assert.NotNil(t, useCase)
 	assert.Equal(t, mockRepo, useCase.userRepo)
@@ -231,9 +260,9 @@ func TestGetUserUseCase_Execute_Authorization(t *testing.T) {
 					Role:      app.RoleUser,
 				})
 			},
-			wantCode:    app.CodeForbidden,
+			wantCode:    app.CodeInsufficientPermissions,
 			wantErr:     true,
-			wantLogMsg:  "authorization denied: IDOR attempt",
+			wantLogMsg:  "authorization denied: missing permission",
 			wantLogAttr: "resourceId",
 		},
 		{
@@ -275,7 +304,7 @@ func TestGetUserUseCase_Execute_Authorization(t *testing.T) {
 					Role:      "power-user",
 				})
 			},
-			wantCode:    app.CodeForbidden,
+			wantCode:    app.CodeInsufficientPermissions,
 			wantErr:     true,
 			wantLogMsg:  "authorization denied: unknown role",
 			wantLogAttr: `"role":"power-user"`,
@@ -334,7 +363,7 @@ func TestGetUserUseCase_Execute_Authorization(t *testing.T) {
 			h := slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
 			logger := slog.New(h)
 
-			useCase := NewGetUserUseCase(mockRepo, mockDB, logger)
+			useCase := NewGetUserUseCase(mockRepo, mockDB, testutil.NewAuthorizer(logger), logger)
 			ctx := tt.setupContext()
 			resp, err := useCase.Execute(ctx, tt.req)
 
@@ -0,0 +1,272 @@
+package user
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// Defaults for UserImportConfig.
+const (
+	DefaultImportChunkSize    = 100
+	DefaultImportLease        = 5 * time.Minute
+	DefaultImportPollInterval = 5 * time.Second
+)
+
+// MaxImportRowErrors bounds the row errors stored on an import job.
+// Failed rows beyond it are still counted in Job.FailedRows.
+const MaxImportRowErrors = 1000
+
+// OpProcessUserImport is the operation name for background user import processing.
+const OpProcessUserImport = "ProcessUserImport"
+
+// UserImportConfig tunes background user import processing.
+type UserImportConfig struct {
+	// ChunkSize is the number of rows created per transaction.
+	ChunkSize int
+	// Lease is how long a worker may hold a job without saving progress
+	// before another worker may take it over.
+	Lease time.Duration
+	// PollInterval is how often UserImportWorker looks for queued jobs.
+	PollInterval time.Duration
+}
+
+// errImportRowFailed rolls back a chunk after one of its rows has failed;
+// the row's own error is recorded separately.
+var errImportRowFailed = errors.New("import row failed")
+
+// UserImportProcessor runs queued user import jobs.
+// Rows are created in chunks, each in one transaction together with the job's
+// progress, so a job interrupted by a crash or shutdown is resumed after its
+// last committed chunk. Every user goes through the same validation,
+// persistence and audit steps as CreateUserUseCase, with the job's creator as
+// the audit actor.
+type UserImportProcessor struct {
+	createUC  *CreateUserUseCase
+	jobRepo   domain.JobRepository
+	chunkSize int
+	lease     time.Duration
+	now       func() time.Time
+	log       *logger.Logger
+}
+
+// NewUserImportProcessor creates a new instance of UserImportProcessor.
+// Non-positive values in cfg fall back to the defaults.
+func NewUserImportProcessor(
+	createUC *CreateUserUseCase,
+	jobRepo domain.JobRepository,
+	cfg UserImportConfig,
+	log *logger.Logger,
+) *UserImportProcessor {
+	if cfg.ChunkSize <= 0 {
+		cfg.ChunkSize = DefaultImportChunkSize
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = DefaultImportLease
+	}
+	return &UserImportProcessor{
+		createUC:  createUC,
+		jobRepo:   jobRepo,
+		chunkSize: cfg.ChunkSize,
+		lease:     cfg.Lease,
+		now:       time.Now,
+		log:       log.With("usecase", OpProcessUserImport),
+	}
+}
+
+// ProcessNext claims the next queued import job and runs it to completion.
+// It reports whether a job was claimed. If ctx is cancelled the job is left
+// running and is resumed by a worker once its lease expires.
+// Returns AppError with Code=INTERNAL_ERROR if no job could be claimed or the
+// job failed; a failed job is marked as such.
+func (p *UserImportProcessor) ProcessNext(ctx context.Context) (bool, error) {
+	job, err := p.jobRepo.ClaimNext(ctx, p.createUC.db, domain.JobTypeUserImport, p.leaseUntil())
+	if errors.Is(err, domain.ErrJobNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, &app.AppError{
+			Op:      OpProcessUserImport,
+			Code:    app.CodeInternalError,
+			Message: "Failed to claim user import job",
+			Err:     err,
+		}
+	}
+
+	// Jobs are claimed across tenants; the job's users belong to its tenant.
+	ctx = sharedctx.SetTenantID(ctx, job.TenantID)
+
+	log := logger.FromContext(ctx, p.log).With("jobId", job.ID, "requestId", job.RequestID)
+	log.InfoContext(ctx, "user import started",
+		"nextRow", job.NextRow,
+		"totalRows", job.TotalRows,
+	)
+
+	file, err := parseImportCSV(job.Input)
+	if err != nil {
+		// The file was checked when the job was queued, so it is corrupt.
+		return true, p.fail(ctx, job, "The uploaded file could not be read", err)
+	}
+
+	for !job.Status.Terminal() {
+		err := p.processChunk(ctx, job, file)
+		switch {
+		case err == nil:
+		case ctx.Err() != nil:
+			log.InfoContext(ctx, "user import interrupted", "nextRow", job.NextRow)
+			return true, nil
+		case errors.Is(err, domain.ErrJobLeaseLost):
+			log.WarnContext(ctx, "user import lease lost to another worker", "nextRow", job.NextRow)
+			return true, nil
+		default:
+			return true, p.fail(ctx, job, "Processing stopped because of an internal error", err)
+		}
+	}
+
+	log.InfoContext(ctx, "user import finished",
+		"processed", job.ProcessedRows,
+		"failed", job.FailedRows,
+	)
+	return true, nil
+}
+
+// processChunk creates the users of the next chunk of rows and saves the
+// job's progress in the same transaction. A row rejected by the database
+// (e.g. a duplicate email) rolls the chunk back; the chunk is then retried
+// without that row. On success job is updated to the saved state.
+func (p *UserImportProcessor) processChunk(ctx context.Context, job *domain.Job, file *importFile) error {
+	start := job.NextRow
+	end := min(start+p.chunkSize, len(file.rows))
+
+	users := make([]*domain.User, end-start)
+	reqs := make([]CreateUserRequest, end-start)
+	var rowErrs []domain.JobRowError
+	for i := start; i < end; i++ {
+		req, msg := file.request(job, i)
+		if msg == "" {
+			user, err := p.createUC.newUser(req)
+			if err != nil {
+				msg = importRowMessage(err)
+			}
+			users[i-start], reqs[i-start] = user, req
+		}
+		if msg != "" {
+			rowErrs = append(rowErrs, domain.JobRowError{Row: i + 1, Message: msg})
+		}
+	}
+
+	for {
+		next := *job
+		failed, failedErr := -1, error(nil)
+		err := p.createUC.txManager.WithTx(ctx, func(tx domain.Querier) error {
+			for k, user := range users {
+				if user == nil {
+					continue
+				}
+				if err := p.createUC.insert(ctx, tx, user, reqs[k]); err != nil {
+					if !isImportRowError(err) {
+						return err
+					}
+					failed, failedErr = k, err
+					return errImportRowFailed
+				}
+			}
+			p.advance(&next, end, len(file.rows), rowErrs)
+			return p.jobRepo.UpdateProgress(ctx, tx, &next, p.leaseUntil())
+		})
+		if failed >= 0 {
+			users[failed] = nil
+			rowErrs = append(rowErrs, domain.JobRowError{Row: start + failed + 1, Message: importRowMessage(failedErr)})
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		*job = next
+		return nil
+	}
+}
+
+// advance records that the rows before end are done, rowErrs being the rows
+// among them that failed, and finishes the job after its last row.
+func (p *UserImportProcessor) advance(job *domain.Job, end, total int, rowErrs []domain.JobRowError) {
+	job.ProcessedRows += end - job.NextRow
+	job.NextRow = end
+	job.FailedRows += len(rowErrs)
+
+	sorted := slices.SortedFunc(slices.Values(rowErrs), func(a, b domain.JobRowError) int {
+		return cmp.Compare(a.Row, b.Row)
+	})
+	room := max(MaxImportRowErrors-len(job.Errors), 0)
+	// Clip so appending never writes into the caller's copy of the slice.
+	job.Errors = append(slices.Clip(job.Errors), sorted[:min(room, len(sorted))]...)
+
+	if end >= total {
+		now := p.now().UTC()
+		job.Status = domain.JobStatusSucceeded
+		job.FinishedAt = &now
+	}
+}
+
+// fail marks job as failed with a client-facing message; cause is only logged.
+func (p *UserImportProcessor) fail(ctx context.Context, job *domain.Job, message string, cause error) error {
+	log := logger.FromContext(ctx, p.log)
+	log.ErrorContext(ctx, "user import failed",
+		"jobId", job.ID,
+		"requestId", job.RequestID,
+		"nextRow", job.NextRow,
+		"err", cause,
+	)
+
+	failed := *job
+	now := p.now().UTC()
+	failed.Status = domain.JobStatusFailed
+	failed.Error = message
+	failed.FinishedAt = &now
+	if err := p.jobRepo.UpdateProgress(ctx, p.createUC.db, &failed, now); err != nil {
+		log.ErrorContext(ctx, "failed to mark user import as failed",
+			"jobId", job.ID,
+			"err", err,
+		)
+	} else {
+		*job = failed
+	}
+
+	return &app.AppError{
+		Op:      OpProcessUserImport,
+		Code:    app.CodeInternalError,
+		Message: "User import failed",
+		Err:     cause,
+	}
+}
+
+func (p *UserImportProcessor) leaseUntil() time.Time {
+	return p.now().UTC().Add(p.lease)
+}
+
+// isImportRowError reports whether err is caused by the row's data rather
+// than by the system, so the import should skip the row and go on.
+func isImportRowError(err error) bool {
+	var appErr *app.AppError
+	return errors.As(err, &appErr) &&
+		(appErr.Code == app.CodeEmailExists || appErr.Code == app.CodeValidationError)
+}
+
+// importRowMessage describes a row error for the job's error list.
+func importRowMessage(err error) string {
+	var appErr *app.AppError
+	if errors.As(err, &appErr) {
+		if appErr.Code == app.CodeEmailExists {
+			return "email already exists"
+		}
+		return appErr.Message
+	}
+	return "row could not be imported"
+}
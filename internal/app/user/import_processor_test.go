@@ -0,0 +1,259 @@
+//go:build !integration
+
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+type importFixture struct {
+	processor *UserImportProcessor
+	users     *uniqueEmailRepository
+	audit     *mockAuditEventRepository
+	jobs      *mockJobRepository
+	tx        *rollbackTxManager
+	log       *bytes.Buffer
+}
+
+func newImportFixture(t *testing.T, chunkSize int) *importFixture {
+	t.Helper()
+
+	users := &uniqueEmailRepository{newMockUserRepository()}
+	auditSvc, deps := newMockAuditService()
+	tx := &rollbackTxManager{repo: users.mockUserRepository, audit: deps.repo}
+	jobs := newMockJobRepository()
+	var buf bytes.Buffer
+	createUC := NewCreateUserUseCase(users, auditSvc, newMockIDGenerator(), tx, &mockQuerier{}, testutil.NewAuthorizer(slog.Default()))
+	processor := NewUserImportProcessor(createUC, jobs, UserImportConfig{ChunkSize: chunkSize, Lease: time.Minute}, newTestLogger(&buf))
+	return &importFixture{processor: processor, users: users, audit: deps.repo, jobs: jobs, tx: tx, log: &buf}
+}
+
+// queue stores a pending import job for csvFile and returns its ID.
+func (f *importFixture) queue(t *testing.T, csvFile string) domain.ID {
+	t.Helper()
+
+	file, err := parseImportCSV([]byte(csvFile))
+	require.NoError(t, err)
+	id := domain.ID(fmt.Sprintf("job-%d", len(f.jobs.jobs)+1))
+	f.jobs.jobs[id] = domain.Job{
+		ID:        id,
+		Type:      domain.JobTypeUserImport,
+		Status:    domain.JobStatusPending,
+		CreatedBy: "admin-id",
+		RequestID: "req-import",
+		Input:     []byte(csvFile),
+		TotalRows: len(file.rows),
+	}
+	return id
+}
+
+func (f *importFixture) emails() []string {
+	var emails []string
+	for _, u := range f.users.users {
+		emails = append(emails, u.Email)
+	}
+	return emails
+}
+
+func TestUserImportProcessor_ProcessNext(t *testing.T) {
+	t.Run("nothing queued", func(t *testing.T) {
+		f := newImportFixture(t, 10)
+
+		claimed, err := f.processor.ProcessNext(context.Background())
+
+		require.NoError(t, err)
+		assert.False(t, claimed)
+	})
+
+	t.Run("creates every row in chunks with one audit event per user", func(t *testing.T) {
+		f := newImportFixture(t, 2)
+		id := f.queue(t, "email,firstName,lastName\na@example.com,Ann,Lee\nb@example.com,Bob,Ray\nc@example.com,Cy,Fox\n")
+
+		claimed, err := f.processor.ProcessNext(context.Background())
+
+		require.NoError(t, err)
+		assert.True(t, claimed)
+		job := f.jobs.jobs[id]
+		assert.Equal(t, domain.JobStatusSucceeded, job.Status)
+		assert.Equal(t, 3, job.NextRow)
+		assert.Equal(t, 3, job.ProcessedRows)
+		assert.Equal(t, 0, job.FailedRows)
+		assert.Empty(t, job.Errors)
+		assert.NotNil(t, job.FinishedAt)
+		assert.Nil(t, job.Input, "input should be dropped once the job is done")
+		assert.Equal(t, 2, f.tx.txs, "3 rows in chunks of 2 should take 2 transactions")
+
+		assert.ElementsMatch(t, []string{"a@example.com", "b@example.com", "c@example.com"}, f.emails())
+		require.Len(t, f.audit.events, 3)
+		for _, e := range f.audit.events {
+			assert.Equal(t, domain.EventUserCreated, e.EventType)
+			assert.Equal(t, domain.ID("admin-id"), e.ActorID)
+			assert.Equal(t, "req-import", e.RequestID)
+		}
+		assert.Contains(t, f.log.String(), "user import finished")
+	})
+
+	t.Run("processes the job within its tenant", func(t *testing.T) {
+		f := newImportFixture(t, 10)
+		id := f.queue(t, "email,firstName,lastName\na@example.com,Ann,Lee\n")
+		job := f.jobs.jobs[id]
+		job.TenantID = "tenant-a"
+		f.jobs.jobs[id] = job
+
+		claimed, err := f.processor.ProcessNext(context.Background())
+
+		require.NoError(t, err)
+		assert.True(t, claimed)
+		assert.Equal(t, domain.JobStatusSucceeded, f.jobs.jobs[id].Status)
+		assert.Equal(t, []string{"tenant-a"}, f.tx.tenants)
+	})
+
+	t.Run("records failed rows and creates the rest", func(t *testing.T) {
+		f := newImportFixture(t, 10)
+		seedUser(f.users.mockUserRepository, "existing")
+		id := f.queue(t, strings.Join([]string{
+			"email,firstName,lastName",
+			"a@example.com,Ann,Lee",
+			"not-an-email,Bad,Email",
+			"me@example.com,Already,There",
+			"a@example.com,Ann,Again",
+			"b@example.com,Bob",
+			"c@example.com,Cy,Fox",
+		}, "\n"))
+
+		_, err := f.processor.ProcessNext(context.Background())
+
+		require.NoError(t, err)
+		job := f.jobs.jobs[id]
+		assert.Equal(t, domain.JobStatusSucceeded, job.Status)
+		assert.Equal(t, 6, job.ProcessedRows)
+		assert.Equal(t, 4, job.FailedRows)
+		assert.Equal(t, []domain.JobRowError{
+			{Row: 2, Message: "email is not a valid address"},
+			{Row: 3, Message: "email already exists"},
+			{Row: 4, Message: "email already exists"},
+			{Row: 5, Message: "lastName is required"},
+		}, job.Errors)
+
+		assert.ElementsMatch(t, []string{"me@example.com", "a@example.com", "c@example.com"}, f.emails())
+		assert.Len(t, f.audit.events, 2, "rolled back attempts must not leave audit events")
+	})
+
+	t.Run("resumes after the last saved row", func(t *testing.T) {
+		f := newImportFixture(t, 10)
+		id := f.queue(t, "email,firstName,lastName\na@example.com,Ann,Lee\nb@example.com,Bob,Ray\n")
+		job := f.jobs.jobs[id]
+		job.NextRow, job.ProcessedRows = 1, 1
+		f.jobs.jobs[id] = job
+
+		_, err := f.processor.ProcessNext(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b@example.com"}, f.emails())
+		assert.Equal(t, 2, f.jobs.jobs[id].ProcessedRows)
+	})
+
+	t.Run("caps stored row errors", func(t *testing.T) {
+		f := newImportFixture(t, 500)
+		rows := []string{"email,firstName,lastName"}
+		for range MaxImportRowErrors + 2 {
+			rows = append(rows, ",No,Email")
+		}
+		id := f.queue(t, strings.Join(rows, "\n"))
+
+		_, err := f.processor.ProcessNext(context.Background())
+
+		require.NoError(t, err)
+		job := f.jobs.jobs[id]
+		assert.Equal(t, MaxImportRowErrors+2, job.FailedRows)
+		assert.Len(t, job.Errors, MaxImportRowErrors)
+		assert.Equal(t, MaxImportRowErrors, job.Errors[len(job.Errors)-1].Row)
+	})
+
+	t.Run("internal error fails the job", func(t *testing.T) {
+		f := newImportFixture(t, 10)
+		f.audit.createError = errors.New("audit store down")
+		id := f.queue(t, "email,firstName,lastName\na@example.com,Ann,Lee\n")
+
+		claimed, err := f.processor.ProcessNext(context.Background())
+
+		assert.True(t, claimed)
+		var appErr *app.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, app.CodeInternalError, appErr.Code)
+
+		job := f.jobs.jobs[id]
+		assert.Equal(t, domain.JobStatusFailed, job.Status)
+		assert.Equal(t, "Processing stopped because of an internal error", job.Error)
+		assert.NotNil(t, job.FinishedAt)
+		assert.Empty(t, f.emails())
+		assert.NotContains(t, job.Error, "audit store down", "causes must not leak to clients")
+	})
+
+	t.Run("lost lease leaves the job to the new owner", func(t *testing.T) {
+		f := newImportFixture(t, 10)
+		f.jobs.updateError = fmt.Errorf("update: %w", domain.ErrJobLeaseLost)
+		id := f.queue(t, "email,firstName,lastName\na@example.com,Ann,Lee\n")
+
+		claimed, err := f.processor.ProcessNext(context.Background())
+
+		require.NoError(t, err)
+		assert.True(t, claimed)
+		assert.Equal(t, domain.JobStatusRunning, f.jobs.jobs[id].Status)
+		assert.Empty(t, f.emails(), "the chunk must be rolled back")
+		assert.Contains(t, f.log.String(), "lease lost")
+	})
+}
+
+// notifyingJobRepository signals done once a job reaches a terminal status,
+// so tests can wait for the worker without sharing state with it.
+type notifyingJobRepository struct {
+	*mockJobRepository
+	done chan struct{}
+}
+
+func (m *notifyingJobRepository) UpdateProgress(ctx context.Context, q domain.Querier, job *domain.Job, leaseUntil time.Time) error {
+	if err := m.mockJobRepository.UpdateProgress(ctx, q, job, leaseUntil); err != nil {
+		return err
+	}
+	if job.Status.Terminal() {
+		close(m.done)
+	}
+	return nil
+}
+
+func TestUserImportWorker(t *testing.T) {
+	f := newImportFixture(t, 10)
+	id := f.queue(t, "email,firstName,lastName\na@example.com,Ann,Lee\n")
+	jobs := &notifyingJobRepository{mockJobRepository: f.jobs, done: make(chan struct{})}
+	f.processor.jobRepo = jobs
+	var buf bytes.Buffer
+	worker := NewUserImportWorker(f.processor, time.Hour, newTestLogger(&buf))
+
+	require.NoError(t, worker.Start(context.Background()))
+	select {
+	case <-jobs.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("queued job was not processed on start")
+	}
+	stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, worker.Stop(stopCtx))
+
+	assert.Equal(t, domain.JobStatusSucceeded, f.jobs.jobs[id].Status)
+	assert.Contains(t, buf.String(), "user import worker stopped")
+}
@@ -0,0 +1,318 @@
+package user
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// StartUserImportRequest represents the input data for queueing a CSV user import.
+type StartUserImportRequest struct {
+	// CSV is the uploaded file, read once to its end. The header row must name
+	// the columns email, firstName and lastName (in any order and case); other
+	// columns are ignored.
+	CSV io.Reader
+	// RequestID correlates this operation with the HTTP request.
+	// Transport layer extracts from context and passes here.
+	RequestID string
+}
+
+// StartUserImportResponse represents the queued import.
+type StartUserImportResponse struct {
+	Job domain.Job
+}
+
+// OpStartUserImport is the operation name for StartUserImport use case.
+const OpStartUserImport = "StartUserImport"
+
+// StartUserImportUseCase validates an uploaded CSV file and queues it as a
+// background job. The users are created later by UserImportProcessor.
+// Requires users:import.
+type StartUserImportUseCase struct {
+	jobRepo domain.JobRepository
+	idGen   domain.IDGenerator
+	db      domain.Querier
+	authz   app.Authorizer
+	log     *logger.Logger
+}
+
+// NewStartUserImportUseCase creates a new instance of StartUserImportUseCase.
+func NewStartUserImportUseCase(
+	jobRepo domain.JobRepository,
+	idGen domain.IDGenerator,
+	db domain.Querier,
+	authz app.Authorizer,
+	log *logger.Logger,
+) *StartUserImportUseCase {
+	return &StartUserImportUseCase{
+		jobRepo: jobRepo,
+		idGen:   idGen,
+		db:      db,
+		authz:   authz,
+		log:     log.With("usecase", OpStartUserImport),
+	}
+}
+
+// Execute checks the file's structure while reading it into a pending job.
+// Rows are only counted, not kept or validated; invalid rows are reported on
+// the job. The file's bytes become the job's input.
+// Returns AppError with Code=INSUFFICIENT_PERMISSIONS without users:import,
+// VALIDATION_ERROR if the file is not valid CSV, lacks a required column or
+// has no data rows, and INTERNAL_ERROR wrapping the reader's error if req.CSV
+// fails.
+func (uc *StartUserImportUseCase) Execute(ctx context.Context, req StartUserImportRequest) (StartUserImportResponse, error) {
+	authCtx, err := app.RequirePermission(ctx, uc.authz, OpStartUserImport, app.PermUsersImport, usersResource)
+	if err != nil {
+		return StartUserImportResponse{}, err
+	}
+
+	var input bytes.Buffer
+	rows, err := countImportRows(io.TeeReader(req.CSV, &input))
+	if err != nil {
+		var readErr *importReadError
+		if errors.As(err, &readErr) {
+			return StartUserImportResponse{}, &app.AppError{
+				Op:      OpStartUserImport,
+				Code:    app.CodeInternalError,
+				Message: "Failed to read CSV file",
+				Err:     readErr.err,
+			}
+		}
+		return StartUserImportResponse{}, &app.AppError{
+			Op:      OpStartUserImport,
+			Code:    app.CodeValidationError,
+			Message: err.Error(),
+			Err:     err,
+		}
+	}
+
+	now := time.Now().UTC()
+	job := domain.Job{
+		ID:        uc.idGen.NewID(),
+		Type:      domain.JobTypeUserImport,
+		Status:    domain.JobStatusPending,
+		CreatedBy: domain.ID(authCtx.SubjectID),
+		RequestID: req.RequestID,
+		Input:     input.Bytes(),
+		TotalRows: rows,
+		Errors:    []domain.JobRowError{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := uc.jobRepo.Create(ctx, uc.db, &job); err != nil {
+		return StartUserImportResponse{}, &app.AppError{
+			Op:      OpStartUserImport,
+			Code:    app.CodeInternalError,
+			Message: "Failed to queue user import",
+			Err:     err,
+		}
+	}
+
+	logger.FromContext(ctx, uc.log).InfoContext(ctx, "user import queued",
+		"actorId", authCtx.SubjectID,
+		"requestId", req.RequestID,
+		"jobId", job.ID,
+		"rows", job.TotalRows,
+	)
+
+	// The input stays with the job; callers only need its status.
+	job.Input = nil
+	return StartUserImportResponse{Job: job}, nil
+}
+
+// importColumns are the CSV columns a user import reads, matched
+// case-insensitively. The header written by GET /users:export satisfies them,
+// so an export can be imported as is.
+var importColumns = []string{"email", "firstName", "lastName"}
+
+// Field limits, matching the validation of POST /users.
+const (
+	importMaxEmailLength = 255
+	importMaxNameLength  = 100
+)
+
+// importFile is a parsed user import CSV.
+type importFile struct {
+	// cols maps each of importColumns to its index in a row.
+	cols map[string]int
+	// rows are the data rows, without the header.
+	rows [][]string
+}
+
+// importReadError is an error of the reader a CSV file is parsed from, as
+// opposed to an error in the file's contents.
+type importReadError struct {
+	err error
+}
+
+func (e *importReadError) Error() string { return "read CSV: " + e.err.Error() }
+func (e *importReadError) Unwrap() error { return e.err }
+
+// failingReader wraps the errors of r, other than io.EOF, in importReadError.
+type failingReader struct {
+	r io.Reader
+}
+
+func (f failingReader) Read(p []byte) (int, error) {
+	n, err := f.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		err = &importReadError{err: err}
+	}
+	return n, err
+}
+
+// newImportReader returns a CSV reader positioned after the header of src and
+// the index of each of importColumns in a row. A leading UTF-8 byte order
+// mark, as written by spreadsheet tools, is ignored.
+func newImportReader(src io.Reader) (*csv.Reader, map[string]int, error) {
+	br := bufio.NewReader(failingReader{r: src})
+	if bom, err := br.Peek(3); err == nil && string(bom) == "\ufeff" {
+		_, _ = br.Discard(3)
+	}
+	r := csv.NewReader(br)
+	// Short rows are reported per row rather than failing the whole file.
+	r.FieldsPerRecord = -1
+	r.ReuseRecord = true
+
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, errors.New("CSV file is empty")
+	}
+	if err != nil {
+		return nil, nil, importCSVError(err)
+	}
+
+	cols := make(map[string]int, len(importColumns))
+	for i, name := range header {
+		for _, col := range importColumns {
+			if !strings.EqualFold(strings.TrimSpace(name), col) {
+				continue
+			}
+			if _, dup := cols[col]; dup {
+				return nil, nil, fmt.Errorf("CSV header has column %q more than once", col)
+			}
+			cols[col] = i
+		}
+	}
+	for _, col := range importColumns {
+		if _, ok := cols[col]; !ok {
+			return nil, nil, fmt.Errorf("CSV header must contain column %q", col)
+		}
+	}
+	return r, cols, nil
+}
+
+// importCSVError returns err of a CSV reader as is if the underlying reader
+// failed, or as an invalid CSV error otherwise.
+func importCSVError(err error) error {
+	var readErr *importReadError
+	if errors.As(err, &readErr) {
+		return readErr
+	}
+	return fmt.Errorf("invalid CSV: %w", err)
+}
+
+// countImportRows checks the header of the CSV file in src and counts its
+// data rows, reading src to its end without keeping the rows.
+func countImportRows(src io.Reader) (int, error) {
+	r, _, err := newImportReader(src)
+	if err != nil {
+		return 0, err
+	}
+	rows := 0
+	for {
+		_, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, importCSVError(err)
+		}
+		rows++
+	}
+	if rows == 0 {
+		return 0, errors.New("CSV file has no data rows")
+	}
+	return rows, nil
+}
+
+// parseImportCSV parses data and locates the import columns in its header.
+func parseImportCSV(data []byte) (*importFile, error) {
+	r, cols, err := newImportReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	r.ReuseRecord = false
+
+	file := &importFile{cols: cols}
+	file.rows, err = r.ReadAll()
+	if err != nil {
+		return nil, importCSVError(err)
+	}
+	if len(file.rows) == 0 {
+		return nil, errors.New("CSV file has no data rows")
+	}
+	return file, nil
+}
+
+// request builds the create request for data row i of job.
+// If the row is invalid it returns a message describing why instead.
+func (f *importFile) request(job *domain.Job, i int) (CreateUserRequest, string) {
+	row := f.rows[i]
+	field := func(col string) string {
+		if idx := f.cols[col]; idx < len(row) {
+			return strings.TrimSpace(row[idx])
+		}
+		return ""
+	}
+
+	req := CreateUserRequest{
+		Email:     field("email"),
+		FirstName: field("firstName"),
+		LastName:  field("lastName"),
+		RequestID: job.RequestID,
+		ActorID:   job.CreatedBy,
+	}
+	return req, validateImportRow(req)
+}
+
+// validateImportRow applies the field rules of POST /users to an import row.
+// It returns an empty string if the row is valid.
+func validateImportRow(req CreateUserRequest) string {
+	switch {
+	case req.Email == "":
+		return "email is required"
+	case utf8.RuneCountInString(req.Email) > importMaxEmailLength:
+		return fmt.Sprintf("email must be at most %d characters", importMaxEmailLength)
+	case !isPlainEmail(req.Email):
+		return "email is not a valid address"
+	case req.FirstName == "":
+		return "firstName is required"
+	case utf8.RuneCountInString(req.FirstName) > importMaxNameLength:
+		return fmt.Sprintf("firstName must be at most %d characters", importMaxNameLength)
+	case req.LastName == "":
+		return "lastName is required"
+	case utf8.RuneCountInString(req.LastName) > importMaxNameLength:
+		return fmt.Sprintf("lastName must be at most %d characters", importMaxNameLength)
+	}
+	return ""
+}
+
+// isPlainEmail reports whether s is a bare address such as a@example.com,
+// rejecting display-name forms like "A <a@example.com>".
+func isPlainEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
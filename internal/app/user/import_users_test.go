@@ -0,0 +1,274 @@
+//go:build !integration
+
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+// mockJobRepository is a test double for domain.JobRepository that enforces
+// leases like the jobs table does.
+type mockJobRepository struct {
+	jobs        map[domain.ID]domain.Job
+	createError error
+	updateError error
+	updates     int
+}
+
+func newMockJobRepository() *mockJobRepository {
+	return &mockJobRepository{jobs: make(map[domain.ID]domain.Job)}
+}
+
+func (m *mockJobRepository) Create(_ context.Context, _ domain.Querier, job *domain.Job) error {
+	if m.createError != nil {
+		return m.createError
+	}
+	m.jobs[job.ID] = *job
+	return nil
+}
+
+func (m *mockJobRepository) GetByID(_ context.Context, _ domain.Querier, id domain.ID) (*domain.Job, error) {
+	job, ok := m.jobs[id]
+	if !ok {
+		return nil, domain.ErrJobNotFound
+	}
+	job.Input = nil
+	return &job, nil
+}
+
+func (m *mockJobRepository) ClaimNext(_ context.Context, _ domain.Querier, jobType domain.JobType, leaseUntil time.Time) (*domain.Job, error) {
+	for id, job := range m.jobs {
+		expired := job.Status == domain.JobStatusRunning && job.LockedUntil != nil && job.LockedUntil.Before(time.Now())
+		if job.Type != jobType || (job.Status != domain.JobStatusPending && !expired) {
+			continue
+		}
+		job.Status = domain.JobStatusRunning
+		job.LockedUntil = &leaseUntil
+		if job.StartedAt == nil {
+			now := time.Now()
+			job.StartedAt = &now
+		}
+		m.jobs[id] = job
+		return &job, nil
+	}
+	return nil, domain.ErrJobNotFound
+}
+
+func (m *mockJobRepository) UpdateProgress(_ context.Context, _ domain.Querier, job *domain.Job, leaseUntil time.Time) error {
+	if m.updateError != nil {
+		return m.updateError
+	}
+	stored, ok := m.jobs[job.ID]
+	if !ok || stored.LockedUntil == nil || job.LockedUntil == nil || !stored.LockedUntil.Equal(*job.LockedUntil) {
+		return domain.ErrJobLeaseLost
+	}
+	m.updates++
+	job.LockedUntil = &leaseUntil
+	updated := *job
+	updated.Input = stored.Input
+	if updated.Status.Terminal() {
+		updated.Input = nil
+	}
+	m.jobs[job.ID] = updated
+	return nil
+}
+
+func TestStartUserImportUseCase_Execute(t *testing.T) {
+	const csvFile = "email,firstName,lastName\na@example.com,Ann,Lee\nb@example.com,Bob,Ray\n"
+
+	t.Run("queues a pending job for the admin", func(t *testing.T) {
+		jobs := newMockJobRepository()
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		uc := NewStartUserImportUseCase(jobs, newMockIDGenerator(), &mockQuerier{}, testutil.NewAuthorizer(log), log)
+
+		resp, err := uc.Execute(userCtx("admin-id", app.RoleAdmin), StartUserImportRequest{
+			CSV:       strings.NewReader(csvFile),
+			RequestID: "req-1",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ID("user-1"), resp.Job.ID)
+		assert.Equal(t, domain.JobTypeUserImport, resp.Job.Type)
+		assert.Equal(t, domain.JobStatusPending, resp.Job.Status)
+		assert.Equal(t, 2, resp.Job.TotalRows)
+		assert.Nil(t, resp.Job.Input, "input should not be returned")
+
+		stored := jobs.jobs[resp.Job.ID]
+		assert.Equal(t, domain.ID("admin-id"), stored.CreatedBy)
+		assert.Equal(t, "req-1", stored.RequestID)
+		assert.Equal(t, []byte(csvFile), stored.Input)
+		assert.Contains(t, buf.String(), "user import queued")
+	})
+
+	t.Run("non-admin is forbidden", func(t *testing.T) {
+		jobs := newMockJobRepository()
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		uc := NewStartUserImportUseCase(jobs, newMockIDGenerator(), &mockQuerier{}, testutil.NewAuthorizer(log), log)
+
+		_, err := uc.Execute(userCtx("user-a", app.RoleUser), StartUserImportRequest{CSV: strings.NewReader(csvFile)})
+
+		var appErr *app.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, app.CodeInsufficientPermissions, appErr.Code)
+		assert.Empty(t, jobs.jobs)
+	})
+
+	t.Run("no auth context is forbidden", func(t *testing.T) {
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		uc := NewStartUserImportUseCase(newMockJobRepository(), newMockIDGenerator(), &mockQuerier{}, testutil.NewAuthorizer(log), log)
+
+		_, err := uc.Execute(context.Background(), StartUserImportRequest{CSV: strings.NewReader(csvFile)})
+
+		var appErr *app.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, app.CodeForbidden, appErr.Code)
+	})
+
+	invalid := []struct {
+		name    string
+		csv     string
+		message string
+	}{
+		{name: "empty file", csv: "", message: "CSV file is empty"},
+		{name: "header only", csv: "email,firstName,lastName\n", message: "CSV file has no data rows"},
+		{name: "missing column", csv: "email,firstName\na@example.com,Ann\n", message: `CSV header must contain column "lastName"`},
+		{name: "duplicate column", csv: "email,firstName,lastName,EMAIL\na,b,c,d\n", message: `CSV header has column "email" more than once`},
+		{name: "malformed quotes", csv: "email,firstName,lastName\n\"a@example.com,Ann,Lee\n", message: "invalid CSV"},
+	}
+	for _, tt := range invalid {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			jobs := newMockJobRepository()
+			var buf bytes.Buffer
+			log := newTestLogger(&buf)
+			uc := NewStartUserImportUseCase(jobs, newMockIDGenerator(), &mockQuerier{}, testutil.NewAuthorizer(log), log)
+
+			_, err := uc.Execute(userCtx("admin-id", app.RoleAdmin), StartUserImportRequest{CSV: strings.NewReader(tt.csv)})
+
+			var appErr *app.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, app.CodeValidationError, appErr.Code)
+			assert.Contains(t, appErr.Message, tt.message)
+			assert.Empty(t, jobs.jobs)
+		})
+	}
+
+	t.Run("keeps a byte order mark in the input", func(t *testing.T) {
+		jobs := newMockJobRepository()
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		uc := NewStartUserImportUseCase(jobs, newMockIDGenerator(), &mockQuerier{}, testutil.NewAuthorizer(log), log)
+
+		resp, err := uc.Execute(userCtx("admin-id", app.RoleAdmin), StartUserImportRequest{CSV: strings.NewReader("\ufeff" + csvFile)})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, resp.Job.TotalRows)
+		assert.Equal(t, []byte("\ufeff"+csvFile), jobs.jobs[resp.Job.ID].Input)
+	})
+
+	t.Run("read failure is internal and keeps the reader's error", func(t *testing.T) {
+		jobs := newMockJobRepository()
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		uc := NewStartUserImportUseCase(jobs, newMockIDGenerator(), &mockQuerier{}, testutil.NewAuthorizer(log), log)
+		readErr := errors.New("body too large")
+		body := io.MultiReader(strings.NewReader(csvFile), iotest.ErrReader(readErr))
+
+		_, err := uc.Execute(userCtx("admin-id", app.RoleAdmin), StartUserImportRequest{CSV: body})
+
+		var appErr *app.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, app.CodeInternalError, appErr.Code)
+		assert.ErrorIs(t, err, readErr)
+		assert.Empty(t, jobs.jobs)
+	})
+
+	t.Run("repository failure is internal", func(t *testing.T) {
+		jobs := newMockJobRepository()
+		jobs.createError = errors.New("db down")
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		uc := NewStartUserImportUseCase(jobs, newMockIDGenerator(), &mockQuerier{}, testutil.NewAuthorizer(log), log)
+
+		_, err := uc.Execute(userCtx("admin-id", app.RoleAdmin), StartUserImportRequest{CSV: strings.NewReader(csvFile)})
+
+		var appErr *app.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, app.CodeInternalError, appErr.Code)
+	})
+}
+
+func TestParseImportCSV(t *testing.T) {
+	t.Run("matches columns case-insensitively and ignores others", func(t *testing.T) {
+		data := "\ufeffid, EMAIL ,lastname,FirstName,createdAt\n1,a@example.com,Lee,Ann,2024-01-01\n"
+
+		file, err := parseImportCSV([]byte(data))
+
+		require.NoError(t, err)
+		require.Len(t, file.rows, 1)
+		req, msg := file.request(&domain.Job{RequestID: "req-1", CreatedBy: "admin-id"}, 0)
+		assert.Empty(t, msg)
+		assert.Equal(t, CreateUserRequest{
+			Email:     "a@example.com",
+			FirstName: "Ann",
+			LastName:  "Lee",
+			RequestID: "req-1",
+			ActorID:   "admin-id",
+		}, req)
+	})
+
+	t.Run("reports short rows per row", func(t *testing.T) {
+		file, err := parseImportCSV([]byte("email,firstName,lastName\na@example.com,Ann\n"))
+
+		require.NoError(t, err)
+		_, msg := file.request(&domain.Job{}, 0)
+		assert.Equal(t, "lastName is required", msg)
+	})
+}
+
+func TestValidateImportRow(t *testing.T) {
+	valid := CreateUserRequest{Email: "a@example.com", FirstName: "Ann", LastName: "Lee"}
+	with := func(f func(*CreateUserRequest)) CreateUserRequest {
+		req := valid
+		f(&req)
+		return req
+	}
+
+	tests := []struct {
+		name string
+		req  CreateUserRequest
+		want string
+	}{
+		{name: "valid", req: valid, want: ""},
+		{name: "missing email", req: with(func(r *CreateUserRequest) { r.Email = "" }), want: "email is required"},
+		{name: "invalid email", req: with(func(r *CreateUserRequest) { r.Email = "not-an-email" }), want: "email is not a valid address"},
+		{name: "display name email", req: with(func(r *CreateUserRequest) { r.Email = "Ann <a@example.com>" }), want: "email is not a valid address"},
+		{name: "long email", req: with(func(r *CreateUserRequest) { r.Email = strings.Repeat("a", 250) + "@example.com" }), want: "email must be at most 255 characters"},
+		{name: "missing first name", req: with(func(r *CreateUserRequest) { r.FirstName = "" }), want: "firstName is required"},
+		{name: "long first name", req: with(func(r *CreateUserRequest) { r.FirstName = strings.Repeat("a", 101) }), want: "firstName must be at most 100 characters"},
+		{name: "missing last name", req: with(func(r *CreateUserRequest) { r.LastName = "" }), want: "lastName is required"},
+		{name: "long last name", req: with(func(r *CreateUserRequest) { r.LastName = strings.Repeat("ä", 101) }), want: "lastName must be at most 100 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validateImportRow(tt.req))
+		})
+	}
+}
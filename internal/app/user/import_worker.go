@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// UserImportWorker polls for queued user import jobs and runs them in the
+// background. It is started and stopped with the application, like the
+// idempotency cleaner.
+type UserImportWorker struct {
+	processor *UserImportProcessor
+	interval  time.Duration
+	log       *logger.Logger
+	cancel    context.CancelFunc
+	doneCh    chan struct{}
+}
+
+// NewUserImportWorker creates a new worker that polls every interval.
+// A non-positive interval falls back to DefaultImportPollInterval.
+func NewUserImportWorker(processor *UserImportProcessor, interval time.Duration, log *logger.Logger) *UserImportWorker {
+	if interval <= 0 {
+		interval = DefaultImportPollInterval
+	}
+	return &UserImportWorker{
+		processor: processor,
+		interval:  interval,
+		log:       log.With("worker", "user_import"),
+		doneCh:    make(chan struct{}),
+	}
+}
+
+// Start begins polling in a background goroutine and returns immediately.
+// ctx only bounds startup: the loop keeps its values but runs until Stop.
+func (w *UserImportWorker) Start(ctx context.Context) error {
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	w.cancel = cancel
+	go w.run(runCtx)
+	w.log.Info("user import worker started", "interval", w.interval.String())
+	return nil
+}
+
+// Stop interrupts the job in progress and waits for the loop to exit or
+// until ctx is cancelled. An interrupted job keeps its committed progress and
+// is resumed once its lease expires.
+func (w *UserImportWorker) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+	select {
+	case <-w.doneCh:
+		w.log.Info("user import worker stopped")
+		return nil
+	case <-ctx.Done():
+		w.log.Warn("user import worker stop timed out")
+		return ctx.Err()
+	}
+}
+
+func (w *UserImportWorker) run(ctx context.Context) {
+	defer close(w.doneCh)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	w.drain(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.drain(ctx)
+		}
+	}
+}
+
+// drain processes queued jobs until none is left or ctx is cancelled.
+func (w *UserImportWorker) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		claimed, err := w.processor.ProcessNext(ctx)
+		if err != nil {
+			w.log.ErrorContext(ctx, "user import job failed", "err", err)
+		}
+		if !claimed {
+			return
+		}
+	}
+}
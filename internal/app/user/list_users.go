@@ -11,55 +11,112 @@ import (
 )
 
 // ListUsersRequest represents the input data for listing users with pagination.
+// Cursor, when set, selects keyset pagination and Page is ignored; it requires
+// the default order and the same Filter as the page that issued it.
 type ListUsersRequest struct {
 	Page     int
 	PageSize int
+	Cursor   *domain.Cursor
+	Filter   domain.UserFilter
 }
 
 // ListUsersResponse represents the result of listing users.
+// TotalCount is domain.TotalCountUnknown for keyset pages.
+// NextCursor and PrevCursor are nil when there is no page in that direction,
+// and always nil for lists in a non-default order.
 type ListUsersResponse struct {
 	Users      []domain.User
 	TotalCount int
 	Page       int
 	PageSize   int
+	NextCursor *domain.Cursor
+	PrevCursor *domain.Cursor
 }
 
+// OpListUsers is the operation name for ListUsers use case.
+const OpListUsers = "ListUsers"
+
 // ListUsersUseCase handles the business logic for listing users with pagination.
 type ListUsersUseCase struct {
 	userRepo domain.UserRepository
 	db       domain.Querier
+	authz    app.Authorizer
 }
 
 // NewListUsersUseCase creates a new instance of ListUsersUseCase.
-func NewListUsersUseCase(userRepo domain.UserRepository, db domain.Querier) *ListUsersUseCase {
+func NewListUsersUseCase(userRepo domain.UserRepository, db domain.Querier, authz app.Authorizer) *ListUsersUseCase {
 	return &ListUsersUseCase{
 		userRepo: userRepo,
 		db:       db,
+		authz:    authz,
 	}
 }
 
-// Execute lists users with pagination support.
-// Returns paginated user list with total count for UI pagination.
+// Execute lists users matching req.Filter with pagination support.
+// Offset pages include the total count for UI pagination; keyset pages skip it.
+// Both modes return cursors for the neighbouring pages, so clients can switch
+// from page numbers to cursors after the first page.
+// Authorization: requires users:read; users:read:self does not cover listing.
 func (uc *ListUsersUseCase) Execute(ctx context.Context, req ListUsersRequest) (ListUsersResponse, error) {
-	params := domain.ListParams{Page: req.Page, PageSize: req.PageSize}
-	if params.Page <= 0 {
+	if _, err := app.RequirePermission(ctx, uc.authz, OpListUsers, app.PermUsersRead, usersResource); err != nil {
+		return ListUsersResponse{}, err
+	}
+
+	params := domain.ListParams{Page: req.Page, PageSize: req.PageSize, Cursor: req.Cursor}
+	if params.Page <= 0 || params.IsKeyset() {
 		params.Page = 1
 	}
+	if params.IsKeyset() && !req.Filter.HasDefaultOrder() {
+		return ListUsersResponse{}, &app.AppError{
+			Op:      OpListUsers,
+			Code:    app.CodeValidationError,
+			Message: "Cursor pagination requires the default sort order",
+		}
+	}
 
-	users, totalCount, err := uc.userRepo.List(ctx, uc.db, params)
+	users, totalCount, err := uc.userRepo.List(ctx, uc.db, req.Filter, params)
 	if err != nil {
 		return ListUsersResponse{}, &app.AppError{
-			Op:      "ListUsers",
+			Op:      OpListUsers,
 			Code:    app.CodeInternalError,
 			Message: "Failed to list users",
 			Err:     err,
 		}
 	}
 
-	return ListUsersResponse{
-		Users:      users,
+	resp := ListUsersResponse{
 		TotalCount: totalCount,
 		Page:       params.Page,
 		PageSize:   params.Limit(),
-	}, nil
+	}
+	if params.IsKeyset() {
+		resp.Page = 0
+	}
+
+	var hasPrev, hasNext bool
+	switch {
+	case !params.IsKeyset():
+		hasPrev = params.Page > 1
+		hasNext = params.Offset()+len(users) < totalCount
+	case params.Cursor.Backward:
+		users, hasPrev = domain.TrimKeysetPage(users, params)
+		hasNext = true
+	default:
+		users, hasNext = domain.TrimKeysetPage(users, params)
+		hasPrev = true
+	}
+	resp.Users = users
+
+	if len(users) > 0 && req.Filter.HasDefaultOrder() {
+		if hasPrev {
+			first := users[0]
+			resp.PrevCursor = &domain.Cursor{Time: first.CreatedAt, ID: first.ID, Backward: true}
+		}
+		if hasNext {
+			last := users[len(users)-1]
+			resp.NextCursor = &domain.Cursor{Time: last.CreatedAt, ID: last.ID}
+		}
+	}
+
+	return resp, nil
 }
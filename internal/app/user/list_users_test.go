@@ -5,6 +5,7 @@ package user
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/iruldev/golang-api-hexagonal/internal/app"
 	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
 )
 
 // mockQuerierListUsers is a test double for domain.Querier.
@@ -54,7 +56,7 @@ func (m *mockUserRepositoryListUsers) GetByID(_ context.Context, _ domain.Querie
 	return &user, nil
 }
 
-func (m *mockUserRepositoryListUsers) List(_ context.Context, _ domain.Querier, _ domain.ListParams) ([]domain.User, int, error) {
+func (m *mockUserRepositoryListUsers) List(_ context.Context, _ domain.Querier, _ domain.UserFilter, _ domain.ListParams) ([]domain.User, int, error) {
 	if m.listError != nil {
 		return nil, 0, m.listError
 	}
@@ -65,6 +67,34 @@ func (m *mockUserRepositoryListUsers) List(_ context.Context, _ domain.Querier,
 	return users, len(users), nil
 }
 
+func (m *mockUserRepositoryListUsers) Update(_ context.Context, _ domain.Querier, user *domain.User) error {
+	if _, exists := m.users[user.ID]; !exists {
+		return domain.ErrUserNotFound
+	}
+	m.users[user.ID] = *user
+	return nil
+}
+
+func (m *mockUserRepositoryListUsers) Delete(_ context.Context, _ domain.Querier, id domain.ID, _ int64) error {
+	if _, exists := m.users[id]; !exists {
+		return domain.ErrUserNotFound
+	}
+	delete(m.users, id)
+	return nil
+}
+
+func (m *mockUserRepositoryListUsers) Restore(_ context.Context, _ domain.Querier, _ domain.ID) error {
+	return domain.ErrUserNotFound
+}
+
+func (m *mockUserRepositoryListUsers) PurgeDeleted(_ context.Context, _ domain.Querier, _ time.Time) ([]domain.ID, error) {
+	return nil, nil
+}
+
+func (m *mockUserRepositoryListUsers) Export(_ context.Context, _ domain.Querier, _ func(domain.User) error) error {
+	return nil
+}
+
 func TestListUsersUseCase_Execute(t *testing.T) {
 	repoErr := errors.New("database connection failed")
 
@@ -159,8 +189,8 @@ func TestListUsersUseCase_Execute(t *testing.T) {
 			mockDB := &mockQuerierListUsers{}
 			tt.setupMock(mockRepo)
 
-			useCase := NewListUsersUseCase(mockRepo, mockDB)
-			resp, err := useCase.Execute(context.Background(), tt.req)
+			useCase := NewListUsersUseCase(mockRepo, mockDB, testutil.NewAuthorizer(slog.Default()))
+			resp, err := useCase.Execute(adminCtx(), tt.req)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -183,10 +213,208 @@ func TestListUsersUseCase_Execute(t *testing.T) {
 	}
 }
 
+// pagedUserRepository returns a fixed result from List and records the params it was called with.
+type pagedUserRepository struct {
+	*mockUserRepositoryListUsers
+	result     []domain.User
+	total      int
+	lastParams domain.ListParams
+}
+
+func (m *pagedUserRepository) List(_ context.Context, _ domain.Querier, _ domain.UserFilter, params domain.ListParams) ([]domain.User, int, error) {
+	m.lastParams = params
+	return m.result, m.total, nil
+}
+
+func TestListUsersUseCase_Execute_Cursors(t *testing.T) {
+	base := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	mkUsers := func(n int) []domain.User {
+		users := make([]domain.User, n)
+		for i := range users {
+			users[i] = domain.User{ID: domain.ID(string(rune('a' + i))), CreatedAt: base.Add(-time.Duration(i) * time.Minute)}
+		}
+		return users
+	}
+	cursorAt := func(u domain.User, backward bool) *domain.Cursor {
+		return &domain.Cursor{Time: u.CreatedAt, ID: u.ID, Backward: backward}
+	}
+	position := &domain.Cursor{Time: base.Add(time.Hour), ID: "z"}
+
+	tests := []struct {
+		name      string
+		req       ListUsersRequest
+		result    []domain.User
+		total     int
+		wantUsers []domain.User
+		wantPage  int
+		wantPrev  *domain.Cursor
+		wantNext  *domain.Cursor
+	}{
+		{
+			name:      "first offset page links to the next page only",
+			req:       ListUsersRequest{Page: 1, PageSize: 2},
+			result:    mkUsers(2),
+			total:     5,
+			wantUsers: mkUsers(2),
+			wantPage:  1,
+			wantNext:  cursorAt(mkUsers(2)[1], false),
+		},
+		{
+			name:      "last offset page links to the previous page only",
+			req:       ListUsersRequest{Page: 3, PageSize: 2},
+			result:    mkUsers(1),
+			total:     5,
+			wantUsers: mkUsers(1),
+			wantPage:  3,
+			wantPrev:  cursorAt(mkUsers(1)[0], true),
+		},
+		{
+			name:      "forward keyset page with probe has both cursors",
+			req:       ListUsersRequest{PageSize: 2, Cursor: position},
+			result:    mkUsers(3),
+			total:     domain.TotalCountUnknown,
+			wantUsers: mkUsers(2),
+			wantPrev:  cursorAt(mkUsers(2)[0], true),
+			wantNext:  cursorAt(mkUsers(2)[1], false),
+		},
+		{
+			name:      "forward keyset page without probe is the last page",
+			req:       ListUsersRequest{PageSize: 2, Cursor: position},
+			result:    mkUsers(2),
+			total:     domain.TotalCountUnknown,
+			wantUsers: mkUsers(2),
+			wantPrev:  cursorAt(mkUsers(2)[0], true),
+		},
+		{
+			name:      "backward keyset page without probe is the first page",
+			req:       ListUsersRequest{PageSize: 2, Cursor: &domain.Cursor{Time: base.Add(-time.Hour), ID: "z", Backward: true}},
+			result:    mkUsers(2),
+			total:     domain.TotalCountUnknown,
+			wantUsers: mkUsers(2),
+			wantNext:  cursorAt(mkUsers(2)[1], false),
+		},
+		{
+			name:      "backward keyset page with probe drops the oldest-first probe",
+			req:       ListUsersRequest{PageSize: 2, Cursor: &domain.Cursor{Time: base.Add(-time.Hour), ID: "z", Backward: true}},
+			result:    mkUsers(3),
+			total:     domain.TotalCountUnknown,
+			wantUsers: mkUsers(3)[1:],
+			wantPrev:  cursorAt(mkUsers(3)[1], true),
+			wantNext:  cursorAt(mkUsers(3)[2], false),
+		},
+		{
+			name:      "empty keyset page has no cursors",
+			req:       ListUsersRequest{PageSize: 2, Cursor: position},
+			result:    []domain.User{},
+			total:     domain.TotalCountUnknown,
+			wantUsers: []domain.User{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &pagedUserRepository{mockUserRepositoryListUsers: newMockUserRepositoryListUsers(), result: tt.result, total: tt.total}
+			useCase := NewListUsersUseCase(repo, &mockQuerierListUsers{}, testutil.NewAuthorizer(slog.Default()))
+
+			resp, err := useCase.Execute(adminCtx(), tt.req)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.req.Cursor, repo.lastParams.Cursor, "cursor must be passed to the repository")
+			assert.Equal(t, tt.wantUsers, resp.Users)
+			assert.Equal(t, tt.total, resp.TotalCount)
+			assert.Equal(t, tt.wantPage, resp.Page)
+			assert.Equal(t, tt.wantPrev, resp.PrevCursor)
+			assert.Equal(t, tt.wantNext, resp.NextCursor)
+		})
+	}
+}
+
+func TestListUsersUseCase_Execute_Filter(t *testing.T) {
+	users := []domain.User{{ID: "a", CreatedAt: time.Now()}}
+
+	t.Run("passes the filter to the repository", func(t *testing.T) {
+		mockRepo := &filterRecordingRepository{mockUserRepositoryListUsers: newMockUserRepositoryListUsers(), result: users}
+		filter := domain.UserFilter{Email: "a@example.com", Query: "jo"}
+
+		resp, err := NewListUsersUseCase(mockRepo, &mockQuerierListUsers{}, testutil.NewAuthorizer(slog.Default())).
+			Execute(adminCtx(), ListUsersRequest{Page: 1, PageSize: 10, Filter: filter})
+
+		require.NoError(t, err)
+		assert.Equal(t, filter, mockRepo.lastFilter)
+		assert.Len(t, resp.Users, 1)
+	})
+
+	t.Run("custom order yields no cursors", func(t *testing.T) {
+		mockRepo := &filterRecordingRepository{mockUserRepositoryListUsers: newMockUserRepositoryListUsers(), result: users, total: 30}
+		filter := domain.UserFilter{Sort: []domain.UserSort{{Key: domain.UserSortLastName}}}
+
+		resp, err := NewListUsersUseCase(mockRepo, &mockQuerierListUsers{}, testutil.NewAuthorizer(slog.Default())).
+			Execute(adminCtx(), ListUsersRequest{Page: 2, PageSize: 10, Filter: filter})
+
+		require.NoError(t, err)
+		assert.Nil(t, resp.NextCursor)
+		assert.Nil(t, resp.PrevCursor)
+	})
+
+	t.Run("cursor with custom order is rejected", func(t *testing.T) {
+		mockRepo := &filterRecordingRepository{mockUserRepositoryListUsers: newMockUserRepositoryListUsers()}
+		filter := domain.UserFilter{Sort: []domain.UserSort{{Key: domain.UserSortLastName}}}
+
+		_, err := NewListUsersUseCase(mockRepo, &mockQuerierListUsers{}, testutil.NewAuthorizer(slog.Default())).
+			Execute(adminCtx(), ListUsersRequest{PageSize: 10, Filter: filter, Cursor: &domain.Cursor{ID: "a", Time: time.Now()}})
+
+		var appErr *app.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, app.CodeValidationError, appErr.Code)
+		assert.False(t, mockRepo.called, "repository must not be queried")
+	})
+}
+
+func TestListUsersUseCase_Execute_Authorization(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode string
+	}{
+		{name: "user role cannot list users", ctx: userCtx("user-1", app.RoleUser), wantCode: app.CodeInsufficientPermissions},
+		{name: "missing auth context", ctx: context.Background(), wantCode: app.CodeForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &filterRecordingRepository{mockUserRepositoryListUsers: newMockUserRepositoryListUsers()}
+
+			_, err := NewListUsersUseCase(mockRepo, &mockQuerierListUsers{}, testutil.NewAuthorizer(slog.Default())).
+				Execute(tt.ctx, ListUsersRequest{Page: 1, PageSize: 10})
+
+			var appErr *app.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, tt.wantCode, appErr.Code)
+			assert.Equal(t, OpListUsers, appErr.Op)
+			assert.False(t, mockRepo.called, "repository must not be queried")
+		})
+	}
+}
+
+// filterRecordingRepository records the filter List was called with.
+type filterRecordingRepository struct {
+	*mockUserRepositoryListUsers
+	result     []domain.User
+	total      int
+	lastFilter domain.UserFilter
+	called     bool
+}
+
+func (m *filterRecordingRepository) List(_ context.Context, _ domain.Querier, filter domain.UserFilter, _ domain.ListParams) ([]domain.User, int, error) {
+	m.called = true
+	m.lastFilter = filter
+	return m.result, m.total, nil
+}
+
 func TestNewListUsersUseCase(t *testing.T) {
 	mockRepo := newMockUserRepositoryListUsers()
 	mockDB := &mockQuerierListUsers{}
-	useCase := NewListUsersUseCase(mockRepo, mockDB)
+	useCase := NewListUsersUseCase(mockRepo, mockDB, testutil.NewAuthorizer(slog.Default()))
 
 	assert.NotNil(t, useCase)
 	assert.Equal(t, mockRepo, useCase.userRepo)
@@ -0,0 +1,125 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// PurgeDeletedUsersRequest represents the input data for purging soft-deleted users.
+type PurgeDeletedUsersRequest struct {
+	// RequestID correlates this operation with the HTTP request.
+	// Transport layer extracts from context and passes here.
+	RequestID string
+	// ActorID identifies who is performing this action.
+	// Transport layer extracts from JWT claims and passes here.
+	ActorID domain.ID
+}
+
+// PurgeDeletedUsersResponse represents the result of a purge run.
+type PurgeDeletedUsersResponse struct {
+	// PurgedIDs lists the users that were permanently removed.
+	PurgedIDs []domain.ID
+	// DeletedBefore is the cutoff: users soft-deleted before it were purged.
+	DeletedBefore time.Time
+}
+
+// OpPurgeDeletedUsers is the operation name for PurgeDeletedUsers use case.
+const OpPurgeDeletedUsers = "PurgeDeletedUsers"
+
+// PurgeDeletedUsersUseCase permanently removes users that have been
+// soft-deleted for longer than the configured retention window.
+// Requires users:purge.
+type PurgeDeletedUsersUseCase struct {
+	userRepo     domain.UserRepository
+	auditService *audit.AuditService
+	txManager    domain.TxManager
+	retention    time.Duration
+	now          func() time.Time
+	authz        app.Authorizer
+	log          *logger.Logger
+}
+
+// NewPurgeDeletedUsersUseCase creates a new instance of PurgeDeletedUsersUseCase.
+// retention is how long a soft-deleted user is kept before it may be purged.
+func NewPurgeDeletedUsersUseCase(
+	userRepo domain.UserRepository,
+	auditService *audit.AuditService,
+	txManager domain.TxManager,
+	retention time.Duration,
+	authz app.Authorizer,
+	log *logger.Logger,
+) *PurgeDeletedUsersUseCase {
+	return &PurgeDeletedUsersUseCase{
+		userRepo:     userRepo,
+		auditService: auditService,
+		txManager:    txManager,
+		retention:    retention,
+		now:          time.Now,
+		authz:        authz,
+		log:          log.With("usecase", OpPurgeDeletedUsers),
+	}
+}
+
+// Execute hard-deletes every user soft-deleted before now minus the retention window.
+// The delete and one user.purged audit event per removed user happen in a
+// single transaction, so either all purges are recorded or none happen.
+func (uc *PurgeDeletedUsersUseCase) Execute(ctx context.Context, req PurgeDeletedUsersRequest) (PurgeDeletedUsersResponse, error) {
+	if _, err := app.RequirePermission(ctx, uc.authz, OpPurgeDeletedUsers, app.PermUsersPurge, usersResource); err != nil {
+		return PurgeDeletedUsersResponse{}, err
+	}
+
+	cutoff := uc.now().UTC().Add(-uc.retention)
+
+	var purged []domain.ID
+	if err := uc.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		ids, err := uc.userRepo.PurgeDeleted(ctx, tx, cutoff)
+		if err != nil {
+			return &app.AppError{
+				Op:      OpPurgeDeletedUsers,
+				Code:    app.CodeInternalError,
+				Message: "Failed to purge users",
+				Err:     err,
+			}
+		}
+
+		// The user rows no longer exist, so only the purge parameters are recorded.
+		payload := map[string]any{
+			"deletedBefore": cutoff,
+			"retention":     uc.retention.String(),
+		}
+		for _, id := range ids {
+			if err := uc.auditService.Record(ctx, tx, audit.AuditEventInput{
+				EventType:  domain.EventUserPurged,
+				ActorID:    req.ActorID,
+				EntityType: "user",
+				EntityID:   id,
+				Payload:    payload,
+				RequestID:  req.RequestID,
+			}); err != nil {
+				return &app.AppError{
+					Op:      OpPurgeDeletedUsers,
+					Code:    app.CodeInternalError,
+					Message: "Failed to record audit event",
+					Err:     err,
+				}
+			}
+		}
+
+		purged = ids
+		return nil
+	}); err != nil {
+		return PurgeDeletedUsersResponse{}, err
+	}
+
+	logger.FromContext(ctx, uc.log).InfoContext(ctx, "purged soft-deleted users",
+		"count", len(purged),
+		"deletedBefore", cutoff,
+	)
+
+	return PurgeDeletedUsersResponse{PurgedIDs: purged, DeletedBefore: cutoff}, nil
+}
@@ -0,0 +1,101 @@
+//go:build !integration
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+func TestPurgeDeletedUsersUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	retention := 30 * 24 * time.Hour
+
+	newUseCase := func(repo *mockUserRepository, buf *bytes.Buffer) (*PurgeDeletedUsersUseCase, *mockAuditDeps) {
+		mockAudit, deps := newMockAuditService()
+		log := newTestLogger(buf)
+		uc := NewPurgeDeletedUsersUseCase(repo, mockAudit, &mockTxManager{}, retention, testutil.NewAuthorizer(log), log)
+		uc.now = func() time.Time { return now }
+		return uc, deps
+	}
+
+	t.Run("purges only users deleted before the retention cutoff", func(t *testing.T) {
+		mockRepo := newMockUserRepository()
+		seedDeletedUser(mockRepo, "old-id", now.Add(-31*24*time.Hour))
+		seedDeletedUser(mockRepo, "recent-id", now.Add(-1*time.Hour))
+
+		var buf bytes.Buffer
+		uc, deps := newUseCase(mockRepo, &buf)
+
+		resp, err := uc.Execute(userCtx("admin-id", app.RoleAdmin), PurgeDeletedUsersRequest{RequestID: "req-1", ActorID: "admin-id"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.ID{"old-id"}, resp.PurgedIDs)
+		assert.Equal(t, now.Add(-retention), resp.DeletedBefore)
+		assert.NotContains(t, mockRepo.deleted, domain.ID("old-id"))
+		assert.Contains(t, mockRepo.deleted, domain.ID("recent-id"))
+
+		require.Len(t, deps.repo.events, 1)
+		event := deps.repo.events[0]
+		assert.Equal(t, domain.EventUserPurged, event.EventType)
+		assert.Equal(t, "user", event.EntityType)
+		assert.Equal(t, domain.ID("old-id"), event.EntityID)
+		assert.Equal(t, domain.ID("admin-id"), event.ActorID)
+		assert.Contains(t, string(event.Payload), "deletedBefore")
+	})
+
+	t.Run("non-admin is forbidden", func(t *testing.T) {
+		mockRepo := newMockUserRepository()
+		seedDeletedUser(mockRepo, "old-id", now.Add(-31*24*time.Hour))
+
+		var buf bytes.Buffer
+		uc, deps := newUseCase(mockRepo, &buf)
+
+		_, err := uc.Execute(userCtx("user-id", app.RoleUser), PurgeDeletedUsersRequest{})
+
+		var appErr *app.AppError
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, app.CodeInsufficientPermissions, appErr.Code)
+		assert.Contains(t, mockRepo.deleted, domain.ID("old-id"), "nothing must be purged")
+		assert.Empty(t, deps.repo.events)
+		assert.Contains(t, buf.String(), "authorization denied: missing permission")
+	})
+
+	t.Run("repository error returns INTERNAL_ERROR", func(t *testing.T) {
+		mockRepo := newMockUserRepository()
+		mockRepo.purgeError = errors.New("database error")
+
+		var buf bytes.Buffer
+		uc, _ := newUseCase(mockRepo, &buf)
+
+		_, err := uc.Execute(userCtx("admin-id", app.RoleAdmin), PurgeDeletedUsersRequest{})
+
+		var appErr *app.AppError
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, app.CodeInternalError, appErr.Code)
+	})
+
+	t.Run("audit failure aborts the purge", func(t *testing.T) {
+		mockRepo := newMockUserRepository()
+		seedDeletedUser(mockRepo, "old-id", now.Add(-31*24*time.Hour))
+
+		var buf bytes.Buffer
+		uc, deps := newUseCase(mockRepo, &buf)
+		deps.repo.createError = errors.New("audit database error")
+
+		_, err := uc.Execute(userCtx("admin-id", app.RoleAdmin), PurgeDeletedUsersRequest{})
+		var appErr *app.AppError
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, app.CodeInternalError, appErr.Code)
+		assert.Contains(t, appErr.Message, "audit")
+	})
+}
@@ -0,0 +1,112 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// RestoreUserRequest represents the input data for restoring a soft-deleted user.
+type RestoreUserRequest struct {
+	ID domain.ID
+	// RequestID correlates this operation with the HTTP request.
+	// Transport layer extracts from context and passes here.
+	RequestID string
+	// ActorID identifies who is performing this action.
+	// Transport layer extracts from JWT claims and passes here.
+	ActorID domain.ID
+}
+
+// RestoreUserResponse represents the result of restoring a user.
+type RestoreUserResponse struct {
+	User domain.User
+}
+
+// OpRestoreUser is the operation name for RestoreUser use case.
+const OpRestoreUser = "RestoreUser"
+
+// RestoreUserUseCase handles the business logic for restoring a soft-deleted user.
+// Requires users:restore.
+type RestoreUserUseCase struct {
+	userRepo     domain.UserRepository
+	auditService *audit.AuditService
+	txManager    domain.TxManager
+	authz        app.Authorizer
+	log          *logger.Logger
+}
+
+// NewRestoreUserUseCase creates a new instance of RestoreUserUseCase.
+func NewRestoreUserUseCase(
+	userRepo domain.UserRepository,
+	auditService *audit.AuditService,
+	txManager domain.TxManager,
+	authz app.Authorizer,
+	log *logger.Logger,
+) *RestoreUserUseCase {
+	return &RestoreUserUseCase{
+		userRepo:     userRepo,
+		auditService: auditService,
+		txManager:    txManager,
+		authz:        authz,
+		log:          log.With("usecase", OpRestoreUser),
+	}
+}
+
+// Execute restores a soft-deleted user.
+// The restore and its audit event happen in a single transaction.
+// Returns AppError with Code=INSUFFICIENT_PERMISSIONS without users:restore, USER_NOT_FOUND if no
+// soft-deleted user exists with the ID, and EMAIL_EXISTS if the email has
+// been taken by another active user since the delete.
+func (uc *RestoreUserUseCase) Execute(ctx context.Context, req RestoreUserRequest) (RestoreUserResponse, error) {
+	if _, err := app.RequirePermission(ctx, uc.authz, OpRestoreUser, app.PermUsersRestore, userResource(req.ID)); err != nil {
+		return RestoreUserResponse{}, err
+	}
+
+	var restored domain.User
+	if err := uc.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		if err := uc.userRepo.Restore(ctx, tx, req.ID); err != nil {
+			return mapUserRepoError(OpRestoreUser, "Failed to restore user", err)
+		}
+
+		user, err := uc.userRepo.GetByID(ctx, tx, req.ID)
+		if err != nil {
+			return mapUserRepoError(OpRestoreUser, "Failed to get user", err)
+		}
+		if user == nil {
+			return &app.AppError{
+				Op:      OpRestoreUser,
+				Code:    app.CodeInternalError,
+				Message: "Failed to get user",
+				Err:     errors.New("user repository returned nil user without error"),
+			}
+		}
+
+		// Record audit event (same transaction context)
+		if err := uc.auditService.Record(ctx, tx, audit.AuditEventInput{
+			EventType:  domain.EventUserRestored,
+			ActorID:    req.ActorID,
+			EntityType: "user",
+			EntityID:   user.ID,
+			Payload:    user,
+			RequestID:  req.RequestID,
+		}); err != nil {
+			return &app.AppError{
+				Op:      OpRestoreUser,
+				Code:    app.CodeInternalError,
+				Message: "Failed to record audit event",
+				Err:     err,
+			}
+		}
+
+		restored = *user
+		return nil
+	}); err != nil {
+		return RestoreUserResponse{}, err
+	}
+
+	return RestoreUserResponse{User: restored}, nil
+}
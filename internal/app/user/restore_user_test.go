@@ -0,0 +1,119 @@
+//go:build !integration
+
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+func seedDeletedUser(m *mockUserRepository, id domain.ID, deletedAt time.Time) {
+	m.deleted[id] = domain.User{
+		ID:        id,
+		Email:     "deleted@example.com",
+		FirstName: "Deleted",
+		LastName:  "User",
+		DeletedAt: &deletedAt,
+		Version:   2,
+	}
+}
+
+func TestRestoreUserUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		setupMock  func(*mockUserRepository)
+		wantCode   string
+		wantErr    error
+		wantLogMsg string
+	}{
+		{
+			name:      "admin restores soft-deleted user",
+			ctx:       userCtx("admin-id", app.RoleAdmin),
+			setupMock: func(m *mockUserRepository) { seedDeletedUser(m, "deleted-id", time.Now()) },
+		},
+		{
+			name:       "regular user cannot restore, even themselves",
+			ctx:        userCtx("deleted-id", app.RoleUser),
+			setupMock:  func(m *mockUserRepository) { seedDeletedUser(m, "deleted-id", time.Now()) },
+			wantCode:   app.CodeInsufficientPermissions,
+			wantLogMsg: "authorization denied: missing permission",
+		},
+		{
+			name:       "no auth context returns FORBIDDEN (fail-closed)",
+			ctx:        context.Background(),
+			setupMock:  func(m *mockUserRepository) { seedDeletedUser(m, "deleted-id", time.Now()) },
+			wantCode:   app.CodeForbidden,
+			wantErr:    app.ErrNoAuthContext,
+			wantLogMsg: "authorization denied: no auth context or invalid credentials",
+		},
+		{
+			name:      "user that is not soft-deleted returns USER_NOT_FOUND",
+			ctx:       userCtx("admin-id", app.RoleAdmin),
+			setupMock: func(m *mockUserRepository) { seedUser(m, "deleted-id") },
+			wantCode:  app.CodeUserNotFound,
+			wantErr:   domain.ErrUserNotFound,
+		},
+		{
+			name: "email re-registered since delete returns EMAIL_EXISTS",
+			ctx:  userCtx("admin-id", app.RoleAdmin),
+			setupMock: func(m *mockUserRepository) {
+				seedDeletedUser(m, "deleted-id", time.Now())
+				m.restoreError = domain.ErrEmailAlreadyExists
+			},
+			wantCode: app.CodeEmailExists,
+			wantErr:  domain.ErrEmailAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newMockUserRepository()
+			mockAudit, deps := newMockAuditService()
+			tt.setupMock(mockRepo)
+
+			var buf bytes.Buffer
+			log := newTestLogger(&buf)
+			useCase := NewRestoreUserUseCase(mockRepo, mockAudit, &mockTxManager{}, testutil.NewAuthorizer(log), log)
+			resp, err := useCase.Execute(tt.ctx, RestoreUserRequest{ID: "deleted-id", RequestID: "req-1", ActorID: "admin-id"})
+
+			if tt.wantLogMsg != "" {
+				assert.Contains(t, buf.String(), tt.wantLogMsg, "expected log message not found")
+			}
+
+			if tt.wantCode != "" {
+				require.Error(t, err)
+				var appErr *app.AppError
+				require.True(t, errors.As(err, &appErr), "expected AppError, got %T", err)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				if tt.wantErr != nil {
+					assert.True(t, errors.Is(err, tt.wantErr), "expected wrapped error %v, got %v", tt.wantErr, appErr.Err)
+				}
+				assert.Empty(t, deps.repo.events, "no audit event expected on failure")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.ID("deleted-id"), resp.User.ID)
+			assert.Nil(t, resp.User.DeletedAt)
+			assert.Contains(t, mockRepo.users, domain.ID("deleted-id"))
+
+			require.Len(t, deps.repo.events, 1)
+			event := deps.repo.events[0]
+			assert.Equal(t, domain.EventUserRestored, event.EventType)
+			assert.Equal(t, domain.ID("deleted-id"), event.EntityID)
+			assert.Equal(t, domain.ID("admin-id"), event.ActorID)
+			assert.Equal(t, "req-1", event.RequestID)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/logger"
+)
+
+// UpdateUserRequest represents the input data for updating an existing user.
+// Nil fields are left unchanged, which lets the same use case serve both
+// full replacement (PUT, all fields set) and partial updates (PATCH).
+type UpdateUserRequest struct {
+	ID        domain.ID
+	Email     *string
+	FirstName *string
+	LastName  *string
+	// Version is the user version the client based its changes on (If-Match).
+	// The update is rejected with VERSION_MISMATCH if the user has changed since.
+	Version int64
+	// RequestID correlates this operation with the HTTP request.
+	// Transport layer extracts from context and passes here.
+	RequestID string
+	// ActorID identifies who is performing this action.
+	// Transport layer extracts from JWT claims and passes here.
+	ActorID domain.ID
+}
+
+// UpdateUserResponse represents the result of updating a user.
+type UpdateUserResponse struct {
+	User domain.User
+}
+
+// OpUpdateUser is the operation name for UpdateUser use case.
+const OpUpdateUser = "UpdateUser"
+
+// UpdateUserUseCase handles the business logic for updating a user.
+// Authorization requires users:write, or users:write:self for the caller's
+// own profile.
+type UpdateUserUseCase struct {
+	userRepo     domain.UserRepository
+	auditService *audit.AuditService
+	txManager    domain.TxManager
+	authz        app.Authorizer
+	log          *logger.Logger
+}
+
+// NewUpdateUserUseCase creates a new instance of UpdateUserUseCase.
+func NewUpdateUserUseCase(
+	userRepo domain.UserRepository,
+	auditService *audit.AuditService,
+	txManager domain.TxManager,
+	authz app.Authorizer,
+	log *logger.Logger,
+) *UpdateUserUseCase {
+	return &UpdateUserUseCase{
+		userRepo:     userRepo,
+		auditService: auditService,
+		txManager:    txManager,
+		authz:        authz,
+		log:          log.With("usecase", OpUpdateUser),
+	}
+}
+
+// Execute applies the requested changes to a user.
+// The read, write and audit event happen in a single transaction.
+// Returns AppError with Code=FORBIDDEN or INSUFFICIENT_PERMISSIONS on authorization failure,
+// USER_NOT_FOUND if the user doesn't exist, VERSION_MISMATCH if req.Version
+// is stale, VALIDATION_ERROR if the resulting user is invalid, and
+// EMAIL_EXISTS if the new email is taken.
+func (uc *UpdateUserUseCase) Execute(ctx context.Context, req UpdateUserRequest) (UpdateUserResponse, error) {
+	if _, err := app.RequirePermission(ctx, uc.authz, OpUpdateUser, app.PermUsersWrite, userResource(req.ID)); err != nil {
+		return UpdateUserResponse{}, err
+	}
+
+	var updated domain.User
+	if err := uc.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		user, err := uc.userRepo.GetByID(ctx, tx, req.ID)
+		if err != nil {
+			return mapUserRepoError(OpUpdateUser, "Failed to get user", err)
+		}
+		if user == nil {
+			return &app.AppError{
+				Op:      OpUpdateUser,
+				Code:    app.CodeInternalError,
+				Message: "Failed to get user",
+				Err:     errors.New("user repository returned nil user without error"),
+			}
+		}
+		if err := checkVersion(OpUpdateUser, user.Version, req.Version); err != nil {
+			return err
+		}
+
+		before := *user
+		if req.Email != nil {
+			user.Email = *req.Email
+		}
+		if req.FirstName != nil {
+			user.FirstName = *req.FirstName
+		}
+		if req.LastName != nil {
+			user.LastName = *req.LastName
+		}
+
+		if err := user.Validate(); err != nil {
+			return &app.AppError{
+				Op:      OpUpdateUser,
+				Code:    app.CodeValidationError,
+				Message: "Validation failed",
+				Err:     err,
+			}
+		}
+
+		user.UpdatedAt = time.Now().UTC()
+		if err := uc.userRepo.Update(ctx, tx, user); err != nil {
+			return mapUserRepoError(OpUpdateUser, "Failed to update user", err)
+		}
+
+		// Record the changed fields as the audit event (same transaction context)
+		if err := uc.auditService.RecordChange(ctx, tx, audit.AuditChangeInput{
+			EventType:  domain.EventUserUpdated,
+			ActorID:    req.ActorID,
+			EntityType: "user",
+			EntityID:   user.ID,
+			Before:     before,
+			After:      user,
+			RequestID:  req.RequestID,
+		}); err != nil {
+			return &app.AppError{
+				Op:      OpUpdateUser,
+				Code:    app.CodeInternalError,
+				Message: "Failed to record audit event",
+				Err:     err,
+			}
+		}
+
+		updated = *user
+		return nil
+	}); err != nil {
+		return UpdateUserResponse{}, err
+	}
+
+	return UpdateUserResponse{User: updated}, nil
+}
+
+// mapUserRepoError converts a UserRepository error into an AppError for op.
+// Unknown errors map to INTERNAL_ERROR with the given message.
+func mapUserRepoError(op, message string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUserNotFound):
+		return &app.AppError{
+			Op:      op,
+			Code:    app.CodeUserNotFound,
+			Message: "User not found",
+			Err:     err,
+		}
+	case errors.Is(err, domain.ErrUserVersionMismatch):
+		return &app.AppError{
+			Op:      op,
+			Code:    app.CodeVersionMismatch,
+			Message: "User was modified since it was last read",
+			Err:     err,
+		}
+	case errors.Is(err, domain.ErrEmailAlreadyExists):
+		return &app.AppError{
+			Op:      op,
+			Code:    app.CodeEmailExists,
+			Message: "Email already exists",
+			Err:     err,
+		}
+	default:
+		return &app.AppError{
+			Op:      op,
+			Code:    app.CodeInternalError,
+			Message: message,
+			Err:     err,
+		}
+	}
+}
+
+// checkVersion rejects a write based on a stale read before any other work is done.
+// The repository re-checks the version atomically, so this is only an early exit.
+func checkVersion(op string, current, expected int64) error {
+	if current == expected {
+		return nil
+	}
+	return mapUserRepoError(op, "", domain.ErrUserVersionMismatch)
+}
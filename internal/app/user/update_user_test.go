@@ -0,0 +1,275 @@
+//go:build !integration
+
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil"
+)
+
+func strPtr(s string) *string { return &s }
+
+func newTestLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+func seedUser(m *mockUserRepository, id domain.ID) domain.User {
+	u := domain.User{
+		ID:        id,
+		Email:     "me@example.com",
+		FirstName: "My",
+		LastName:  "User",
+		CreatedAt: time.Unix(0, 0).UTC(),
+		UpdatedAt: time.Unix(0, 0).UTC(),
+		Version:   1,
+	}
+	m.users[id] = u
+	return u
+}
+
+func userCtx(subjectID, role string) context.Context {
+	return app.SetAuthContext(context.Background(), &app.AuthContext{
+		SubjectID: subjectID,
+		Role:      role,
+	})
+}
+
+// adminCtx returns a context authenticated as an admin.
+func adminCtx() context.Context {
+	return userCtx("admin-1", app.RoleAdmin)
+}
+
+func TestUpdateUserUseCase_Execute(t *testing.T) {
+	repoErr := errors.New("database error")
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		req        UpdateUserRequest
+		setupMock  func(*mockUserRepository)
+		wantCode   string
+		wantErr    error
+		wantLogMsg string
+		wantUser   *domain.User
+	}{
+		{
+			name: "user replaces all fields of own profile",
+			ctx:  userCtx("my-user-id", app.RoleUser),
+			req: UpdateUserRequest{
+				ID:        "my-user-id",
+				Version:   1,
+				Email:     strPtr("new@example.com"),
+				FirstName: strPtr("New"),
+				LastName:  strPtr("Name"),
+			},
+			setupMock: func(m *mockUserRepository) { seedUser(m, "my-user-id") },
+			wantUser:  &domain.User{ID: "my-user-id", Email: "new@example.com", FirstName: "New", LastName: "Name"},
+		},
+		{
+			name: "partial update leaves nil fields unchanged",
+			ctx:  userCtx("my-user-id", app.RoleUser),
+			req: UpdateUserRequest{
+				ID:        "my-user-id",
+				Version:   1,
+				FirstName: strPtr("Patched"),
+			},
+			setupMock: func(m *mockUserRepository) { seedUser(m, "my-user-id") },
+			wantUser:  &domain.User{ID: "my-user-id", Email: "me@example.com", FirstName: "Patched", LastName: "User"},
+		},
+		{
+			name:      "admin can update any user",
+			ctx:       userCtx("admin-id", app.RoleAdmin),
+			req:       UpdateUserRequest{ID: "other-user-id", Version: 1, LastName: strPtr("Changed")},
+			setupMock: func(m *mockUserRepository) { seedUser(m, "other-user-id") },
+			wantUser:  &domain.User{ID: "other-user-id", Email: "me@example.com", FirstName: "My", LastName: "Changed"},
+		},
+		{
+			name:       "user cannot update other user's profile",
+			ctx:        userCtx("my-user-id", app.RoleUser),
+			req:        UpdateUserRequest{ID: "other-user-id", Version: 1, FirstName: strPtr("Hacked")},
+			setupMock:  func(m *mockUserRepository) { seedUser(m, "other-user-id") },
+			wantCode:   app.CodeInsufficientPermissions,
+			wantLogMsg: "authorization denied: missing permission",
+		},
+		{
+			name:       "no auth context returns FORBIDDEN (fail-closed)",
+			ctx:        context.Background(),
+			req:        UpdateUserRequest{ID: "any-user-id", Version: 1, FirstName: strPtr("X")},
+			setupMock:  func(m *mockUserRepository) { seedUser(m, "any-user-id") },
+			wantCode:   app.CodeForbidden,
+			wantErr:    app.ErrNoAuthContext,
+			wantLogMsg: "authorization denied: no auth context or invalid credentials",
+		},
+		{
+			name:      "blank first name fails validation",
+			ctx:       userCtx("my-user-id", app.RoleUser),
+			req:       UpdateUserRequest{ID: "my-user-id", Version: 1, FirstName: strPtr("   ")},
+			setupMock: func(m *mockUserRepository) { seedUser(m, "my-user-id") },
+			wantCode:  app.CodeValidationError,
+			wantErr:   domain.ErrInvalidFirstName,
+		},
+		{
+			name:      "missing user returns USER_NOT_FOUND",
+			ctx:       userCtx("admin-id", app.RoleAdmin),
+			req:       UpdateUserRequest{ID: "missing-id", Version: 1, FirstName: strPtr("X")},
+			setupMock: func(_ *mockUserRepository) {},
+			wantCode:  app.CodeUserNotFound,
+			wantErr:   domain.ErrUserNotFound,
+		},
+		{
+			name:      "stale version returns VERSION_MISMATCH",
+			ctx:       userCtx("my-user-id", app.RoleUser),
+			req:       UpdateUserRequest{ID: "my-user-id", Version: 2, FirstName: strPtr("Late")},
+			setupMock: func(m *mockUserRepository) { seedUser(m, "my-user-id") },
+			wantCode:  app.CodeVersionMismatch,
+			wantErr:   domain.ErrUserVersionMismatch,
+		},
+		{
+			name: "stale write detected by repository returns VERSION_MISMATCH",
+			ctx:  userCtx("my-user-id", app.RoleUser),
+			req:  UpdateUserRequest{ID: "my-user-id", Version: 1, FirstName: strPtr("Raced")},
+			setupMock: func(m *mockUserRepository) {
+				seedUser(m, "my-user-id")
+				m.updateError = domain.ErrUserVersionMismatch
+			},
+			wantCode: app.CodeVersionMismatch,
+			wantErr:  domain.ErrUserVersionMismatch,
+		},
+		{
+			name: "email conflict returns EMAIL_EXISTS",
+			ctx:  userCtx("my-user-id", app.RoleUser),
+			req:  UpdateUserRequest{ID: "my-user-id", Version: 1, Email: strPtr("taken@example.com")},
+			setupMock: func(m *mockUserRepository) {
+				seedUser(m, "my-user-id")
+				m.updateError = domain.ErrEmailAlreadyExists
+			},
+			wantCode: app.CodeEmailExists,
+			wantErr:  domain.ErrEmailAlreadyExists,
+		},
+		{
+			name: "repository update error returns INTERNAL_ERROR",
+			ctx:  userCtx("my-user-id", app.RoleUser),
+			req:  UpdateUserRequest{ID: "my-user-id", Version: 1, FirstName: strPtr("X")},
+			setupMock: func(m *mockUserRepository) {
+				seedUser(m, "my-user-id")
+				m.updateError = repoErr
+			},
+			wantCode: app.CodeInternalError,
+			wantErr:  repoErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newMockUserRepository()
+			mockAudit, deps := newMockAuditService()
+			tt.setupMock(mockRepo)
+
+			var buf bytes.Buffer
+			log := newTestLogger(&buf)
+			useCase := NewUpdateUserUseCase(mockRepo, mockAudit, &mockTxManager{}, testutil.NewAuthorizer(log), log)
+			resp, err := useCase.Execute(tt.ctx, tt.req)
+
+			if tt.wantLogMsg != "" {
+				assert.Contains(t, buf.String(), tt.wantLogMsg, "expected log message not found")
+			}
+
+			if tt.wantCode != "" {
+				require.Error(t, err)
+				var appErr *app.AppError
+				require.True(t, errors.As(err, &appErr), "expected AppError, got %T", err)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				if tt.wantErr != nil {
+					assert.True(t, errors.Is(err, tt.wantErr), "expected wrapped error %v, got %v", tt.wantErr, appErr.Err)
+				}
+				assert.True(t, resp.User.ID.IsEmpty())
+				assert.Empty(t, deps.repo.events, "no audit event expected on failure")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUser.ID, resp.User.ID)
+			assert.Equal(t, tt.wantUser.Email, resp.User.Email)
+			assert.Equal(t, tt.wantUser.FirstName, resp.User.FirstName)
+			assert.Equal(t, tt.wantUser.LastName, resp.User.LastName)
+			assert.True(t, resp.User.UpdatedAt.After(resp.User.CreatedAt), "UpdatedAt should be bumped")
+			assert.Equal(t, tt.req.Version+1, resp.User.Version, "Version should be bumped")
+			assert.Equal(t, resp.User, mockRepo.users[tt.req.ID], "repository should hold updated user")
+		})
+	}
+}
+
+func TestUpdateUserUseCase_Execute_AuditEventRecorded(t *testing.T) {
+	t.Run("records audit event on successful update", func(t *testing.T) {
+		mockRepo := newMockUserRepository()
+		seedUser(mockRepo, "my-user-id")
+		mockAudit, deps := newMockAuditService()
+
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		useCase := NewUpdateUserUseCase(mockRepo, mockAudit, &mockTxManager{}, testutil.NewAuthorizer(log), log)
+		req := UpdateUserRequest{
+			ID:        "my-user-id",
+			Version:   1,
+			FirstName: strPtr("Updated"),
+			RequestID: "req-123",
+			ActorID:   "my-user-id",
+		}
+
+		_, err := useCase.Execute(userCtx("my-user-id", app.RoleUser), req)
+		require.NoError(t, err)
+
+		require.Len(t, deps.repo.events, 1)
+		event := deps.repo.events[0]
+		assert.Equal(t, domain.EventUserUpdated, event.EventType)
+		assert.Equal(t, "user", event.EntityType)
+		assert.Equal(t, req.ID, event.EntityID)
+		assert.Equal(t, req.RequestID, event.RequestID)
+		assert.Equal(t, req.ActorID, event.ActorID)
+
+		var payload audit.ChangePayload
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		fields := make([]string, 0, len(payload.Changes))
+		for _, c := range payload.Changes {
+			fields = append(fields, c.Field)
+		}
+		assert.Equal(t, []string{"FirstName", "UpdatedAt", "Version"}, fields)
+		assert.Equal(t, audit.FieldChange{Field: "FirstName", Old: "My", New: "Updated"}, payload.Changes[0])
+	})
+
+	t.Run("returns error when audit recording fails", func(t *testing.T) {
+		mockRepo := newMockUserRepository()
+		seedUser(mockRepo, "my-user-id")
+		mockAudit, deps := newMockAuditService()
+		deps.repo.createError = errors.New("audit database error")
+
+		var buf bytes.Buffer
+		log := newTestLogger(&buf)
+		useCase := NewUpdateUserUseCase(mockRepo, mockAudit, &mockTxManager{}, testutil.NewAuthorizer(log), log)
+
+		_, err := useCase.Execute(userCtx("my-user-id", app.RoleUser), UpdateUserRequest{
+			ID:        "my-user-id",
+			Version:   1,
+			FirstName: strPtr("Updated"),
+		})
+
+		require.Error(t, err)
+		var appErr *app.AppError
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, app.CodeInternalError, appErr.Code)
+		assert.Contains(t, appErr.Message, "audit")
+	})
+}
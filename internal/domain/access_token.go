@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// AccessToken is the content of an access token issued by this service.
+type AccessToken struct {
+	// ID is the unique token ID (jti claim), used to revoke the token.
+	ID string
+	// Subject is the sub claim, e.g. the client_id of an OAuth client.
+	Subject string
+	// Role is the role claim used for authorization.
+	Role string
+	// TenantID is the tenant_id claim scoping the data the token can access.
+	TenantID string
+	// Scopes make up the scope claim. Nil omits the claim.
+	Scopes    []string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// AccessTokenSigner encodes and signs access tokens in the format the
+// service's authentication middleware verifies.
+type AccessTokenSigner interface {
+	// Sign returns the signed, encoded token.
+	Sign(token AccessToken) (string, error)
+}
+
+// IntrospectedToken describes an opaque access token as reported by the
+// authorization server that issued it (RFC 7662 token introspection).
+type IntrospectedToken struct {
+	AccessToken
+	// Scope is the space-delimited scope granted to the token.
+	Scope string
+	// ClientID is the OAuth client the token was issued to, if reported.
+	ClientID string
+}
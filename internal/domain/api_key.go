@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// APIKey authenticates a partner integration that cannot use JWTs. Callers
+// present the key itself; only its digest (KeyHash) is stored.
+type APIKey struct {
+	ID   ID
+	Name string
+	// Prefix is the start of the key, stored to let admins recognise a key
+	// without revealing it.
+	Prefix string
+	// KeyHash is the hex SHA-256 digest of the key, used to look it up.
+	KeyHash string
+	// Role is the role of callers authenticated with the key.
+	Role string
+	// TenantID is the tenant of callers authenticated with the key.
+	TenantID string
+	// Scopes are the scopes granted to callers authenticated with the key.
+	// Nil marks a key created before keys had scopes; its callers are
+	// unscoped.
+	Scopes []string
+	// CreatedBy is the subject of the caller that created the key.
+	CreatedBy string
+	CreatedAt time.Time
+	// ExpiresAt is when the key stops authenticating, or nil for never.
+	// Rotation sets it on the replaced key to end its grace period.
+	ExpiresAt *time.Time
+	// RevokedAt is when the key was revoked, or nil.
+	RevokedAt *time.Time
+	// LastUsedAt is when the key last authenticated a request, or nil. It is
+	// updated at most once per configured interval.
+	LastUsedAt *time.Time
+}
+
+// Active reports whether the key may authenticate requests at now.
+func (k APIKey) Active(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+// APIKeyRepository defines the interface for API key persistence.
+type APIKeyRepository interface {
+	// Create stores a new key.
+	Create(ctx context.Context, q Querier, key *APIKey) error
+
+	// GetByID returns the key with the given ID.
+	// Returns ErrAPIKeyNotFound if there is none.
+	GetByID(ctx context.Context, q Querier, id ID) (*APIKey, error)
+
+	// GetByHash returns the key with the given KeyHash.
+	// Returns ErrAPIKeyNotFound if there is none.
+	GetByHash(ctx context.Context, q Querier, keyHash string) (*APIKey, error)
+
+	// List returns all keys, including revoked and expired ones, newest first.
+	List(ctx context.Context, q Querier) ([]APIKey, error)
+
+	// Revoke sets RevokedAt of the key if it is not revoked yet.
+	// Returns ErrAPIKeyNotFound if there is no such key.
+	Revoke(ctx context.Context, q Querier, id ID, revokedAt time.Time) error
+
+	// SetExpiresAt sets ExpiresAt of the key.
+	// Returns ErrAPIKeyNotFound if there is no such key.
+	SetExpiresAt(ctx context.Context, q Querier, id ID, expiresAt time.Time) error
+
+	// TouchLastUsed sets LastUsedAt of the key to usedAt unless it is already later.
+	TouchLastUsed(ctx context.Context, q Querier, id ID, usedAt time.Time) error
+}
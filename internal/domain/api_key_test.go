@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKey_Active(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	tests := []struct {
+		name string
+		key  APIKey
+		want bool
+	}{
+		{name: "no expiry", key: APIKey{}, want: true},
+		{name: "expires later", key: APIKey{ExpiresAt: &future}, want: true},
+		{name: "expires now", key: APIKey{ExpiresAt: &now}},
+		{name: "expired", key: APIKey{ExpiresAt: &past}},
+		{name: "revoked", key: APIKey{RevokedAt: &past, ExpiresAt: &future}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.key.Active(now))
+		})
+	}
+}
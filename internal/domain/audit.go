@@ -53,6 +53,9 @@ import (
 //	}
 //	err := uc.auditService.Record(ctx, tx, auditInput)
 //
+// For "updated" events, call auditService.RecordChange() with the entity
+// before and after the change instead; it records only the changed fields.
+//
 // Step 3: Ensure your use case request struct carries RequestID and ActorID:
 //
 //	type CreateOrderRequest struct {
@@ -72,13 +75,50 @@ const (
 	// Used in: CreateUserUseCase.
 	EventUserCreated = "user.created"
 
-	// EventUserUpdated is recorded when a user is updated.
+	// EventUserUpdated is recorded when a user is updated, with a diff of the
+	// changed fields as payload.
 	// Used in: UpdateUserUseCase.
 	EventUserUpdated = "user.updated"
 
-	// EventUserDeleted is recorded when a user is deleted.
+	// EventUserDeleted is recorded when a user is (soft-)deleted.
 	// Used in: DeleteUserUseCase.
 	EventUserDeleted = "user.deleted"
+
+	// EventUserRestored is recorded when a soft-deleted user is restored.
+	// Used in: RestoreUserUseCase.
+	EventUserRestored = "user.restored"
+
+	// EventUserPurged is recorded when a soft-deleted user is permanently removed.
+	// Used in: PurgeDeletedUsersUseCase.
+	EventUserPurged = "user.purged"
+)
+
+// Event types for authentication.
+const (
+	// EventTokenRevoked is recorded when access tokens are revoked before they expire.
+	// Used in: RevokeTokensUseCase.
+	EventTokenRevoked = "token.revoked"
+
+	// EventOAuthClientCreated is recorded when an OAuth client is registered.
+	// Used in: CreateClientUseCase.
+	EventOAuthClientCreated = "oauth_client.created"
+
+	// EventAPIKeyCreated is recorded when an API key is created.
+	// Used in: CreateAPIKeyUseCase.
+	EventAPIKeyCreated = "api_key.created"
+
+	// EventAPIKeyRevoked is recorded when an API key is revoked.
+	// Used in: RevokeAPIKeyUseCase.
+	EventAPIKeyRevoked = "api_key.revoked"
+
+	// EventAPIKeyRotated is recorded when an API key is replaced by a new key.
+	// Used in: RotateAPIKeyUseCase.
+	EventAPIKeyRotated = "api_key.rotated"
+
+	// EventAuthLockout is recorded when a client IP or subject is locked out
+	// after repeated authentication failures.
+	// Used in: lockout.Tracker.
+	EventAuthLockout = "auth.lockout"
 )
 
 // ============================================================================
@@ -144,6 +184,18 @@ type AuditEvent struct {
 	// RequestID correlates this event with the originating HTTP request.
 	// This is a string (not domain.ID) since it comes from transport layer.
 	RequestID string
+
+	// ChainSeq is the position of the event in its tenant's hash chain,
+	// starting at 1. Zero for events recorded before the log was chained.
+	// Set by the repository on Create, like PrevHash and Hash.
+	ChainSeq int64
+
+	// PrevHash is the Hash of the previous event in the chain
+	// (AuditChainGenesis for the first event).
+	PrevHash []byte
+
+	// Hash is the ChainHash of this event.
+	Hash []byte
 }
 
 // Validate checks if the AuditEvent has required fields.
@@ -190,12 +242,33 @@ func (e AuditEvent) Validate() error {
 //
 //go:generate mockgen -destination=../testutil/mocks/audit_event_repository_mock.go -package=mocks github.com/iruldev/golang-api-hexagonal/internal/domain AuditEventRepository
 type AuditEventRepository interface {
-	// Create stores a new audit event.
+	// Create stores a new audit event and appends it to the hash chain of
+	// its tenant, setting ChainSeq, PrevHash and Hash. Concurrent Creates
+	// are serialized so the chain never forks.
 	// Returns an error if the event cannot be persisted.
 	Create(ctx context.Context, q Querier, event *AuditEvent) error
 
 	// ListByEntityID retrieves audit events for a specific entity.
-	// Results are ordered by timestamp DESC (newest first).
+	// Results are ordered by timestamp DESC, id DESC (newest first).
 	// Returns the slice of events, total count of matching events, and any error.
+	// With params.Cursor set, pagination is by keyset (see ListParams).
 	ListByEntityID(ctx context.Context, q Querier, entityType string, entityID ID, params ListParams) ([]AuditEvent, int, error)
+
+	// GetByID retrieves an audit event by its ID.
+	// Returns ErrAuditEventNotFound if the event does not exist.
+	GetByID(ctx context.Context, q Querier, id ID) (*AuditEvent, error)
+
+	// List retrieves audit events matching filter, newest first.
+	// Pagination is always by keyset: no total is counted, and up to
+	// params.KeysetLimit() events are returned in list order, starting from
+	// the newest event when params.Cursor is nil.
+	List(ctx context.Context, q Querier, filter AuditEventFilter, params ListParams) ([]AuditEvent, error)
+
+	// ListChain retrieves up to limit chained events with ChainSeq greater
+	// than afterSeq, in chain order. Unchained events are skipped.
+	ListChain(ctx context.Context, q Querier, afterSeq int64, limit int) ([]AuditEvent, error)
+
+	// GetChainAnchor retrieves the anchor of the chain: the last event
+	// removed by retention. Returns nil if no chained event was removed.
+	GetChainAnchor(ctx context.Context, q Querier) (*AuditChainAnchor, error)
 }
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// AuditChainHashSize is the length of audit chain hashes (SHA-256).
+const AuditChainHashSize = sha256.Size
+
+// auditChainVersion is hashed first, so the hash input can change without
+// old hashes verifying under the new rules.
+const auditChainVersion = "audit-chain-v1"
+
+// Reasons reported in AuditChainBreak.
+const (
+	// AuditChainMissingEvent means chain positions are skipped: events were
+	// deleted, or the chain does not start right after its anchor (position 1
+	// without one).
+	AuditChainMissingEvent = "missing_event"
+
+	// AuditChainPrevHashMismatch means the event does not link to the hash of
+	// the event before it: that event was replaced, or this one was moved.
+	AuditChainPrevHashMismatch = "prev_hash_mismatch"
+
+	// AuditChainHashMismatch means the event's fields no longer produce its
+	// hash: the event was modified.
+	AuditChainHashMismatch = "hash_mismatch"
+)
+
+// AuditChainGenesis returns the PrevHash of the first event of a chain:
+// AuditChainHashSize zero bytes.
+func AuditChainGenesis() []byte {
+	return make([]byte, AuditChainHashSize)
+}
+
+// ChainHash computes the hash of e as a link in its tenant's chain: SHA-256
+// over ChainSeq, PrevHash and every recorded field, each length-prefixed so
+// no two events encode alike. Timestamp is hashed at microsecond precision,
+// as Postgres stores it, and Payload as read back from storage.
+func (e AuditEvent) ChainHash() []byte {
+	h := sha256.New()
+	var n [8]byte
+	write := func(b []byte) {
+		binary.BigEndian.PutUint64(n[:], uint64(len(b)))
+		h.Write(n[:])
+		h.Write(b)
+	}
+	writeInt := func(v int64) {
+		write(binary.BigEndian.AppendUint64(nil, uint64(v)))
+	}
+
+	write([]byte(auditChainVersion))
+	writeInt(e.ChainSeq)
+	write(e.PrevHash)
+	write([]byte(e.ID))
+	write([]byte(e.EventType))
+	write([]byte(e.ActorID))
+	write([]byte(e.EntityType))
+	write([]byte(e.EntityID))
+	write(e.Payload)
+	writeInt(e.Timestamp.UnixMicro())
+	write([]byte(e.RequestID))
+	return h.Sum(nil)
+}
+
+// AuditChainAnchor is the last link of a chain removed by retention. The
+// chain continues after it, so verification starts from the anchor instead of
+// the genesis hash.
+type AuditChainAnchor struct {
+	// Seq is the chain position of the removed event.
+	Seq int64
+
+	// Hash is the hash of the removed event.
+	Hash []byte
+}
+
+// AuditChainBreak describes the first broken link found in a chain.
+type AuditChainBreak struct {
+	// Seq is the chain position where the chain breaks.
+	Seq int64
+
+	// EventID is the event found at (or, for AuditChainMissingEvent, after) Seq.
+	EventID ID
+
+	// Reason is one of AuditChainMissingEvent, AuditChainPrevHashMismatch
+	// and AuditChainHashMismatch.
+	Reason string
+}
+
+// AuditChainVerifier checks a chain link by link. Pass every event of the
+// chain to Check in ChainSeq order, starting with the first. The zero value
+// starts at position 1; use NewAuditChainVerifier for a chain with an anchor.
+//
+// Deleting the newest events leaves a valid, shorter chain; compare the head
+// (Seq, Hash) against a copy kept outside the database to detect that.
+type AuditChainVerifier struct {
+	seq  int64
+	hash []byte
+}
+
+// NewAuditChainVerifier returns a verifier that starts right after anchor, or
+// at position 1 if anchor is nil.
+func NewAuditChainVerifier(anchor *AuditChainAnchor) AuditChainVerifier {
+	if anchor == nil {
+		return AuditChainVerifier{}
+	}
+	return AuditChainVerifier{seq: anchor.Seq, hash: anchor.Hash}
+}
+
+// Check verifies e against the events checked before it and returns the
+// break it finds, or nil if e extends the chain.
+func (v *AuditChainVerifier) Check(e AuditEvent) *AuditChainBreak {
+	want := v.seq + 1
+	if e.ChainSeq != want {
+		return &AuditChainBreak{Seq: want, EventID: e.ID, Reason: AuditChainMissingEvent}
+	}
+
+	prev := v.hash
+	if prev == nil {
+		prev = AuditChainGenesis()
+	}
+	if !bytes.Equal(e.PrevHash, prev) {
+		return &AuditChainBreak{Seq: e.ChainSeq, EventID: e.ID, Reason: AuditChainPrevHashMismatch}
+	}
+	if !bytes.Equal(e.Hash, e.ChainHash()) {
+		return &AuditChainBreak{Seq: e.ChainSeq, EventID: e.ID, Reason: AuditChainHashMismatch}
+	}
+
+	v.seq, v.hash = e.ChainSeq, e.Hash
+	return nil
+}
+
+// Seq returns the position of the last event that passed Check, or of the
+// anchor if none did (0 without one).
+func (v *AuditChainVerifier) Seq() int64 {
+	return v.seq
+}
+
+// Hash returns the hash of the last event that passed Check, or of the
+// anchor if none did (nil without one).
+func (v *AuditChainVerifier) Hash() []byte {
+	return v.hash
+}
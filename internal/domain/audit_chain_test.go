@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testChain returns a valid chain of n events.
+func testChain(n int) []AuditEvent {
+	base := time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC)
+	prev := AuditChainGenesis()
+	events := make([]AuditEvent, n)
+	for i := range events {
+		e := AuditEvent{
+			ID:         ID(fmt.Sprintf("event-%d", i+1)),
+			EventType:  EventUserUpdated,
+			ActorID:    "admin-1",
+			EntityType: "user",
+			EntityID:   "user-1",
+			Payload:    []byte(`{"firstName": "Sarah"}`),
+			Timestamp:  base.Add(time.Duration(i) * time.Second),
+			RequestID:  "req-1",
+			ChainSeq:   int64(i + 1),
+			PrevHash:   prev,
+		}
+		e.Hash = e.ChainHash()
+		prev = e.Hash
+		events[i] = e
+	}
+	return events
+}
+
+func TestAuditEvent_ChainHash(t *testing.T) {
+	e := testChain(1)[0]
+
+	assert.Len(t, e.Hash, AuditChainHashSize)
+	assert.Equal(t, e.Hash, e.ChainHash(), "hash is deterministic")
+
+	t.Run("sub-microsecond precision is ignored", func(t *testing.T) {
+		other := e
+		other.Timestamp = e.Timestamp.Add(999 * time.Nanosecond)
+		assert.Equal(t, e.Hash, other.ChainHash())
+	})
+
+	changes := map[string]func(*AuditEvent){
+		"seq":        func(e *AuditEvent) { e.ChainSeq++ },
+		"prev hash":  func(e *AuditEvent) { e.PrevHash = e.Hash },
+		"event type": func(e *AuditEvent) { e.EventType = EventUserDeleted },
+		"actor":      func(e *AuditEvent) { e.ActorID = "" },
+		"entity":     func(e *AuditEvent) { e.EntityID = "user-2" },
+		"payload":    func(e *AuditEvent) { e.Payload = []byte(`{"firstName": "Sara"}`) },
+		"timestamp":  func(e *AuditEvent) { e.Timestamp = e.Timestamp.Add(time.Microsecond) },
+		"request ID": func(e *AuditEvent) { e.RequestID = "req-2" },
+		// Length prefixes keep field boundaries: moving bytes between fields changes the hash.
+		"field boundary": func(e *AuditEvent) { e.EntityType, e.EntityID = "use", "ruser-1" },
+	}
+	for name, change := range changes {
+		t.Run(name, func(t *testing.T) {
+			other := e
+			change(&other)
+			assert.NotEqual(t, e.Hash, other.ChainHash())
+		})
+	}
+}
+
+func TestAuditChainVerifier_Check(t *testing.T) {
+	t.Run("valid chain", func(t *testing.T) {
+		events := testChain(3)
+		var v AuditChainVerifier
+
+		for _, e := range events {
+			require.Nil(t, v.Check(e))
+		}
+		assert.Equal(t, int64(3), v.Seq())
+		assert.Equal(t, events[2].Hash, v.Hash())
+	})
+
+	tests := []struct {
+		name   string
+		tamper func([]AuditEvent) []AuditEvent
+		want   AuditChainBreak
+	}{
+		{
+			name:   "modified event",
+			tamper: func(e []AuditEvent) []AuditEvent { e[1].Payload = []byte(`{}`); return e },
+			want:   AuditChainBreak{Seq: 2, EventID: "event-2", Reason: AuditChainHashMismatch},
+		},
+		{
+			name: "modified event with recomputed hash",
+			tamper: func(e []AuditEvent) []AuditEvent {
+				e[1].Payload = []byte(`{}`)
+				e[1].Hash = e[1].ChainHash()
+				return e
+			},
+			want: AuditChainBreak{Seq: 3, EventID: "event-3", Reason: AuditChainPrevHashMismatch},
+		},
+		{
+			name:   "deleted event",
+			tamper: func(e []AuditEvent) []AuditEvent { return append(e[:1], e[2:]...) },
+			want:   AuditChainBreak{Seq: 2, EventID: "event-3", Reason: AuditChainMissingEvent},
+		},
+		{
+			name:   "deleted first event",
+			tamper: func(e []AuditEvent) []AuditEvent { return e[1:] },
+			want:   AuditChainBreak{Seq: 1, EventID: "event-2", Reason: AuditChainMissingEvent},
+		},
+		{
+			name:   "first event not linked to genesis",
+			tamper: func(e []AuditEvent) []AuditEvent { e[0].PrevHash = e[2].Hash; return e },
+			want:   AuditChainBreak{Seq: 1, EventID: "event-1", Reason: AuditChainPrevHashMismatch},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v AuditChainVerifier
+			var got *AuditChainBreak
+			for _, e := range tt.tamper(testChain(3)) {
+				if got = v.Check(e); got != nil {
+					break
+				}
+			}
+
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want, *got)
+		})
+	}
+}
+
+func TestNewAuditChainVerifier(t *testing.T) {
+	events := testChain(4)
+	anchor := &AuditChainAnchor{Seq: events[1].ChainSeq, Hash: events[1].Hash}
+
+	t.Run("resumes after the anchor", func(t *testing.T) {
+		v := NewAuditChainVerifier(anchor)
+		assert.Equal(t, anchor.Seq, v.Seq())
+		assert.Equal(t, anchor.Hash, v.Hash())
+
+		for _, e := range events[2:] {
+			require.Nil(t, v.Check(e))
+		}
+		assert.Equal(t, int64(4), v.Seq())
+	})
+
+	t.Run("event after the anchor removed", func(t *testing.T) {
+		v := NewAuditChainVerifier(anchor)
+
+		got := v.Check(events[3])
+
+		require.NotNil(t, got)
+		assert.Equal(t, AuditChainBreak{Seq: 3, EventID: "event-4", Reason: AuditChainMissingEvent}, *got)
+	})
+
+	t.Run("anchor replaced", func(t *testing.T) {
+		v := NewAuditChainVerifier(&AuditChainAnchor{Seq: anchor.Seq, Hash: events[0].Hash})
+
+		got := v.Check(events[2])
+
+		require.NotNil(t, got)
+		assert.Equal(t, AuditChainPrevHashMismatch, got.Reason)
+	})
+
+	t.Run("nil anchor starts at position 1", func(t *testing.T) {
+		v := NewAuditChainVerifier(nil)
+		assert.Nil(t, v.Check(events[0]))
+	})
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// AuditEventTypeWildcard ends an AuditEventFilter.EventType that matches
+// every event type under a prefix: "user.*" matches "user.created",
+// "user.deleted", and so on.
+const AuditEventTypeWildcard = ".*"
+
+// AuditEventFilter narrows an audit event listing. The zero value lists all
+// events of the tenant. Zero-valued fields do not filter. Lists are always
+// ordered by timestamp DESC, id DESC (newest first).
+type AuditEventFilter struct {
+	// EntityType matches the affected entity type exactly.
+	EntityType string
+	// EntityID matches the affected entity exactly.
+	EntityID ID
+	// ActorID matches the actor exactly.
+	ActorID ID
+	// EventType matches the event type exactly or, when it ends with
+	// AuditEventTypeWildcard, by prefix (see EventTypePrefix).
+	EventType string
+	// RequestID matches the originating request exactly.
+	RequestID string
+	// From keeps events at or after this instant.
+	From time.Time
+	// To keeps events strictly before this instant.
+	To time.Time
+}
+
+// EventTypePrefix returns the prefix matched by a wildcard EventType,
+// including the trailing dot ("user." for "user.*"), and whether EventType
+// is a wildcard at all.
+func (f AuditEventFilter) EventTypePrefix() (string, bool) {
+	if !strings.HasSuffix(f.EventType, AuditEventTypeWildcard) {
+		return "", false
+	}
+	return strings.TrimSuffix(f.EventType, "*"), true
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditEventFilter_EventTypePrefix(t *testing.T) {
+	tests := []struct {
+		eventType  string
+		wantPrefix string
+		wantOK     bool
+	}{
+		{eventType: "user.*", wantPrefix: "user.", wantOK: true},
+		{eventType: "oauth_client.*", wantPrefix: "oauth_client.", wantOK: true},
+		{eventType: "user.created"},
+		{eventType: "user*"},
+		{eventType: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.eventType, func(t *testing.T) {
+			prefix, ok := AuditEventFilter{EventType: tt.eventType}.EventTypePrefix()
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantPrefix, prefix)
+		})
+	}
+}
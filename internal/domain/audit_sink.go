@@ -0,0 +1,45 @@
+package domain
+
+import "context"
+
+// AuditSinkRecord is an audit event delivered to an AuditSink, with the
+// tenant whose hash chain it belongs to.
+type AuditSinkRecord struct {
+	// TenantID is the tenant that recorded the event.
+	TenantID string
+
+	// Event is the committed audit event, including its chain position.
+	Event AuditEvent
+}
+
+// AuditSink streams committed audit events to an external system, such as a
+// SIEM. Records are delivered at least once, in chain order per tenant; a
+// record may be delivered again when delivery or the progress update after
+// it fails, so receivers should deduplicate by Event.ID.
+type AuditSink interface {
+	// Name identifies the sink, e.g. in its delivery progress and metrics.
+	// It must be stable across restarts.
+	Name() string
+
+	// Deliver writes records to the sink. It returns only once the records
+	// are stored or accepted by the receiver; an error means none of them
+	// may be considered delivered.
+	Deliver(ctx context.Context, records []AuditSinkRecord) error
+}
+
+// AuditSinkCursorRepository tracks how far each AuditSink has got through
+// the hash chain of each tenant. Its methods are not scoped to the tenant of
+// ctx: delivery covers all tenants.
+type AuditSinkCursorRepository interface {
+	// LockSink claims delivery to sink until the transaction of q ends.
+	// It returns false without waiting if another transaction holds it.
+	LockSink(ctx context.Context, q Querier, sink string) (bool, error)
+
+	// ListUndelivered retrieves up to limit committed, chained events that
+	// were not yet delivered to sink, ordered by tenant and ChainSeq.
+	ListUndelivered(ctx context.Context, q Querier, sink string, limit int) ([]AuditSinkRecord, error)
+
+	// SaveCursor records that the events of tenantID up to chainSeq were
+	// delivered to sink. The cursor never moves backwards.
+	SaveCursor(ctx context.Context, q Querier, sink, tenantID string, chainSeq int64) error
+}
@@ -48,7 +48,7 @@
 //	type UserRepository interface {
 //	    Create(ctx context.Context, q Querier, user *User) error
 //	    GetByID(ctx context.Context, q Querier, id ID) (*User, error)
-//	    List(ctx context.Context, q Querier, params ListParams) ([]User, int, error)
+//	    List(ctx context.Context, q Querier, filter UserFilter, params ListParams) ([]User, int, error)
 //	}
 //
 // The Querier interface enables both direct pool and transaction usage:
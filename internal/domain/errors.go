@@ -14,6 +14,9 @@ var (
 	ErrInvalidEmail       = errors.ErrInvalidEmail
 	ErrInvalidFirstName   = errors.ErrInvalidFirstName
 	ErrInvalidLastName    = errors.ErrInvalidLastName
+	// ErrUserVersionMismatch is returned by UserRepository writes when the
+	// stored version no longer matches the version the caller read.
+	ErrUserVersionMismatch = errors.ErrUserVersionMismatch
 
 	// Audit domain errors.
 	ErrAuditEventNotFound = errors.ErrAuditNotFound
@@ -24,4 +27,15 @@ var (
 	ErrInvalidTimestamp   = errors.ErrInvalidTimestamp
 	ErrInvalidPayload     = errors.ErrInvalidPayload
 	ErrInvalidRequestID   = errors.ErrInvalidRequestID
+
+	// Job domain errors.
+	ErrJobNotFound  = errors.ErrJobNotFound
+	ErrJobLeaseLost = errors.ErrJobLeaseLost
+
+	// OAuth client domain errors.
+	ErrOAuthClientNotFound = errors.ErrOAuthClientNotFound
+	ErrInvalidCredentials  = errors.ErrInvalidCredentials
+
+	// API key domain errors.
+	ErrAPIKeyNotFound = errors.ErrAPIKeyNotFound
 )
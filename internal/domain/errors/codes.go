@@ -24,6 +24,9 @@ const (
 
 	// ErrCodeInvalidLastName indicates that the last name is invalid or empty.
 	ErrCodeInvalidLastName ErrorCode = "ERR_USER_INVALID_LAST_NAME"
+
+	// ErrCodeUserVersionMismatch indicates a write was based on a stale version of the user.
+	ErrCodeUserVersionMismatch ErrorCode = "ERR_USER_VERSION_MISMATCH"
 )
 
 // Audit domain error codes.
@@ -53,6 +56,30 @@ const (
 	ErrCodeInvalidRequestID ErrorCode = "ERR_AUDIT_INVALID_REQUEST_ID"
 )
 
+// Job domain error codes.
+const (
+	// ErrCodeJobNotFound indicates that a requested background job was not found.
+	ErrCodeJobNotFound ErrorCode = "ERR_JOB_NOT_FOUND"
+
+	// ErrCodeJobLeaseLost indicates a worker no longer holds the lease on a job.
+	ErrCodeJobLeaseLost ErrorCode = "ERR_JOB_LEASE_LOST"
+)
+
+// OAuth client error codes.
+const (
+	// ErrCodeOAuthClientNotFound indicates that an OAuth client was not found.
+	ErrCodeOAuthClientNotFound ErrorCode = "ERR_OAUTH_CLIENT_NOT_FOUND"
+
+	// ErrCodeInvalidCredentials indicates that client credentials were not accepted.
+	ErrCodeInvalidCredentials ErrorCode = "ERR_INVALID_CREDENTIALS"
+)
+
+// API key error codes.
+const (
+	// ErrCodeAPIKeyNotFound indicates that an API key was not found.
+	ErrCodeAPIKeyNotFound ErrorCode = "ERR_API_KEY_NOT_FOUND"
+)
+
 // General error codes.
 const (
 	// ErrCodeInternal indicates an internal server error.
@@ -72,6 +99,10 @@ const (
 
 	// ErrCodeForbidden indicates insufficient permissions.
 	ErrCodeForbidden ErrorCode = "ERR_FORBIDDEN"
+
+	// ErrCodeInsufficientPermissions indicates the actor's role does not grant
+	// the requested action.
+	ErrCodeInsufficientPermissions ErrorCode = "ERR_INSUFFICIENT_PERMISSIONS"
 )
 
 // Sentinel errors for common error conditions.
@@ -88,6 +119,8 @@ var (
 	ErrInvalidFirstName = New(ErrCodeInvalidFirstName, "invalid first name")
 	// ErrInvalidLastName indicates the last name is invalid.
 	ErrInvalidLastName = New(ErrCodeInvalidLastName, "invalid last name")
+	// ErrUserVersionMismatch indicates the user was modified since it was read.
+	ErrUserVersionMismatch = New(ErrCodeUserVersionMismatch, "user version mismatch")
 
 	// Audit errors
 	// ErrAuditNotFound indicates an audit log entry was not found.
@@ -107,6 +140,22 @@ var (
 	// ErrInvalidRequestID indicates the request ID is invalid.
 	ErrInvalidRequestID = New(ErrCodeInvalidRequestID, "invalid request ID")
 
+	// Job errors
+	// ErrJobNotFound indicates a background job was not found.
+	ErrJobNotFound = New(ErrCodeJobNotFound, "job not found")
+	// ErrJobLeaseLost indicates another worker has taken over the job.
+	ErrJobLeaseLost = New(ErrCodeJobLeaseLost, "job lease lost")
+
+	// OAuth client errors
+	// ErrOAuthClientNotFound indicates an OAuth client was not found.
+	ErrOAuthClientNotFound = New(ErrCodeOAuthClientNotFound, "oauth client not found")
+	// ErrInvalidCredentials indicates an unknown client, a wrong secret or a disabled client.
+	ErrInvalidCredentials = New(ErrCodeInvalidCredentials, "invalid credentials")
+
+	// API key errors
+	// ErrAPIKeyNotFound indicates an API key was not found.
+	ErrAPIKeyNotFound = New(ErrCodeAPIKeyNotFound, "api key not found")
+
 	// General errors
 	// ErrInternal indicates an internal server error.
 	ErrInternal = New(ErrCodeInternal, "internal error")
@@ -120,6 +169,8 @@ var (
 	ErrUnauthorized = New(ErrCodeUnauthorized, "unauthorized")
 	// ErrForbidden indicates the user is not authorized.
 	ErrForbidden = New(ErrCodeForbidden, "forbidden")
+	// ErrInsufficientPermissions indicates the actor lacks a required permission.
+	ErrInsufficientPermissions = New(ErrCodeInsufficientPermissions, "insufficient permissions")
 )
 
 // Convenience constructors for user errors
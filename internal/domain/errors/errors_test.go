@@ -155,12 +155,19 @@ func TestSentinelErrors(t *testing.T) {
 		{ErrUserNotFound, ErrCodeUserNotFound},
 		{ErrEmailExists, ErrCodeEmailExists},
 		{ErrInvalidEmail, ErrCodeInvalidEmail},
+		{ErrUserVersionMismatch, ErrCodeUserVersionMismatch},
+		{ErrJobNotFound, ErrCodeJobNotFound},
+		{ErrJobLeaseLost, ErrCodeJobLeaseLost},
+		{ErrOAuthClientNotFound, ErrCodeOAuthClientNotFound},
+		{ErrInvalidCredentials, ErrCodeInvalidCredentials},
+		{ErrAPIKeyNotFound, ErrCodeAPIKeyNotFound},
 		{ErrAuditNotFound, ErrCodeAuditNotFound},
 		{ErrInternal, ErrCodeInternal},
 		{ErrValidation, ErrCodeValidation},
 		{ErrNotFound, ErrCodeNotFound},
 		{ErrUnauthorized, ErrCodeUnauthorized},
 		{ErrForbidden, ErrCodeForbidden},
+		{ErrInsufficientPermissions, ErrCodeInsufficientPermissions},
 	}
 
 	for _, tt := range tests {
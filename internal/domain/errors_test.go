@@ -28,6 +28,8 @@ func TestErrorAliases(t *testing.T) {
 		{"ErrInvalidTimestamp", domain.ErrInvalidTimestamp, domainerrors.ErrInvalidTimestamp},
 		{"ErrInvalidPayload", domain.ErrInvalidPayload, domainerrors.ErrInvalidPayload},
 		{"ErrInvalidRequestID", domain.ErrInvalidRequestID, domainerrors.ErrInvalidRequestID},
+		{"ErrJobNotFound", domain.ErrJobNotFound, domainerrors.ErrJobNotFound},
+		{"ErrJobLeaseLost", domain.ErrJobLeaseLost, domainerrors.ErrJobLeaseLost},
 	}
 
 	for _, tt := range tests {
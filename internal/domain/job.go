@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// JobType identifies the kind of work a background job performs.
+type JobType string
+
+// JobTypeUserImport imports users from an uploaded CSV file.
+const JobTypeUserImport JobType = "user_import"
+
+// JobStatus is the lifecycle state of a background job.
+type JobStatus string
+
+const (
+	// JobStatusPending means the job is waiting for a worker.
+	JobStatusPending JobStatus = "pending"
+	// JobStatusRunning means a worker holds the job's lease and is processing it.
+	JobStatusRunning JobStatus = "running"
+	// JobStatusSucceeded means every row was processed; individual rows may still have failed.
+	JobStatusSucceeded JobStatus = "succeeded"
+	// JobStatusFailed means processing stopped early; see Job.Error.
+	JobStatusFailed JobStatus = "failed"
+)
+
+// Terminal reports whether no further processing will happen for the job.
+func (s JobStatus) Terminal() bool {
+	return s == JobStatusSucceeded || s == JobStatusFailed
+}
+
+// JobRowError describes why a single input row could not be processed.
+type JobRowError struct {
+	// Row is the 1-based number of the data row, not counting the header.
+	Row     int
+	Message string
+}
+
+// Job is a unit of background work with persisted progress.
+// Progress is committed together with the work it describes, so a job whose
+// worker dies can be resumed by another worker from NextRow.
+type Job struct {
+	ID        ID
+	Type      JobType
+	Status    JobStatus
+	CreatedBy ID
+	// TenantID is the tenant the job belongs to; it is processed within that
+	// tenant's scope.
+	TenantID string
+	// RequestID is the ID of the HTTP request that created the job; it is
+	// carried into audit events recorded while processing.
+	RequestID string
+
+	// Input is the job payload (e.g. the CSV file). It is only loaded by
+	// JobRepository.ClaimNext and is discarded once the job is terminal.
+	Input []byte
+
+	TotalRows     int
+	NextRow       int // rows before this 0-based index are done
+	ProcessedRows int // rows handled so far, including FailedRows
+	FailedRows    int
+	// Errors holds details for the first failed rows; FailedRows counts all of them.
+	Errors []JobRowError
+	// Error is set when the job as a whole failed.
+	Error string
+
+	// LockedUntil is the end of the current worker's lease.
+	LockedUntil *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+}
+
+// JobRepository defines the interface for background job persistence.
+type JobRepository interface {
+	// Create stores a new job.
+	Create(ctx context.Context, q Querier, job *Job) error
+
+	// GetByID retrieves a job without its Input.
+	// Returns ErrJobNotFound if no job exists with the given ID.
+	GetByID(ctx context.Context, q Querier, id ID) (*Job, error)
+
+	// ClaimNext leases the oldest job of type jobType that is pending or whose
+	// previous lease expired, marks it running and returns it with its Input.
+	// Concurrent callers never receive the same job.
+	// Returns ErrJobNotFound if no job is available.
+	ClaimNext(ctx context.Context, q Querier, jobType JobType, leaseUntil time.Time) (*Job, error)
+
+	// UpdateProgress saves the job's status, progress, errors and finish time
+	// and extends its lease to leaseUntil. Input is dropped once the job is terminal.
+	// Returns ErrJobLeaseLost if job.LockedUntil no longer matches the stored
+	// lease, i.e. another worker has claimed the job; job is left unchanged then.
+	UpdateProgress(ctx context.Context, q Querier, job *Job, leaseUntil time.Time) error
+}
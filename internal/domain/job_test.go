@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobStatus_Terminal(t *testing.T) {
+	assert.False(t, JobStatusPending.Terminal())
+	assert.False(t, JobStatusRunning.Terminal())
+	assert.True(t, JobStatusSucceeded.Terminal())
+	assert.True(t, JobStatusFailed.Terminal())
+}
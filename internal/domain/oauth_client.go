@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// OAuthClient is a machine client that obtains access tokens with the OAuth 2.0
+// client_credentials grant. Its ID is the client_id.
+type OAuthClient struct {
+	ID   ID
+	Name string
+	// SecretHash is the SecretHasher hash of the client secret. The secret
+	// itself is never stored.
+	SecretHash string
+	// Role is the role claim of the tokens issued to the client.
+	Role string
+	// TenantID is the tenant_id claim of the tokens issued to the client.
+	TenantID string
+	// Scopes are the scopes the client may be issued. Nil marks a client
+	// created before clients had scopes; its tokens carry no scope claim.
+	Scopes []string
+	// CreatedBy is the subject of the caller that created the client.
+	CreatedBy string
+	// DisabledAt is when the client was disabled, or nil. Disabled clients
+	// cannot obtain tokens.
+	DisabledAt *time.Time
+	CreatedAt  time.Time
+}
+
+// Disabled reports whether the client may no longer obtain tokens.
+func (c OAuthClient) Disabled() bool {
+	return c.DisabledAt != nil
+}
+
+// OAuthClientRepository defines the interface for OAuth client persistence.
+type OAuthClientRepository interface {
+	// Create stores a new client.
+	Create(ctx context.Context, q Querier, client *OAuthClient) error
+
+	// GetByID returns the client with the given client_id.
+	// Returns ErrOAuthClientNotFound if there is none, including when id is
+	// not a valid client_id.
+	GetByID(ctx context.Context, q Querier, id ID) (*OAuthClient, error)
+}
+
+// SecretHasher hashes client secrets for storage and checks presented secrets
+// against stored hashes. Implementations must be slow to brute-force and
+// compare in constant time.
+type SecretHasher interface {
+	// Hash returns a salted hash of secret.
+	Hash(secret string) (string, error)
+
+	// Verify reports whether secret matches hash.
+	Verify(hash, secret string) bool
+}
@@ -1,16 +1,41 @@
 package domain
 
+import "time"
+
 // DefaultPageSize is the default number of items per page when PageSize is not specified.
 const DefaultPageSize = 20
 
 // MaxPageSize is an upper bound to prevent unbounded list requests.
 const MaxPageSize = 100
 
+// TotalCountUnknown is returned by list repositories in place of the total
+// count when paginating by cursor, where counting is skipped.
+const TotalCountUnknown = -1
+
 // ListParams contains pagination parameters for list operations.
 // Page is 1-based (first page is 1, not 0).
+//
+// When Cursor is set the list is paginated by keyset instead of offset:
+// Page is ignored, repositories skip the total count (returning TotalCountUnknown)
+// and fetch up to KeysetLimit items so callers can tell with TrimKeysetPage
+// whether another page follows.
 type ListParams struct {
 	Page     int
 	PageSize int
+	Cursor   *Cursor
+}
+
+// Cursor is a keyset position in a list ordered by (time DESC, id DESC),
+// such as users by created_at or audit events by timestamp.
+// The zero value is not a valid position.
+type Cursor struct {
+	// Time is the sort timestamp of the item at the position.
+	Time time.Time
+	// ID breaks ties between items with the same Time.
+	ID ID
+	// Backward selects the items before the position (newer ones)
+	// instead of the items after it.
+	Backward bool
 }
 
 // Offset calculates the database offset for the current page.
@@ -33,3 +58,28 @@ func (p ListParams) Limit() int {
 	}
 	return p.PageSize
 }
+
+// IsKeyset reports whether the list is paginated by cursor.
+func (p ListParams) IsKeyset() bool {
+	return p.Cursor != nil
+}
+
+// KeysetLimit returns the number of items a repository fetches in keyset mode:
+// one more than the page size, so an extra item signals a further page.
+func (p ListParams) KeysetLimit() int {
+	return p.Limit() + 1
+}
+
+// TrimKeysetPage drops the probe item a repository fetched beyond the page in
+// keyset mode and reports whether more items follow in the cursor's direction.
+// items must be in list order; for a Backward cursor the probe is the first item.
+// Offset-mode results are returned unchanged with more=false.
+func TrimKeysetPage[T any](items []T, p ListParams) (page []T, more bool) {
+	if !p.IsKeyset() || len(items) <= p.Limit() {
+		return items, false
+	}
+	if p.Cursor.Backward {
+		return items[len(items)-p.Limit():], true
+	}
+	return items[:p.Limit()], true
+}
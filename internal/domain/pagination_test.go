@@ -90,3 +90,63 @@ func TestListParams_Limit(t *testing.T) {
 		})
 	}
 }
+
+func TestListParams_Keyset(t *testing.T) {
+	assert.False(t, ListParams{Page: 2}.IsKeyset())
+	assert.True(t, ListParams{Cursor: &Cursor{ID: "x"}}.IsKeyset())
+	assert.Equal(t, 11, ListParams{PageSize: 10}.KeysetLimit())
+	assert.Equal(t, MaxPageSize+1, ListParams{PageSize: MaxPageSize + 50}.KeysetLimit())
+}
+
+func TestTrimKeysetPage(t *testing.T) {
+	items := []int{1, 2, 3}
+
+	tests := []struct {
+		name     string
+		items    []int
+		params   ListParams
+		wantPage []int
+		wantMore bool
+	}{
+		{
+			name:     "offset mode is unchanged",
+			items:    items,
+			params:   ListParams{PageSize: 2},
+			wantPage: items,
+		},
+		{
+			name:     "forward page with probe drops the last item",
+			items:    items,
+			params:   ListParams{PageSize: 2, Cursor: &Cursor{ID: "c"}},
+			wantPage: []int{1, 2},
+			wantMore: true,
+		},
+		{
+			name:     "backward page with probe drops the first item",
+			items:    items,
+			params:   ListParams{PageSize: 2, Cursor: &Cursor{ID: "c", Backward: true}},
+			wantPage: []int{2, 3},
+			wantMore: true,
+		},
+		{
+			name:     "short page has no more items",
+			items:    items[:2],
+			params:   ListParams{PageSize: 2, Cursor: &Cursor{ID: "c"}},
+			wantPage: []int{1, 2},
+		},
+		{
+			name:     "empty page",
+			items:    []int{},
+			params:   ListParams{PageSize: 2, Cursor: &Cursor{ID: "c"}},
+			wantPage: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, more := TrimKeysetPage(tt.items, tt.params)
+			assert.Equal(t, tt.wantPage, page)
+			assert.Equal(t, tt.wantMore, more)
+		})
+	}
+}
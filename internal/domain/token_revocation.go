@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// TokenRevocation invalidates access tokens before their exp claim. It
+// targets either a single token by its jti claim (JTI), or every token of a
+// subject issued before IssuedBefore (Subject), of its tenant only.
+type TokenRevocation struct {
+	ID ID
+	// TenantID is the tenant of the caller that revoked the tokens; only
+	// tokens whose tenant_id claim names it are revoked.
+	TenantID string
+	// JTI is the jti claim of the revoked token. Empty when Subject is set.
+	JTI string
+	// Subject is the sub claim whose tokens are revoked. Empty when JTI is set.
+	Subject string
+	// IssuedBefore is the cutoff for a Subject revocation: tokens with an
+	// earlier iat claim, or without one, are revoked.
+	IssuedBefore time.Time
+	// ExpiresAt is when every revoked token has expired, so the revocation
+	// may be deleted.
+	ExpiresAt time.Time
+	// RevokedBy is the subject of the caller that revoked the tokens.
+	RevokedBy string
+	// RequestID is the ID of the HTTP request that revoked the tokens.
+	RequestID string
+	CreatedAt time.Time
+}
+
+// Revokes reports whether r revokes a token of tenantID with the given
+// claims. A zero issuedAt means the token has no iat claim.
+func (r TokenRevocation) Revokes(tenantID, jti, subject string, issuedAt time.Time) bool {
+	if tenantID != r.TenantID {
+		return false
+	}
+	if r.JTI != "" {
+		return jti == r.JTI
+	}
+	return subject == r.Subject && (issuedAt.IsZero() || issuedAt.Before(r.IssuedBefore))
+}
+
+// TokenRevocationRepository defines the interface for token revocation persistence.
+type TokenRevocationRepository interface {
+	// Create stores a new revocation.
+	Create(ctx context.Context, q Querier, revocation *TokenRevocation) error
+
+	// ListActive returns every revocation of every tenant that has not
+	// expired yet.
+	ListActive(ctx context.Context, q Querier) ([]TokenRevocation, error)
+
+	// DeleteExpired removes expired revocations of every tenant and returns
+	// how many were removed.
+	DeleteExpired(ctx context.Context, q Querier) (int64, error)
+}
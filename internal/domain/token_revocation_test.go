@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenRevocation_Revokes(t *testing.T) {
+	cutoff := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	byJTI := TokenRevocation{TenantID: "tenant-a", JTI: "jti-1"}
+	bySubject := TokenRevocation{TenantID: "tenant-a", Subject: "user-1", IssuedBefore: cutoff}
+
+	tests := []struct {
+		name       string
+		revocation TokenRevocation
+		tenantID   string
+		jti        string
+		subject    string
+		issuedAt   time.Time
+		want       bool
+	}{
+		{name: "jti matches", revocation: byJTI, jti: "jti-1", subject: "user-1", want: true},
+		{name: "other jti", revocation: byJTI, jti: "jti-2", subject: "user-1"},
+		{name: "no jti", revocation: byJTI, subject: "user-1"},
+		{name: "subject issued before cutoff", revocation: bySubject, subject: "user-1", issuedAt: cutoff.Add(-time.Second), want: true},
+		{name: "subject issued at cutoff", revocation: bySubject, subject: "user-1", issuedAt: cutoff},
+		{name: "subject issued after cutoff", revocation: bySubject, subject: "user-1", issuedAt: cutoff.Add(time.Second)},
+		{name: "subject without iat", revocation: bySubject, subject: "user-1", want: true},
+		{name: "other subject", revocation: bySubject, subject: "user-2", issuedAt: cutoff.Add(-time.Hour)},
+		{name: "jti of another tenant", revocation: byJTI, tenantID: "tenant-b", jti: "jti-1", subject: "user-1"},
+		{name: "subject of another tenant", revocation: bySubject, tenantID: "tenant-b", subject: "user-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantID := tt.tenantID
+			if tenantID == "" {
+				tenantID = "tenant-a"
+			}
+			assert.Equal(t, tt.want, tt.revocation.Revokes(tenantID, tt.jti, tt.subject, tt.issuedAt))
+		})
+	}
+}
@@ -15,6 +15,11 @@ type User struct {
 	LastName  string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// DeletedAt is set when the user has been soft-deleted; nil for active users.
+	DeletedAt *time.Time
+	// Version is incremented by the repository on every write and is used for
+	// optimistic concurrency control. New users start at version 1.
+	Version int64
 }
 
 // Validate checks if the User entity has valid required fields.
@@ -42,13 +47,43 @@ func (u User) Validate() error {
 //
 //go:generate mockgen -destination=../testutil/mocks/user_repository_mock.go -package=mocks github.com/iruldev/golang-api-hexagonal/internal/domain UserRepository
 type UserRepository interface {
-	// Create stores a new user.
+	// Create stores a new user and sets its initial Version.
 	Create(ctx context.Context, q Querier, user *User) error
 
-	// GetByID retrieves a user by their ID.
+	// GetByID retrieves an active (not soft-deleted) user by their ID.
 	GetByID(ctx context.Context, q Querier, id ID) (*User, error)
 
-	// List retrieves users with pagination.
+	// List retrieves active (not soft-deleted) users matching filter with pagination,
+	// ordered by filter.Sort (default created_at DESC, id DESC).
 	// Returns the slice of users, total count of matching users, and any error.
-	List(ctx context.Context, q Querier, params ListParams) ([]User, int, error)
+	// With params.Cursor set, pagination is by keyset (see ListParams); this
+	// requires the default order.
+	List(ctx context.Context, q Querier, filter UserFilter, params ListParams) ([]User, int, error)
+
+	// Update persists changes to an existing user's mutable fields.
+	// user.Version must be the version the caller read; on success it is set to the new version.
+	// Returns ErrUserNotFound if the user does not exist and
+	// ErrUserVersionMismatch if the user was modified since it was read.
+	Update(ctx context.Context, q Querier, user *User) error
+
+	// Delete soft-deletes a user by their ID if it is still at the given version.
+	// The row is kept until purged.
+	// Returns ErrUserNotFound if the user does not exist or is already deleted,
+	// and ErrUserVersionMismatch if the user was modified since it was read.
+	Delete(ctx context.Context, q Querier, id ID, version int64) error
+
+	// Restore reverses a soft delete.
+	// Returns ErrUserNotFound if no soft-deleted user exists with the given ID,
+	// and ErrEmailAlreadyExists if the email was re-registered in the meantime.
+	Restore(ctx context.Context, q Querier, id ID) error
+
+	// PurgeDeleted hard-deletes users that were soft-deleted before the cutoff.
+	// Returns the IDs of the purged users.
+	PurgeDeleted(ctx context.Context, q Querier, before time.Time) ([]ID, error)
+
+	// Export calls fn for every active user, oldest first, reading rows through a
+	// server-side cursor so memory use does not grow with the table.
+	// q must be a transaction. Iteration stops at the first error returned by fn
+	// or on context cancellation, and that error is returned.
+	Export(ctx context.Context, q Querier, fn func(User) error) error
 }
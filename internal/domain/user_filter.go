@@ -0,0 +1,82 @@
+package domain
+
+import "time"
+
+// UserSortKey names a user attribute lists can be ordered by.
+// Only the keys declared below are accepted; repositories map them to columns
+// and must reject anything else.
+type UserSortKey string
+
+// Sortable user attributes. Values match the JSON field names of the HTTP API.
+const (
+	UserSortCreatedAt UserSortKey = "createdAt"
+	UserSortUpdatedAt UserSortKey = "updatedAt"
+	UserSortEmail     UserSortKey = "email"
+	UserSortFirstName UserSortKey = "firstName"
+	UserSortLastName  UserSortKey = "lastName"
+)
+
+// UserSortKeys returns the allowlisted sort keys.
+func UserSortKeys() []UserSortKey {
+	return []UserSortKey{
+		UserSortCreatedAt,
+		UserSortUpdatedAt,
+		UserSortEmail,
+		UserSortFirstName,
+		UserSortLastName,
+	}
+}
+
+// Valid reports whether k is an allowlisted sort key.
+func (k UserSortKey) Valid() bool {
+	for _, allowed := range UserSortKeys() {
+		if k == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// UserSort is one ordering term of a user list.
+type UserSort struct {
+	Key  UserSortKey
+	Desc bool
+}
+
+// UserFilter narrows and orders a user listing.
+// The zero value lists all active users in the default order
+// (created_at DESC, id DESC). Zero-valued fields do not filter.
+type UserFilter struct {
+	// Email matches the address exactly (case-insensitive).
+	Email string
+	// Query matches a prefix of the first name, last name or email,
+	// or a name similar to it (trigram similarity).
+	Query string
+	// CreatedAfter keeps users created at or after this instant.
+	CreatedAfter time.Time
+	// CreatedBefore keeps users created strictly before this instant.
+	CreatedBefore time.Time
+	// Sort lists the ordering terms in priority order. Ties are always broken
+	// by ID so that pages are stable.
+	Sort []UserSort
+}
+
+// HasDefaultOrder reports whether the filter uses the default list order,
+// the only order keyset (cursor) pagination supports.
+func (f UserFilter) HasDefaultOrder() bool {
+	switch len(f.Sort) {
+	case 0:
+		return true
+	case 1:
+		return f.Sort[0] == UserSort{Key: UserSortCreatedAt, Desc: true}
+	default:
+		return false
+	}
+}
+
+// IsZero reports whether the filter neither narrows nor reorders the list.
+func (f UserFilter) IsZero() bool {
+	return f.Email == "" && f.Query == "" &&
+		f.CreatedAfter.IsZero() && f.CreatedBefore.IsZero() &&
+		f.HasDefaultOrder()
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserSortKey_Valid(t *testing.T) {
+	for _, k := range UserSortKeys() {
+		assert.True(t, k.Valid(), "%s should be allowlisted", k)
+	}
+	assert.False(t, UserSortKey("password").Valid())
+	assert.False(t, UserSortKey("created_at").Valid(), "column names are not sort keys")
+	assert.False(t, UserSortKey("").Valid())
+}
+
+func TestUserFilter_HasDefaultOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		sort []UserSort
+		want bool
+	}{
+		{name: "no sort", want: true},
+		{name: "createdAt descending", sort: []UserSort{{Key: UserSortCreatedAt, Desc: true}}, want: true},
+		{name: "createdAt ascending", sort: []UserSort{{Key: UserSortCreatedAt}}},
+		{name: "other key", sort: []UserSort{{Key: UserSortLastName, Desc: true}}},
+		{name: "multiple keys", sort: []UserSort{{Key: UserSortCreatedAt, Desc: true}, {Key: UserSortLastName}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, UserFilter{Sort: tt.sort}.HasDefaultOrder())
+		})
+	}
+}
+
+func TestUserFilter_IsZero(t *testing.T) {
+	assert.True(t, UserFilter{}.IsZero())
+	assert.True(t, UserFilter{Sort: []UserSort{{Key: UserSortCreatedAt, Desc: true}}}.IsZero())
+	assert.False(t, UserFilter{Email: "a@example.com"}.IsZero())
+	assert.False(t, UserFilter{Query: "jo"}.IsZero())
+	assert.False(t, UserFilter{CreatedAfter: time.Now()}.IsZero())
+	assert.False(t, UserFilter{CreatedBefore: time.Now()}.IsZero())
+	assert.False(t, UserFilter{Sort: []UserSort{{Key: UserSortEmail}}}.IsZero())
+}
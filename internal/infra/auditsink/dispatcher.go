@@ -0,0 +1,267 @@
+package auditsink
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/resilience"
+)
+
+const (
+	// DefaultPollInterval is the default time between checks for new events.
+	DefaultPollInterval = time.Second
+	// DefaultBatchSize is the default number of events delivered at a time.
+	DefaultBatchSize = 100
+)
+
+// DispatcherConfig holds configuration for the Dispatcher.
+type DispatcherConfig struct {
+	// Interval is the time between checks for new events once a sink has
+	// caught up. Default: DefaultPollInterval.
+	Interval time.Duration
+	// BatchSize is the maximum number of events per Deliver call.
+	// Default: DefaultBatchSize.
+	BatchSize int
+}
+
+// Dispatcher delivers committed audit events to sinks. Each sink runs in its
+// own goroutine, so a slow or failing sink does not hold back the others.
+//
+// A batch is read, delivered and recorded in the sink's cursor within one
+// transaction that also holds the sink's advisory lock, so instances of the
+// service take turns instead of delivering the same events. Failed
+// deliveries are retried by the Retrier; once it gives up, the batch is read
+// again on the next poll, and the sink does not get past it until it is
+// accepted.
+type Dispatcher struct {
+	txManager domain.TxManager
+	cursors   domain.AuditSinkCursorRepository
+	sinks     []domain.AuditSink
+	retrier   resilience.Retrier
+	cfg       DispatcherConfig
+	logger    *slog.Logger
+
+	eventsDelivered *prometheus.CounterVec
+	deliveryErrors  *prometheus.CounterVec
+	lastDelivery    *prometheus.GaugeVec
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	doneCh chan struct{}
+}
+
+// NewDispatcher creates a dispatcher for sinks and registers its metrics with
+// registry. If registry is nil, a new registry is created. txManager must not
+// scope transactions to a tenant (see postgres.NewCrossTenantTxManager), and
+// retrier may be nil to deliver without retries.
+func NewDispatcher(
+	txManager domain.TxManager,
+	cursors domain.AuditSinkCursorRepository,
+	sinks []domain.AuditSink,
+	retrier resilience.Retrier,
+	cfg DispatcherConfig,
+	logger *slog.Logger,
+	registry *prometheus.Registry,
+) *Dispatcher {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultPollInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if registry == nil {
+		registry = prometheus.NewRegistry()
+	}
+
+	eventsDelivered := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "audit_sink_events_delivered_total",
+		Help: "Total number of audit events delivered, by sink",
+	}, []string{"sink"})
+	deliveryErrors := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "audit_sink_delivery_errors_total",
+		Help: "Total number of failed audit event deliveries after retries, by sink",
+	}, []string{"sink"})
+	lastDelivery := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "audit_sink_last_delivery_timestamp_seconds",
+		Help: "Unix time of the last successful audit event delivery, by sink",
+	}, []string{"sink"})
+	// Errors are intentionally ignored as they indicate metrics are already registered.
+	_ = registry.Register(eventsDelivered)
+	_ = registry.Register(deliveryErrors)
+	_ = registry.Register(lastDelivery)
+
+	return &Dispatcher{
+		txManager:       txManager,
+		cursors:         cursors,
+		sinks:           sinks,
+		retrier:         retrier,
+		cfg:             cfg,
+		logger:          logger,
+		eventsDelivered: eventsDelivered,
+		deliveryErrors:  deliveryErrors,
+		lastDelivery:    lastDelivery,
+		doneCh:          make(chan struct{}),
+	}
+}
+
+// Start begins delivering to every sink.
+// This method returns immediately; delivery runs in background goroutines
+// that outlive ctx until Stop is called.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	d.cancel = cancel
+	for _, sink := range d.sinks {
+		d.wg.Add(1)
+		go d.run(runCtx, sink)
+	}
+	go func() {
+		d.wg.Wait()
+		close(d.doneCh)
+	}()
+
+	names := make([]string, 0, len(d.sinks))
+	for _, sink := range d.sinks {
+		names = append(names, sink.Name())
+	}
+	d.logger.Info("audit sink dispatcher started",
+		"sinks", names,
+		"interval", d.cfg.Interval.String(),
+	)
+	return nil
+}
+
+// Stop interrupts delivery, waits for the sinks' goroutines to exit or until
+// ctx is cancelled, and closes sinks that implement io.Closer. A batch
+// interrupted before its cursor was saved is delivered again after restart.
+func (d *Dispatcher) Stop(ctx context.Context) error {
+	if d.cancel == nil {
+		return nil
+	}
+	d.cancel()
+	select {
+	case <-d.doneCh:
+	case <-ctx.Done():
+		d.logger.Warn("audit sink dispatcher stop timed out")
+		return ctx.Err()
+	}
+
+	var errs []error
+	for _, sink := range d.sinks {
+		if closer, ok := sink.(io.Closer); ok {
+			errs = append(errs, closer.Close())
+		}
+	}
+	d.logger.Info("audit sink dispatcher stopped")
+	return errors.Join(errs...)
+}
+
+func (d *Dispatcher) run(ctx context.Context, sink domain.AuditSink) {
+	defer d.wg.Done()
+
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	d.drain(ctx, sink)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.drain(ctx, sink)
+		}
+	}
+}
+
+// drain delivers batches until sink has caught up, delivery fails or ctx is
+// cancelled.
+func (d *Dispatcher) drain(ctx context.Context, sink domain.AuditSink) {
+	for ctx.Err() == nil {
+		delivered, err := d.DeliverBatch(ctx, sink)
+		if err != nil {
+			if ctx.Err() == nil {
+				d.logger.Error("audit sink delivery failed", "sink", sink.Name(), "error", err)
+				d.deliveryErrors.WithLabelValues(sink.Name()).Inc()
+			}
+			return
+		}
+		if delivered < d.cfg.BatchSize {
+			return
+		}
+	}
+}
+
+// DeliverBatch delivers the next batch of undelivered events to sink and
+// moves its cursors past them. It returns the number of events delivered,
+// which is zero when there are none or another instance holds the sink.
+func (d *Dispatcher) DeliverBatch(ctx context.Context, sink domain.AuditSink) (int, error) {
+	name := sink.Name()
+	var delivered int
+
+	err := d.txManager.WithTx(ctx, func(tx domain.Querier) error {
+		locked, err := d.cursors.LockSink(ctx, tx, name)
+		if err != nil || !locked {
+			return err
+		}
+
+		records, err := d.cursors.ListUndelivered(ctx, tx, name, d.cfg.BatchSize)
+		if err != nil || len(records) == 0 {
+			return err
+		}
+
+		if err := d.deliver(ctx, sink, records); err != nil {
+			return err
+		}
+
+		// Records are ordered by tenant and chain position, so the last
+		// record of each tenant is its new cursor.
+		for i, r := range records {
+			if i+1 < len(records) && records[i+1].TenantID == r.TenantID {
+				continue
+			}
+			if err := d.cursors.SaveCursor(ctx, tx, name, r.TenantID, r.Event.ChainSeq); err != nil {
+				return err
+			}
+		}
+		delivered = len(records)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if delivered > 0 {
+		d.eventsDelivered.WithLabelValues(name).Add(float64(delivered))
+		d.lastDelivery.WithLabelValues(name).SetToCurrentTime()
+	}
+	return delivered, nil
+}
+
+// deliver hands records to sink, through the retrier if there is one.
+func (d *Dispatcher) deliver(ctx context.Context, sink domain.AuditSink, records []domain.AuditSinkRecord) error {
+	if d.retrier == nil {
+		return sink.Deliver(ctx, records)
+	}
+	return d.retrier.Do(ctx, func(ctx context.Context) error {
+		return sink.Deliver(ctx, records)
+	})
+}
+
+// permanentError marks an error retrying cannot fix, such as an event that
+// cannot be encoded or a rejected webhook request, so the retrier gives up
+// at once.
+type permanentError struct {
+	err error
+}
+
+func permanent(err error) error { return &permanentError{err: err} }
+
+func (e *permanentError) Error() string   { return e.err.Error() }
+func (e *permanentError) Unwrap() error   { return e.err }
+func (e *permanentError) Retryable() bool { return false }
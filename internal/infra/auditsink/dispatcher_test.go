@@ -0,0 +1,254 @@
+package auditsink
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/resilience"
+)
+
+// fakeTxManager runs fn without a database and counts rolled-back transactions.
+type fakeTxManager struct {
+	mu         sync.Mutex
+	rolledBack int
+}
+
+func (m *fakeTxManager) WithTx(_ context.Context, fn func(tx domain.Querier) error) error {
+	err := fn(nil)
+	if err != nil {
+		m.mu.Lock()
+		m.rolledBack++
+		m.mu.Unlock()
+	}
+	return err
+}
+
+// fakeCursorRepo holds events ordered by tenant and chain position, and the
+// cursors per sink and tenant.
+type fakeCursorRepo struct {
+	mu      sync.Mutex
+	events  []domain.AuditSinkRecord
+	cursors map[string]map[string]int64
+	held    bool
+}
+
+func newFakeCursorRepo(events ...domain.AuditSinkRecord) *fakeCursorRepo {
+	return &fakeCursorRepo{events: events, cursors: make(map[string]map[string]int64)}
+}
+
+func (r *fakeCursorRepo) LockSink(context.Context, domain.Querier, string) (bool, error) {
+	return !r.held, nil
+}
+
+func (r *fakeCursorRepo) ListUndelivered(_ context.Context, _ domain.Querier, sink string, limit int) ([]domain.AuditSinkRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var records []domain.AuditSinkRecord
+	for _, e := range r.events {
+		if e.Event.ChainSeq > r.cursors[sink][e.TenantID] && len(records) < limit {
+			records = append(records, e)
+		}
+	}
+	return records, nil
+}
+
+func (r *fakeCursorRepo) SaveCursor(_ context.Context, _ domain.Querier, sink, tenantID string, chainSeq int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cursors[sink] == nil {
+		r.cursors[sink] = make(map[string]int64)
+	}
+	r.cursors[sink][tenantID] = chainSeq
+	return nil
+}
+
+func (r *fakeCursorRepo) cursor(sink, tenantID string) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cursors[sink][tenantID]
+}
+
+// fakeSink records delivered batches and fails with the queued errors first.
+type fakeSink struct {
+	name string
+
+	mu       sync.Mutex
+	errs     []error
+	attempts int
+	batches  [][]domain.AuditSinkRecord
+	closed   bool
+}
+
+func (s *fakeSink) Name() string { return s.name }
+
+func (s *fakeSink) Deliver(_ context.Context, records []domain.AuditSinkRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts++
+	if len(s.errs) > 0 {
+		err := s.errs[0]
+		s.errs = s.errs[1:]
+		return err
+	}
+	s.batches = append(s.batches, records)
+	return nil
+}
+
+func (s *fakeSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *fakeSink) delivered() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var seqs []int64
+	for _, b := range s.batches {
+		for _, r := range b {
+			seqs = append(seqs, r.Event.ChainSeq)
+		}
+	}
+	return seqs
+}
+
+func testRetrier() resilience.Retrier {
+	return resilience.NewRetrier("audit_sink_test", resilience.RetryConfig{
+		MaxAttempts:  3,
+		InitialDelay: time.Millisecond,
+		MaxDelay:     time.Millisecond,
+		Multiplier:   2,
+	}, resilience.WithRetryLogger(slog.New(slog.DiscardHandler)))
+}
+
+func newTestDispatcher(repo *fakeCursorRepo, tx *fakeTxManager, sinks ...domain.AuditSink) *Dispatcher {
+	return NewDispatcher(tx, repo, sinks, testRetrier(), DispatcherConfig{
+		Interval:  time.Hour,
+		BatchSize: 2,
+	}, slog.New(slog.DiscardHandler), prometheus.NewRegistry())
+}
+
+func TestDispatcher_DeliverBatch(t *testing.T) {
+	repo := newFakeCursorRepo(
+		testRecord("acme", 1), testRecord("acme", 2), testRecord("acme", 3),
+		testRecord("globex", 1),
+	)
+	sink := &fakeSink{name: "siem"}
+	d := newTestDispatcher(repo, &fakeTxManager{}, sink)
+	ctx := context.Background()
+
+	n, err := d.DeliverBatch(ctx, sink)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, int64(2), repo.cursor("siem", "acme"))
+
+	// The next batch ends with another tenant; each gets its last position.
+	n, err = d.DeliverBatch(ctx, sink)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, int64(3), repo.cursor("siem", "acme"))
+	assert.Equal(t, int64(1), repo.cursor("siem", "globex"))
+
+	n, err = d.DeliverBatch(ctx, sink)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	assert.Equal(t, []int64{1, 2, 3, 1}, sink.delivered())
+	assert.InDelta(t, 4, testutil.ToFloat64(d.eventsDelivered.WithLabelValues("siem")), 0)
+	// Cursors are per sink.
+	assert.Zero(t, repo.cursor("other", "acme"))
+}
+
+func TestDispatcher_DeliverBatch_RetriesTransientErrors(t *testing.T) {
+	repo := newFakeCursorRepo(testRecord("acme", 1))
+	sink := &fakeSink{name: "siem", errs: []error{errors.New("connection reset"), errors.New("connection reset")}}
+	d := newTestDispatcher(repo, &fakeTxManager{}, sink)
+
+	n, err := d.DeliverBatch(context.Background(), sink)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 3, sink.attempts)
+	assert.Equal(t, int64(1), repo.cursor("siem", "acme"))
+}
+
+func TestDispatcher_DeliverBatch_FailureKeepsCursor(t *testing.T) {
+	tests := []struct {
+		name         string
+		errs         []error
+		wantAttempts int
+	}{
+		{"retries exhausted", []error{errors.New("down"), errors.New("down"), errors.New("down")}, 3},
+		{"permanent error", []error{permanent(errors.New("rejected"))}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeCursorRepo(testRecord("acme", 1))
+			sink := &fakeSink{name: "siem", errs: tt.errs}
+			tx := &fakeTxManager{}
+			d := newTestDispatcher(repo, tx, sink)
+
+			n, err := d.DeliverBatch(context.Background(), sink)
+
+			require.Error(t, err)
+			assert.Zero(t, n)
+			assert.Equal(t, tt.wantAttempts, sink.attempts)
+			assert.Zero(t, repo.cursor("siem", "acme"))
+			assert.Equal(t, 1, tx.rolledBack)
+
+			// The batch is delivered on the next run.
+			n, err = d.DeliverBatch(context.Background(), sink)
+			require.NoError(t, err)
+			assert.Equal(t, 1, n)
+		})
+	}
+}
+
+func TestDispatcher_DeliverBatch_SinkHeldElsewhere(t *testing.T) {
+	repo := newFakeCursorRepo(testRecord("acme", 1))
+	repo.held = true
+	sink := &fakeSink{name: "siem"}
+	d := newTestDispatcher(repo, &fakeTxManager{}, sink)
+
+	n, err := d.DeliverBatch(context.Background(), sink)
+
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Zero(t, sink.attempts)
+}
+
+func TestDispatcher_StartStop(t *testing.T) {
+	repo := newFakeCursorRepo(testRecord("acme", 1), testRecord("acme", 2), testRecord("acme", 3))
+	fast := &fakeSink{name: "fast"}
+	failing := &fakeSink{name: "failing", errs: []error{permanent(errors.New("rejected"))}}
+	d := newTestDispatcher(repo, &fakeTxManager{}, fast, failing)
+
+	require.NoError(t, d.Start(context.Background()))
+	// Each sink drains independently on start; a failing sink stops at its
+	// first batch without holding back the other.
+	require.Eventually(t, func() bool { return repo.cursor("fast", "acme") == 3 }, time.Second, time.Millisecond)
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(d.deliveryErrors.WithLabelValues("failing")) == 1
+	}, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, d.Stop(ctx))
+
+	assert.Equal(t, []int64{1, 2, 3}, fast.delivered())
+	assert.Zero(t, repo.cursor("failing", "acme"))
+	assert.True(t, fast.closed)
+	assert.True(t, failing.closed)
+}
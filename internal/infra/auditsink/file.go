@@ -0,0 +1,187 @@
+package auditsink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+const (
+	// FileSinkName is the name of the file sink in AUDIT_SINKS.
+	FileSinkName = "file"
+	// DefaultFileMaxBytes is the size at which the file is rotated by default.
+	DefaultFileMaxBytes = 100 << 20
+	// DefaultFileMaxBackups is the number of rotated files kept by default.
+	DefaultFileMaxBackups = 5
+	// backupTimeFormat suffixes rotated files; it sorts chronologically.
+	backupTimeFormat = "20060102T150405.000000000Z"
+)
+
+// FileConfig holds configuration for a FileSink.
+type FileConfig struct {
+	// Path is the file events are appended to. Required.
+	Path string
+	// MaxBytes is the size above which the file is rotated before a write.
+	// Default: DefaultFileMaxBytes.
+	MaxBytes int64
+	// MaxBackups is the number of rotated files kept; older ones are removed.
+	// Default: DefaultFileMaxBackups.
+	MaxBackups int
+	// Logger reports backups that could not be removed.
+	Logger *slog.Logger
+}
+
+// FileSink appends audit events as NDJSON to a local file and rotates it by
+// size. A rotated file is renamed to Path plus a UTC timestamp suffix, e.g.
+// audit.ndjson.20260117T093000.000000000Z. Each batch is synced to disk
+// before Deliver returns. It is safe for concurrent use.
+type FileSink struct {
+	cfg FileConfig
+	now func() time.Time
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewFileSink creates a sink appending to cfg.Path. The file is opened on the
+// first delivery.
+func NewFileSink(cfg FileConfig) (*FileSink, error) {
+	if strings.TrimSpace(cfg.Path) == "" {
+		return nil, errors.New("auditsink: file path is required")
+	}
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = DefaultFileMaxBytes
+	}
+	if cfg.MaxBackups <= 0 {
+		cfg.MaxBackups = DefaultFileMaxBackups
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = slog.New(slog.DiscardHandler)
+	}
+	return &FileSink{cfg: cfg, now: time.Now}, nil
+}
+
+// Name returns FileSinkName.
+func (s *FileSink) Name() string {
+	return FileSinkName
+}
+
+// Deliver appends records to the file, rotating it first if the batch would
+// take it past MaxBytes. A batch is never split across files.
+func (s *FileSink) Deliver(_ context.Context, records []domain.AuditSinkRecord) error {
+	data, err := EncodeNDJSON(records)
+	if err != nil {
+		return permanent(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.open(); err != nil {
+		return err
+	}
+	if s.size > 0 && s.size+int64(len(data)) > s.cfg.MaxBytes {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(data)
+	s.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("auditsink: write %s: %w", s.cfg.Path, err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("auditsink: sync %s: %w", s.cfg.Path, err)
+	}
+	return nil
+}
+
+// Close closes the file. A later Deliver reopens it.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// open opens the file for appending unless it is open. The caller must hold s.mu.
+func (s *FileSink) open() error {
+	if s.file != nil {
+		return nil
+	}
+	f, err := os.OpenFile(s.cfg.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("auditsink: open %s: %w", s.cfg.Path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("auditsink: stat %s: %w", s.cfg.Path, err)
+	}
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+// rotate renames the open file to a backup, opens a new one and removes the
+// oldest backups beyond MaxBackups. Failing to remove a backup does not fail
+// the delivery; it is logged and retried on the next rotation. The caller
+// must hold s.mu.
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("auditsink: close %s: %w", s.cfg.Path, err)
+	}
+	s.file = nil
+
+	backup := s.cfg.Path + "." + s.now().UTC().Format(backupTimeFormat)
+	if err := os.Rename(s.cfg.Path, backup); err != nil {
+		return fmt.Errorf("auditsink: rotate %s: %w", s.cfg.Path, err)
+	}
+	if err := s.open(); err != nil {
+		return err
+	}
+	if err := s.removeOldBackups(); err != nil {
+		s.cfg.Logger.Warn("audit sink backups not removed", "path", s.cfg.Path, "error", err)
+	}
+	return nil
+}
+
+// removeOldBackups keeps the MaxBackups newest rotated files.
+func (s *FileSink) removeOldBackups() error {
+	backups, err := filepath.Glob(s.cfg.Path + ".*")
+	if err != nil {
+		return fmt.Errorf("auditsink: list backups of %s: %w", s.cfg.Path, err)
+	}
+	backups = slices.DeleteFunc(backups, func(name string) bool {
+		_, err := time.Parse(backupTimeFormat, strings.TrimPrefix(name, s.cfg.Path+"."))
+		return err != nil
+	})
+	if len(backups) <= s.cfg.MaxBackups {
+		return nil
+	}
+	slices.Sort(backups)
+	var errs []error
+	for _, name := range backups[:len(backups)-s.cfg.MaxBackups] {
+		if err := os.Remove(name); err != nil {
+			errs = append(errs, fmt.Errorf("auditsink: remove backup: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+var _ domain.AuditSink = (*FileSink)(nil)
@@ -0,0 +1,75 @@
+// Package auditsink streams committed audit events to external systems such
+// as a SIEM.
+//
+// A Dispatcher reads the events of every tenant's hash chain after each
+// sink's cursor (domain.AuditSinkCursorRepository) and hands them to the
+// sink, moving the cursor forward in the same transaction once the sink has
+// accepted them. Because only committed rows are read, an event recorded in
+// a rolled-back transaction is never emitted. Delivery is at least once.
+//
+// Every sink writes the same record format, one JSON object per event (see
+// Record); the file and stdout sinks write NDJSON, the webhook sink sends a
+// signed NDJSON body.
+package auditsink
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// Record is the JSON representation of an audit event written to sinks. It
+// mirrors the audit event API response and adds the tenant. Hashes are hex.
+type Record struct {
+	ID         string          `json:"id"`
+	TenantID   string          `json:"tenantId"`
+	EventType  string          `json:"eventType"`
+	ActorID    string          `json:"actorId,omitempty"`
+	EntityType string          `json:"entityType"`
+	EntityID   string          `json:"entityId"`
+	Payload    json.RawMessage `json:"payload"`
+	Timestamp  time.Time       `json:"timestamp"`
+	RequestID  string          `json:"requestId,omitempty"`
+	ChainSeq   int64           `json:"chainSeq"`
+	PrevHash   string          `json:"prevHash"`
+	Hash       string          `json:"hash"`
+}
+
+// NewRecord converts a delivered audit event to its JSON representation.
+func NewRecord(r domain.AuditSinkRecord) Record {
+	e := r.Event
+	payload := json.RawMessage(e.Payload)
+	if !json.Valid(payload) {
+		payload = json.RawMessage("null")
+	}
+	return Record{
+		ID:         string(e.ID),
+		TenantID:   r.TenantID,
+		EventType:  e.EventType,
+		ActorID:    string(e.ActorID),
+		EntityType: e.EntityType,
+		EntityID:   string(e.EntityID),
+		Payload:    payload,
+		Timestamp:  e.Timestamp.UTC(),
+		RequestID:  e.RequestID,
+		ChainSeq:   e.ChainSeq,
+		PrevHash:   hex.EncodeToString(e.PrevHash),
+		Hash:       hex.EncodeToString(e.Hash),
+	}
+}
+
+// EncodeNDJSON encodes records as newline-delimited JSON, one Record per line.
+func EncodeNDJSON(records []domain.AuditSinkRecord) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, r := range records {
+		if err := enc.Encode(NewRecord(r)); err != nil {
+			return nil, fmt.Errorf("auditsink: encode event %s: %w", r.Event.ID, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,250 @@
+package auditsink
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/resilience"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+var fixedNow = time.Date(2026, 1, 17, 9, 30, 0, 0, time.UTC)
+
+func testRecord(tenantID string, seq int64) domain.AuditSinkRecord {
+	return domain.AuditSinkRecord{
+		TenantID: tenantID,
+		Event: domain.AuditEvent{
+			ID:         domain.ID("019bc5a0-0000-7000-8000-00000000000" + string(rune('0'+seq%10))),
+			EventType:  domain.EventUserCreated,
+			ActorID:    "019bc5a0-0000-7000-8000-0000000000aa",
+			EntityType: "user",
+			EntityID:   "019bc5a0-0000-7000-8000-0000000000bb",
+			Payload:    []byte(`{"email":"[REDACTED]"}`),
+			Timestamp:  fixedNow.In(time.FixedZone("WIB", 7*60*60)),
+			RequestID:  "req-1",
+			ChainSeq:   seq,
+			PrevHash:   bytes.Repeat([]byte{0x01}, domain.AuditChainHashSize),
+			Hash:       bytes.Repeat([]byte{0xab}, domain.AuditChainHashSize),
+		},
+	}
+}
+
+// decodeLines decodes NDJSON into records.
+func decodeLines(t *testing.T, data []byte) []Record {
+	t.Helper()
+	var records []Record
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var r Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+		records = append(records, r)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestEncodeNDJSON(t *testing.T) {
+	invalid := testRecord("acme", 2)
+	invalid.Event.Payload = []byte("not json")
+	invalid.Event.ActorID = ""
+
+	data, err := EncodeNDJSON([]domain.AuditSinkRecord{testRecord("acme", 1), invalid})
+
+	require.NoError(t, err)
+	require.Equal(t, 2, bytes.Count(data, []byte("\n")))
+	records := decodeLines(t, data)
+	require.Len(t, records, 2)
+	assert.Equal(t, Record{
+		ID:         "019bc5a0-0000-7000-8000-000000000001",
+		TenantID:   "acme",
+		EventType:  domain.EventUserCreated,
+		ActorID:    "019bc5a0-0000-7000-8000-0000000000aa",
+		EntityType: "user",
+		EntityID:   "019bc5a0-0000-7000-8000-0000000000bb",
+		Payload:    json.RawMessage(`{"email":"[REDACTED]"}`),
+		Timestamp:  fixedNow,
+		RequestID:  "req-1",
+		ChainSeq:   1,
+		PrevHash:   strings.Repeat("01", domain.AuditChainHashSize),
+		Hash:       strings.Repeat("ab", domain.AuditChainHashSize),
+	}, records[0])
+	assert.JSONEq(t, "null", string(records[1].Payload))
+	assert.Contains(t, string(data), `"timestamp":"2026-01-17T09:30:00Z"`)
+	assert.NotContains(t, strings.Split(string(data), "\n")[1], "actorId")
+}
+
+func TestStdoutSink_Deliver(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewStdoutSink(&buf)
+
+	require.NoError(t, sink.Deliver(context.Background(), []domain.AuditSinkRecord{testRecord("acme", 1)}))
+	require.NoError(t, sink.Deliver(context.Background(), []domain.AuditSinkRecord{testRecord("acme", 2)}))
+
+	assert.Equal(t, StdoutSinkName, sink.Name())
+	records := decodeLines(t, buf.Bytes())
+	require.Len(t, records, 2)
+	assert.Equal(t, int64(1), records[0].ChainSeq)
+	assert.Equal(t, int64(2), records[1].ChainSeq)
+}
+
+func TestNewFileSink_RequiresPath(t *testing.T) {
+	_, err := NewFileSink(FileConfig{Path: " "})
+	assert.Error(t, err)
+}
+
+func TestFileSink_Deliver_Appends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.ndjson")
+	require.NoError(t, os.WriteFile(path, []byte("{}\n"), 0o600))
+	sink, err := NewFileSink(FileConfig{Path: path})
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Deliver(context.Background(), []domain.AuditSinkRecord{testRecord("acme", 1), testRecord("acme", 2)}))
+	require.NoError(t, sink.Close())
+	// Reopened on the next delivery.
+	require.NoError(t, sink.Deliver(context.Background(), []domain.AuditSinkRecord{testRecord("acme", 3)}))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	records := decodeLines(t, data)
+	require.Len(t, records, 4, "existing content is kept")
+	assert.Equal(t, int64(3), records[3].ChainSeq)
+}
+
+func TestFileSink_Deliver_Rotates(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "audit.ndjson")
+	batch := []domain.AuditSinkRecord{testRecord("acme", 1)}
+	line, err := EncodeNDJSON(batch)
+	require.NoError(t, err)
+
+	// Room for two batches per file, keeping two backups.
+	sink, err := NewFileSink(FileConfig{Path: path, MaxBytes: int64(2 * len(line)), MaxBackups: 2})
+	require.NoError(t, err)
+	now := fixedNow
+	sink.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	unrelated := filepath.Join(dir, "audit.ndjson.bak")
+	require.NoError(t, os.WriteFile(unrelated, nil, 0o600))
+
+	for range 7 {
+		require.NoError(t, sink.Deliver(context.Background(), batch))
+	}
+	require.NoError(t, sink.Close())
+
+	matches, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	sort.Strings(matches)
+	// Rotated before the 3rd, 5th and 7th batch.
+	require.Equal(t, []string{
+		path + ".20260117T093002.000000000Z",
+		path + ".20260117T093003.000000000Z",
+		unrelated,
+	}, matches, "the oldest backup is removed, other files are left alone")
+	for _, name := range matches[:2] {
+		data, err := os.ReadFile(name)
+		require.NoError(t, err)
+		assert.Len(t, decodeLines(t, data), 2)
+	}
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Len(t, decodeLines(t, data), 1)
+}
+
+func TestNewWebhookSink_Validation(t *testing.T) {
+	_, err := NewWebhookSink(WebhookConfig{URL: "siem.example.com", Secret: testSecret})
+	assert.ErrorContains(t, err, "URL")
+
+	_, err = NewWebhookSink(WebhookConfig{URL: "https://siem.example.com", Secret: "short"})
+	assert.ErrorContains(t, err, "secret")
+}
+
+func TestWebhookSink_Deliver(t *testing.T) {
+	var (
+		gotBody      []byte
+		gotSignature string
+		gotType      string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotSignature = r.Header.Get(SignatureHeader)
+		gotType = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sink, err := NewWebhookSink(WebhookConfig{URL: server.URL, Secret: testSecret})
+	require.NoError(t, err)
+	sink.now = func() time.Time { return fixedNow }
+
+	err = sink.Deliver(context.Background(), []domain.AuditSinkRecord{testRecord("acme", 1), testRecord("globex", 1)})
+
+	require.NoError(t, err)
+	assert.Equal(t, WebhookSinkName, sink.Name())
+	assert.Equal(t, ContentTypeNDJSON, gotType)
+	records := decodeLines(t, gotBody)
+	require.Len(t, records, 2)
+	assert.Equal(t, "globex", records[1].TenantID)
+
+	// The receiver recomputes the signature over the timestamp and body.
+	ts, sig, ok := strings.Cut(gotSignature, ",v1=")
+	require.True(t, ok)
+	assert.Equal(t, "t=1768642200", ts)
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	mac.Write([]byte("1768642200."))
+	mac.Write(gotBody)
+	got, err := hex.DecodeString(sig)
+	require.NoError(t, err)
+	assert.True(t, hmac.Equal(mac.Sum(nil), got))
+}
+
+func TestWebhookSink_Deliver_Status(t *testing.T) {
+	tests := []struct {
+		status        int
+		wantRetryable bool
+	}{
+		{http.StatusInternalServerError, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusTooManyRequests, true},
+		{http.StatusRequestTimeout, true},
+		{http.StatusBadRequest, false},
+		{http.StatusUnauthorized, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+			sink, err := NewWebhookSink(WebhookConfig{URL: server.URL, Secret: testSecret})
+			require.NoError(t, err)
+
+			err = sink.Deliver(context.Background(), []domain.AuditSinkRecord{testRecord("acme", 1)})
+
+			require.Error(t, err)
+			assert.Equal(t, tt.wantRetryable, resilience.IsRetryable(err))
+		})
+	}
+}
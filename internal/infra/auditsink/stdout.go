@@ -0,0 +1,53 @@
+package auditsink
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// StdoutSinkName is the name of the stdout sink in AUDIT_SINKS.
+const StdoutSinkName = "stdout"
+
+// StdoutSink writes audit events to standard output as NDJSON, for log
+// collectors that ship the container output. Each batch is written with a
+// single Write, so lines are not interleaved with other writers of w that
+// also write whole lines.
+type StdoutSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewStdoutSink creates a sink writing to w, or to os.Stdout if w is nil.
+func NewStdoutSink(w io.Writer) *StdoutSink {
+	if w == nil {
+		w = os.Stdout
+	}
+	return &StdoutSink{w: w}
+}
+
+// Name returns StdoutSinkName.
+func (s *StdoutSink) Name() string {
+	return StdoutSinkName
+}
+
+// Deliver writes records as NDJSON.
+func (s *StdoutSink) Deliver(_ context.Context, records []domain.AuditSinkRecord) error {
+	data, err := EncodeNDJSON(records)
+	if err != nil {
+		return permanent(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.w.Write(data); err != nil {
+		return fmt.Errorf("auditsink: write stdout: %w", err)
+	}
+	return nil
+}
+
+var _ domain.AuditSink = (*StdoutSink)(nil)
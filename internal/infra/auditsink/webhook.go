@@ -0,0 +1,127 @@
+package auditsink
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+const (
+	// WebhookSinkName is the name of the webhook sink in AUDIT_SINKS.
+	WebhookSinkName = "webhook"
+	// DefaultWebhookTimeout bounds a single webhook request by default.
+	DefaultWebhookTimeout = 10 * time.Second
+	// SignatureHeader carries the signature of a webhook request.
+	SignatureHeader = "X-Audit-Signature"
+	// ContentTypeNDJSON is the content type of webhook request bodies.
+	ContentTypeNDJSON = "application/x-ndjson"
+	// MinWebhookSecretLength is the minimum length of the signing secret.
+	MinWebhookSecretLength = 32
+)
+
+// WebhookConfig holds configuration for a WebhookSink.
+type WebhookConfig struct {
+	// URL receives the events. Required.
+	URL string
+	// Secret signs each request (see WebhookSink). Required, at least
+	// MinWebhookSecretLength bytes.
+	Secret string
+	// HTTPClient calls URL. Default: a client with DefaultWebhookTimeout.
+	HTTPClient *http.Client
+}
+
+// WebhookSink POSTs each batch of audit events to a URL as an NDJSON body.
+//
+// Requests carry a SignatureHeader of the form "t=<unix seconds>,v1=<hex>",
+// where v1 is the HMAC-SHA256 with the secret of the timestamp, a dot and
+// the body. Receivers should recompute it, compare in constant time and
+// reject stale timestamps to prevent replays.
+//
+// Any 2xx status accepts the batch. 408, 429 and 5xx are retried; other
+// statuses are not, but the batch is delivered again on the next run.
+type WebhookSink struct {
+	url        string
+	secret     []byte
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewWebhookSink creates a sink for the configured URL.
+func NewWebhookSink(cfg WebhookConfig) (*WebhookSink, error) {
+	parsed, err := url.Parse(cfg.URL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return nil, errors.New("auditsink: webhook URL must be an absolute http(s) URL")
+	}
+	if len(cfg.Secret) < MinWebhookSecretLength {
+		return nil, fmt.Errorf("auditsink: webhook secret must be at least %d bytes", MinWebhookSecretLength)
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: DefaultWebhookTimeout}
+	}
+	return &WebhookSink{
+		url:        cfg.URL,
+		secret:     []byte(cfg.Secret),
+		httpClient: cfg.HTTPClient,
+		now:        time.Now,
+	}, nil
+}
+
+// Name returns WebhookSinkName.
+func (s *WebhookSink) Name() string {
+	return WebhookSinkName
+}
+
+// Deliver sends records in one signed request.
+func (s *WebhookSink) Deliver(ctx context.Context, records []domain.AuditSinkRecord) error {
+	body, err := EncodeNDJSON(records)
+	if err != nil {
+		return permanent(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return permanent(fmt.Errorf("auditsink: webhook: %w", err))
+	}
+	req.Header.Set("Content-Type", ContentTypeNDJSON)
+	req.Header.Set(SignatureHeader, Sign(s.secret, s.now(), body))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("auditsink: webhook request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	err = fmt.Errorf("auditsink: webhook: unexpected status %d", resp.StatusCode)
+	if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusRequestTimeout {
+		return err
+	}
+	return permanent(err)
+}
+
+// Sign returns the SignatureHeader value for body sent at t.
+func Sign(secret []byte, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+var _ domain.AuditSink = (*WebhookSink)(nil)
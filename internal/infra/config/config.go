@@ -4,6 +4,7 @@ package config
 import (
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -48,12 +49,98 @@ type Config struct {
 	JWTEnabled bool `envconfig:"JWT_ENABLED" default:"false"`
 	// JWTSecret is the secret key for JWT signing (required if JWTEnabled=true).
 	JWTSecret string `envconfig:"JWT_SECRET"`
+	// JWTSecretKID is the kid issuers put in the header of tokens signed with JWTSecret (optional).
+	// Required when JWTPreviousKeys is set.
+	JWTSecretKID string `envconfig:"JWT_SECRET_KID"`
+	// JWTPreviousKeys lists HS256 secrets still accepted after a rotation, as comma-separated
+	// "<kid>:<secret>@<not-after RFC 3339>" entries, in the order they are tried (optional).
+	// Each key stops verifying tokens after its not-after time. See JWTKeyring.
+	JWTPreviousKeys string `envconfig:"JWT_PREVIOUS_KEYS"`
+	// JWTRevocationCacheTTL is how long the token denylist is cached before it is reloaded,
+	// i.e. how long a revocation made on another instance takes to apply. Default: 10s.
+	JWTRevocationCacheTTL time.Duration `envconfig:"JWT_REVOCATION_CACHE_TTL" default:"10s"`
+	// JWTRevocationRetention is how long a revocation is kept when the revoked tokens' expiry is
+	// not given; set it to at least the longest token lifetime. Default: 24h.
+	JWTRevocationRetention time.Duration `envconfig:"JWT_REVOCATION_RETENTION" default:"24h"`
+	// JWTRevocationCleanupInterval is the interval between runs of the expired revocation cleanup. Default: 1h.
+	JWTRevocationCleanupInterval time.Duration `envconfig:"JWT_REVOCATION_CLEANUP_INTERVAL" default:"1h"`
 	// JWTIssuer is the expected issuer claim (optional).
 	JWTIssuer string `envconfig:"JWT_ISSUER"`
 	// JWTAudience is the expected audience claim (optional).
 	JWTAudience string `envconfig:"JWT_AUDIENCE"`
 	// JWTClockSkew is the tolerance for expired tokens (optional). Default: 0s.
 	JWTClockSkew time.Duration `envconfig:"JWT_CLOCK_SKEW" default:"0s"`
+	// JWTDefaultTenantID is the tenant of tokens without a tenant_id claim (optional),
+	// for single-tenant deployments. When empty, such tokens are rejected with 401.
+	JWTDefaultTenantID string `envconfig:"JWT_DEFAULT_TENANT_ID"`
+	// JWTJWKSURL is the URL of a JWKS document with the identity provider's public keys (optional).
+	// When it or JWTJWKSFile is set, tokens are verified with RS256, ES256 or EdDSA
+	// by their kid header instead of HS256 with JWTSecret.
+	JWTJWKSURL string `envconfig:"JWT_JWKS_URL"`
+	// JWTJWKSFile is the path of a local JWKS document (optional, alternative to JWTJWKSURL).
+	JWTJWKSFile string `envconfig:"JWT_JWKS_FILE"`
+	// JWTJWKSRefreshInterval is how often the JWKS document is reloaded. Default: 10m.
+	JWTJWKSRefreshInterval time.Duration `envconfig:"JWT_JWKS_REFRESH_INTERVAL" default:"10m"`
+	// JWTIntrospectionURL is the RFC 7662 introspection endpoint of an authorization server
+	// issuing opaque tokens (optional). When set, bearer tokens are validated by introspection
+	// instead of being verified as JWTs; JWT_ISSUER, JWT_AUDIENCE and JWT_CLOCK_SKEW do not apply.
+	JWTIntrospectionURL string `envconfig:"JWT_INTROSPECTION_URL"`
+	// JWTIntrospectionClientID authenticates this service to the introspection endpoint (optional).
+	JWTIntrospectionClientID string `envconfig:"JWT_INTROSPECTION_CLIENT_ID"`
+	// JWTIntrospectionClientSecret is the secret of JWTIntrospectionClientID.
+	JWTIntrospectionClientSecret string `envconfig:"JWT_INTROSPECTION_CLIENT_SECRET"`
+	// JWTIntrospectionCacheSize caps the number of active tokens cached until their expiry. Default: 10000.
+	JWTIntrospectionCacheSize int `envconfig:"JWT_INTROSPECTION_CACHE_SIZE" default:"10000"`
+
+	// OAuth 2.0 token endpoint
+	// OAuthEnabled enables POST /oauth/token (client_credentials grant) and client registration.
+	// Tokens are signed with JWT_SECRET, so it requires JWT_ENABLED without a JWKS source. Default: false.
+	OAuthEnabled bool `envconfig:"OAUTH_ENABLED" default:"false"`
+	// OAuthTokenTTL is the lifetime of issued access tokens. Default: 15m.
+	// It may not exceed JWT_REVOCATION_RETENTION so a revoked jti outlives the token.
+	OAuthTokenTTL time.Duration `envconfig:"OAUTH_TOKEN_TTL" default:"15m"`
+
+	// API keys
+	// APIKeysEnabled accepts API keys (X-API-Key or Authorization: ApiKey) on
+	// protected routes and enables the admin key endpoints. Requires JWT_ENABLED. Default: false.
+	APIKeysEnabled bool `envconfig:"API_KEYS_ENABLED" default:"false"`
+	// APIKeyRotationGrace is how long a rotated key keeps working. Default: 24h.
+	APIKeyRotationGrace time.Duration `envconfig:"API_KEY_ROTATION_GRACE" default:"24h"`
+	// APIKeyLastUsedInterval is how often a key's last-used timestamp is written. Default: 1m.
+	APIKeyLastUsedInterval time.Duration `envconfig:"API_KEY_LAST_USED_INTERVAL" default:"1m"`
+
+	// Authentication lockout
+	// AuthLockoutEnabled locks out client IPs and subjects after repeated
+	// authentication failures (tokens, API keys, OAuth client secrets). Default: false.
+	AuthLockoutEnabled bool `envconfig:"AUTH_LOCKOUT_ENABLED" default:"false"`
+	// AuthLockoutMaxFailures is the number of failures within AUTH_LOCKOUT_WINDOW
+	// that locks out a client IP or subject. Default: 5.
+	AuthLockoutMaxFailures int `envconfig:"AUTH_LOCKOUT_MAX_FAILURES" default:"5"`
+	// AuthLockoutWindow is the period failures are counted in. Default: 15m.
+	AuthLockoutWindow time.Duration `envconfig:"AUTH_LOCKOUT_WINDOW" default:"15m"`
+	// AuthLockoutDuration is the first lockout; each consecutive lockout
+	// doubles it. Default: 1m.
+	AuthLockoutDuration time.Duration `envconfig:"AUTH_LOCKOUT_DURATION" default:"1m"`
+	// AuthLockoutMaxDuration caps the lockout duration. Default: 1h.
+	AuthLockoutMaxDuration time.Duration `envconfig:"AUTH_LOCKOUT_MAX_DURATION" default:"1h"`
+	// AuthLockoutMaxEntries caps the client IPs and subjects tracked at once
+	// per instance. Default: 100000.
+	AuthLockoutMaxEntries int `envconfig:"AUTH_LOCKOUT_MAX_ENTRIES" default:"100000"`
+
+	// Authorization
+	// RolePermissions grants permissions to roles, as semicolon-separated
+	// "<role>=<permission>,<permission>" entries, e.g.
+	// "auditor=audit:read,users:read;user=users:read:self" (optional). A listed
+	// role's permissions replace its built-in ones; other roles keep theirs.
+	// See RolePermissionOverrides.
+	RolePermissions string `envconfig:"ROLE_PERMISSIONS"`
+	// AuthAllowUnscoped lets credentials without scope claims (tokens issued
+	// without scope or scp, and API keys and OAuth clients created before they
+	// had scopes) pass every route's scope check, leaving them limited by role
+	// permissions only. Default: false, such credentials get 403 on every route
+	// requiring a scope. API keys and OAuth clients carry the scopes granted
+	// when they are created.
+	AuthAllowUnscoped bool `envconfig:"AUTH_ALLOW_UNSCOPED" default:"false"`
 
 	// Rate Limiting
 	// RateLimitRPS is the rate limit in requests per second. Default: 100.
@@ -95,6 +182,37 @@ type Config struct {
 	// AuditRedactEmail controls how email addresses are redacted in audit logs.
 	// Options: "full" (default, replaces with [REDACTED]) or "partial" (shows first 2 chars + domain).
 	AuditRedactEmail string `envconfig:"AUDIT_REDACT_EMAIL" default:"full"`
+	// AuditRetention is how long audit events are kept before their monthly
+	// partition is detached or dropped. Default: 0 (keep forever).
+	AuditRetention time.Duration `envconfig:"AUDIT_RETENTION" default:"0s"`
+	// AuditRetentionAction is what happens to partitions past AUDIT_RETENTION:
+	// "detach" (default, kept as a standalone table for archiving) or "drop".
+	AuditRetentionAction string `envconfig:"AUDIT_RETENTION_ACTION" default:"detach"`
+	// AuditPartitionPremake is the number of monthly partitions created ahead
+	// of the current month. Default: 3.
+	AuditPartitionPremake int `envconfig:"AUDIT_PARTITION_PREMAKE" default:"3"`
+	// AuditPartitionMaintenanceInterval is the interval between partition maintenance runs. Default: 1h.
+	AuditPartitionMaintenanceInterval time.Duration `envconfig:"AUDIT_PARTITION_MAINTENANCE_INTERVAL" default:"1h"`
+	// AuditSinks is a comma-separated list of sinks committed audit events are
+	// streamed to: "stdout", "file" and/or "webhook". Default: empty (disabled).
+	AuditSinks string `envconfig:"AUDIT_SINKS"`
+	// AuditSinkFilePath is the NDJSON file of the file sink. Required with "file".
+	AuditSinkFilePath string `envconfig:"AUDIT_SINK_FILE_PATH"`
+	// AuditSinkFileMaxBytes is the size at which the file is rotated. Default: 100MB.
+	AuditSinkFileMaxBytes int64 `envconfig:"AUDIT_SINK_FILE_MAX_BYTES" default:"104857600"`
+	// AuditSinkFileMaxBackups is the number of rotated files kept. Default: 5.
+	AuditSinkFileMaxBackups int `envconfig:"AUDIT_SINK_FILE_MAX_BACKUPS" default:"5"`
+	// AuditSinkWebhookURL receives batches of events. Required with "webhook".
+	AuditSinkWebhookURL string `envconfig:"AUDIT_SINK_WEBHOOK_URL"`
+	// AuditSinkWebhookSecret signs webhook requests (HMAC-SHA256). Required
+	// with "webhook", at least 32 bytes.
+	AuditSinkWebhookSecret string `envconfig:"AUDIT_SINK_WEBHOOK_SECRET"`
+	// AuditSinkWebhookTimeout bounds a single webhook request. Default: 10s.
+	AuditSinkWebhookTimeout time.Duration `envconfig:"AUDIT_SINK_WEBHOOK_TIMEOUT" default:"10s"`
+	// AuditSinkPollInterval is the time between checks for new events. Default: 1s.
+	AuditSinkPollInterval time.Duration `envconfig:"AUDIT_SINK_POLL_INTERVAL" default:"1s"`
+	// AuditSinkBatchSize is the maximum number of events delivered at a time. Default: 100.
+	AuditSinkBatchSize int `envconfig:"AUDIT_SINK_BATCH_SIZE" default:"100"`
 
 	// Resilience - Circuit Breaker
 	// CBMaxRequests is the number of requests allowed in the half-open state. Default: 3.
@@ -142,6 +260,31 @@ type Config struct {
 	// IdempotencyCleanupInterval is the interval between cleanup job runs. Default: 1h.
 	IdempotencyCleanupInterval time.Duration `envconfig:"IDEMPOTENCY_CLEANUP_INTERVAL" default:"1h"`
 
+	// User Lifecycle
+	// UserPurgeRetention is how long soft-deleted users are kept before they can be purged. Default: 720h (30 days).
+	UserPurgeRetention time.Duration `envconfig:"USER_PURGE_RETENTION" default:"720h"`
+	// UserBatchMaxItems is the maximum number of items per POST /users:batchCreate. Default: 500.
+	UserBatchMaxItems int `envconfig:"USER_BATCH_MAX_ITEMS" default:"500"`
+	// UserExportMaxDuration is the longest a GET /users:export may stream before it is
+	// aborted. Each flush is still bounded by HTTPWriteTimeout. Default: 30m.
+	UserExportMaxDuration time.Duration `envconfig:"USER_EXPORT_MAX_DURATION" default:"30m"`
+	// UserImportMaxSize is the maximum CSV upload size of POST /users:import in bytes,
+	// replacing MaxRequestSize for that route. Default: 10MB (10485760 bytes).
+	UserImportMaxSize int64 `envconfig:"USER_IMPORT_MAX_SIZE" default:"10485760"`
+	// UserImportChunkSize is the number of CSV rows a user import creates per transaction. Default: 100.
+	UserImportChunkSize int `envconfig:"USER_IMPORT_CHUNK_SIZE" default:"100"`
+	// UserImportPollInterval is how often the import worker looks for queued jobs. Default: 5s.
+	UserImportPollInterval time.Duration `envconfig:"USER_IMPORT_POLL_INTERVAL" default:"5s"`
+	// UserImportLease is how long a worker may hold an import job without saving
+	// progress before another instance may resume it. Default: 5m.
+	UserImportLease time.Duration `envconfig:"USER_IMPORT_LEASE" default:"5m"`
+
+	// Pagination
+	// CursorSigningKey is the HMAC key for opaque list pagination cursors (min 32 bytes).
+	// If empty, a random key is generated at startup, so cursors do not survive
+	// restarts and are not portable across replicas.
+	CursorSigningKey string `envconfig:"CURSOR_SIGNING_KEY"`
+
 	// Health Check (Story 3.4)
 	// HealthCheckDBTimeout is the timeout for database health check. Default: 2s.
 	HealthCheckDBTimeout time.Duration `envconfig:"HEALTH_CHECK_DB_TIMEOUT" default:"2s"`
@@ -152,6 +295,18 @@ func (c *Config) Redacted() string {
 	safe := *c
 	safe.DatabaseURL = "[REDACTED]"
 	safe.JWTSecret = "[REDACTED]"
+	if safe.JWTPreviousKeys != "" {
+		safe.JWTPreviousKeys = "[REDACTED]"
+	}
+	if safe.JWTIntrospectionClientSecret != "" {
+		safe.JWTIntrospectionClientSecret = "[REDACTED]"
+	}
+	if safe.CursorSigningKey != "" {
+		safe.CursorSigningKey = "[REDACTED]"
+	}
+	if safe.AuditSinkWebhookSecret != "" {
+		safe.AuditSinkWebhookSecret = "[REDACTED]"
+	}
 	return fmt.Sprintf("%+v", safe)
 }
 
@@ -205,7 +360,14 @@ func (c *Config) Validate() error {
 	c.Env = strings.ToLower(strings.TrimSpace(c.Env))
 	// Fix: Normalize JWTSecret by trimming whitespace and updating the struct
 	c.JWTSecret = strings.TrimSpace(c.JWTSecret)
+	c.JWTSecretKID = strings.TrimSpace(c.JWTSecretKID)
+	c.JWTPreviousKeys = strings.TrimSpace(c.JWTPreviousKeys)
+	c.JWTJWKSURL = strings.TrimSpace(c.JWTJWKSURL)
+	c.JWTJWKSFile = strings.TrimSpace(c.JWTJWKSFile)
+	c.JWTIntrospectionURL = strings.TrimSpace(c.JWTIntrospectionURL)
+	c.JWTIntrospectionClientID = strings.TrimSpace(c.JWTIntrospectionClientID)
 	c.AuditRedactEmail = strings.ToLower(strings.TrimSpace(c.AuditRedactEmail))
+	c.AuditRetentionAction = strings.ToLower(strings.TrimSpace(c.AuditRetentionAction))
 
 	switch c.Env {
 	case "development", "staging", "production", "test":
@@ -235,18 +397,25 @@ func (c *Config) Validate() error {
 		}
 		// The generic check below will ensure JWTSecret is set and valid,
 		// but we prefer a specific error message for production empty secret.
-		if c.JWTSecret == "" {
-			return fmt.Errorf("ENV=production requires JWT_SECRET to be set")
+		if c.JWTSecret == "" && !c.UsesJWKS() && !c.UsesIntrospection() {
+			return fmt.Errorf("ENV=production requires JWT_SECRET to be set (or JWT_JWKS_URL / JWT_JWKS_FILE / JWT_INTROSPECTION_URL)")
 		}
 	}
 
-	if c.JWTEnabled {
-		if c.JWTSecret == "" {
-			return fmt.Errorf("JWT_ENABLED is true but JWT_SECRET is empty")
-		}
-		if len(c.JWTSecret) < 32 {
-			return fmt.Errorf("JWT_SECRET must be at least 32 bytes when JWT_ENABLED is true")
-		}
+	if err := c.validateJWT(); err != nil {
+		return err
+	}
+	if err := c.validateOAuth(); err != nil {
+		return err
+	}
+	if err := c.validateAPIKeys(); err != nil {
+		return err
+	}
+	if err := c.validateAuthLockout(); err != nil {
+		return err
+	}
+	if _, err := c.RolePermissionOverrides(); err != nil {
+		return err
 	}
 
 	if c.RateLimitRPS < 1 {
@@ -258,6 +427,23 @@ func (c *Config) Validate() error {
 	default:
 		return fmt.Errorf("invalid AUDIT_REDACT_EMAIL: must be 'full' or 'partial'")
 	}
+	if c.AuditRetention < 0 {
+		return fmt.Errorf("invalid AUDIT_RETENTION: must be non-negative")
+	}
+	switch c.AuditRetentionAction {
+	case "detach", "drop":
+	default:
+		return fmt.Errorf("invalid AUDIT_RETENTION_ACTION: must be 'detach' or 'drop'")
+	}
+	if c.AuditPartitionPremake < 1 {
+		return fmt.Errorf("invalid AUDIT_PARTITION_PREMAKE: must be greater than 0")
+	}
+	if c.AuditPartitionMaintenanceInterval <= 0 {
+		return fmt.Errorf("invalid AUDIT_PARTITION_MAINTENANCE_INTERVAL: must be greater than 0")
+	}
+	if err := c.validateAuditSinks(); err != nil {
+		return err
+	}
 
 	// Story 5.1: Database Pool Validation
 	if c.DBPoolMaxConns < 1 {
@@ -289,9 +475,350 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid SHUTDOWN_GRACE_PERIOD: must be non-negative")
 	}
 
+	if c.UserPurgeRetention <= 0 {
+		return fmt.Errorf("invalid USER_PURGE_RETENTION: must be greater than 0")
+	}
+	if c.UserBatchMaxItems < 1 || c.UserBatchMaxItems > 1000 {
+		return fmt.Errorf("invalid USER_BATCH_MAX_ITEMS: must be between 1 and 1000")
+	}
+	if c.UserExportMaxDuration <= 0 {
+		return fmt.Errorf("invalid USER_EXPORT_MAX_DURATION: must be greater than 0")
+	}
+	if c.UserImportMaxSize < 1 {
+		return fmt.Errorf("invalid USER_IMPORT_MAX_SIZE: must be greater than 0")
+	}
+	if c.UserImportChunkSize < 1 || c.UserImportChunkSize > 1000 {
+		return fmt.Errorf("invalid USER_IMPORT_CHUNK_SIZE: must be between 1 and 1000")
+	}
+	if c.UserImportPollInterval <= 0 {
+		return fmt.Errorf("invalid USER_IMPORT_POLL_INTERVAL: must be greater than 0")
+	}
+	if c.UserImportLease <= 0 {
+		return fmt.Errorf("invalid USER_IMPORT_LEASE: must be greater than 0")
+	}
+	if c.JWTRevocationCacheTTL <= 0 {
+		return fmt.Errorf("invalid JWT_REVOCATION_CACHE_TTL: must be greater than 0")
+	}
+	if c.JWTRevocationRetention <= 0 {
+		return fmt.Errorf("invalid JWT_REVOCATION_RETENTION: must be greater than 0")
+	}
+	if c.JWTRevocationCleanupInterval <= 0 {
+		return fmt.Errorf("invalid JWT_REVOCATION_CLEANUP_INTERVAL: must be greater than 0")
+	}
+
+	c.CursorSigningKey = strings.TrimSpace(c.CursorSigningKey)
+	if c.CursorSigningKey != "" && len(c.CursorSigningKey) < 32 {
+		return fmt.Errorf("CURSOR_SIGNING_KEY must be at least 32 bytes when set")
+	}
+
 	return nil
 }
 
+// validateJWT checks the token verification settings. With an introspection
+// endpoint or a JWKS source, JWT_SECRET is not used and therefore not required.
+func (c *Config) validateJWT() error {
+	if c.JWTJWKSURL != "" && c.JWTJWKSFile != "" {
+		return fmt.Errorf("JWT_JWKS_URL and JWT_JWKS_FILE are mutually exclusive")
+	}
+	if c.UsesIntrospection() {
+		return c.validateIntrospection()
+	}
+	if c.UsesJWKS() {
+		if c.JWTJWKSRefreshInterval <= 0 {
+			return fmt.Errorf("invalid JWT_JWKS_REFRESH_INTERVAL: must be greater than 0")
+		}
+		if c.JWTJWKSURL != "" {
+			parsed, err := url.Parse(c.JWTJWKSURL)
+			if err != nil {
+				return fmt.Errorf("invalid JWT_JWKS_URL: %w", err)
+			}
+			if (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+				return fmt.Errorf("invalid JWT_JWKS_URL: must be an absolute http(s) URL")
+			}
+			// Keys fetched over plain HTTP could be swapped by anyone on the path.
+			if c.Env == "production" && parsed.Scheme != "https" {
+				return fmt.Errorf("ENV=production requires JWT_JWKS_URL to use https")
+			}
+		}
+		if c.JWTPreviousKeys != "" {
+			return fmt.Errorf("JWT_PREVIOUS_KEYS cannot be combined with JWT_JWKS_URL or JWT_JWKS_FILE")
+		}
+		return nil
+	}
+
+	if c.JWTEnabled {
+		if c.JWTSecret == "" {
+			return fmt.Errorf("JWT_ENABLED is true but JWT_SECRET is empty")
+		}
+		if len(c.JWTSecret) < 32 {
+			return fmt.Errorf("JWT_SECRET must be at least 32 bytes when JWT_ENABLED is true")
+		}
+	}
+	if c.JWTPreviousKeys != "" && c.JWTSecretKID == "" {
+		return fmt.Errorf("JWT_PREVIOUS_KEYS requires JWT_SECRET_KID to be set")
+	}
+	_, err := c.JWTKeyring()
+	return err
+}
+
+// validateIntrospection checks the token introspection settings, which replace
+// JWT verification altogether.
+func (c *Config) validateIntrospection() error {
+	if c.UsesJWKS() {
+		return fmt.Errorf("JWT_INTROSPECTION_URL cannot be combined with JWT_JWKS_URL or JWT_JWKS_FILE")
+	}
+	if c.JWTPreviousKeys != "" {
+		return fmt.Errorf("JWT_PREVIOUS_KEYS cannot be combined with JWT_INTROSPECTION_URL")
+	}
+	parsed, err := url.Parse(c.JWTIntrospectionURL)
+	if err != nil {
+		return fmt.Errorf("invalid JWT_INTROSPECTION_URL: %w", err)
+	}
+	if (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return fmt.Errorf("invalid JWT_INTROSPECTION_URL: must be an absolute http(s) URL")
+	}
+	// Tokens and client credentials sent over plain HTTP could be read on the path.
+	if c.Env == "production" && parsed.Scheme != "https" {
+		return fmt.Errorf("ENV=production requires JWT_INTROSPECTION_URL to use https")
+	}
+	if c.JWTIntrospectionClientSecret != "" && c.JWTIntrospectionClientID == "" {
+		return fmt.Errorf("JWT_INTROSPECTION_CLIENT_SECRET requires JWT_INTROSPECTION_CLIENT_ID to be set")
+	}
+	if c.JWTIntrospectionCacheSize <= 0 {
+		return fmt.Errorf("invalid JWT_INTROSPECTION_CACHE_SIZE: must be greater than 0")
+	}
+	return nil
+}
+
+// validateAuditSinks checks the settings of the sinks in AUDIT_SINKS.
+func (c *Config) validateAuditSinks() error {
+	sinks, err := c.AuditSinkNames()
+	if err != nil {
+		return err
+	}
+	if len(sinks) == 0 {
+		return nil
+	}
+	if c.AuditSinkPollInterval <= 0 {
+		return fmt.Errorf("invalid AUDIT_SINK_POLL_INTERVAL: must be greater than 0")
+	}
+	if c.AuditSinkBatchSize < 1 || c.AuditSinkBatchSize > 1000 {
+		return fmt.Errorf("invalid AUDIT_SINK_BATCH_SIZE: must be between 1 and 1000")
+	}
+	for _, sink := range sinks {
+		switch sink {
+		case "file":
+			if strings.TrimSpace(c.AuditSinkFilePath) == "" {
+				return fmt.Errorf("AUDIT_SINKS=file requires AUDIT_SINK_FILE_PATH to be set")
+			}
+			if c.AuditSinkFileMaxBytes <= 0 {
+				return fmt.Errorf("invalid AUDIT_SINK_FILE_MAX_BYTES: must be greater than 0")
+			}
+			if c.AuditSinkFileMaxBackups < 1 {
+				return fmt.Errorf("invalid AUDIT_SINK_FILE_MAX_BACKUPS: must be greater than 0")
+			}
+		case "webhook":
+			parsed, err := url.Parse(c.AuditSinkWebhookURL)
+			if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+				return fmt.Errorf("invalid AUDIT_SINK_WEBHOOK_URL: must be an absolute http(s) URL")
+			}
+			// Audit events sent over plain HTTP could be read on the path.
+			if c.Env == "production" && parsed.Scheme != "https" {
+				return fmt.Errorf("ENV=production requires AUDIT_SINK_WEBHOOK_URL to use https")
+			}
+			if len(c.AuditSinkWebhookSecret) < 32 {
+				return fmt.Errorf("AUDIT_SINKS=webhook requires AUDIT_SINK_WEBHOOK_SECRET of at least 32 bytes")
+			}
+			if c.AuditSinkWebhookTimeout <= 0 {
+				return fmt.Errorf("invalid AUDIT_SINK_WEBHOOK_TIMEOUT: must be greater than 0")
+			}
+		}
+	}
+	return nil
+}
+
+// AuditSinkNames parses AUDIT_SINKS into the names of the enabled sinks, in
+// order and without duplicates.
+func (c *Config) AuditSinkNames() ([]string, error) {
+	var names []string
+	for _, entry := range strings.Split(c.AuditSinks, ",") {
+		name := strings.ToLower(strings.TrimSpace(entry))
+		if name == "" || slices.Contains(names, name) {
+			continue
+		}
+		switch name {
+		case "stdout", "file", "webhook":
+		default:
+			return nil, fmt.Errorf("invalid AUDIT_SINKS: unknown sink %q (must be stdout, file or webhook)", name)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// validateOAuth checks the token endpoint settings. Issued tokens must be
+// verifiable by JWTAuth, which only holds an HS256 secret without JWKS or
+// introspection.
+func (c *Config) validateOAuth() error {
+	if !c.OAuthEnabled {
+		return nil
+	}
+	if !c.JWTEnabled {
+		return fmt.Errorf("OAUTH_ENABLED requires JWT_ENABLED=true")
+	}
+	if c.UsesJWKS() {
+		return fmt.Errorf("OAUTH_ENABLED cannot be combined with JWT_JWKS_URL or JWT_JWKS_FILE")
+	}
+	if c.UsesIntrospection() {
+		return fmt.Errorf("OAUTH_ENABLED cannot be combined with JWT_INTROSPECTION_URL")
+	}
+	if c.OAuthTokenTTL <= 0 {
+		return fmt.Errorf("invalid OAUTH_TOKEN_TTL: must be greater than 0")
+	}
+	if c.JWTRevocationRetention > 0 && c.OAuthTokenTTL > c.JWTRevocationRetention {
+		return fmt.Errorf("invalid OAUTH_TOKEN_TTL: must not exceed JWT_REVOCATION_RETENTION")
+	}
+	return nil
+}
+
+// validateAPIKeys checks the API key settings. Keys authenticate protected
+// routes, which are only mounted with JWT authentication enabled.
+func (c *Config) validateAPIKeys() error {
+	if !c.APIKeysEnabled {
+		return nil
+	}
+	if !c.JWTEnabled {
+		return fmt.Errorf("API_KEYS_ENABLED requires JWT_ENABLED=true")
+	}
+	if c.APIKeyRotationGrace < 0 {
+		return fmt.Errorf("invalid API_KEY_ROTATION_GRACE: must not be negative")
+	}
+	if c.APIKeyLastUsedInterval <= 0 {
+		return fmt.Errorf("invalid API_KEY_LAST_USED_INTERVAL: must be greater than 0")
+	}
+	return nil
+}
+
+// validateAuthLockout checks the authentication lockout settings.
+func (c *Config) validateAuthLockout() error {
+	if !c.AuthLockoutEnabled {
+		return nil
+	}
+	if c.AuthLockoutMaxFailures < 1 {
+		return fmt.Errorf("invalid AUTH_LOCKOUT_MAX_FAILURES: must be greater than 0")
+	}
+	if c.AuthLockoutWindow <= 0 {
+		return fmt.Errorf("invalid AUTH_LOCKOUT_WINDOW: must be greater than 0")
+	}
+	if c.AuthLockoutDuration <= 0 {
+		return fmt.Errorf("invalid AUTH_LOCKOUT_DURATION: must be greater than 0")
+	}
+	if c.AuthLockoutMaxDuration < c.AuthLockoutDuration {
+		return fmt.Errorf("invalid AUTH_LOCKOUT_MAX_DURATION: must not be less than AUTH_LOCKOUT_DURATION")
+	}
+	if c.AuthLockoutMaxEntries < 1 {
+		return fmt.Errorf("invalid AUTH_LOCKOUT_MAX_ENTRIES: must be greater than 0")
+	}
+	return nil
+}
+
+// JWTKey is one HS256 secret of the JWT keyring.
+type JWTKey struct {
+	// ID is the kid of tokens signed with the key.
+	ID string
+	// Secret is the HMAC key.
+	Secret string
+	// NotAfter is when the key stops verifying tokens. Zero for the primary key.
+	NotAfter time.Time
+}
+
+// JWTKeyring returns the HS256 keys in the order they are tried: JWT_SECRET
+// first, then JWT_PREVIOUS_KEYS. Previous keys must have a unique kid, a
+// secret of at least 32 bytes and a not-after time; a key past its not-after
+// time is kept, as the middleware ignores it.
+func (c *Config) JWTKeyring() ([]JWTKey, error) {
+	keys := []JWTKey{{ID: c.JWTSecretKID, Secret: c.JWTSecret}}
+	if c.JWTPreviousKeys == "" {
+		return keys, nil
+	}
+
+	seen := map[string]bool{c.JWTSecretKID: true}
+	for i, entry := range strings.Split(c.JWTPreviousKeys, ",") {
+		key, err := parseJWTKey(strings.TrimSpace(entry))
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWT_PREVIOUS_KEYS entry %d: %w", i+1, err)
+		}
+		if seen[key.ID] {
+			return nil, fmt.Errorf("invalid JWT_PREVIOUS_KEYS entry %d: duplicate kid %q", i+1, key.ID)
+		}
+		seen[key.ID] = true
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// parseJWTKey parses a "<kid>:<secret>@<not-after>" entry. The kid ends at the
+// first colon and the secret at the last @, so base64 secrets need no escaping.
+func parseJWTKey(entry string) (JWTKey, error) {
+	kid, rest, ok := strings.Cut(entry, ":")
+	at := strings.LastIndex(rest, "@")
+	if !ok || at < 0 {
+		return JWTKey{}, fmt.Errorf("must have the form <kid>:<secret>@<not-after>")
+	}
+	key := JWTKey{ID: strings.TrimSpace(kid), Secret: rest[:at]}
+	if key.ID == "" {
+		return JWTKey{}, fmt.Errorf("kid must not be empty")
+	}
+	if len(key.Secret) < 32 {
+		return JWTKey{}, fmt.Errorf("secret for kid %q must be at least 32 bytes", key.ID)
+	}
+	notAfter, err := time.Parse(time.RFC3339, rest[at+1:])
+	if err != nil {
+		return JWTKey{}, fmt.Errorf("not-after for kid %q must be an RFC 3339 time", key.ID)
+	}
+	key.NotAfter = notAfter
+	return key, nil
+}
+
+// RolePermissionOverrides returns the permission names ROLE_PERMISSIONS grants
+// by lower-cased role. A role listed without permissions is granted none.
+// Permission names are checked against the registry when the authorizer is
+// built.
+func (c *Config) RolePermissionOverrides() (map[string][]string, error) {
+	overrides := make(map[string][]string)
+	for i, entry := range strings.Split(c.RolePermissions, ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		role, perms, ok := strings.Cut(entry, "=")
+		role = strings.ToLower(strings.TrimSpace(role))
+		if !ok || role == "" {
+			return nil, fmt.Errorf("invalid ROLE_PERMISSIONS entry %d: must have the form <role>=<permission>,...", i+1)
+		}
+		if _, dup := overrides[role]; dup {
+			return nil, fmt.Errorf("invalid ROLE_PERMISSIONS entry %d: duplicate role %q", i+1, role)
+		}
+		names := []string{}
+		for _, name := range strings.Split(perms, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+		overrides[role] = names
+	}
+	return overrides, nil
+}
+
+// UsesJWKS reports whether tokens are verified with keys from a JWKS document.
+func (c *Config) UsesJWKS() bool {
+	return c.JWTJWKSURL != "" || c.JWTJWKSFile != ""
+}
+
+// UsesIntrospection reports whether tokens are validated by token introspection.
+func (c *Config) UsesIntrospection() bool {
+	return c.JWTIntrospectionURL != ""
+}
+
 func validateProblemBaseURL(raw string) error {
 	trimmed := strings.TrimSpace(raw)
 	if trimmed == "" {
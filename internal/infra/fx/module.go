@@ -12,7 +12,9 @@ package fxmodule
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"net/http"
 
 	"go.opentelemetry.io/otel"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -22,10 +24,20 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/fx"
 
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/apikey"
 	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/job"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/lockout"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/oauth"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/token"
 	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
 	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/auditsink"
 	"github.com/iruldev/golang-api-hexagonal/internal/infra/config"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/hashing"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/introspection"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/jwks"
 	"github.com/iruldev/golang-api-hexagonal/internal/infra/observability"
 	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres"
 	"github.com/iruldev/golang-api-hexagonal/internal/infra/resilience"
@@ -63,6 +75,8 @@ var ObservabilityModule = fx.Options(
 		slog.SetDefault(logger)
 	}),
 	fx.Provide(provideMetrics),
+	fx.Provide(provideAuthMetrics),
+	fx.Provide(provideLockoutMetrics),
 	fx.Provide(provideTracer),
 )
 
@@ -105,6 +119,14 @@ func provideMetrics() MetricsResult {
 	}
 }
 
+func provideAuthMetrics(registry *prometheus.Registry) (metrics.AuthMetrics, error) {
+	return observability.NewAuthMetrics(registry)
+}
+
+func provideLockoutMetrics(registry *prometheus.Registry) (metrics.LockoutMetrics, error) {
+	return observability.NewLockoutMetrics(registry)
+}
+
 // ResilienceModule provides resilience dependencies (Story 1.6 + 1.7).
 var ResilienceModule = fx.Options(
 	fx.Provide(provideResilienceConfig),
@@ -238,6 +260,8 @@ var PostgresModule = fx.Options(
 	fx.Provide(provideIdempotencyRepo),
 	fx.Provide(provideIdempotencyStore),
 	fx.Invoke(startIdempotencyCleaner),
+	fx.Invoke(startTokenRevocationCleaner),
+	fx.Invoke(startAuditPartitionMaintainer),
 	fx.Invoke(registerDBMetrics),
 )
 
@@ -308,6 +332,56 @@ func startIdempotencyCleaner(
 	})
 }
 
+// startTokenRevocationCleaner removes revocations of expired tokens in the background.
+func startTokenRevocationCleaner(
+	lc fx.Lifecycle,
+	pool postgres.Pooler,
+	cfg *config.Config,
+	logger *slog.Logger,
+	registry *prometheus.Registry,
+) {
+	cleaner := postgres.NewTokenRevocationCleaner(
+		pool,
+		postgres.TokenRevocationCleanerConfig{
+			Interval: cfg.JWTRevocationCleanupInterval,
+		},
+		logger,
+		registry,
+	)
+
+	lc.Append(fx.Hook{
+		OnStart: cleaner.Start,
+		OnStop:  cleaner.Stop,
+	})
+}
+
+// startAuditPartitionMaintainer keeps audit_events partitions ahead of time and
+// removes the ones past AUDIT_RETENTION in the background.
+func startAuditPartitionMaintainer(
+	lc fx.Lifecycle,
+	pool postgres.Pooler,
+	cfg *config.Config,
+	logger *slog.Logger,
+	registry *prometheus.Registry,
+) {
+	maintainer := postgres.NewAuditPartitionMaintainer(
+		pool,
+		postgres.AuditPartitionMaintainerConfig{
+			Interval:  cfg.AuditPartitionMaintenanceInterval,
+			Premake:   cfg.AuditPartitionPremake,
+			Retention: cfg.AuditRetention,
+			Action:    cfg.AuditRetentionAction,
+		},
+		logger,
+		registry,
+	)
+
+	lc.Append(fx.Hook{
+		OnStart: maintainer.Start,
+		OnStop:  maintainer.Stop,
+	})
+}
+
 // DomainModule provides domain-level dependencies.
 var DomainModule = fx.Options(
 	fx.Provide(
@@ -322,6 +396,31 @@ var DomainModule = fx.Options(
 			fx.As(new(domain.AuditEventRepository)),
 		),
 	),
+	fx.Provide(
+		fx.Annotate(
+			postgres.NewJobRepo,
+			fx.As(new(domain.JobRepository)),
+		),
+	),
+	fx.Provide(
+		fx.Annotate(
+			postgres.NewTokenRevocationRepo,
+			fx.As(new(domain.TokenRevocationRepository)),
+		),
+	),
+	fx.Provide(
+		fx.Annotate(
+			postgres.NewOAuthClientRepo,
+			fx.As(new(domain.OAuthClientRepository)),
+		),
+	),
+	fx.Provide(
+		fx.Annotate(
+			postgres.NewAPIKeyRepo,
+			fx.As(new(domain.APIKeyRepository)),
+		),
+	),
+	fx.Provide(provideSecretHasher),
 	fx.Provide(postgres.NewIDGenerator),
 	fx.Provide(provideRedactorConfig),
 	fx.Provide(
@@ -332,6 +431,10 @@ var DomainModule = fx.Options(
 	),
 )
 
+func provideSecretHasher() domain.SecretHasher {
+	return hashing.NewBcryptHasher(hashing.DefaultCost)
+}
+
 func provideRedactorConfig(cfg *config.Config) domain.RedactorConfig {
 	return domain.RedactorConfig{EmailMode: cfg.AuditRedactEmail}
 }
@@ -339,16 +442,276 @@ func provideRedactorConfig(cfg *config.Config) domain.RedactorConfig {
 // AppModule provides application layer dependencies.
 var AppModule = fx.Options(
 	fx.Provide(audit.NewAuditService),
+	fx.Provide(audit.NewGetAuditEventUseCase),
+	fx.Provide(audit.NewListAuditEventsUseCase),
+	fx.Provide(audit.NewVerifyAuditChainUseCase),
+	fx.Provide(provideAuthorizer),
 	fx.Provide(user.NewCreateUserUseCase),
 	fx.Provide(user.NewGetUserUseCase),
 	fx.Provide(user.NewListUsersUseCase),
+	fx.Provide(user.NewUpdateUserUseCase),
+	fx.Provide(user.NewDeleteUserUseCase),
+	fx.Provide(user.NewRestoreUserUseCase),
+	fx.Provide(providePurgeDeletedUsersUseCase),
+	fx.Provide(provideBatchCreateUsersUseCase),
+	fx.Provide(user.NewExportUsersUseCase),
+	fx.Provide(user.NewStartUserImportUseCase),
+	fx.Provide(provideUserImportProcessor),
+	fx.Provide(job.NewGetJobUseCase),
+	fx.Invoke(startUserImportWorker),
+	fx.Invoke(startAuditSinkDispatcher),
+	fx.Provide(provideTokenDenylist),
+	fx.Provide(provideRevokeTokensUseCase),
+	fx.Provide(provideIssueTokenUseCase),
+	fx.Provide(oauth.NewCreateClientUseCase),
+	fx.Provide(apikey.NewCreateAPIKeyUseCase),
+	fx.Provide(apikey.NewListAPIKeysUseCase),
+	fx.Provide(apikey.NewRevokeAPIKeyUseCase),
+	fx.Provide(provideRotateAPIKeyUseCase),
+	fx.Provide(provideAPIKeyAuthenticator),
+	fx.Provide(provideLockoutTracker),
 )
 
+// provideAuthorizer builds the role-based authorizer from the built-in roles
+// and ROLE_PERMISSIONS. Without JWT authentication, requests have no auth
+// context: creating and listing users stay open to them, while every other
+// operation is denied.
+func provideAuthorizer(cfg *config.Config, logger *slog.Logger) (app.Authorizer, error) {
+	overrides, err := cfg.RolePermissionOverrides()
+	if err != nil {
+		return nil, err
+	}
+	roles, err := app.ParseRolePermissions(overrides)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ROLE_PERMISSIONS: %w", err)
+	}
+	authz := app.NewRoleAuthorizer(roles, logger)
+	if !cfg.JWTEnabled {
+		return app.NewNoAuthAuthorizer(authz, []app.Permission{app.PermUsersRead, app.PermUsersWrite}, logger), nil
+	}
+	return authz, nil
+}
+
+func providePurgeDeletedUsersUseCase(
+	userRepo domain.UserRepository,
+	auditService *audit.AuditService,
+	txManager domain.TxManager,
+	authz app.Authorizer,
+	cfg *config.Config,
+	logger *slog.Logger,
+) *user.PurgeDeletedUsersUseCase {
+	return user.NewPurgeDeletedUsersUseCase(userRepo, auditService, txManager, cfg.UserPurgeRetention, authz, logger)
+}
+
+func provideBatchCreateUsersUseCase(
+	createUC *user.CreateUserUseCase,
+	cfg *config.Config,
+) *user.BatchCreateUsersUseCase {
+	return user.NewBatchCreateUsersUseCase(createUC, cfg.UserBatchMaxItems)
+}
+
+func provideUserImportProcessor(
+	createUC *user.CreateUserUseCase,
+	jobRepo domain.JobRepository,
+	cfg *config.Config,
+	logger *slog.Logger,
+) *user.UserImportProcessor {
+	return user.NewUserImportProcessor(createUC, jobRepo, user.UserImportConfig{
+		ChunkSize: cfg.UserImportChunkSize,
+		Lease:     cfg.UserImportLease,
+	}, logger)
+}
+
+func provideTokenDenylist(
+	repo domain.TokenRevocationRepository,
+	db domain.Querier,
+	cfg *config.Config,
+	logger *slog.Logger,
+) *token.Denylist {
+	return token.NewDenylist(repo, db, cfg.JWTRevocationCacheTTL, logger)
+}
+
+func provideRevokeTokensUseCase(
+	repo domain.TokenRevocationRepository,
+	auditService *audit.AuditService,
+	txManager domain.TxManager,
+	idGen domain.IDGenerator,
+	denylist *token.Denylist,
+	authz app.Authorizer,
+	cfg *config.Config,
+	logger *slog.Logger,
+) *token.RevokeTokensUseCase {
+	return token.NewRevokeTokensUseCase(repo, auditService, txManager, idGen, denylist, cfg.JWTRevocationRetention, authz, logger)
+}
+
+func provideIssueTokenUseCase(
+	repo domain.OAuthClientRepository,
+	db domain.Querier,
+	hasher domain.SecretHasher,
+	signer domain.AccessTokenSigner,
+	idGen domain.IDGenerator,
+	cfg *config.Config,
+	logger *slog.Logger,
+) *oauth.IssueTokenUseCase {
+	return oauth.NewIssueTokenUseCase(repo, db, hasher, signer, idGen, cfg.OAuthTokenTTL, logger)
+}
+
+func provideRotateAPIKeyUseCase(
+	repo domain.APIKeyRepository,
+	auditService *audit.AuditService,
+	txManager domain.TxManager,
+	idGen domain.IDGenerator,
+	authz app.Authorizer,
+	cfg *config.Config,
+	logger *slog.Logger,
+) *apikey.RotateAPIKeyUseCase {
+	return apikey.NewRotateAPIKeyUseCase(repo, auditService, txManager, idGen, cfg.APIKeyRotationGrace, authz, logger)
+}
+
+func provideAPIKeyAuthenticator(
+	repo domain.APIKeyRepository,
+	db domain.Querier,
+	cfg *config.Config,
+	logger *slog.Logger,
+) *apikey.Authenticator {
+	return apikey.NewAuthenticator(repo, db, cfg.APIKeyLastUsedInterval, logger)
+}
+
+func provideLockoutTracker(
+	auditService *audit.AuditService,
+	db domain.Querier,
+	idGen domain.IDGenerator,
+	lockoutMetrics metrics.LockoutMetrics,
+	cfg *config.Config,
+	logger *slog.Logger,
+) *lockout.Tracker {
+	return lockout.NewTracker(lockout.Config{
+		MaxFailures: cfg.AuthLockoutMaxFailures,
+		Window:      cfg.AuthLockoutWindow,
+		BaseLockout: cfg.AuthLockoutDuration,
+		MaxLockout:  cfg.AuthLockoutMaxDuration,
+		MaxEntries:  cfg.AuthLockoutMaxEntries,
+	}, auditService, db, idGen, lockoutMetrics, logger)
+}
+
+// startUserImportWorker runs queued CSV user imports in the background for
+// the lifetime of the application, like the idempotency cleaner.
+func startUserImportWorker(
+	lc fx.Lifecycle,
+	processor *user.UserImportProcessor,
+	cfg *config.Config,
+	logger *slog.Logger,
+) {
+	worker := user.NewUserImportWorker(processor, cfg.UserImportPollInterval, logger)
+
+	lc.Append(fx.Hook{
+		OnStart: worker.Start,
+		OnStop:  worker.Stop,
+	})
+}
+
+// startAuditSinkDispatcher streams committed audit events to the sinks in
+// AUDIT_SINKS in the background. Nothing is started without sinks.
+func startAuditSinkDispatcher(
+	lc fx.Lifecycle,
+	pool postgres.Pooler,
+	cfg *config.Config,
+	resCfg resilience.ResilienceConfig,
+	retryMetrics *resilience.RetryMetrics,
+	logger *slog.Logger,
+	registry *prometheus.Registry,
+) error {
+	sinks, err := provideAuditSinks(cfg, logger)
+	if err != nil || len(sinks) == 0 {
+		return err
+	}
+
+	retrier := resilience.NewRetrier(
+		"audit_sink",
+		resCfg.Retry,
+		resilience.WithRetryMetrics(retryMetrics),
+		resilience.WithRetryLogger(logger),
+	)
+	dispatcher := auditsink.NewDispatcher(
+		// Delivery covers every tenant's events, so its transactions are
+		// not scoped to one.
+		postgres.NewCrossTenantTxManager(pool),
+		postgres.NewAuditSinkCursorRepo(),
+		sinks,
+		retrier,
+		auditsink.DispatcherConfig{
+			Interval:  cfg.AuditSinkPollInterval,
+			BatchSize: cfg.AuditSinkBatchSize,
+		},
+		logger,
+		registry,
+	)
+
+	lc.Append(fx.Hook{
+		OnStart: dispatcher.Start,
+		OnStop:  dispatcher.Stop,
+	})
+	return nil
+}
+
+// provideAuditSinks creates the sinks named in AUDIT_SINKS.
+func provideAuditSinks(cfg *config.Config, logger *slog.Logger) ([]domain.AuditSink, error) {
+	names, err := cfg.AuditSinkNames()
+	if err != nil {
+		return nil, err
+	}
+
+	sinks := make([]domain.AuditSink, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case auditsink.StdoutSinkName:
+			sinks = append(sinks, auditsink.NewStdoutSink(nil))
+		case auditsink.FileSinkName:
+			sink, err := auditsink.NewFileSink(auditsink.FileConfig{
+				Path:       cfg.AuditSinkFilePath,
+				MaxBytes:   cfg.AuditSinkFileMaxBytes,
+				MaxBackups: cfg.AuditSinkFileMaxBackups,
+				Logger:     logger,
+			})
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, sink)
+		case auditsink.WebhookSinkName:
+			sink, err := auditsink.NewWebhookSink(auditsink.WebhookConfig{
+				URL:        cfg.AuditSinkWebhookURL,
+				Secret:     cfg.AuditSinkWebhookSecret,
+				HTTPClient: &http.Client{Timeout: cfg.AuditSinkWebhookTimeout},
+			})
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, sink)
+		default:
+			return nil, fmt.Errorf("unknown audit sink %q", name)
+		}
+	}
+	return sinks, nil
+}
+
 // TransportModule provides HTTP transport dependencies.
 var TransportModule = fx.Options(
 
 	fx.Provide(provideStartupHandler),
+	fx.Provide(provideCursorCodec),
 	fx.Provide(provideUserHandler),
+	fx.Provide(provideUserAdminHandler),
+	fx.Provide(provideUserBatchHandler),
+	fx.Provide(provideUserExportHandler),
+	fx.Provide(provideUserImportHandler),
+	fx.Provide(provideJobHandler),
+	fx.Provide(provideTokenHandler),
+	fx.Provide(provideOAuthHandler),
+	fx.Provide(provideAPIKeyHandler),
+	fx.Provide(provideAuditEventHandler),
+	fx.Provide(provideAccessTokenSigner),
+	fx.Provide(provideJWKSCache),
+	fx.Provide(provideTokenIntrospector),
 	fx.Provide(provideJWTConfig),
 	fx.Provide(provideRateLimitConfig),
 	// Story 3.4: Health Check Library Integration
@@ -378,23 +741,181 @@ func provideHealthCheckRegistry(
 	return hc
 }
 
+// provideCursorCodec creates the signer for list pagination cursors.
+// Without CURSOR_SIGNING_KEY a random key is used and cursors are only valid
+// on this instance until it restarts.
+func provideCursorCodec(cfg *config.Config, logger *slog.Logger) *contract.CursorCodec {
+	if cfg.CursorSigningKey == "" {
+		logger.Warn("CURSOR_SIGNING_KEY not set; using a random per-process key for pagination cursors")
+		return contract.NewCursorCodec(nil)
+	}
+	return contract.NewCursorCodec([]byte(cfg.CursorSigningKey))
+}
+
 func provideUserHandler(
 	createUC *user.CreateUserUseCase,
 	getUC *user.GetUserUseCase,
 	listUC *user.ListUsersUseCase,
+	updateUC *user.UpdateUserUseCase,
+	deleteUC *user.DeleteUserUseCase,
+	cursors *contract.CursorCodec,
 ) *handler.UserHandler {
-	return handler.NewUserHandler(createUC, getUC, listUC, httpTransport.BasePath+"/users")
+	return handler.NewUserHandler(createUC, getUC, listUC, updateUC, deleteUC, httpTransport.BasePath+"/users",
+		handler.WithCursorCodec(cursors))
+}
+
+func provideUserAdminHandler(
+	restoreUC *user.RestoreUserUseCase,
+	purgeUC *user.PurgeDeletedUsersUseCase,
+) *handler.UserAdminHandler {
+	return handler.NewUserAdminHandler(restoreUC, purgeUC)
+}
+
+func provideUserBatchHandler(
+	batchCreateUC *user.BatchCreateUsersUseCase,
+	cfg *config.Config,
+) *handler.UserBatchHandler {
+	return handler.NewUserBatchHandler(batchCreateUC, cfg.UserBatchMaxItems)
 }
 
-func provideJWTConfig(cfg *config.Config) httpTransport.JWTConfig {
-	return httpTransport.JWTConfig{
-		Enabled:   cfg.JWTEnabled,
-		Secret:    []byte(cfg.JWTSecret),
-		Now:       nil,
-		Issuer:    cfg.JWTIssuer,
-		Audience:  cfg.JWTAudience,
-		ClockSkew: cfg.JWTClockSkew,
+func provideUserExportHandler(exportUC *user.ExportUsersUseCase, cfg *config.Config) *handler.UserExportHandler {
+	return handler.NewUserExportHandler(exportUC, cfg.HTTPWriteTimeout, cfg.UserExportMaxDuration)
+}
+
+func provideUserImportHandler(startUC *user.StartUserImportUseCase, cfg *config.Config) *handler.UserImportHandler {
+	return handler.NewUserImportHandler(startUC, httpTransport.BasePath+"/jobs", cfg.UserImportMaxSize)
+}
+
+func provideJobHandler(getUC *job.GetJobUseCase) *handler.JobHandler {
+	return handler.NewJobHandler(getUC)
+}
+
+func provideTokenHandler(revokeUC *token.RevokeTokensUseCase) *handler.TokenHandler {
+	return handler.NewTokenHandler(revokeUC)
+}
+
+func provideOAuthHandler(issueUC *oauth.IssueTokenUseCase, createUC *oauth.CreateClientUseCase) *handler.OAuthHandler {
+	return handler.NewOAuthHandler(issueUC, createUC)
+}
+
+func provideAPIKeyHandler(
+	createUC *apikey.CreateAPIKeyUseCase,
+	listUC *apikey.ListAPIKeysUseCase,
+	revokeUC *apikey.RevokeAPIKeyUseCase,
+	rotateUC *apikey.RotateAPIKeyUseCase,
+) *handler.APIKeyHandler {
+	return handler.NewAPIKeyHandler(createUC, listUC, revokeUC, rotateUC)
+}
+
+func provideAuditEventHandler(
+	getUC *audit.GetAuditEventUseCase,
+	listUC *audit.ListAuditEventsUseCase,
+	verifyUC *audit.VerifyAuditChainUseCase,
+	cursors *contract.CursorCodec,
+) *handler.AuditEventHandler {
+	return handler.NewAuditEventHandler(getUC, listUC, verifyUC, cursors)
+}
+
+// provideAccessTokenSigner signs tokens of the OAuth token endpoint with the
+// primary JWT key, so JWTAuth verifies them like any other HS256 token.
+func provideAccessTokenSigner(cfg *config.Config) domain.AccessTokenSigner {
+	return middleware.NewTokenSigner(
+		middleware.HMACKey{ID: cfg.JWTSecretKID, Secret: []byte(cfg.JWTSecret)},
+		cfg.JWTIssuer,
+		cfg.JWTAudience,
+	)
+}
+
+// provideJWKSCache creates the JWKS key cache when JWT_JWKS_URL or JWT_JWKS_FILE is set,
+// and nil otherwise. Keys are loaded on start, before the servers accept requests.
+func provideJWKSCache(lc fx.Lifecycle, cfg *config.Config, logger *slog.Logger) (*jwks.Cache, error) {
+	if !cfg.JWTEnabled || !cfg.UsesJWKS() {
+		return nil, nil
+	}
+
+	cache, err := jwks.NewCache(jwks.Config{
+		URL:             cfg.JWTJWKSURL,
+		File:            cfg.JWTJWKSFile,
+		RefreshInterval: cfg.JWTJWKSRefreshInterval,
+		Logger:          logger,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: cache.Start,
+		OnStop:  cache.Stop,
+	})
+	return cache, nil
+}
+
+// provideTokenIntrospector creates the token introspection client when
+// JWT_INTROSPECTION_URL is set, and nil otherwise. Calls to the endpoint go
+// through the resilience wrapper.
+func provideTokenIntrospector(
+	cfg *config.Config,
+	wrapper resilience.ResilienceWrapper,
+	logger *slog.Logger,
+) (*introspection.Client, error) {
+	if !cfg.JWTEnabled || !cfg.UsesIntrospection() {
+		return nil, nil
+	}
+	return introspection.NewClient(introspection.Config{
+		URL:             cfg.JWTIntrospectionURL,
+		ClientID:        cfg.JWTIntrospectionClientID,
+		ClientSecret:    cfg.JWTIntrospectionClientSecret,
+		MaxCacheEntries: cfg.JWTIntrospectionCacheSize,
+		Resilience:      wrapper,
+		Logger:          logger,
+	})
+}
+
+func provideJWTConfig(
+	cfg *config.Config,
+	jwksCache *jwks.Cache,
+	introspector *introspection.Client,
+	authMetrics metrics.AuthMetrics,
+	denylist *token.Denylist,
+	apiKeys *apikey.Authenticator,
+	lockoutTracker *lockout.Tracker,
+) (httpTransport.JWTConfig, error) {
+	keyring, err := cfg.JWTKeyring()
+	if err != nil {
+		return httpTransport.JWTConfig{}, err
 	}
+	keys := make([]middleware.HMACKey, 0, len(keyring))
+	for _, k := range keyring {
+		keys = append(keys, middleware.HMACKey{ID: k.ID, Secret: []byte(k.Secret), NotAfter: k.NotAfter})
+	}
+
+	jwtConfig := httpTransport.JWTConfig{
+		Enabled:         cfg.JWTEnabled,
+		Secret:          []byte(cfg.JWTSecret),
+		Keys:            keys,
+		Metrics:         authMetrics,
+		Revocations:     denylist,
+		Now:             nil,
+		Issuer:          cfg.JWTIssuer,
+		Audience:        cfg.JWTAudience,
+		ClockSkew:       cfg.JWTClockSkew,
+		AllowUnscoped:   cfg.AuthAllowUnscoped,
+		DefaultTenantID: cfg.JWTDefaultTenantID,
+	}
+	// Assign only a non-nil cache: a nil *jwks.Cache in the interface would not be nil.
+	if jwksCache != nil {
+		jwtConfig.KeySource = jwksCache
+	}
+	if introspector != nil {
+		jwtConfig.Introspector = introspector
+	}
+	if cfg.APIKeysEnabled {
+		jwtConfig.APIKeys = apiKeys
+	}
+	if cfg.AuthLockoutEnabled {
+		jwtConfig.Lockout = lockoutTracker
+	}
+	return jwtConfig, nil
 }
 
 func provideRateLimitConfig(cfg *config.Config) httpTransport.RateLimitConfig {
@@ -412,22 +933,48 @@ func providePublicRouter(
 	healthRegistry *handler.HealthCheckRegistry, // Story 3.4: Use library registry
 	startupHandler *handler.StartupHandler,
 	userHandler *handler.UserHandler,
+	userAdminHandler *handler.UserAdminHandler,
+	userBatchHandler *handler.UserBatchHandler,
+	userExportHandler *handler.UserExportHandler,
+	userImportHandler *handler.UserImportHandler,
+	jobHandler *handler.JobHandler,
+	tokenHandler *handler.TokenHandler,
+	oauthHandler *handler.OAuthHandler,
+	apiKeyHandler *handler.APIKeyHandler,
+	auditEventHandler *handler.AuditEventHandler,
 	jwtConfig httpTransport.JWTConfig,
 	rateLimitConfig httpTransport.RateLimitConfig,
 	shutdownCoord resilience.ShutdownCoordinator,
 	idempotencyStore middleware.IdempotencyStore,
 ) chi.Router {
+	handlers := httpTransport.RouterHandlers{
+		LivenessHandler:   healthRegistry.LiveHandler(),  // Story 3.4: Library handler
+		ReadinessHandler:  healthRegistry.ReadyHandler(), // Story 3.4: Library handler
+		StartupHandler:    startupHandler,
+		UserHandler:       userHandler,
+		UserAdminHandler:  userAdminHandler,
+		UserBatchHandler:  userBatchHandler,
+		UserExportHandler: userExportHandler,
+		UserImportHandler: userImportHandler,
+		JobHandler:        jobHandler,
+		TokenHandler:      tokenHandler,
+		AuditEventHandler: auditEventHandler,
+	}
+	// The token endpoint and client registration are only mounted with OAUTH_ENABLED.
+	if cfg.OAuthEnabled {
+		handlers.OAuthHandler = oauthHandler
+	}
+	// Keys are only accepted, and managed, with API_KEYS_ENABLED.
+	if cfg.APIKeysEnabled {
+		handlers.APIKeyHandler = apiKeyHandler
+	}
+
 	return httpTransport.NewRouter(
 		logger,
 		cfg.OTELEnabled,
 		registry,
 		httpMetrics,
-		httpTransport.RouterHandlers{
-			LivenessHandler:  healthRegistry.LiveHandler(),  // Story 3.4: Library handler
-			ReadinessHandler: healthRegistry.ReadyHandler(), // Story 3.4: Library handler
-			StartupHandler:   startupHandler,
-			UserHandler:      userHandler,
-		},
+		handlers,
 		cfg.MaxRequestSize,
 		jwtConfig,
 		rateLimitConfig,
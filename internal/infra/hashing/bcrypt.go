@@ -0,0 +1,51 @@
+// Package hashing implements domain.SecretHasher for storing client secrets.
+package hashing
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// DefaultCost is the bcrypt cost used for client secrets by default.
+const DefaultCost = bcrypt.DefaultCost
+
+// maxSecretLength is the longest secret bcrypt hashes; it rejects longer input.
+const maxSecretLength = 72
+
+// BcryptHasher hashes secrets with bcrypt.
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher creates a BcryptHasher with the given cost.
+// A cost outside bcrypt's range uses DefaultCost.
+func NewBcryptHasher(cost int) *BcryptHasher {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = DefaultCost
+	}
+	return &BcryptHasher{cost: cost}
+}
+
+// Hash returns the bcrypt hash of secret, which must be at most 72 bytes.
+func (h *BcryptHasher) Hash(secret string) (string, error) {
+	if len(secret) > maxSecretLength {
+		return "", fmt.Errorf("hash secret: longer than %d bytes", maxSecretLength)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(secret), h.cost)
+	if err != nil {
+		return "", fmt.Errorf("hash secret: %w", err)
+	}
+	return string(hash), nil
+}
+
+// Verify reports whether secret matches the bcrypt hash. Malformed hashes
+// never match.
+func (h *BcryptHasher) Verify(hash, secret string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(secret)) == nil
+}
+
+// Ensure BcryptHasher implements domain.SecretHasher at compile time.
+var _ domain.SecretHasher = (*BcryptHasher)(nil)
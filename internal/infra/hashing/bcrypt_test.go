@@ -0,0 +1,39 @@
+package hashing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestBcryptHasher_HashAndVerify(t *testing.T) {
+	h := NewBcryptHasher(bcrypt.MinCost)
+
+	hash, err := h.Hash("client-secret")
+	require.NoError(t, err)
+
+	assert.NotContains(t, hash, "client-secret")
+	assert.True(t, h.Verify(hash, "client-secret"))
+	assert.False(t, h.Verify(hash, "other-secret"))
+	assert.False(t, h.Verify("not-a-hash", "client-secret"))
+
+	again, err := h.Hash("client-secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, again, "hashes must be salted")
+}
+
+func TestBcryptHasher_Hash_RejectsLongSecret(t *testing.T) {
+	h := NewBcryptHasher(bcrypt.MinCost)
+
+	_, err := h.Hash(strings.Repeat("a", 73))
+
+	assert.Error(t, err)
+}
+
+func TestNewBcryptHasher_DefaultCost(t *testing.T) {
+	assert.Equal(t, bcrypt.DefaultCost, NewBcryptHasher(0).cost)
+	assert.Equal(t, bcrypt.MinCost, NewBcryptHasher(bcrypt.MinCost).cost)
+}
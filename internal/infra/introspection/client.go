@@ -0,0 +1,286 @@
+// Package introspection validates opaque access tokens by asking the
+// authorization server that issued them (OAuth 2.0 Token Introspection,
+// RFC 7662).
+//
+// Calls to the introspection endpoint go through a resilience.ResilienceWrapper
+// (circuit breaker, retry, timeout, bulkhead). Active tokens are cached until
+// their exp claim, so a token is introspected once rather than per request.
+// Inactive tokens are never cached.
+package introspection
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/resilience"
+)
+
+const (
+	// DefaultMaxCacheEntries caps the number of cached active tokens by default.
+	DefaultMaxCacheEntries = 10000
+	// DefaultRequestTimeout bounds a single introspection request by default.
+	DefaultRequestTimeout = 5 * time.Second
+	// OperationName names introspection calls in the resilience wrapper, e.g.
+	// its circuit breaker.
+	OperationName = "token_introspection"
+	// sweepInterval is the minimum time between sweeps of expired cache entries.
+	sweepInterval = time.Minute
+	// maxResponseSize caps the size of an introspection response.
+	maxResponseSize = 1 << 20
+)
+
+// Config holds configuration for a Client.
+type Config struct {
+	// URL is the introspection endpoint of the authorization server. Required.
+	URL string
+	// ClientID and ClientSecret authenticate this service to the endpoint with
+	// HTTP Basic authentication. Optional if the endpoint is unauthenticated.
+	ClientID     string
+	ClientSecret string
+	// MaxCacheEntries caps the number of cached active tokens. Default: 10000.
+	MaxCacheEntries int
+	// HTTPClient calls URL. Default: a client with DefaultRequestTimeout.
+	HTTPClient *http.Client
+	// Resilience wraps each call to URL. Optional.
+	Resilience resilience.ResilienceWrapper
+	// Logger for cache events.
+	Logger *slog.Logger
+}
+
+// Client introspects opaque access tokens. It is safe for concurrent use.
+type Client struct {
+	url          string
+	clientID     string
+	clientSecret string
+	maxEntries   int
+	httpClient   *http.Client
+	resilience   resilience.ResilienceWrapper
+	logger       *slog.Logger
+	now          func() time.Time
+
+	mu        sync.Mutex
+	cache     map[string]domain.IntrospectedToken
+	lastSweep time.Time
+}
+
+// NewClient creates a Client for the configured endpoint.
+func NewClient(cfg Config) (*Client, error) {
+	parsed, err := url.Parse(cfg.URL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return nil, errors.New("introspection: URL must be an absolute http(s) URL")
+	}
+	if cfg.MaxCacheEntries <= 0 {
+		cfg.MaxCacheEntries = DefaultMaxCacheEntries
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: DefaultRequestTimeout}
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = slog.New(slog.DiscardHandler)
+	}
+
+	return &Client{
+		url:          cfg.URL,
+		clientID:     cfg.ClientID,
+		clientSecret: cfg.ClientSecret,
+		maxEntries:   cfg.MaxCacheEntries,
+		httpClient:   cfg.HTTPClient,
+		resilience:   cfg.Resilience,
+		logger:       cfg.Logger.With("introspection_url", cfg.URL),
+		now:          time.Now,
+		cache:        make(map[string]domain.IntrospectedToken),
+	}, nil
+}
+
+// Introspect returns the description of token and whether it is active. An
+// error means the token's status is unknown, e.g. because the endpoint is
+// unreachable or the circuit breaker is open.
+//
+// The subject is the sub member of the response, or its client_id when sub is
+// absent, as for tokens of the client_credentials grant.
+func (c *Client) Introspect(ctx context.Context, token string) (domain.IntrospectedToken, bool, error) {
+	key := cacheKey(token)
+	if cached, ok := c.lookup(key); ok {
+		return cached, true, nil
+	}
+
+	var resp response
+	call := func(ctx context.Context) error {
+		var err error
+		resp, err = c.call(ctx, token)
+		return err
+	}
+	var err error
+	if c.resilience != nil {
+		err = c.resilience.Execute(ctx, OperationName, call)
+	} else {
+		err = call(ctx)
+	}
+	if err != nil {
+		return domain.IntrospectedToken{}, false, err
+	}
+	if !resp.Active {
+		return domain.IntrospectedToken{}, false, nil
+	}
+
+	introspected := resp.token()
+	c.store(key, introspected)
+	return introspected, true, nil
+}
+
+// response holds the RFC 7662 response members this package understands. role
+// and tenant_id are not registered but carry the same claims as our JWTs.
+type response struct {
+	Active   bool    `json:"active"`
+	Subject  string  `json:"sub"`
+	ClientID string  `json:"client_id"`
+	Scope    string  `json:"scope"`
+	Role     string  `json:"role"`
+	TenantID string  `json:"tenant_id"`
+	ID       string  `json:"jti"`
+	IssuedAt float64 `json:"iat"`
+	Expiry   float64 `json:"exp"`
+}
+
+func (r response) token() domain.IntrospectedToken {
+	t := domain.IntrospectedToken{
+		AccessToken: domain.AccessToken{
+			ID:       r.ID,
+			Subject:  r.Subject,
+			Role:     r.Role,
+			TenantID: r.TenantID,
+		},
+		Scope:    r.Scope,
+		ClientID: r.ClientID,
+	}
+	if t.Subject == "" {
+		t.Subject = r.ClientID
+	}
+	if r.IssuedAt > 0 {
+		t.IssuedAt = time.Unix(int64(r.IssuedAt), 0)
+	}
+	if r.Expiry > 0 {
+		t.ExpiresAt = time.Unix(int64(r.Expiry), 0)
+	}
+	return t
+}
+
+// call sends one introspection request.
+func (c *Client) call(ctx context.Context, token string) (response, error) {
+	form := url.Values{"token": {token}, "token_type_hint": {"access_token"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return response{}, permanent(fmt.Errorf("introspection: %w", err))
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if c.clientID != "" {
+		req.SetBasicAuth(url.QueryEscape(c.clientID), url.QueryEscape(c.clientSecret))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return response{}, fmt.Errorf("introspection: request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("introspection: unexpected status %d", resp.StatusCode)
+		// Server errors and throttling are transient; anything else, such as
+		// rejected client credentials, is not fixed by retrying.
+		if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+			return response{}, err
+		}
+		return response{}, permanent(err)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return response{}, fmt.Errorf("introspection: read: %w", err)
+	}
+	if len(data) > maxResponseSize {
+		return response{}, permanent(fmt.Errorf("introspection: response exceeds %d bytes", maxResponseSize))
+	}
+	var body response
+	if err := json.Unmarshal(data, &body); err != nil {
+		return response{}, permanent(fmt.Errorf("introspection: invalid response: %w", err))
+	}
+	return body, nil
+}
+
+// lookup returns the cached description of the token with the given cache
+// key if it has not expired.
+func (c *Client) lookup(key string) (domain.IntrospectedToken, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t, ok := c.cache[key]
+	if !ok {
+		return domain.IntrospectedToken{}, false
+	}
+	if !c.now().Before(t.ExpiresAt) {
+		delete(c.cache, key)
+		return domain.IntrospectedToken{}, false
+	}
+	return t, true
+}
+
+// store caches an active token until its expiry. Tokens without exp are not
+// cached, as nothing bounds how long they stay active. When the cache is full
+// after dropping expired entries, the token is not cached.
+func (c *Client) store(key string, t domain.IntrospectedToken) {
+	now := c.now()
+	if !now.Before(t.ExpiresAt) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.cache) >= c.maxEntries || now.Sub(c.lastSweep) >= sweepInterval {
+		c.sweep(now)
+	}
+	if len(c.cache) >= c.maxEntries {
+		c.logger.Debug("introspection cache full, not caching token", "entries", len(c.cache))
+		return
+	}
+	c.cache[key] = t
+}
+
+// sweep drops expired entries. The caller must hold c.mu.
+func (c *Client) sweep(now time.Time) {
+	c.lastSweep = now
+	for key, t := range c.cache {
+		if !now.Before(t.ExpiresAt) {
+			delete(c.cache, key)
+		}
+	}
+}
+
+// cacheKey returns the cache key of token, so tokens are not kept in memory.
+func cacheKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// permanentError marks an error retrying cannot fix, so the resilience
+// retrier gives up at once.
+type permanentError struct {
+	err error
+}
+
+func permanent(err error) error { return &permanentError{err: err} }
+
+func (e *permanentError) Error() string   { return e.err.Error() }
+func (e *permanentError) Unwrap() error   { return e.err }
+func (e *permanentError) Retryable() bool { return false }
@@ -0,0 +1,279 @@
+package introspection
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/resilience"
+)
+
+var fixedNow = time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+// introspectionServer stands in for an authorization server's introspection
+// endpoint. It answers with the response set for each token and counts calls.
+type introspectionServer struct {
+	*httptest.Server
+	mu        sync.Mutex
+	responses map[string]map[string]any
+	status    int
+	calls     atomic.Int32
+	lastReq   *http.Request
+}
+
+func newIntrospectionServer(t *testing.T) *introspectionServer {
+	t.Helper()
+
+	s := &introspectionServer{responses: map[string]map[string]any{}, status: http.StatusOK}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.calls.Add(1)
+		_ = r.ParseForm()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.lastReq = r
+		if s.status != http.StatusOK {
+			w.WriteHeader(s.status)
+			return
+		}
+		resp, ok := s.responses[r.PostForm.Get("token")]
+		if !ok {
+			resp = map[string]any{"active": false}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *introspectionServer) set(token string, resp map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses[token] = resp
+}
+
+func (s *introspectionServer) setStatus(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
+
+// newTestClient returns a client of srv whose clock reads *now.
+func newTestClient(t *testing.T, srv *introspectionServer, now *time.Time, cfg Config) *Client {
+	t.Helper()
+
+	cfg.URL = srv.URL
+	client, err := NewClient(cfg)
+	require.NoError(t, err)
+	client.now = func() time.Time { return *now }
+	return client
+}
+
+func activeResponse(exp time.Time) map[string]any {
+	return map[string]any{
+		"active":    true,
+		"sub":       "user-1",
+		"client_id": "client-1",
+		"scope":     "users:read users:write",
+		"role":      "Admin",
+		"tenant_id": "tenant-1",
+		"jti":       "jti-1",
+		"iat":       fixedNow.Add(-time.Minute).Unix(),
+		"exp":       exp.Unix(),
+	}
+}
+
+func TestNewClient_RequiresURL(t *testing.T) {
+	for _, u := range []string{"", "/introspect", "ftp://idp.example.com/introspect"} {
+		_, err := NewClient(Config{URL: u})
+		assert.Error(t, err, u)
+	}
+}
+
+func TestClient_Introspect_Active(t *testing.T) {
+	srv := newIntrospectionServer(t)
+	srv.set("opaque-1", activeResponse(fixedNow.Add(time.Hour)))
+	now := fixedNow
+	client := newTestClient(t, srv, &now, Config{ClientID: "api", ClientSecret: "s3cret"})
+
+	token, active, err := client.Introspect(context.Background(), "opaque-1")
+
+	require.NoError(t, err)
+	require.True(t, active)
+	assert.Equal(t, "user-1", token.Subject)
+	assert.Equal(t, "client-1", token.ClientID)
+	assert.Equal(t, "users:read users:write", token.Scope)
+	assert.Equal(t, "Admin", token.Role)
+	assert.Equal(t, "tenant-1", token.TenantID)
+	assert.Equal(t, "jti-1", token.ID)
+	assert.True(t, token.IssuedAt.Equal(fixedNow.Add(-time.Minute)))
+	assert.True(t, token.ExpiresAt.Equal(fixedNow.Add(time.Hour)))
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	assert.Equal(t, http.MethodPost, srv.lastReq.Method)
+	assert.Equal(t, "access_token", srv.lastReq.PostForm.Get("token_type_hint"))
+	id, secret, ok := srv.lastReq.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "api", id)
+	assert.Equal(t, "s3cret", secret)
+}
+
+func TestClient_Introspect_SubjectDefaultsToClientID(t *testing.T) {
+	srv := newIntrospectionServer(t)
+	srv.set("opaque-1", map[string]any{"active": true, "client_id": "client-1", "exp": fixedNow.Add(time.Hour).Unix()})
+	now := fixedNow
+	client := newTestClient(t, srv, &now, Config{})
+
+	token, active, err := client.Introspect(context.Background(), "opaque-1")
+
+	require.NoError(t, err)
+	require.True(t, active)
+	assert.Equal(t, "client-1", token.Subject)
+}
+
+func TestClient_Introspect_CachesActiveTokensUntilExpiry(t *testing.T) {
+	ctx := context.Background()
+	srv := newIntrospectionServer(t)
+	srv.set("opaque-1", activeResponse(fixedNow.Add(time.Minute)))
+	now := fixedNow
+	client := newTestClient(t, srv, &now, Config{})
+
+	for i := 0; i < 3; i++ {
+		_, active, err := client.Introspect(ctx, "opaque-1")
+		require.NoError(t, err)
+		assert.True(t, active)
+	}
+	assert.EqualValues(t, 1, srv.calls.Load())
+
+	// Revoked at the authorization server: noticed once the cached token expires.
+	srv.set("opaque-1", map[string]any{"active": false})
+	now = fixedNow.Add(time.Minute)
+	_, active, err := client.Introspect(ctx, "opaque-1")
+	require.NoError(t, err)
+	assert.False(t, active)
+	assert.EqualValues(t, 2, srv.calls.Load())
+}
+
+func TestClient_Introspect_DoesNotCache(t *testing.T) {
+	tests := []struct {
+		name       string
+		resp       map[string]any
+		maxEntries int
+		wantActive bool
+	}{
+		{name: "inactive token", resp: map[string]any{"active": false}},
+		{name: "token without exp", resp: map[string]any{"active": true, "sub": "user-1"}, wantActive: true},
+		{name: "expired token", resp: activeResponse(fixedNow.Add(-time.Second)), wantActive: true},
+		{name: "full cache", resp: activeResponse(fixedNow.Add(time.Hour)), maxEntries: 1, wantActive: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			srv := newIntrospectionServer(t)
+			srv.set("other", activeResponse(fixedNow.Add(time.Hour)))
+			srv.set("opaque-1", tt.resp)
+			now := fixedNow
+			client := newTestClient(t, srv, &now, Config{MaxCacheEntries: tt.maxEntries})
+			if tt.maxEntries > 0 {
+				_, _, err := client.Introspect(ctx, "other")
+				require.NoError(t, err)
+				srv.calls.Store(0)
+			}
+
+			for i := 0; i < 2; i++ {
+				_, active, err := client.Introspect(ctx, "opaque-1")
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantActive, active)
+			}
+			assert.EqualValues(t, 2, srv.calls.Load())
+		})
+	}
+}
+
+func TestClient_Introspect_FullCacheDropsExpiredEntries(t *testing.T) {
+	ctx := context.Background()
+	srv := newIntrospectionServer(t)
+	srv.set("short", activeResponse(fixedNow.Add(time.Second)))
+	srv.set("opaque-1", activeResponse(fixedNow.Add(time.Hour)))
+	now := fixedNow
+	client := newTestClient(t, srv, &now, Config{MaxCacheEntries: 1})
+
+	_, _, err := client.Introspect(ctx, "short")
+	require.NoError(t, err)
+	now = now.Add(time.Second)
+	_, _, err = client.Introspect(ctx, "opaque-1")
+	require.NoError(t, err)
+	_, _, err = client.Introspect(ctx, "opaque-1")
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 2, srv.calls.Load())
+	assert.Len(t, client.cache, 1)
+}
+
+// retryingWrapper returns a resilience wrapper making up to three attempts.
+func retryingWrapper() resilience.ResilienceWrapper {
+	return resilience.NewResilienceWrapper(
+		resilience.WithWrapperRetrier(resilience.NewRetrier(OperationName, resilience.RetryConfig{
+			MaxAttempts:  3,
+			InitialDelay: time.Millisecond,
+			MaxDelay:     time.Millisecond,
+			Multiplier:   1,
+		})),
+	)
+}
+
+func TestClient_Introspect_Resilience(t *testing.T) {
+	newRetryingClient := func(t *testing.T, srv *introspectionServer) *Client {
+		now := fixedNow
+		return newTestClient(t, srv, &now, Config{Resilience: retryingWrapper()})
+	}
+
+	t.Run("server errors are retried", func(t *testing.T) {
+		srv := newIntrospectionServer(t)
+		srv.setStatus(http.StatusServiceUnavailable)
+		client := newRetryingClient(t, srv)
+
+		_, active, err := client.Introspect(context.Background(), "opaque-1")
+
+		require.Error(t, err)
+		assert.False(t, active)
+		assert.EqualValues(t, 3, srv.calls.Load())
+	})
+
+	t.Run("rejected credentials are not retried", func(t *testing.T) {
+		srv := newIntrospectionServer(t)
+		srv.setStatus(http.StatusUnauthorized)
+		client := newRetryingClient(t, srv)
+
+		_, _, err := client.Introspect(context.Background(), "opaque-1")
+
+		require.Error(t, err)
+		assert.EqualValues(t, 1, srv.calls.Load())
+	})
+
+	t.Run("invalid response is not retried", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			_, _ = w.Write([]byte("<html>"))
+		}))
+		t.Cleanup(srv.Close)
+		client, err := NewClient(Config{URL: srv.URL, Resilience: retryingWrapper()})
+		require.NoError(t, err)
+
+		_, _, err = client.Introspect(context.Background(), "opaque-1")
+
+		require.Error(t, err)
+		assert.EqualValues(t, 1, calls.Load())
+	})
+}
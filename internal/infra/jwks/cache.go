@@ -0,0 +1,256 @@
+package jwks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRefreshInterval is how often the key set is reloaded by default.
+	DefaultRefreshInterval = 10 * time.Minute
+	// DefaultFetchTimeout bounds a single fetch of a remote key set.
+	DefaultFetchTimeout = 10 * time.Second
+	// MinRefreshOnMissInterval limits reloads triggered by unknown kids, so
+	// tokens with random kids cannot make us hammer the identity provider.
+	MinRefreshOnMissInterval = time.Minute
+	// maxDocumentSize caps the size of a key set document.
+	maxDocumentSize = 1 << 20
+)
+
+// Config holds configuration for a Cache. Exactly one of URL and File must be set.
+type Config struct {
+	// URL is the HTTP(S) location of the JWKS document.
+	URL string
+	// File is the path of a local JWKS document.
+	File string
+	// RefreshInterval is the time between background reloads. Default: 10m.
+	RefreshInterval time.Duration
+	// HTTPClient fetches URL. Default: a client with DefaultFetchTimeout.
+	HTTPClient *http.Client
+	// Logger for reload events.
+	Logger *slog.Logger
+}
+
+// Cache holds the keys of a JWKS document in memory and reloads them in the
+// background. A failed reload keeps the previous keys, so a short outage of
+// the identity provider does not reject valid tokens.
+type Cache struct {
+	source   string
+	load     func(ctx context.Context) ([]byte, error)
+	interval time.Duration
+	logger   *slog.Logger
+	now      func() time.Time
+
+	mu          sync.RWMutex
+	keys        map[string]key
+	lastRefresh time.Time
+
+	// refreshMu serializes reloads so concurrent misses trigger one fetch.
+	refreshMu sync.Mutex
+
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+// NewCache creates a Cache for the configured source. No keys are loaded
+// until Start or Refresh is called.
+func NewCache(cfg Config) (*Cache, error) {
+	if (cfg.URL == "") == (cfg.File == "") {
+		return nil, errors.New("jwks: exactly one of URL and File must be set")
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = DefaultRefreshInterval
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = slog.New(slog.DiscardHandler)
+	}
+
+	c := &Cache{
+		interval: cfg.RefreshInterval,
+		now:      time.Now,
+		doneCh:   make(chan struct{}),
+	}
+	if cfg.URL != "" {
+		client := cfg.HTTPClient
+		if client == nil {
+			client = &http.Client{Timeout: DefaultFetchTimeout}
+		}
+		c.source = cfg.URL
+		c.load = func(ctx context.Context) ([]byte, error) { return fetch(ctx, client, cfg.URL) }
+	} else {
+		c.source = cfg.File
+		c.load = func(context.Context) ([]byte, error) { return readFile(cfg.File) }
+	}
+	c.logger = cfg.Logger.With("jwks_source", c.source)
+	return c, nil
+}
+
+// Key returns the public key with the given kid if it may verify alg.
+// An unknown kid triggers a reload, at most once per MinRefreshOnMissInterval,
+// so keys published by the identity provider are picked up before the next
+// scheduled refresh.
+func (c *Cache) Key(ctx context.Context, kid, alg string) (any, error) {
+	k, ok := c.lookup(kid)
+	if !ok {
+		c.refreshMu.Lock()
+		// Another request may have reloaded while we waited.
+		if k, ok = c.lookup(kid); !ok && c.now().Sub(c.lastRefreshTime()) >= MinRefreshOnMissInterval {
+			if err := c.refreshLocked(ctx); err != nil {
+				c.logger.WarnContext(ctx, "jwks refresh on unknown kid failed", "kid", kid, "error", err)
+			}
+			k, ok = c.lookup(kid)
+		}
+		c.refreshMu.Unlock()
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: kid %q", ErrKeyNotFound, kid)
+	}
+	if k.alg != alg {
+		return nil, fmt.Errorf("%w: kid %q verifies %s, not %s", ErrAlgorithmMismatch, kid, k.alg, alg)
+	}
+	return k.public, nil
+}
+
+// Refresh reloads the key set now. On error the previous keys are kept.
+func (c *Cache) Refresh(ctx context.Context) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+	return c.refreshLocked(ctx)
+}
+
+// Start loads the key set and begins reloading it in the background.
+// It fails if the initial load fails, so a misconfigured source stops startup
+// instead of rejecting every token. ctx only bounds the initial load.
+func (c *Cache) Start(ctx context.Context) error {
+	if err := c.Refresh(ctx); err != nil {
+		return err
+	}
+
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	c.cancel = cancel
+	go c.run(runCtx)
+	c.logger.Info("jwks cache started", "interval", c.interval.String(), "keys", c.size())
+	return nil
+}
+
+// Stop ends background reloading and waits until ctx is cancelled at most.
+func (c *Cache) Stop(ctx context.Context) error {
+	if c.cancel == nil {
+		return nil
+	}
+	c.cancel()
+	select {
+	case <-c.doneCh:
+		c.logger.Info("jwks cache stopped")
+		return nil
+	case <-ctx.Done():
+		c.logger.Warn("jwks cache stop timed out")
+		return ctx.Err()
+	}
+}
+
+func (c *Cache) run(ctx context.Context) {
+	defer close(c.doneCh)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Refresh(ctx); err != nil && ctx.Err() == nil {
+				c.logger.Error("jwks refresh failed, keeping previous keys", "error", err)
+			}
+		}
+	}
+}
+
+func (c *Cache) refreshLocked(ctx context.Context) error {
+	c.mu.Lock()
+	c.lastRefresh = c.now()
+	c.mu.Unlock()
+
+	data, err := c.load(ctx)
+	if err != nil {
+		return err
+	}
+	keys, skipped, err := parse(data)
+	if err != nil {
+		return err
+	}
+	for _, s := range skipped {
+		c.logger.Debug("jwks key skipped", "reason", s)
+	}
+
+	c.mu.Lock()
+	c.keys = keys
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *Cache) lookup(kid string) (key, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	k, ok := c.keys[kid]
+	return k, ok
+}
+
+func (c *Cache) lastRefreshTime() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lastRefresh
+}
+
+func (c *Cache) size() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.keys)
+}
+
+func fetch(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("jwks: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("jwks: fetch: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwks: fetch: unexpected status %d", resp.StatusCode)
+	}
+	return readLimited(resp.Body)
+}
+
+func readFile(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("jwks: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	return readLimited(f)
+}
+
+func readLimited(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxDocumentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("jwks: read: %w", err)
+	}
+	if len(data) > maxDocumentSize {
+		return nil, fmt.Errorf("jwks: document exceeds %d bytes", maxDocumentSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,170 @@
+package jwks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jwksServer serves a swappable JWKS document and counts fetches.
+type jwksServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	doc     []byte
+	status  int
+	fetches atomic.Int32
+}
+
+func newJWKSServer(t *testing.T, doc []byte) *jwksServer {
+	t.Helper()
+
+	s := &jwksServer{doc: doc, status: http.StatusOK}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		s.fetches.Add(1)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		w.WriteHeader(s.status)
+		_, _ = w.Write(s.doc)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) set(status int, doc []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status, s.doc = status, doc
+}
+
+func TestNewCache_RequiresOneSource(t *testing.T) {
+	_, err := NewCache(Config{})
+	assert.Error(t, err)
+
+	_, err = NewCache(Config{URL: "https://idp.example.com/jwks.json", File: "/tmp/jwks.json"})
+	assert.Error(t, err)
+}
+
+func TestCache_URL(t *testing.T) {
+	ctx := context.Background()
+	k := newTestKeys(t)
+	srv := newJWKSServer(t, document(t, k.rsaJWK, k.edJWK))
+
+	cache, err := NewCache(Config{URL: srv.URL, RefreshInterval: time.Hour})
+	require.NoError(t, err)
+	require.NoError(t, cache.Start(ctx))
+	defer func() { require.NoError(t, cache.Stop(ctx)) }()
+
+	pub, err := cache.Key(ctx, "rsa-1", AlgRS256)
+	require.NoError(t, err)
+	assert.True(t, k.rsa.PublicKey.Equal(pub))
+
+	_, err = cache.Key(ctx, "rsa-1", AlgEdDSA)
+	assert.ErrorIs(t, err, ErrAlgorithmMismatch)
+
+	_, err = cache.Key(ctx, "rsa-1", "HS256")
+	assert.ErrorIs(t, err, ErrAlgorithmMismatch, "a public key must never be usable as an HMAC secret")
+
+	t.Run("unknown kid reloads at most once per interval", func(t *testing.T) {
+		srv.set(http.StatusOK, document(t, k.rsaJWK, k.edJWK, k.ecJWK))
+		before := srv.fetches.Load()
+
+		// The key set was loaded just now, so a miss does not reload yet.
+		_, err := cache.Key(ctx, "ec-1", AlgES256)
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+		assert.Equal(t, before, srv.fetches.Load())
+
+		cache.now = func() time.Time { return time.Now().Add(MinRefreshOnMissInterval) }
+		pub, err := cache.Key(ctx, "ec-1", AlgES256)
+		require.NoError(t, err)
+		assert.True(t, k.ec.PublicKey.Equal(pub))
+		assert.Equal(t, before+1, srv.fetches.Load())
+
+		_, err = cache.Key(ctx, "unknown", AlgES256)
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+		assert.Equal(t, before+1, srv.fetches.Load(), "a second miss within the interval must not reload")
+	})
+
+	t.Run("failed reload keeps previous keys", func(t *testing.T) {
+		srv.set(http.StatusServiceUnavailable, nil)
+
+		err := cache.Refresh(ctx)
+		assert.ErrorContains(t, err, "unexpected status 503")
+
+		_, err = cache.Key(ctx, "rsa-1", AlgRS256)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCache_File(t *testing.T) {
+	ctx := context.Background()
+	k := newTestKeys(t)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, document(t, k.ecJWK), 0o600))
+
+	cache, err := NewCache(Config{File: path})
+	require.NoError(t, err)
+	require.NoError(t, cache.Refresh(ctx))
+
+	_, err = cache.Key(ctx, "ec-1", AlgES256)
+	require.NoError(t, err)
+
+	// Rotated keys are picked up by the next reload.
+	require.NoError(t, os.WriteFile(path, document(t, k.edJWK), 0o600))
+	require.NoError(t, cache.Refresh(ctx))
+	_, err = cache.Key(ctx, "ed-1", AlgEdDSA)
+	require.NoError(t, err)
+	cache.now = func() time.Time { return time.Now().Add(MinRefreshOnMissInterval) }
+	_, err = cache.Key(ctx, "ec-1", AlgES256)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestCache_StartFailsWithoutKeys(t *testing.T) {
+	ctx := context.Background()
+
+	cache, err := NewCache(Config{File: filepath.Join(t.TempDir(), "missing.json")})
+	require.NoError(t, err)
+	assert.Error(t, cache.Start(ctx))
+	assert.NoError(t, cache.Stop(ctx), "stop after a failed start is a no-op")
+
+	srv := newJWKSServer(t, []byte(`{"keys":[]}`))
+	cache, err = NewCache(Config{URL: srv.URL})
+	require.NoError(t, err)
+	assert.ErrorContains(t, cache.Start(ctx), "no usable signing key")
+}
+
+func TestCache_DocumentTooLarge(t *testing.T) {
+	srv := newJWKSServer(t, make([]byte, maxDocumentSize+1))
+
+	cache, err := NewCache(Config{URL: srv.URL})
+	require.NoError(t, err)
+	assert.ErrorContains(t, cache.Refresh(context.Background()), "exceeds")
+}
+
+func TestCache_BackgroundRefresh(t *testing.T) {
+	ctx := context.Background()
+	k := newTestKeys(t)
+	srv := newJWKSServer(t, document(t, k.rsaJWK))
+
+	cache, err := NewCache(Config{URL: srv.URL, RefreshInterval: 10 * time.Millisecond})
+	require.NoError(t, err)
+	require.NoError(t, cache.Start(ctx))
+
+	srv.set(http.StatusOK, document(t, k.edJWK))
+	assert.Eventually(t, func() bool {
+		_, err := cache.Key(ctx, "ed-1", AlgEdDSA)
+		return err == nil
+	}, 2*time.Second, 10*time.Millisecond)
+
+	stopCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	require.NoError(t, cache.Stop(stopCtx))
+}
@@ -0,0 +1,207 @@
+// Package jwks loads JSON Web Key Sets (RFC 7517) with the public keys used to
+// verify asymmetrically signed JWTs.
+//
+// Supported keys and the single algorithm each may verify:
+//   - RSA (kty "RSA", at least 2048 bits): RS256
+//   - EC on P-256 (kty "EC", crv "P-256"): ES256
+//   - Ed25519 (kty "OKP", crv "Ed25519"): EdDSA
+//
+// Keys without a kid, keys marked for encryption (use "enc"), keys whose alg
+// does not match their type and unsupported key types are skipped, so one odd
+// entry in an identity provider's document does not break verification.
+package jwks
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Algorithms verified by keys from a JWKS document.
+const (
+	AlgRS256 = "RS256"
+	AlgES256 = "ES256"
+	AlgEdDSA = "EdDSA"
+)
+
+// minRSABits is the smallest RSA modulus accepted.
+const minRSABits = 2048
+
+var (
+	// ErrKeyNotFound is returned when no key has the requested kid.
+	ErrKeyNotFound = errors.New("jwks: key not found")
+	// ErrAlgorithmMismatch is returned when a key exists for the kid but may
+	// not verify the requested algorithm.
+	ErrAlgorithmMismatch = errors.New("jwks: key does not match algorithm")
+)
+
+// key is a parsed public key together with the only algorithm it verifies.
+type key struct {
+	alg    string
+	public any
+}
+
+// jsonWebKey holds the JWK members this package understands.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parse parses a JWKS document into verification keys by kid.
+// It fails if the document is malformed or contains no usable key.
+// The returned skipped list names the kids (or positions) that were ignored and why.
+func parse(data []byte) (keys map[string]key, skipped []string, err error) {
+	var doc struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, nil, fmt.Errorf("jwks: invalid document: %w", err)
+	}
+
+	keys = make(map[string]key, len(doc.Keys))
+	for i, raw := range doc.Keys {
+		var jwk jsonWebKey
+		if err := json.Unmarshal(raw, &jwk); err != nil {
+			skipped = append(skipped, fmt.Sprintf("keys[%d]: %v", i, err))
+			continue
+		}
+		name := jwk.Kid
+		if name == "" {
+			name = fmt.Sprintf("keys[%d]", i)
+		}
+
+		k, err := parseKey(jwk)
+		if err != nil {
+			skipped = append(skipped, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		if _, dup := keys[jwk.Kid]; dup {
+			skipped = append(skipped, fmt.Sprintf("%s: duplicate kid", name))
+			continue
+		}
+		keys[jwk.Kid] = k
+	}
+
+	if len(keys) == 0 {
+		return nil, skipped, errors.New("jwks: document has no usable signing key")
+	}
+	return keys, skipped, nil
+}
+
+func parseKey(jwk jsonWebKey) (key, error) {
+	if jwk.Kid == "" {
+		return key{}, errors.New("missing kid")
+	}
+	if jwk.Use != "" && jwk.Use != "sig" {
+		return key{}, fmt.Errorf("use %q is not sig", jwk.Use)
+	}
+
+	var k key
+	var err error
+	switch jwk.Kty {
+	case "RSA":
+		k, err = parseRSA(jwk)
+	case "EC":
+		k, err = parseEC(jwk)
+	case "OKP":
+		k, err = parseOKP(jwk)
+	default:
+		return key{}, fmt.Errorf("unsupported kty %q", jwk.Kty)
+	}
+	if err != nil {
+		return key{}, err
+	}
+
+	if jwk.Alg != "" && jwk.Alg != k.alg {
+		return key{}, fmt.Errorf("alg %q does not match %s key", jwk.Alg, jwk.Kty)
+	}
+	return k, nil
+}
+
+func parseRSA(jwk jsonWebKey) (key, error) {
+	n, err := decodeBase64URL(jwk.N, "n")
+	if err != nil {
+		return key{}, err
+	}
+	e, err := decodeBase64URL(jwk.E, "e")
+	if err != nil {
+		return key{}, err
+	}
+
+	modulus := new(big.Int).SetBytes(n)
+	if modulus.BitLen() < minRSABits {
+		return key{}, fmt.Errorf("RSA key is %d bits, at least %d required", modulus.BitLen(), minRSABits)
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 || exponent.Bit(0) == 0 {
+		return key{}, errors.New("invalid RSA exponent")
+	}
+
+	return key{
+		alg:    AlgRS256,
+		public: &rsa.PublicKey{N: modulus, E: int(exponent.Int64())},
+	}, nil
+}
+
+func parseEC(jwk jsonWebKey) (key, error) {
+	if jwk.Crv != "P-256" {
+		return key{}, fmt.Errorf("unsupported EC curve %q", jwk.Crv)
+	}
+	x, err := decodeBase64URL(jwk.X, "x")
+	if err != nil {
+		return key{}, err
+	}
+	y, err := decodeBase64URL(jwk.Y, "y")
+	if err != nil {
+		return key{}, err
+	}
+	if len(x) != 32 || len(y) != 32 {
+		return key{}, errors.New("P-256 coordinates must be 32 bytes")
+	}
+
+	// ParseUncompressedPublicKey rejects points that are not on the curve.
+	point := append(append([]byte{4}, x...), y...)
+	pub, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), point)
+	if err != nil {
+		return key{}, fmt.Errorf("invalid P-256 point: %w", err)
+	}
+	return key{alg: AlgES256, public: pub}, nil
+}
+
+func parseOKP(jwk jsonWebKey) (key, error) {
+	if jwk.Crv != "Ed25519" {
+		return key{}, fmt.Errorf("unsupported OKP curve %q", jwk.Crv)
+	}
+	x, err := decodeBase64URL(jwk.X, "x")
+	if err != nil {
+		return key{}, err
+	}
+	if len(x) != ed25519.PublicKeySize {
+		return key{}, fmt.Errorf("Ed25519 key must be %d bytes", ed25519.PublicKeySize)
+	}
+	return key{alg: AlgEdDSA, public: ed25519.PublicKey(x)}, nil
+}
+
+func decodeBase64URL(s, member string) ([]byte, error) {
+	if s == "" {
+		return nil, fmt.Errorf("missing %s", member)
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", member, err)
+	}
+	return b, nil
+}
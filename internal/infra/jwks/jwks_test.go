@@ -0,0 +1,156 @@
+package jwks
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testKeys struct {
+	rsa     *rsa.PrivateKey
+	ec      *ecdsa.PrivateKey
+	ed      ed25519.PrivateKey
+	rsaJWK  map[string]any
+	ecJWK   map[string]any
+	edJWK   map[string]any
+	smallRS map[string]any
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	smallKey, err := rsa.GenerateKey(rand.Reader, 1024) //nolint:gosec // checks that weak keys are rejected
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return testKeys{
+		rsa:     rsaKey,
+		ec:      ecKey,
+		ed:      edKey,
+		rsaJWK:  rsaJWK(t, "rsa-1", &rsaKey.PublicKey),
+		ecJWK:   ecJWK(t, "ec-1", &ecKey.PublicKey),
+		edJWK:   edJWK("ed-1", edKey.Public().(ed25519.PublicKey)),
+		smallRS: rsaJWK(t, "rsa-small", &smallKey.PublicKey),
+	}
+}
+
+func b64(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+func rsaJWK(t *testing.T, kid string, pub *rsa.PublicKey) map[string]any {
+	t.Helper()
+	return map[string]any{
+		"kty": "RSA", "kid": kid, "use": "sig", "alg": "RS256",
+		"n": b64(pub.N.Bytes()), "e": b64(big.NewInt(int64(pub.E)).Bytes()),
+	}
+}
+
+func ecJWK(t *testing.T, kid string, pub *ecdsa.PublicKey) map[string]any {
+	t.Helper()
+	point, err := pub.Bytes()
+	require.NoError(t, err)
+	return map[string]any{
+		"kty": "EC", "kid": kid, "crv": "P-256",
+		"x": b64(point[1:33]), "y": b64(point[33:]),
+	}
+}
+
+func edJWK(kid string, pub ed25519.PublicKey) map[string]any {
+	return map[string]any{"kty": "OKP", "kid": kid, "crv": "Ed25519", "x": b64(pub)}
+}
+
+func document(t *testing.T, keys ...map[string]any) []byte {
+	t.Helper()
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	require.NoError(t, err)
+	return data
+}
+
+func with(jwk map[string]any, k string, v any) map[string]any {
+	out := make(map[string]any, len(jwk)+1)
+	for key, val := range jwk {
+		out[key] = val
+	}
+	if v == nil {
+		delete(out, k)
+	} else {
+		out[k] = v
+	}
+	return out
+}
+
+func TestParse_SupportedKeys(t *testing.T) {
+	k := newTestKeys(t)
+
+	keys, skipped, err := parse(document(t, k.rsaJWK, k.ecJWK, k.edJWK))
+
+	require.NoError(t, err)
+	assert.Empty(t, skipped)
+	require.Len(t, keys, 3)
+
+	assert.Equal(t, AlgRS256, keys["rsa-1"].alg)
+	assert.True(t, k.rsa.PublicKey.Equal(keys["rsa-1"].public))
+	assert.Equal(t, AlgES256, keys["ec-1"].alg)
+	assert.True(t, k.ec.PublicKey.Equal(keys["ec-1"].public))
+	assert.Equal(t, AlgEdDSA, keys["ed-1"].alg)
+	assert.True(t, k.ed.Public().(ed25519.PublicKey).Equal(keys["ed-1"].public))
+}
+
+func TestParse_SkipsUnusableKeys(t *testing.T) {
+	k := newTestKeys(t)
+	badPoint := with(k.ecJWK, "y", b64(make([]byte, 32)))
+
+	tests := []struct {
+		name   string
+		jwk    map[string]any
+		reason string
+	}{
+		{name: "no kid", jwk: with(k.rsaJWK, "kid", nil), reason: "missing kid"},
+		{name: "encryption key", jwk: with(k.rsaJWK, "use", "enc"), reason: "not sig"},
+		{name: "alg mismatch", jwk: with(k.rsaJWK, "alg", "HS256"), reason: "does not match"},
+		{name: "weak rsa", jwk: k.smallRS, reason: "at least 2048"},
+		{name: "unsupported kty", jwk: map[string]any{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"}, reason: "unsupported kty"},
+		{name: "unsupported curve", jwk: with(k.ecJWK, "crv", "P-384"), reason: "unsupported EC curve"},
+		{name: "point off curve", jwk: badPoint, reason: "invalid P-256 point"},
+		{name: "bad encoding", jwk: with(k.edJWK, "x", "not base64!"), reason: "invalid x"},
+		{name: "short ed25519 key", jwk: with(k.edJWK, "x", b64([]byte("short"))), reason: "32 bytes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, skipped, err := parse(document(t, tt.jwk, k.edJWK))
+
+			require.NoError(t, err)
+			assert.Len(t, keys, 1, "the usable key should still be loaded")
+			require.Len(t, skipped, 1)
+			assert.Contains(t, skipped[0], tt.reason)
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	k := newTestKeys(t)
+
+	_, _, err := parse([]byte("not json"))
+	assert.ErrorContains(t, err, "invalid document")
+
+	_, _, err = parse(document(t))
+	assert.ErrorContains(t, err, "no usable signing key")
+
+	_, skipped, err := parse(document(t, k.rsaJWK, with(k.ecJWK, "kid", "rsa-1")))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rsa-1: duplicate kid"}, skipped)
+}
@@ -0,0 +1,91 @@
+package observability
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/metrics"
+)
+
+// authMetrics implements the shared AuthMetrics interface using Prometheus collectors.
+type authMetrics struct {
+	verified *prometheus.CounterVec
+}
+
+func (m *authMetrics) IncTokenVerified(keyID, match string) {
+	m.verified.WithLabelValues(keyID, match).Inc()
+}
+
+// NewAuthMetrics registers the authentication metrics with registry:
+//
+//	jwt_tokens_verified_total{key_id, match}
+//
+// key_id is the kid of the key that verified the token and match is "kid" when
+// the token named that key or "trial" when it was found by trying each key.
+// Only verified tokens are counted, so label cardinality is bounded by the
+// configured keys.
+func NewAuthMetrics(registry *prometheus.Registry) (metrics.AuthMetrics, error) {
+	verified, err := NewCounter(registry,
+		"jwt_tokens_verified_total",
+		"Total number of JWTs verified, by verifying key and how the key was selected",
+		[]string{"key_id", "match"},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &authMetrics{verified: verified}, nil
+}
+
+// lockoutMetrics implements the shared LockoutMetrics interface using Prometheus collectors.
+type lockoutMetrics struct {
+	failures *prometheus.CounterVec
+	lockouts *prometheus.CounterVec
+	rejected *prometheus.CounterVec
+}
+
+func (m *lockoutMetrics) IncAuthFailure(keyType string) {
+	m.failures.WithLabelValues(keyType).Inc()
+}
+
+func (m *lockoutMetrics) IncLockout(keyType string) {
+	m.lockouts.WithLabelValues(keyType).Inc()
+}
+
+func (m *lockoutMetrics) IncLockoutRejected(keyType string) {
+	m.rejected.WithLabelValues(keyType).Inc()
+}
+
+// NewLockoutMetrics registers the authentication lockout metrics with registry:
+//
+//	auth_failures_total{key_type}
+//	auth_lockouts_total{key_type}
+//	auth_lockout_rejections_total{key_type}
+//
+// key_type is "ip" or "subject". The keys themselves are not labels, so
+// cardinality stays fixed however many callers fail.
+func NewLockoutMetrics(registry *prometheus.Registry) (metrics.LockoutMetrics, error) {
+	failures, err := NewCounter(registry,
+		"auth_failures_total",
+		"Total number of failed authentication attempts, by lockout key type",
+		[]string{"key_type"},
+	)
+	if err != nil {
+		return nil, err
+	}
+	lockouts, err := NewCounter(registry,
+		"auth_lockouts_total",
+		"Total number of keys locked out after repeated authentication failures",
+		[]string{"key_type"},
+	)
+	if err != nil {
+		return nil, err
+	}
+	rejected, err := NewCounter(registry,
+		"auth_lockout_rejections_total",
+		"Total number of requests rejected because their key is locked out",
+		[]string{"key_type"},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &lockoutMetrics{failures: failures, lockouts: lockouts, rejected: rejected}, nil
+}
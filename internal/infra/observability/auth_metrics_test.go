@@ -0,0 +1,60 @@
+package observability
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestNewAuthMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m, err := NewAuthMetrics(registry)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	m.IncTokenVerified("2026-q4", "kid")
+	m.IncTokenVerified("2026-q4", "kid")
+	m.IncTokenVerified("2026-q3", "trial")
+
+	counter := m.(*authMetrics).verified
+	if got := testutil.ToFloat64(counter.WithLabelValues("2026-q4", "kid")); got != 2 {
+		t.Errorf("expected 2 verifications by 2026-q4, got %v", got)
+	}
+	if got := testutil.ToFloat64(counter.WithLabelValues("2026-q3", "trial")); got != 1 {
+		t.Errorf("expected 1 verification by 2026-q3, got %v", got)
+	}
+
+	if _, err := NewAuthMetrics(registry); err != nil {
+		t.Errorf("registering twice should reuse the collector: %v", err)
+	}
+}
+
+func TestNewLockoutMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m, err := NewLockoutMetrics(registry)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	m.IncAuthFailure("ip")
+	m.IncAuthFailure("ip")
+	m.IncLockout("subject")
+	m.IncLockoutRejected("ip")
+
+	lm := m.(*lockoutMetrics)
+	if got := testutil.ToFloat64(lm.failures.WithLabelValues("ip")); got != 2 {
+		t.Errorf("expected 2 ip failures, got %v", got)
+	}
+	if got := testutil.ToFloat64(lm.lockouts.WithLabelValues("subject")); got != 1 {
+		t.Errorf("expected 1 subject lockout, got %v", got)
+	}
+	if got := testutil.ToFloat64(lm.rejected.WithLabelValues("ip")); got != 1 {
+		t.Errorf("expected 1 ip rejection, got %v", got)
+	}
+
+	if _, err := NewLockoutMetrics(registry); err != nil {
+		t.Errorf("registering twice should reuse the collector: %v", err)
+	}
+}
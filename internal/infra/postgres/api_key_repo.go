@@ -0,0 +1,275 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres/sqlcgen"
+)
+
+// APIKeyRepo implements domain.APIKeyRepository for PostgreSQL.
+// Except for GetByHash, every method is scoped to the tenant of its context.
+type APIKeyRepo struct{}
+
+// NewAPIKeyRepo creates a new APIKeyRepo instance.
+func NewAPIKeyRepo() *APIKeyRepo {
+	return &APIKeyRepo{}
+}
+
+// Create stores a new API key for the tenant of ctx.
+func (r *APIKeyRepo) Create(ctx context.Context, q domain.Querier, key *domain.APIKey) error {
+	const op = "apiKeyRepo.Create"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	id, err := uuid.Parse(string(key.ID))
+	if err != nil {
+		return fmt.Errorf("%s: parse ID: %w", op, err)
+	}
+
+	err = queries.CreateAPIKey(ctx, sqlcgen.CreateAPIKeyParams{
+		ID:        pgtype.UUID{Bytes: id, Valid: true},
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		KeyHash:   key.KeyHash,
+		Role:      key.Role,
+		CreatedBy: pgtype.Text{String: key.CreatedBy, Valid: key.CreatedBy != ""},
+		CreatedAt: pgtype.Timestamptz{Time: key.CreatedAt, Valid: true},
+		ExpiresAt: optionalTimestamptz(key.ExpiresAt),
+		TenantID:  tenantID(ctx),
+		Scopes:    key.Scopes,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// GetByID retrieves an API key by its ID.
+// It returns domain.ErrAPIKeyNotFound if no key has the given ID.
+func (r *APIKeyRepo) GetByID(ctx context.Context, q domain.Querier, id domain.ID) (*domain.APIKey, error) {
+	const op = "apiKeyRepo.GetByID"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	uid, err := uuid.Parse(string(id))
+	if err != nil {
+		return nil, fmt.Errorf("%s: parse ID: %w", op, err)
+	}
+
+	row, err := queries.GetAPIKeyByID(ctx, sqlcgen.GetAPIKeyByIDParams{
+		TenantID: tenantID(ctx),
+		ID:       pgtype.UUID{Bytes: uid, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", op, domain.ErrAPIKeyNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	key, err := toDomainAPIKey(row)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &key, nil
+}
+
+// GetByHash retrieves an API key by the digest of the key, whatever its
+// tenant: the key establishes the tenant of the caller. With a pool querier
+// the lookup runs cross-tenant; in a transaction it sees that transaction's
+// tenant only.
+// It returns domain.ErrAPIKeyNotFound if no key has the given digest.
+func (r *APIKeyRepo) GetByHash(ctx context.Context, q domain.Querier, keyHash string) (*domain.APIKey, error) {
+	const op = "apiKeyRepo.GetByHash"
+
+	ctx = CrossTenant(ctx)
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	row, err := queries.GetAPIKeyByHash(ctx, keyHash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", op, domain.ErrAPIKeyNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	key, err := toDomainAPIKey(row)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &key, nil
+}
+
+// List returns all API keys of the tenant of ctx, newest first.
+func (r *APIKeyRepo) List(ctx context.Context, q domain.Querier) ([]domain.APIKey, error) {
+	const op = "apiKeyRepo.List"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	rows, err := queries.ListAPIKeys(ctx, tenantID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	keys := make([]domain.APIKey, 0, len(rows))
+	for _, row := range rows {
+		key, err := toDomainAPIKey(row)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Revoke sets revoked_at of the key unless it is already revoked.
+// It returns domain.ErrAPIKeyNotFound if no key has the given ID.
+func (r *APIKeyRepo) Revoke(ctx context.Context, q domain.Querier, id domain.ID, revokedAt time.Time) error {
+	const op = "apiKeyRepo.Revoke"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	uid, err := uuid.Parse(string(id))
+	if err != nil {
+		return fmt.Errorf("%s: parse ID: %w", op, err)
+	}
+
+	n, err := queries.RevokeAPIKey(ctx, sqlcgen.RevokeAPIKeyParams{
+		TenantID:  tenantID(ctx),
+		ID:        pgtype.UUID{Bytes: uid, Valid: true},
+		RevokedAt: pgtype.Timestamptz{Time: revokedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%s: %w", op, domain.ErrAPIKeyNotFound)
+	}
+	return nil
+}
+
+// SetExpiresAt sets expires_at of the key.
+// It returns domain.ErrAPIKeyNotFound if no key has the given ID.
+func (r *APIKeyRepo) SetExpiresAt(ctx context.Context, q domain.Querier, id domain.ID, expiresAt time.Time) error {
+	const op = "apiKeyRepo.SetExpiresAt"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	uid, err := uuid.Parse(string(id))
+	if err != nil {
+		return fmt.Errorf("%s: parse ID: %w", op, err)
+	}
+
+	n, err := queries.SetAPIKeyExpiresAt(ctx, sqlcgen.SetAPIKeyExpiresAtParams{
+		TenantID:  tenantID(ctx),
+		ID:        pgtype.UUID{Bytes: uid, Valid: true},
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%s: %w", op, domain.ErrAPIKeyNotFound)
+	}
+	return nil
+}
+
+// TouchLastUsed moves last_used_at of the key forward to usedAt.
+func (r *APIKeyRepo) TouchLastUsed(ctx context.Context, q domain.Querier, id domain.ID, usedAt time.Time) error {
+	const op = "apiKeyRepo.TouchLastUsed"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	uid, err := uuid.Parse(string(id))
+	if err != nil {
+		return fmt.Errorf("%s: parse ID: %w", op, err)
+	}
+
+	err = queries.TouchAPIKeyLastUsed(ctx, sqlcgen.TouchAPIKeyLastUsedParams{
+		TenantID:   tenantID(ctx),
+		ID:         pgtype.UUID{Bytes: uid, Valid: true},
+		LastUsedAt: pgtype.Timestamptz{Time: usedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func toDomainAPIKey(row sqlcgen.ApiKey) (domain.APIKey, error) {
+	id, err := uuid.FromBytes(row.ID.Bytes[:])
+	if err != nil {
+		return domain.APIKey{}, fmt.Errorf("invalid ID: %w", err)
+	}
+	return domain.APIKey{
+		ID:         domain.ID(id.String()),
+		Name:       row.Name,
+		Prefix:     row.Prefix,
+		KeyHash:    row.KeyHash,
+		Role:       row.Role,
+		TenantID:   row.TenantID,
+		Scopes:     row.Scopes,
+		CreatedBy:  row.CreatedBy.String,
+		CreatedAt:  row.CreatedAt.Time,
+		ExpiresAt:  timePtr(row.ExpiresAt),
+		RevokedAt:  timePtr(row.RevokedAt),
+		LastUsedAt: timePtr(row.LastUsedAt),
+	}, nil
+}
+
+// optionalTimestamptz converts an optional time to a nullable timestamptz.
+func optionalTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+// timePtr converts a nullable timestamptz to an optional time.
+func timePtr(ts pgtype.Timestamptz) *time.Time {
+	if !ts.Valid {
+		return nil
+	}
+	t := ts.Time
+	return &t
+}
+
+// Ensure APIKeyRepo implements domain.APIKeyRepository at compile time.
+var _ domain.APIKeyRepository = (*APIKeyRepo)(nil)
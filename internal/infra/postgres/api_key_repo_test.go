@@ -0,0 +1,155 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres"
+)
+
+func newTestAPIKey(t *testing.T, hash string, createdAt time.Time) *domain.APIKey {
+	t.Helper()
+	id, err := uuid.NewV7()
+	require.NoError(t, err)
+	return &domain.APIKey{
+		ID:        domain.ID(id.String()),
+		Name:      "partner",
+		Prefix:    "ak_abcdefgh",
+		KeyHash:   hash,
+		Role:      "user",
+		Scopes:    []string{"users:read", "audit:read"},
+		CreatedBy: "admin-1",
+		CreatedAt: createdAt,
+	}
+}
+
+func TestAPIKeyRepo_CreateAndGet(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer func() { _, _ = pool.Exec(context.Background(), "DELETE FROM api_keys") }()
+
+	ctx := context.Background()
+	repo := postgres.NewAPIKeyRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	expiresAt := now.Add(time.Hour)
+	key := newTestAPIKey(t, "hash-1", now)
+	key.ExpiresAt = &expiresAt
+	require.NoError(t, repo.Create(ctx, querier, key))
+
+	byID, err := repo.GetByID(ctx, querier, key.ID)
+	require.NoError(t, err)
+	byHash, err := repo.GetByHash(ctx, querier, "hash-1")
+	require.NoError(t, err)
+
+	for _, got := range []*domain.APIKey{byID, byHash} {
+		assert.Equal(t, key.ID, got.ID)
+		assert.Equal(t, key.Name, got.Name)
+		assert.Equal(t, key.Prefix, got.Prefix)
+		assert.Equal(t, key.KeyHash, got.KeyHash)
+		assert.Equal(t, key.Role, got.Role)
+		assert.Equal(t, key.Scopes, got.Scopes)
+		assert.Equal(t, key.CreatedBy, got.CreatedBy)
+		assert.True(t, key.CreatedAt.Equal(got.CreatedAt))
+		require.NotNil(t, got.ExpiresAt)
+		assert.True(t, expiresAt.Equal(*got.ExpiresAt))
+		assert.Nil(t, got.RevokedAt)
+		assert.Nil(t, got.LastUsedAt)
+	}
+}
+
+func TestAPIKeyRepo_NotFound(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewAPIKeyRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+	id := domain.ID(uuid.NewString())
+
+	_, err := repo.GetByID(ctx, querier, id)
+	assert.ErrorIs(t, err, domain.ErrAPIKeyNotFound)
+	_, err = repo.GetByHash(ctx, querier, "missing")
+	assert.ErrorIs(t, err, domain.ErrAPIKeyNotFound)
+	assert.ErrorIs(t, repo.Revoke(ctx, querier, id, time.Now()), domain.ErrAPIKeyNotFound)
+	assert.ErrorIs(t, repo.SetExpiresAt(ctx, querier, id, time.Now()), domain.ErrAPIKeyNotFound)
+}
+
+func TestAPIKeyRepo_RevokeKeepsFirstRevocation(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer func() { _, _ = pool.Exec(context.Background(), "DELETE FROM api_keys") }()
+
+	ctx := context.Background()
+	repo := postgres.NewAPIKeyRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	key := newTestAPIKey(t, "hash-revoke", now)
+	require.NoError(t, repo.Create(ctx, querier, key))
+
+	require.NoError(t, repo.Revoke(ctx, querier, key.ID, now.Add(time.Minute)))
+	require.NoError(t, repo.Revoke(ctx, querier, key.ID, now.Add(time.Hour)))
+
+	got, err := repo.GetByID(ctx, querier, key.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.RevokedAt)
+	assert.True(t, now.Add(time.Minute).Equal(*got.RevokedAt))
+	assert.False(t, got.Active(now.Add(2*time.Minute)))
+}
+
+func TestAPIKeyRepo_TouchLastUsedOnlyMovesForward(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer func() { _, _ = pool.Exec(context.Background(), "DELETE FROM api_keys") }()
+
+	ctx := context.Background()
+	repo := postgres.NewAPIKeyRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	key := newTestAPIKey(t, "hash-touch", now)
+	require.NoError(t, repo.Create(ctx, querier, key))
+
+	require.NoError(t, repo.TouchLastUsed(ctx, querier, key.ID, now.Add(time.Hour)))
+	require.NoError(t, repo.TouchLastUsed(ctx, querier, key.ID, now.Add(time.Minute)))
+
+	got, err := repo.GetByID(ctx, querier, key.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.LastUsedAt)
+	assert.True(t, now.Add(time.Hour).Equal(*got.LastUsedAt))
+}
+
+func TestAPIKeyRepo_ListNewestFirst(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer func() { _, _ = pool.Exec(context.Background(), "DELETE FROM api_keys") }()
+
+	ctx := context.Background()
+	repo := postgres.NewAPIKeyRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	older := newTestAPIKey(t, "hash-older", now.Add(-time.Hour))
+	newer := newTestAPIKey(t, "hash-newer", now)
+	require.NoError(t, repo.Create(ctx, querier, older))
+	require.NoError(t, repo.Create(ctx, querier, newer))
+	require.NoError(t, repo.SetExpiresAt(ctx, querier, older.ID, now))
+
+	keys, err := repo.List(ctx, querier)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, newer.ID, keys[0].ID)
+	assert.Equal(t, older.ID, keys[1].ID)
+	require.NotNil(t, keys[1].ExpiresAt)
+	assert.True(t, now.Equal(*keys[1].ExpiresAt))
+}
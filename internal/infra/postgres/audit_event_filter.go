@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres/sqlcgen"
+)
+
+// auditEventColumns is the select list shared with the sqlc audit queries,
+// in sqlcgen.AuditEvent field order.
+const auditEventColumns = "id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash"
+
+// auditListQuery builds the SQL for filtered audit event lists, which sqlc's
+// static queries cannot express. Like the sqlc queries, it only matches events
+// of one tenant. The expressions match the indexes in the
+// partition_audit_events migration.
+type auditListQuery struct {
+	where []string
+	args  []any
+}
+
+func newAuditListQuery(tenantID string, filter domain.AuditEventFilter) (*auditListQuery, error) {
+	b := &auditListQuery{}
+	b.where = append(b.where, "tenant_id = "+b.arg(tenantID))
+
+	if filter.EntityType != "" {
+		b.where = append(b.where, "entity_type = "+b.arg(filter.EntityType))
+	}
+	if !filter.EntityID.IsEmpty() {
+		id, err := uuid.Parse(string(filter.EntityID))
+		if err != nil {
+			return nil, fmt.Errorf("parse entity ID: %w", err)
+		}
+		b.where = append(b.where, "entity_id = "+b.arg(pgtype.UUID{Bytes: id, Valid: true}))
+	}
+	if !filter.ActorID.IsEmpty() {
+		id, err := uuid.Parse(string(filter.ActorID))
+		if err != nil {
+			return nil, fmt.Errorf("parse actor ID: %w", err)
+		}
+		b.where = append(b.where, "actor_id = "+b.arg(pgtype.UUID{Bytes: id, Valid: true}))
+	}
+	if prefix, ok := filter.EventTypePrefix(); ok {
+		b.where = append(b.where, "event_type LIKE "+b.arg(escapeLike(prefix)+"%"))
+	} else if filter.EventType != "" {
+		b.where = append(b.where, "event_type = "+b.arg(filter.EventType))
+	}
+	if filter.RequestID != "" {
+		b.where = append(b.where, "request_id = "+b.arg(filter.RequestID))
+	}
+	if !filter.From.IsZero() {
+		b.where = append(b.where, "timestamp >= "+b.arg(pgtype.Timestamptz{Time: filter.From, Valid: true}))
+	}
+	if !filter.To.IsZero() {
+		b.where = append(b.where, "timestamp < "+b.arg(pgtype.Timestamptz{Time: filter.To, Valid: true}))
+	}
+
+	return b, nil
+}
+
+// arg binds v and returns its placeholder.
+func (b *auditListQuery) arg(v any) string {
+	b.args = append(b.args, v)
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+// pageSQL returns the query for one page of up to params.KeysetLimit() rows.
+// With a cursor it seeks by (timestamp, id), nearest first for a Backward
+// cursor; without one it starts at the newest event.
+func (b *auditListQuery) pageSQL(params domain.ListParams) (string, error) {
+	dir := "DESC"
+	if params.IsKeyset() {
+		cursorTime, cursorID, err := keysetArgs(params.Cursor)
+		if err != nil {
+			return "", err
+		}
+		cmp := "<"
+		if params.Cursor.Backward {
+			cmp, dir = ">", "ASC"
+		}
+		b.where = append(b.where, "(timestamp, id) "+cmp+" ("+b.arg(cursorTime)+", "+b.arg(cursorID)+")")
+	}
+
+	return "SELECT " + auditEventColumns + " FROM audit_events" +
+		" WHERE " + strings.Join(b.where, " AND ") +
+		" ORDER BY timestamp " + dir + ", id " + dir +
+		" LIMIT " + b.arg(int32(params.KeysetLimit())), nil
+}
+
+// scanAuditEvent scans a row selected with auditEventColumns.
+func scanAuditEvent(row pgx.Row) (sqlcgen.AuditEvent, error) {
+	var e sqlcgen.AuditEvent
+	err := row.Scan(
+		&e.ID,
+		&e.EventType,
+		&e.ActorID,
+		&e.EntityType,
+		&e.EntityID,
+		&e.Payload,
+		&e.Timestamp,
+		&e.RequestID,
+		&e.TenantID,
+		&e.ChainSeq,
+		&e.PrevHash,
+		&e.Hash,
+	)
+	return e, err
+}
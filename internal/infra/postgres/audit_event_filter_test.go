@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+func TestAuditListQuery_PageSQL(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cursorID := domain.ID("019400a0-1234-7abc-8def-1234567890ab")
+
+	tests := []struct {
+		name     string
+		filter   domain.AuditEventFilter
+		params   domain.ListParams
+		wantSQL  string
+		wantArgs int
+	}{
+		{
+			name:     "no filter",
+			params:   domain.ListParams{PageSize: 10},
+			wantSQL:  "SELECT " + auditEventColumns + " FROM audit_events WHERE tenant_id = $1 ORDER BY timestamp DESC, id DESC LIMIT $2",
+			wantArgs: 2,
+		},
+		{
+			name: "all filters",
+			filter: domain.AuditEventFilter{
+				EntityType: "user",
+				EntityID:   cursorID,
+				ActorID:    cursorID,
+				EventType:  "user.created",
+				RequestID:  "req-1",
+				From:       from,
+				To:         from.Add(time.Hour),
+			},
+			params: domain.ListParams{PageSize: 10},
+			wantSQL: "SELECT " + auditEventColumns + " FROM audit_events WHERE tenant_id = $1" +
+				" AND entity_type = $2 AND entity_id = $3 AND actor_id = $4 AND event_type = $5" +
+				" AND request_id = $6 AND timestamp >= $7 AND timestamp < $8" +
+				" ORDER BY timestamp DESC, id DESC LIMIT $9",
+			wantArgs: 9,
+		},
+		{
+			name:   "forward keyset",
+			filter: domain.AuditEventFilter{EventType: "user.created"},
+			params: domain.ListParams{PageSize: 10, Cursor: &domain.Cursor{Time: from, ID: cursorID}},
+			wantSQL: "SELECT " + auditEventColumns + " FROM audit_events WHERE tenant_id = $1 AND event_type = $2" +
+				" AND (timestamp, id) < ($3, $4) ORDER BY timestamp DESC, id DESC LIMIT $5",
+			wantArgs: 5,
+		},
+		{
+			name:   "backward keyset",
+			params: domain.ListParams{PageSize: 10, Cursor: &domain.Cursor{Time: from, ID: cursorID, Backward: true}},
+			wantSQL: "SELECT " + auditEventColumns + " FROM audit_events WHERE tenant_id = $1" +
+				" AND (timestamp, id) > ($2, $3) ORDER BY timestamp ASC, id ASC LIMIT $4",
+			wantArgs: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := newAuditListQuery(testTenant, tt.filter)
+			require.NoError(t, err)
+			sql, err := b.pageSQL(tt.params)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+			require.Len(t, b.args, tt.wantArgs)
+			assert.Equal(t, testTenant, b.args[0])
+			assert.Equal(t, int32(11), b.args[len(b.args)-1], "keyset pages fetch one probe row")
+		})
+	}
+}
+
+func TestAuditListQuery_EventTypeWildcard(t *testing.T) {
+	b, err := newAuditListQuery(testTenant, domain.AuditEventFilter{EventType: "api_key.*"})
+	require.NoError(t, err)
+
+	sql, err := b.pageSQL(domain.ListParams{})
+
+	require.NoError(t, err)
+	assert.Contains(t, sql, "event_type LIKE $2")
+	assert.Equal(t, `api\_key.%`, b.args[1], "LIKE wildcards in the prefix must be escaped")
+}
+
+func TestAuditListQuery_Errors(t *testing.T) {
+	_, err := newAuditListQuery(testTenant, domain.AuditEventFilter{ActorID: "not-a-uuid"})
+	assert.Error(t, err)
+
+	_, err = newAuditListQuery(testTenant, domain.AuditEventFilter{EntityID: "not-a-uuid"})
+	assert.Error(t, err)
+
+	b, err := newAuditListQuery(testTenant, domain.AuditEventFilter{})
+	require.NoError(t, err)
+	_, err = b.pageSQL(domain.ListParams{Cursor: &domain.Cursor{ID: "not-a-uuid"}})
+	assert.Error(t, err)
+}
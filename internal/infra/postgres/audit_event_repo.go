@@ -2,10 +2,13 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/iruldev/golang-api-hexagonal/internal/domain"
@@ -37,16 +40,17 @@ func (r *AuditEventRepo) getDBTX(q domain.Querier) (sqlcgen.DBTX, error) {
 	}
 }
 
-// Create stores a new audit event in the database.
+// Create stores a new audit event for the tenant of ctx in the database and
+// appends it to the tenant's hash chain, setting event.ChainSeq, PrevHash and Hash.
+//
+// Appends are serialized per tenant by a transaction-scoped advisory lock, so
+// the chain head read under the lock is final until the transaction ends. With
+// a pool querier Create runs in its own transaction; in a caller's transaction
+// the lock is held until that transaction commits, so record audit events
+// late in long transactions.
 func (r *AuditEventRepo) Create(ctx context.Context, q domain.Querier, event *domain.AuditEvent) error {
 	const op = "auditEventRepo.Create"
 
-	dbtx, err := r.getDBTX(q)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	queries := sqlcgen.New(dbtx)
-
 	// Parse domain.ID to uuid.UUID at repository boundary
 	id, err := uuid.Parse(string(event.ID))
 	if err != nil {
@@ -82,17 +86,94 @@ func (r *AuditEventRepo) Create(ctx context.Context, q domain.Querier, event *do
 		Payload:    event.Payload,
 		Timestamp:  pgtype.Timestamptz{Time: event.Timestamp, Valid: true},
 		RequestID:  pgtype.Text{String: event.RequestID, Valid: event.RequestID != ""},
+		TenantID:   tenantID(ctx),
 	}
 
-	if err := queries.CreateAuditEvent(ctx, params); err != nil {
+	err = r.withTx(ctx, q, func(dbtx sqlcgen.DBTX) error {
+		return appendAuditEvent(ctx, sqlcgen.New(dbtx), &params)
+	})
+	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	event.ChainSeq = params.ChainSeq.Int64
+	event.PrevHash = params.PrevHash
+	event.Hash = params.Hash
 	return nil
 }
 
-// ListByEntityID retrieves audit events for a specific entity.
-// Results are ordered by timestamp DESC (newest first).
+// appendAuditEvent links params to the head of its tenant's chain and inserts it.
+func appendAuditEvent(ctx context.Context, queries *sqlcgen.Queries, params *sqlcgen.CreateAuditEventParams) error {
+	if err := queries.LockAuditChain(ctx, params.TenantID); err != nil {
+		return fmt.Errorf("lock chain: %w", err)
+	}
+
+	prevSeq, prevHash := int64(0), domain.AuditChainGenesis()
+	head, err := queries.GetAuditChainHead(ctx, params.TenantID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// First event of the tenant's chain.
+	case err != nil:
+		return fmt.Errorf("chain head: %w", err)
+	default:
+		prevSeq, prevHash = head.ChainSeq.Int64, head.Hash
+	}
+
+	// Hash the payload as it will be read back: jsonb reorders keys and
+	// reformats the JSON it is given.
+	payload, err := queries.NormalizeAuditPayload(ctx, params.Payload)
+	if err != nil {
+		return fmt.Errorf("normalize payload: %w", err)
+	}
+	params.Payload = payload
+	params.ChainSeq = pgtype.Int8{Int64: prevSeq + 1, Valid: true}
+	params.PrevHash = prevHash
+
+	stored := toDomainAuditEvent(sqlcgen.AuditEvent{
+		ID:         params.ID,
+		EventType:  params.EventType,
+		ActorID:    params.ActorID,
+		EntityType: params.EntityType,
+		EntityID:   params.EntityID,
+		Payload:    params.Payload,
+		Timestamp:  params.Timestamp,
+		RequestID:  params.RequestID,
+		ChainSeq:   params.ChainSeq,
+		PrevHash:   params.PrevHash,
+	})
+	params.Hash = stored.ChainHash()
+
+	return queries.CreateAuditEvent(ctx, *params)
+}
+
+// withTx runs fn in the transaction of q, or in a new transaction scoped to
+// the tenant of ctx when q is the pool.
+func (r *AuditEventRepo) withTx(ctx context.Context, q domain.Querier, fn func(sqlcgen.DBTX) error) error {
+	switch v := q.(type) {
+	case *PoolQuerier:
+		pool := v.pool.Pool()
+		if pool == nil {
+			return fmt.Errorf("database not connected")
+		}
+		return pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+			if err := setLocalScope(ctx, tx); err != nil {
+				return fmt.Errorf("set tenant: %w", err)
+			}
+			return fn(tx)
+		})
+	case *TxQuerier:
+		return fn(v.tx)
+	default:
+		return fmt.Errorf("auditEventRepo: unsupported querier type: %T", q)
+	}
+}
+
+// ListByEntityID retrieves audit events of the tenant of ctx for a specific entity.
+// Results are ordered by timestamp DESC, id DESC (newest first).
+//
+// With params.Cursor set the page is read by keyset: no count query is run
+// (the total is domain.TotalCountUnknown) and up to params.KeysetLimit() events
+// are returned in list order.
 func (r *AuditEventRepo) ListByEntityID(ctx context.Context, q domain.Querier, entityType string, entityID domain.ID, params domain.ListParams) ([]domain.AuditEvent, int, error) {
 	const op = "auditEventRepo.ListByEntityID"
 
@@ -108,8 +189,21 @@ func (r *AuditEventRepo) ListByEntityID(ctx context.Context, q domain.Querier, e
 		return nil, 0, fmt.Errorf("%s: parse entityID: %w", op, err)
 	}
 
+	if params.IsKeyset() {
+		rows, err := r.listByEntityKeyset(ctx, queries, entityType, pgtype.UUID{Bytes: eid, Valid: true}, params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
+		}
+		events := make([]domain.AuditEvent, 0, len(rows))
+		for _, row := range rows {
+			events = append(events, toDomainAuditEvent(row))
+		}
+		return events, domain.TotalCountUnknown, nil
+	}
+
 	// 1. Get total count
 	count, err := queries.CountAuditEventsByEntity(ctx, sqlcgen.CountAuditEventsByEntityParams{
+		TenantID:   tenantID(ctx),
 		EntityType: entityType,
 		EntityID:   pgtype.UUID{Bytes: eid, Valid: true},
 	})
@@ -126,6 +220,7 @@ func (r *AuditEventRepo) ListByEntityID(ctx context.Context, q domain.Querier, e
 
 	// 2. Get paginated results
 	rows, err := queries.ListAuditEventsByEntity(ctx, sqlcgen.ListAuditEventsByEntityParams{
+		TenantID:   tenantID(ctx),
 		EntityType: entityType,
 		EntityID:   pgtype.UUID{Bytes: eid, Valid: true},
 		Limit:      int32(params.Limit()),
@@ -137,34 +232,204 @@ func (r *AuditEventRepo) ListByEntityID(ctx context.Context, q domain.Querier, e
 
 	var events []domain.AuditEvent
 	for _, row := range rows {
-		// Convert generated struct back to domain struct
-		evt := domain.AuditEvent{
-			EventType:  row.EventType,
-			EntityType: row.EntityType,
-			Payload:    row.Payload,
-			Timestamp:  row.Timestamp.Time,
-			RequestID:  row.RequestID.String,
+		events = append(events, toDomainAuditEvent(row))
+	}
+
+	return events, totalCount, nil
+}
+
+// listByEntityKeyset reads the page after (or before) params.Cursor, returned in list order.
+func (r *AuditEventRepo) listByEntityKeyset(ctx context.Context, queries *sqlcgen.Queries, entityType string, entityID pgtype.UUID, params domain.ListParams) ([]sqlcgen.AuditEvent, error) {
+	cursorTime, cursorID, err := keysetArgs(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Cursor.Backward {
+		rows, err := queries.ListAuditEventsByEntityBefore(ctx, sqlcgen.ListAuditEventsByEntityBeforeParams{
+			TenantID:   tenantID(ctx),
+			EntityType: entityType,
+			EntityID:   entityID,
+			CursorTime: cursorTime,
+			CursorID:   cursorID,
+			RowLimit:   int32(params.KeysetLimit()),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("query: %w", err)
 		}
+		// Rows come nearest-first; flip them back into list order.
+		slices.Reverse(rows)
+		return rows, nil
+	}
+
+	rows, err := queries.ListAuditEventsByEntityAfter(ctx, sqlcgen.ListAuditEventsByEntityAfterParams{
+		TenantID:   tenantID(ctx),
+		EntityType: entityType,
+		EntityID:   entityID,
+		CursorTime: cursorTime,
+		CursorID:   cursorID,
+		RowLimit:   int32(params.KeysetLimit()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	return rows, nil
+}
 
-		// UUID conversions
-		var idUUID uuid.UUID
-		copy(idUUID[:], row.ID.Bytes[:])
-		evt.ID = domain.ID(idUUID.String())
+// GetByID retrieves an audit event of the tenant of ctx by its ID.
+// Returns domain.ErrAuditEventNotFound if no such event exists.
+func (r *AuditEventRepo) GetByID(ctx context.Context, q domain.Querier, id domain.ID) (*domain.AuditEvent, error) {
+	const op = "auditEventRepo.GetByID"
 
-		var eidUUID uuid.UUID
-		copy(eidUUID[:], row.EntityID.Bytes[:])
-		evt.EntityID = domain.ID(eidUUID.String())
+	dbtx, err := r.getDBTX(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	uid, err := uuid.Parse(string(id))
+	if err != nil {
+		return nil, fmt.Errorf("%s: parse ID: %w", op, err)
+	}
 
-		if row.ActorID.Valid {
-			var aidUUID uuid.UUID
-			copy(aidUUID[:], row.ActorID.Bytes[:])
-			evt.ActorID = domain.ID(aidUUID.String())
+	row, err := queries.GetAuditEventByID(ctx, sqlcgen.GetAuditEventByIDParams{
+		TenantID: tenantID(ctx),
+		ID:       pgtype.UUID{Bytes: uid, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", op, domain.ErrAuditEventNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	evt := toDomainAuditEvent(row)
+	return &evt, nil
+}
+
+// List retrieves audit events of the tenant of ctx matching filter.
+// Results are ordered by timestamp DESC, id DESC (newest first); up to
+// params.KeysetLimit() events are returned in list order.
+func (r *AuditEventRepo) List(ctx context.Context, q domain.Querier, filter domain.AuditEventFilter, params domain.ListParams) ([]domain.AuditEvent, error) {
+	const op = "auditEventRepo.List"
+
+	dbtx, err := r.getDBTX(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	b, err := newAuditListQuery(tenantID(ctx), filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	sql, err := b.pageSQL(params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := dbtx.Query(ctx, sql, b.args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: query: %w", op, err)
+	}
+	defer rows.Close()
+
+	events := []domain.AuditEvent{}
+	for rows.Next() {
+		row, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan: %w", op, err)
 		}
+		events = append(events, toDomainAuditEvent(row))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: query: %w", op, err)
+	}
 
-		events = append(events, evt)
+	if params.IsKeyset() && params.Cursor.Backward {
+		// Rows come nearest-first; flip them back into list order.
+		slices.Reverse(events)
 	}
+	return events, nil
+}
 
-	return events, totalCount, nil
+// ListChain retrieves up to limit chained events of the tenant of ctx with
+// ChainSeq greater than afterSeq, in chain order.
+func (r *AuditEventRepo) ListChain(ctx context.Context, q domain.Querier, afterSeq int64, limit int) ([]domain.AuditEvent, error) {
+	const op = "auditEventRepo.ListChain"
+
+	dbtx, err := r.getDBTX(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	rows, err := queries.ListAuditChain(ctx, sqlcgen.ListAuditChainParams{
+		TenantID: tenantID(ctx),
+		AfterSeq: afterSeq,
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: query: %w", op, err)
+	}
+
+	events := make([]domain.AuditEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, toDomainAuditEvent(row))
+	}
+	return events, nil
+}
+
+// GetChainAnchor retrieves the anchor of the chain of the tenant of ctx,
+// recorded by AuditPartitionMaintainer when it removes chained events.
+// Returns nil if there is none.
+func (r *AuditEventRepo) GetChainAnchor(ctx context.Context, q domain.Querier) (*domain.AuditChainAnchor, error) {
+	const op = "auditEventRepo.GetChainAnchor"
+
+	dbtx, err := r.getDBTX(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	row, err := queries.GetAuditChainAnchor(ctx, tenantID(ctx))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &domain.AuditChainAnchor{Seq: row.ChainSeq, Hash: row.Hash}, nil
+}
+
+// toDomainAuditEvent converts a generated row back to the domain struct.
+func toDomainAuditEvent(row sqlcgen.AuditEvent) domain.AuditEvent {
+	evt := domain.AuditEvent{
+		EventType:  row.EventType,
+		EntityType: row.EntityType,
+		Payload:    row.Payload,
+		Timestamp:  row.Timestamp.Time,
+		RequestID:  row.RequestID.String,
+		ChainSeq:   row.ChainSeq.Int64,
+		PrevHash:   row.PrevHash,
+		Hash:       row.Hash,
+	}
+
+	// UUID conversions
+	var idUUID uuid.UUID
+	copy(idUUID[:], row.ID.Bytes[:])
+	evt.ID = domain.ID(idUUID.String())
+
+	var eidUUID uuid.UUID
+	copy(eidUUID[:], row.EntityID.Bytes[:])
+	evt.EntityID = domain.ID(eidUUID.String())
+
+	if row.ActorID.Valid {
+		var aidUUID uuid.UUID
+		copy(aidUUID[:], row.ActorID.Bytes[:])
+		evt.ActorID = domain.ID(aidUUID.String())
+	}
+
+	return evt
 }
 
 // Ensure AuditEventRepo implements domain.AuditEventRepository at compile time.
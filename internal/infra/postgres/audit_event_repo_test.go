@@ -4,6 +4,7 @@ package postgres_test
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -143,6 +144,51 @@ func TestAuditEventRepo_ListByEntityID_WithPagination(t *testing.T) {
 	assert.Len(t, events, 5)
 }
 
+func TestAuditEventRepo_ListByEntityID_Keyset(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewAuditEventRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	entityID, _ := uuid.NewV7()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	for i := 0; i < 5; i++ {
+		id, _ := uuid.NewV7()
+		require.NoError(t, repo.Create(ctx, querier, &domain.AuditEvent{
+			ID:         domain.ID(id.String()),
+			EventType:  domain.EventUserUpdated,
+			EntityType: "user",
+			EntityID:   domain.ID(entityID.String()),
+			Payload:    []byte(`{}`),
+			Timestamp:  now.Add(time.Duration(i) * time.Second),
+		}))
+	}
+
+	all, _, err := repo.ListByEntityID(ctx, querier, "user", domain.ID(entityID.String()), domain.ListParams{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, all, 5)
+
+	// Forward from the newest event, probing one beyond the page.
+	cursor := &domain.Cursor{Time: all[0].Timestamp, ID: all[0].ID}
+	events, total, err := repo.ListByEntityID(ctx, querier, "user", domain.ID(entityID.String()), domain.ListParams{PageSize: 2, Cursor: cursor})
+	require.NoError(t, err)
+	assert.Equal(t, domain.TotalCountUnknown, total)
+	require.Len(t, events, 3)
+	assert.Equal(t, all[1].ID, events[0].ID)
+	assert.Equal(t, all[3].ID, events[2].ID)
+
+	// Backward from the oldest event, returned in list order.
+	cursor = &domain.Cursor{Time: all[4].Timestamp, ID: all[4].ID, Backward: true}
+	events, _, err = repo.ListByEntityID(ctx, querier, "user", domain.ID(entityID.String()), domain.ListParams{PageSize: 2, Cursor: cursor})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, all[1].ID, events[0].ID)
+	assert.Equal(t, all[3].ID, events[2].ID)
+}
+
 func TestAuditEventRepo_ListByEntityID_OrderByTimestampDesc(t *testing.T) {
 	pool, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -332,3 +378,219 @@ func TestAuditEventRepo_ListByEntityID_InvalidID(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "parse entityID")
 }
+
+func TestAuditEventRepo_GetByID(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewAuditEventRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	id, _ := uuid.NewV7()
+	entityID, _ := uuid.NewV7()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	require.NoError(t, repo.Create(ctx, querier, &domain.AuditEvent{
+		ID:         domain.ID(id.String()),
+		EventType:  domain.EventUserCreated,
+		EntityType: "user",
+		EntityID:   domain.ID(entityID.String()),
+		Payload:    []byte(`{"email":"[REDACTED]"}`),
+		Timestamp:  now,
+		RequestID:  "req-123",
+	}))
+
+	event, err := repo.GetByID(ctx, querier, domain.ID(id.String()))
+	require.NoError(t, err)
+	assert.Equal(t, domain.EventUserCreated, event.EventType)
+	assert.Equal(t, domain.ID(entityID.String()), event.EntityID)
+	assert.JSONEq(t, `{"email":"[REDACTED]"}`, string(event.Payload))
+	assert.True(t, now.Equal(event.Timestamp))
+
+	missing, _ := uuid.NewV7()
+	_, err = repo.GetByID(ctx, querier, domain.ID(missing.String()))
+	assert.ErrorIs(t, err, domain.ErrAuditEventNotFound)
+}
+
+func TestAuditEventRepo_List_Filters(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewAuditEventRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	actorID, _ := uuid.NewV7()
+	entityID, _ := uuid.NewV7()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	create := func(eventType string, actor domain.ID, requestID string, at time.Time) domain.ID {
+		id, _ := uuid.NewV7()
+		require.NoError(t, repo.Create(ctx, querier, &domain.AuditEvent{
+			ID:         domain.ID(id.String()),
+			EventType:  eventType,
+			ActorID:    actor,
+			EntityType: "user",
+			EntityID:   domain.ID(entityID.String()),
+			Payload:    []byte(`{}`),
+			Timestamp:  at,
+			RequestID:  requestID,
+		}))
+		return domain.ID(id.String())
+	}
+	created := create(domain.EventUserCreated, domain.ID(actorID.String()), "req-1", now)
+	updated := create(domain.EventUserUpdated, "", "req-2", now.Add(time.Second))
+	revoked := create(domain.EventAPIKeyRevoked, domain.ID(actorID.String()), "req-2", now.Add(2*time.Second))
+
+	tests := []struct {
+		name   string
+		filter domain.AuditEventFilter
+		want   []domain.ID
+	}{
+		{name: "entity", filter: domain.AuditEventFilter{EntityType: "user", EntityID: domain.ID(entityID.String())}, want: []domain.ID{revoked, updated, created}},
+		{name: "actor", filter: domain.AuditEventFilter{ActorID: domain.ID(actorID.String())}, want: []domain.ID{revoked, created}},
+		{name: "exact event type", filter: domain.AuditEventFilter{EventType: domain.EventUserCreated}, want: []domain.ID{created}},
+		{name: "event type prefix", filter: domain.AuditEventFilter{EventType: "user.*"}, want: []domain.ID{updated, created}},
+		{name: "request ID", filter: domain.AuditEventFilter{RequestID: "req-2"}, want: []domain.ID{revoked, updated}},
+		{name: "time range", filter: domain.AuditEventFilter{From: now.Add(time.Second), To: now.Add(2 * time.Second)}, want: []domain.ID{updated}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := repo.List(ctx, querier, tt.filter, domain.ListParams{PageSize: 10})
+			require.NoError(t, err)
+
+			ids := make([]domain.ID, 0, len(events))
+			for _, e := range events {
+				ids = append(ids, e.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestAuditEventRepo_List_Keyset(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewAuditEventRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	entityID, _ := uuid.NewV7()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	for i := 0; i < 5; i++ {
+		id, _ := uuid.NewV7()
+		require.NoError(t, repo.Create(ctx, querier, &domain.AuditEvent{
+			ID:         domain.ID(id.String()),
+			EventType:  domain.EventUserUpdated,
+			EntityType: "user",
+			EntityID:   domain.ID(entityID.String()),
+			Payload:    []byte(`{}`),
+			Timestamp:  now.Add(time.Duration(i) * time.Second),
+		}))
+	}
+
+	// The first page starts at the newest event and probes one beyond the page.
+	all, err := repo.List(ctx, querier, domain.AuditEventFilter{}, domain.ListParams{PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, all, 5)
+	first, err := repo.List(ctx, querier, domain.AuditEventFilter{}, domain.ListParams{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, first, 3)
+	assert.Equal(t, all[0].ID, first[0].ID)
+
+	cursor := &domain.Cursor{Time: all[0].Timestamp, ID: all[0].ID}
+	events, err := repo.List(ctx, querier, domain.AuditEventFilter{}, domain.ListParams{PageSize: 2, Cursor: cursor})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, all[1].ID, events[0].ID)
+	assert.Equal(t, all[3].ID, events[2].ID)
+
+	// Backward from the oldest event, returned in list order.
+	cursor = &domain.Cursor{Time: all[4].Timestamp, ID: all[4].ID, Backward: true}
+	events, err = repo.List(ctx, querier, domain.AuditEventFilter{}, domain.ListParams{PageSize: 2, Cursor: cursor})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, all[1].ID, events[0].ID)
+	assert.Equal(t, all[3].ID, events[2].ID)
+}
+
+func TestAuditEventRepo_Create_HashChain(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewAuditEventRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+	txManager := postgres.NewTxManager(&dbAdapter{p: pool})
+	entityID, _ := uuid.NewV7()
+
+	newEvent := func() *domain.AuditEvent {
+		id, _ := uuid.NewV7()
+		return &domain.AuditEvent{
+			ID:         domain.ID(id.String()),
+			EventType:  domain.EventUserUpdated,
+			EntityType: "user",
+			EntityID:   domain.ID(entityID.String()),
+			// Key order and spacing change in jsonb; the hash must not.
+			Payload:   []byte(`{"lastName":"Chen",  "firstName":"Sarah","n":1e2}`),
+			Timestamp: time.Now().UTC(),
+		}
+	}
+
+	// Concurrent appends, through the pool and in transactions, keep one chain.
+	const n = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if i%2 == 0 {
+				errs <- repo.Create(ctx, querier, newEvent())
+				return
+			}
+			errs <- txManager.WithTx(ctx, func(tx domain.Querier) error {
+				return repo.Create(ctx, tx, newEvent())
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	last := newEvent()
+	require.NoError(t, repo.Create(ctx, querier, last))
+	assert.Equal(t, int64(n+1), last.ChainSeq)
+	assert.Len(t, last.Hash, domain.AuditChainHashSize)
+
+	chain, err := repo.ListChain(ctx, querier, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, chain, n+1)
+
+	var verifier domain.AuditChainVerifier
+	for _, e := range chain {
+		require.Nil(t, verifier.Check(e), "event %d", e.ChainSeq)
+	}
+	assert.Equal(t, last.Hash, verifier.Hash())
+
+	// Tampering with a stored event breaks the chain at that event.
+	_, err = pool.Exec(ctx, `UPDATE audit_events SET payload = '{"firstName":"Eve"}' WHERE chain_seq = 5`)
+	require.NoError(t, err)
+
+	chain, err = repo.ListChain(ctx, querier, 0, 100)
+	require.NoError(t, err)
+	verifier = domain.AuditChainVerifier{}
+	var brk *domain.AuditChainBreak
+	for _, e := range chain {
+		if brk = verifier.Check(e); brk != nil {
+			break
+		}
+	}
+	require.NotNil(t, brk)
+	assert.Equal(t, int64(5), brk.Seq)
+	assert.Equal(t, domain.AuditChainHashMismatch, brk.Reason)
+}
@@ -0,0 +1,448 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres/sqlcgen"
+)
+
+// Ways to remove audit_events partitions past retention.
+const (
+	// AuditRetentionDetach detaches expired partitions, leaving them as
+	// standalone tables to be archived and dropped by an operator.
+	AuditRetentionDetach = "detach"
+
+	// AuditRetentionDrop drops expired partitions and their events.
+	AuditRetentionDrop = "drop"
+)
+
+// DefaultAuditPartitionPremake is the default number of months ahead of the
+// current one that partitions are created for.
+const DefaultAuditPartitionPremake = 3
+
+// auditPartitionPrefix prefixes the monthly partitions of audit_events, which
+// are named audit_events_pYYYYMM as in the partition_audit_events migration.
+// Other partitions, such as audit_events_default, are never removed.
+const auditPartitionPrefix = "audit_events_p"
+
+// auditDefaultPartition is the partition of audit_events holding the events
+// outside every monthly partition.
+const auditDefaultPartition = "audit_events_default"
+
+// listAuditPartitionsSQL lists the partitions of audit_events with their size
+// on disk, including indexes and TOAST. sqlc does not know the system catalogs.
+const listAuditPartitionsSQL = `SELECT c.relname::text, pg_total_relation_size(c.oid)
+FROM pg_inherits i
+JOIN pg_class c ON c.oid = i.inhrelid
+WHERE i.inhparent = 'audit_events'::regclass
+ORDER BY c.relname`
+
+// AuditPartitionMaintainerConfig holds configuration for the maintainer.
+type AuditPartitionMaintainerConfig struct {
+	// Interval is the duration between maintenance runs. Default: 1 hour.
+	Interval time.Duration
+
+	// Premake is the number of months ahead of the current one to create
+	// partitions for. Default: 3.
+	Premake int
+
+	// Retention is how long events are kept. A monthly partition is removed
+	// once its month ended more than Retention ago, so events are kept for up
+	// to a month longer. Zero keeps every partition.
+	Retention time.Duration
+
+	// Action is how expired partitions are removed: AuditRetentionDetach
+	// (default) or AuditRetentionDrop.
+	Action string
+}
+
+// auditPartition is a partition of audit_events.
+type auditPartition struct {
+	name  string
+	bytes int64
+}
+
+// AuditPartitionMaintainer keeps audit_events partitioned by month: it creates
+// the partitions of the coming months ahead of time and detaches or drops the
+// partitions past retention, recording each tenant's last removed chain link
+// as its anchor (see domain.AuditChainAnchor) in the same transaction.
+// It runs as a background goroutine and can be gracefully stopped.
+type AuditPartitionMaintainer struct {
+	pool   Pooler
+	cfg    AuditPartitionMaintainerConfig
+	logger *slog.Logger
+
+	partitions        prometheus.Gauge
+	partitionBytes    *prometheus.GaugeVec
+	partitionsCreated prometheus.Counter
+	partitionsRemoved *prometheus.CounterVec
+	defaultRowsMoved  prometheus.Counter
+	maintenanceErrors prometheus.Counter
+
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+// NewAuditPartitionMaintainer creates a new maintainer instance and registers
+// its metrics with registry. If registry is nil, a new registry is created.
+func NewAuditPartitionMaintainer(
+	pool Pooler,
+	cfg AuditPartitionMaintainerConfig,
+	logger *slog.Logger,
+	registry *prometheus.Registry,
+) *AuditPartitionMaintainer {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultCleanupInterval
+	}
+	if cfg.Premake <= 0 {
+		cfg.Premake = DefaultAuditPartitionPremake
+	}
+	if cfg.Action == "" {
+		cfg.Action = AuditRetentionDetach
+	}
+	if registry == nil {
+		registry = prometheus.NewRegistry()
+	}
+
+	partitions := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "audit_partitions",
+		Help: "Number of partitions of audit_events, including the default partition",
+	})
+	partitionBytes := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "audit_partition_bytes",
+		Help: "Size on disk of each audit_events partition, including indexes and TOAST",
+	}, []string{"partition"})
+	partitionsCreated := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "audit_partitions_created_total",
+		Help: "Total number of audit_events partitions created ahead of time",
+	})
+	partitionsRemoved := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "audit_partitions_removed_total",
+		Help: "Total number of audit_events partitions detached or dropped past retention",
+	}, []string{"action"})
+	defaultRowsMoved := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "audit_partition_default_rows_moved_total",
+		Help: "Total number of audit events moved out of the default partition into a new monthly partition",
+	})
+	maintenanceErrors := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "audit_partition_maintenance_errors_total",
+		Help: "Total number of errors during audit_events partition maintenance",
+	})
+	// Errors are intentionally ignored as they indicate metrics are already registered.
+	_ = registry.Register(partitions)
+	_ = registry.Register(partitionBytes)
+	_ = registry.Register(partitionsCreated)
+	_ = registry.Register(partitionsRemoved)
+	_ = registry.Register(defaultRowsMoved)
+	_ = registry.Register(maintenanceErrors)
+
+	return &AuditPartitionMaintainer{
+		pool:              pool,
+		cfg:               cfg,
+		logger:            logger,
+		partitions:        partitions,
+		partitionBytes:    partitionBytes,
+		partitionsCreated: partitionsCreated,
+		partitionsRemoved: partitionsRemoved,
+		defaultRowsMoved:  defaultRowsMoved,
+		maintenanceErrors: maintenanceErrors,
+		doneCh:            make(chan struct{}),
+	}
+}
+
+// Start begins the periodic maintenance loop.
+// This method returns immediately; maintenance runs in a background goroutine
+// that outlives ctx until Stop is called.
+func (m *AuditPartitionMaintainer) Start(ctx context.Context) error {
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	m.cancel = cancel
+	go m.run(runCtx)
+	m.logger.Info("audit partition maintainer started",
+		"interval", m.cfg.Interval.String(),
+		"premake", m.cfg.Premake,
+		"retention", m.cfg.Retention.String(),
+		"action", m.cfg.Action,
+	)
+	return nil
+}
+
+// Stop gracefully stops the maintainer.
+// It waits for the current maintenance run to complete or until ctx is cancelled.
+func (m *AuditPartitionMaintainer) Stop(ctx context.Context) error {
+	if m.cancel == nil {
+		return nil
+	}
+	m.cancel()
+	select {
+	case <-m.doneCh:
+		m.logger.Info("audit partition maintainer stopped")
+		return nil
+	case <-ctx.Done():
+		m.logger.Warn("audit partition maintainer stop timed out")
+		return ctx.Err()
+	}
+}
+
+func (m *AuditPartitionMaintainer) run(ctx context.Context) {
+	defer close(m.doneCh)
+
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	// Run maintenance immediately on start
+	m.maintain(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.maintain(ctx)
+		}
+	}
+}
+
+func (m *AuditPartitionMaintainer) maintain(ctx context.Context) {
+	if m.pool.Pool() == nil {
+		m.logger.Warn("audit partition maintenance skipped: database not connected")
+		return
+	}
+	if err := m.Maintain(ctx); err != nil && ctx.Err() == nil {
+		m.logger.Error("audit partition maintenance failed", "error", err)
+		m.maintenanceErrors.Inc()
+	}
+}
+
+// Maintain runs one maintenance pass: it creates missing partitions, removes
+// partitions past retention and updates the partition metrics. Start runs it
+// every Interval.
+func (m *AuditPartitionMaintainer) Maintain(ctx context.Context) error {
+	pool := m.pool.Pool()
+	if pool == nil {
+		return fmt.Errorf("database not connected")
+	}
+	// Retention anchors the hash chains of every tenant.
+	ctx = CrossTenant(ctx)
+	now := time.Now().UTC()
+
+	// Later steps run even if creation failed: removing expired partitions
+	// does not depend on it.
+	errCreate := m.createPartitions(ctx, pool, now)
+	var errRemove error
+	if m.cfg.Retention > 0 {
+		errRemove = m.removePartitions(ctx, pool, now)
+	}
+	errMetrics := m.updateMetrics(ctx, pool)
+	return errors.Join(errCreate, errRemove, errMetrics)
+}
+
+// createPartitions creates the partitions of the current month and the
+// Premake months after it that do not exist yet.
+func (m *AuditPartitionMaintainer) createPartitions(ctx context.Context, pool *pgxpool.Pool, now time.Time) error {
+	return pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+		if err := sqlcgen.New(tx).LockAuditPartitions(ctx); err != nil {
+			return fmt.Errorf("lock partitions: %w", err)
+		}
+		partitions, err := listAuditPartitions(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		hasDefault := hasAuditPartition(partitions, auditDefaultPartition)
+		month := auditPartitionMonth(now)
+		for range m.cfg.Premake + 1 {
+			name := auditPartitionName(month)
+			if !hasAuditPartition(partitions, name) {
+				if err := m.createPartition(ctx, tx, name, month, hasDefault); err != nil {
+					return fmt.Errorf("create partition %s: %w", name, err)
+				}
+				m.partitionsCreated.Inc()
+				m.logger.Info("audit partition created", "partition", name)
+			}
+			month = month.AddDate(0, 1, 0)
+		}
+		return nil
+	})
+}
+
+// createPartition creates the partition name for month in tx. Events of month
+// in the default partition (recorded while no partition covered it, e.g. when
+// maintenance kept failing) would make CREATE TABLE ... PARTITION OF fail, so
+// they are moved into the new partition, which is attached afterwards.
+func (m *AuditPartitionMaintainer) createPartition(ctx context.Context, tx pgx.Tx, name string, month time.Time, hasDefault bool) error {
+	table := pgx.Identifier{name}.Sanitize()
+	from, to := month, month.AddDate(0, 1, 0)
+	bounds := fmt.Sprintf("FOR VALUES FROM ('%s') TO ('%s')", from.Format(time.RFC3339), to.Format(time.RFC3339))
+
+	stray := false
+	if hasDefault {
+		err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM "+pgx.Identifier{auditDefaultPartition}.Sanitize()+
+			" WHERE timestamp >= $1 AND timestamp < $2)", from, to).Scan(&stray)
+		if err != nil {
+			return fmt.Errorf("check default partition: %w", err)
+		}
+	}
+	if !stray {
+		_, err := tx.Exec(ctx, "CREATE TABLE "+table+" PARTITION OF audit_events "+bounds)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, "CREATE TABLE "+table+" (LIKE audit_events INCLUDING DEFAULTS INCLUDING CONSTRAINTS)"); err != nil {
+		return err
+	}
+	moved, err := tx.Exec(ctx, "WITH moved AS (DELETE FROM "+pgx.Identifier{auditDefaultPartition}.Sanitize()+
+		" WHERE timestamp >= $1 AND timestamp < $2 RETURNING *) INSERT INTO "+table+" SELECT * FROM moved", from, to)
+	if err != nil {
+		return fmt.Errorf("move events from default partition: %w", err)
+	}
+	if _, err := tx.Exec(ctx, "ALTER TABLE audit_events ATTACH PARTITION "+table+" "+bounds); err != nil {
+		return fmt.Errorf("attach: %w", err)
+	}
+	m.defaultRowsMoved.Add(float64(moved.RowsAffected()))
+	m.logger.Warn("audit events moved out of the default partition",
+		"partition", name,
+		"events", moved.RowsAffected(),
+	)
+	return nil
+}
+
+// removePartitions detaches or drops the monthly partitions whose month ended
+// more than Retention before now, oldest first, one transaction each.
+func (m *AuditPartitionMaintainer) removePartitions(ctx context.Context, pool *pgxpool.Pool, now time.Time) error {
+	partitions, err := listAuditPartitions(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	cutoff := now.Add(-m.cfg.Retention)
+	// Names sort by month.
+	for _, p := range partitions {
+		month, ok := parseAuditPartitionName(p.name)
+		if !ok || month.AddDate(0, 1, 0).After(cutoff) {
+			continue
+		}
+		removed, err := m.removePartition(ctx, pool, p.name, month)
+		if err != nil {
+			return fmt.Errorf("remove partition %s: %w", p.name, err)
+		}
+		if !removed {
+			continue
+		}
+		m.partitionsRemoved.WithLabelValues(m.cfg.Action).Inc()
+		m.logger.Info("audit partition removed", "partition", p.name, "action", m.cfg.Action)
+	}
+	return nil
+}
+
+// removePartition anchors the chains of the events of month in partition name,
+// then detaches or drops it. It reports false if another instance removed the
+// partition first.
+func (m *AuditPartitionMaintainer) removePartition(ctx context.Context, pool *pgxpool.Pool, name string, month time.Time) (bool, error) {
+	removed := false
+	err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+		queries := sqlcgen.New(tx)
+		if err := queries.LockAuditPartitions(ctx); err != nil {
+			return fmt.Errorf("lock partitions: %w", err)
+		}
+		partitions, err := listAuditPartitions(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if !hasAuditPartition(partitions, name) {
+			return nil
+		}
+
+		if err := queries.AnchorAuditChains(ctx, sqlcgen.AnchorAuditChainsParams{
+			FromTime: pgtype.Timestamptz{Time: month, Valid: true},
+			ToTime:   pgtype.Timestamptz{Time: month.AddDate(0, 1, 0), Valid: true},
+		}); err != nil {
+			return fmt.Errorf("anchor chains: %w", err)
+		}
+
+		sql := "DROP TABLE " + pgx.Identifier{name}.Sanitize()
+		if m.cfg.Action == AuditRetentionDetach {
+			sql = "ALTER TABLE audit_events DETACH PARTITION " + pgx.Identifier{name}.Sanitize()
+		}
+		if _, err := tx.Exec(ctx, sql); err != nil {
+			return fmt.Errorf("%s: %w", m.cfg.Action, err)
+		}
+		removed = true
+		return nil
+	})
+	return removed, err
+}
+
+// updateMetrics reports the number and size of the partitions.
+func (m *AuditPartitionMaintainer) updateMetrics(ctx context.Context, pool *pgxpool.Pool) error {
+	partitions, err := listAuditPartitions(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	m.partitions.Set(float64(len(partitions)))
+	// Reset drops the series of removed partitions.
+	m.partitionBytes.Reset()
+	for _, p := range partitions {
+		m.partitionBytes.WithLabelValues(p.name).Set(float64(p.bytes))
+	}
+	return nil
+}
+
+// listAuditPartitions returns the partitions of audit_events, sorted by name.
+func listAuditPartitions(ctx context.Context, db sqlcgen.DBTX) ([]auditPartition, error) {
+	rows, err := db.Query(ctx, listAuditPartitionsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("list partitions: %w", err)
+	}
+	partitions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (auditPartition, error) {
+		var p auditPartition
+		err := row.Scan(&p.name, &p.bytes)
+		return p, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list partitions: %w", err)
+	}
+	return partitions, nil
+}
+
+// hasAuditPartition reports whether partitions contains the partition name.
+func hasAuditPartition(partitions []auditPartition, name string) bool {
+	return slices.ContainsFunc(partitions, func(p auditPartition) bool { return p.name == name })
+}
+
+// auditPartitionMonth returns the start of the month of t in UTC, the lower
+// bound of the partition holding events at t.
+func auditPartitionMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// auditPartitionName returns the name of the partition of month.
+func auditPartitionName(month time.Time) string {
+	return auditPartitionPrefix + month.Format("200601")
+}
+
+// parseAuditPartitionName returns the month of a monthly partition, and false
+// for any other partition.
+func parseAuditPartitionName(name string) (time.Time, bool) {
+	suffix, ok := strings.CutPrefix(name, auditPartitionPrefix)
+	if !ok || len(suffix) != len("200601") {
+		return time.Time{}, false
+	}
+	month, err := time.Parse("200601", suffix)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return month, true
+}
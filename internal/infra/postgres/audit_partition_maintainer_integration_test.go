@@ -0,0 +1,225 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres"
+)
+
+// isAuditPartition reports whether name is attached to audit_events.
+func isAuditPartition(t *testing.T, pool *pgxpool.Pool, name string) bool {
+	t.Helper()
+	var attached bool
+	err := pool.QueryRow(context.Background(), `SELECT EXISTS (
+		SELECT 1 FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'audit_events'::regclass AND c.relname = $1)`, name).Scan(&attached)
+	require.NoError(t, err)
+	return attached
+}
+
+// tableExists reports whether the table name exists.
+func tableExists(t *testing.T, pool *pgxpool.Pool, name string) bool {
+	t.Helper()
+	var exists bool
+	err := pool.QueryRow(context.Background(), "SELECT to_regclass($1) IS NOT NULL", name).Scan(&exists)
+	require.NoError(t, err)
+	return exists
+}
+
+func TestAuditPartitionMaintainer_Maintain(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewAuditEventRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), "DROP TABLE IF EXISTS audit_events_p202001")
+	})
+
+	_, err := pool.Exec(ctx, "CREATE TABLE audit_events_p202001 PARTITION OF audit_events FOR VALUES FROM ('2020-01-01T00:00:00Z') TO ('2020-02-01T00:00:00Z')")
+	require.NoError(t, err)
+
+	// Two events of an expired month, then a current one.
+	entityID, _ := uuid.NewV7()
+	record := func(ts time.Time) *domain.AuditEvent {
+		id, _ := uuid.NewV7()
+		e := &domain.AuditEvent{
+			ID:         domain.ID(id.String()),
+			EventType:  domain.EventUserUpdated,
+			EntityType: "user",
+			EntityID:   domain.ID(entityID.String()),
+			Payload:    []byte(`{}`),
+			Timestamp:  ts,
+		}
+		require.NoError(t, repo.Create(ctx, querier, e))
+		return e
+	}
+	record(time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC))
+	lastExpired := record(time.Date(2020, 1, 20, 0, 0, 0, 0, time.UTC))
+	current := record(time.Now().UTC())
+
+	registry := prometheus.NewRegistry()
+	maintainer := postgres.NewAuditPartitionMaintainer(&dbAdapter{p: pool}, postgres.AuditPartitionMaintainerConfig{
+		Premake:   2,
+		Retention: 24 * time.Hour,
+		Action:    postgres.AuditRetentionDetach,
+	}, slog.Default(), registry)
+
+	require.NoError(t, maintainer.Maintain(ctx))
+	// Idempotent.
+	require.NoError(t, maintainer.Maintain(ctx))
+
+	assert.False(t, isAuditPartition(t, pool, "audit_events_p202001"))
+	assert.True(t, tableExists(t, pool, "audit_events_p202001"), "detached partitions are kept")
+
+	// Partitions of this month and the next two exist.
+	month := time.Now().UTC()
+	for range 3 {
+		assert.True(t, isAuditPartition(t, pool, "audit_events_p"+month.Format("200601")))
+		month = time.Date(month.Year(), month.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	// The chain is anchored at the last removed event and still verifies.
+	anchor, err := repo.GetChainAnchor(ctx, querier)
+	require.NoError(t, err)
+	require.NotNil(t, anchor)
+	assert.Equal(t, lastExpired.ChainSeq, anchor.Seq)
+	assert.Equal(t, lastExpired.Hash, anchor.Hash)
+
+	chain, err := repo.ListChain(ctx, querier, anchor.Seq, 100)
+	require.NoError(t, err)
+	require.Len(t, chain, 1)
+	verifier := domain.NewAuditChainVerifier(anchor)
+	assert.Nil(t, verifier.Check(chain[0]))
+	assert.Equal(t, current.Hash, verifier.Hash())
+
+	// Metrics report the remaining partitions. The migration created more
+	// months than the maintainer keeps ahead, so count them.
+	var attached int
+	require.NoError(t, pool.QueryRow(ctx, "SELECT count(*) FROM pg_inherits WHERE inhparent = 'audit_events'::regclass").Scan(&attached))
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(fmt.Sprintf(`
+# HELP audit_partitions Number of partitions of audit_events, including the default partition
+# TYPE audit_partitions gauge
+audit_partitions %d
+`, attached)), "audit_partitions"))
+	count, err := testutil.GatherAndCount(registry, "audit_partition_bytes")
+	require.NoError(t, err)
+	assert.Equal(t, attached, count)
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP audit_partitions_removed_total Total number of audit_events partitions detached or dropped past retention
+# TYPE audit_partitions_removed_total counter
+audit_partitions_removed_total{action="detach"} 1
+`), "audit_partitions_removed_total"))
+}
+
+func TestAuditPartitionMaintainer_MovesEventsOutOfDefaultPartition(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewAuditEventRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	// Next month has no partition yet, so its events land in the default one.
+	now := time.Now().UTC()
+	next := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	nextPartition := "audit_events_p" + next.Format("200601")
+	_, err := pool.Exec(ctx, "DROP TABLE IF EXISTS "+nextPartition)
+	require.NoError(t, err)
+
+	entityID, _ := uuid.NewV7()
+	id, _ := uuid.NewV7()
+	event := &domain.AuditEvent{
+		ID:         domain.ID(id.String()),
+		EventType:  domain.EventUserUpdated,
+		EntityType: "user",
+		EntityID:   domain.ID(entityID.String()),
+		Payload:    []byte(`{}`),
+		Timestamp:  time.Date(next.Year(), next.Month(), 2, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, repo.Create(ctx, querier, event))
+
+	registry := prometheus.NewRegistry()
+	maintainer := postgres.NewAuditPartitionMaintainer(&dbAdapter{p: pool}, postgres.AuditPartitionMaintainerConfig{
+		Premake: 1,
+	}, slog.Default(), registry)
+
+	require.NoError(t, maintainer.Maintain(ctx))
+	// Later runs no longer collide with the default partition.
+	require.NoError(t, maintainer.Maintain(ctx))
+
+	assert.True(t, isAuditPartition(t, pool, nextPartition))
+	var inPartition, inDefault int
+	require.NoError(t, pool.QueryRow(ctx, "SELECT count(*) FROM "+nextPartition).Scan(&inPartition))
+	require.NoError(t, pool.QueryRow(ctx, "SELECT count(*) FROM audit_events_default").Scan(&inDefault))
+	assert.Equal(t, 1, inPartition, "the event moves into the new partition")
+	assert.Zero(t, inDefault)
+
+	stored, err := repo.GetByID(ctx, querier, event.ID)
+	require.NoError(t, err)
+	assert.Equal(t, event.Hash, stored.Hash)
+
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP audit_partition_default_rows_moved_total Total number of audit events moved out of the default partition into a new monthly partition
+# TYPE audit_partition_default_rows_moved_total counter
+audit_partition_default_rows_moved_total 1
+`), "audit_partition_default_rows_moved_total"))
+}
+
+func TestAuditPartitionMaintainer_AnchorContinuesChain(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewAuditEventRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), "DROP TABLE IF EXISTS audit_events_p202001")
+	})
+
+	_, err := pool.Exec(ctx, "CREATE TABLE audit_events_p202001 PARTITION OF audit_events FOR VALUES FROM ('2020-01-01T00:00:00Z') TO ('2020-02-01T00:00:00Z')")
+	require.NoError(t, err)
+
+	newEvent := func(ts time.Time) *domain.AuditEvent {
+		id, _ := uuid.NewV7()
+		entityID, _ := uuid.NewV7()
+		return &domain.AuditEvent{
+			ID:         domain.ID(id.String()),
+			EventType:  domain.EventUserCreated,
+			EntityType: "user",
+			EntityID:   domain.ID(entityID.String()),
+			Payload:    []byte(`{}`),
+			Timestamp:  ts,
+		}
+	}
+	old := newEvent(time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, repo.Create(ctx, querier, old))
+
+	maintainer := postgres.NewAuditPartitionMaintainer(&dbAdapter{p: pool}, postgres.AuditPartitionMaintainerConfig{
+		Retention: 24 * time.Hour,
+		Action:    postgres.AuditRetentionDrop,
+	}, slog.Default(), nil)
+	require.NoError(t, maintainer.Maintain(ctx))
+
+	// Every event of the tenant was removed; the next one links to the anchor.
+	next := newEvent(time.Now().UTC())
+	require.NoError(t, repo.Create(ctx, querier, next))
+	assert.Equal(t, old.ChainSeq+1, next.ChainSeq)
+	assert.Equal(t, old.Hash, next.PrevHash)
+}
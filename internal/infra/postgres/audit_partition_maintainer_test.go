@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditPartitionMonth(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want time.Time
+	}{
+		{"mid-month", time.Date(2026, 1, 15, 9, 30, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"first instant", time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"last instant", time.Date(2026, 1, 31, 23, 59, 59, 999999999, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		// Partitions follow UTC months: 1 Feb 05:00 in UTC+7 is still January.
+		{"other zone", time.Date(2026, 2, 1, 5, 0, 0, 0, jakarta), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, auditPartitionMonth(tt.t))
+		})
+	}
+}
+
+func TestAuditPartitionName(t *testing.T) {
+	month := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	name := auditPartitionName(month)
+
+	assert.Equal(t, "audit_events_p202603", name)
+	parsed, ok := parseAuditPartitionName(name)
+	assert.True(t, ok)
+	assert.Equal(t, month, parsed)
+}
+
+func TestParseAuditPartitionName_NotMonthly(t *testing.T) {
+	for _, name := range []string{
+		"audit_events_default",
+		"audit_events_p2026",
+		"audit_events_p202613",
+		"audit_events_p2026031",
+		"users_p202603",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, ok := parseAuditPartitionName(name)
+			assert.False(t, ok)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres/sqlcgen"
+)
+
+// AuditSinkCursorRepo implements domain.AuditSinkCursorRepository for PostgreSQL.
+type AuditSinkCursorRepo struct{}
+
+// NewAuditSinkCursorRepo creates a new AuditSinkCursorRepo instance.
+func NewAuditSinkCursorRepo() *AuditSinkCursorRepo {
+	return &AuditSinkCursorRepo{}
+}
+
+// LockSink takes a transaction-scoped advisory lock on sink if it is free.
+// With a pool querier the lock is released at once, so call it in a transaction.
+func (r *AuditSinkCursorRepo) LockSink(ctx context.Context, q domain.Querier, sink string) (bool, error) {
+	const op = "auditSinkCursorRepo.LockSink"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	locked, err := sqlcgen.New(dbtx).LockAuditSink(ctx, sink)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return locked, nil
+}
+
+// ListUndelivered retrieves up to limit chained events of any tenant after
+// the tenant's cursor for sink. Only committed events are visible, and chain
+// positions become visible in order, so no event is skipped. Events recorded
+// before the log was chained are never delivered.
+func (r *AuditSinkCursorRepo) ListUndelivered(ctx context.Context, q domain.Querier, sink string, limit int) ([]domain.AuditSinkRecord, error) {
+	const op = "auditSinkCursorRepo.ListUndelivered"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := sqlcgen.New(dbtx).ListUndeliveredAuditEvents(ctx, sqlcgen.ListUndeliveredAuditEventsParams{
+		Sink:     sink,
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: query: %w", op, err)
+	}
+
+	records := make([]domain.AuditSinkRecord, 0, len(rows))
+	for _, row := range rows {
+		records = append(records, domain.AuditSinkRecord{
+			TenantID: row.TenantID,
+			Event:    toDomainAuditEvent(row),
+		})
+	}
+	return records, nil
+}
+
+// SaveCursor moves the cursor of sink for tenantID forward to chainSeq.
+func (r *AuditSinkCursorRepo) SaveCursor(ctx context.Context, q domain.Querier, sink, tenantID string, chainSeq int64) error {
+	const op = "auditSinkCursorRepo.SaveCursor"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = sqlcgen.New(dbtx).SaveAuditSinkCursor(ctx, sqlcgen.SaveAuditSinkCursorParams{
+		Sink:     sink,
+		TenantID: tenantID,
+		ChainSeq: chainSeq,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Ensure AuditSinkCursorRepo implements domain.AuditSinkCursorRepository at compile time.
+var _ domain.AuditSinkCursorRepository = (*AuditSinkCursorRepo)(nil)
@@ -0,0 +1,147 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+)
+
+func TestAuditSinkCursorRepo_Delivery(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := postgres.NewAuditSinkCursorRepo()
+	events := postgres.NewAuditEventRepo()
+	txManager := postgres.NewTxManager(&dbAdapter{p: pool})
+	crossTenant := postgres.NewCrossTenantTxManager(&dbAdapter{p: pool})
+	acme := sharedctx.SetTenantID(context.Background(), "acme")
+	globex := sharedctx.SetTenantID(context.Background(), "globex")
+	ctx := context.Background()
+
+	record := func(ctx context.Context, tx domain.Querier) *domain.AuditEvent {
+		id, _ := uuid.NewV7()
+		entityID, _ := uuid.NewV7()
+		e := &domain.AuditEvent{
+			ID:         domain.ID(id.String()),
+			EventType:  domain.EventUserCreated,
+			EntityType: "user",
+			EntityID:   domain.ID(entityID.String()),
+			Payload:    []byte(`{}`),
+			Timestamp:  time.Now().UTC(),
+		}
+		require.NoError(t, events.Create(ctx, tx, e))
+		return e
+	}
+	commit := func(ctx context.Context) *domain.AuditEvent {
+		var e *domain.AuditEvent
+		require.NoError(t, txManager.WithTx(ctx, func(tx domain.Querier) error {
+			e = record(ctx, tx)
+			return nil
+		}))
+		return e
+	}
+
+	a1 := commit(acme)
+	// A rolled-back event is never listed, and its chain position is reused.
+	errRollback := errors.New("rollback")
+	err := txManager.WithTx(acme, func(tx domain.Querier) error {
+		record(acme, tx)
+		return errRollback
+	})
+	require.ErrorIs(t, err, errRollback)
+	a2 := commit(acme)
+	g1 := commit(globex)
+	assert.Equal(t, int64(2), a2.ChainSeq)
+
+	list := func(sink string, limit int) []domain.AuditSinkRecord {
+		var records []domain.AuditSinkRecord
+		require.NoError(t, crossTenant.WithTx(ctx, func(tx domain.Querier) error {
+			var err error
+			records, err = repo.ListUndelivered(ctx, tx, sink, limit)
+			return err
+		}))
+		return records
+	}
+
+	records := list("siem", 10)
+	require.Len(t, records, 3)
+	assert.Equal(t, "acme", records[0].TenantID)
+	assert.Equal(t, a1.ID, records[0].Event.ID)
+	assert.Equal(t, a1.Hash, records[0].Event.Hash)
+	assert.Equal(t, a2.ID, records[1].Event.ID)
+	assert.Equal(t, "globex", records[2].TenantID)
+	assert.Equal(t, g1.ID, records[2].Event.ID)
+
+	// A tenant-scoped transaction only sees its tenant's events.
+	require.NoError(t, txManager.WithTx(globex, func(tx domain.Querier) error {
+		scoped, err := repo.ListUndelivered(globex, tx, "siem", 10)
+		require.Len(t, scoped, 1)
+		return err
+	}))
+
+	assert.Len(t, list("siem", 2), 2)
+
+	require.NoError(t, crossTenant.WithTx(ctx, func(tx domain.Querier) error {
+		if err := repo.SaveCursor(ctx, tx, "siem", "acme", 2); err != nil {
+			return err
+		}
+		// The cursor never moves backwards.
+		return repo.SaveCursor(ctx, tx, "siem", "acme", 1)
+	}))
+
+	records = list("siem", 10)
+	require.Len(t, records, 1)
+	assert.Equal(t, g1.ID, records[0].Event.ID)
+	assert.Len(t, list("archive", 10), 3, "cursors are per sink")
+
+	a3 := commit(acme)
+	records = list("siem", 10)
+	require.Len(t, records, 2)
+	assert.Equal(t, a3.ID, records[0].Event.ID)
+}
+
+func TestAuditSinkCursorRepo_LockSink(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := postgres.NewAuditSinkCursorRepo()
+	txManager := postgres.NewCrossTenantTxManager(&dbAdapter{p: pool})
+	ctx := context.Background()
+
+	err := txManager.WithTx(ctx, func(tx domain.Querier) error {
+		locked, err := repo.LockSink(ctx, tx, "siem")
+		require.NoError(t, err)
+		assert.True(t, locked)
+
+		// Another transaction cannot claim the same sink, but can claim others.
+		return txManager.WithTx(ctx, func(other domain.Querier) error {
+			locked, err := repo.LockSink(ctx, other, "siem")
+			require.NoError(t, err)
+			assert.False(t, locked)
+
+			locked, err = repo.LockSink(ctx, other, "archive")
+			require.NoError(t, err)
+			assert.True(t, locked)
+			return nil
+		})
+	})
+	require.NoError(t, err)
+
+	// Released when the transaction ends.
+	require.NoError(t, txManager.WithTx(ctx, func(tx domain.Querier) error {
+		locked, err := repo.LockSink(ctx, tx, "siem")
+		assert.True(t, locked)
+		return err
+	}))
+}
@@ -64,8 +64,8 @@ func TestCaseInsensitiveEmail_CITEXT(t *testing.T) {
 	userID1 := uuid.New()
 	now := time.Now().UTC()
 	_, err = conn.Exec(ctx,
-		`INSERT INTO users (id, email, first_name, last_name, created_at, updated_at) 
-		 VALUES ($1, $2, $3, $4, $5, $6)`,
+		`INSERT INTO users (id, email, first_name, last_name, created_at, updated_at, tenant_id) 
+		 VALUES ($1, $2, $3, $4, $5, $6, 'default')`,
 		userID1, email1, "Test", "User", now, now,
 	)
 	require.NoError(t, err, "Should be able to insert first user")
@@ -74,8 +74,8 @@ func TestCaseInsensitiveEmail_CITEXT(t *testing.T) {
 	const errCodeUniqueViolation = "23505"
 	userID2 := uuid.New()
 	_, err = conn.Exec(ctx,
-		`INSERT INTO users (id, email, first_name, last_name, created_at, updated_at) 
-		 VALUES ($1, $2, $3, $4, $5, $6)`,
+		`INSERT INTO users (id, email, first_name, last_name, created_at, updated_at, tenant_id) 
+		 VALUES ($1, $2, $3, $4, $5, $6, 'default')`,
 		userID2, email2, "Duplicate", "User", now, now,
 	)
 
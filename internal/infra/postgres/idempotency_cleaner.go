@@ -172,7 +172,8 @@ func (c *IdempotencyCleaner) cleanup(ctx context.Context) {
 
 	queries := sqlcgen.New(pool)
 
-	deleted, err := queries.DeleteExpiredIdempotencyKeys(ctx)
+	// Expired keys of every tenant are deleted.
+	deleted, err := queries.DeleteExpiredIdempotencyKeys(CrossTenant(ctx))
 	duration := time.Since(start)
 
 	if err != nil {
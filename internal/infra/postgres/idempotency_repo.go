@@ -25,6 +25,7 @@ var ErrKeyAlreadyExists = errors.New("idempotency key already exists")
 
 // IdempotencyRepo implements middleware.IdempotencyStore for PostgreSQL.
 // It stores idempotency records for replay of duplicate POST requests.
+// Keys are scoped to the tenant of the request context.
 type IdempotencyRepo struct {
 	pool Pooler
 }
@@ -45,7 +46,10 @@ func (r *IdempotencyRepo) Get(ctx context.Context, key string) (*middleware.Idem
 	}
 	queries := sqlcgen.New(pool)
 
-	row, err := queries.GetIdempotencyKey(ctx, key)
+	row, err := queries.GetIdempotencyKey(ctx, sqlcgen.GetIdempotencyKeyParams{
+		TenantID: tenantID(ctx),
+		Key:      key,
+	})
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, nil // Key not found or expired
 	}
@@ -95,6 +99,7 @@ func (r *IdempotencyRepo) Store(ctx context.Context, record *middleware.Idempote
 		ResponseBody:    record.ResponseBody,
 		CreatedAt:       pgtype.Timestamptz{Time: record.CreatedAt, Valid: true},
 		ExpiresAt:       pgtype.Timestamptz{Time: record.ExpiresAt, Valid: true},
+		TenantID:        tenantID(ctx),
 	}
 
 	if err := queries.CreateIdempotencyKey(ctx, params); err != nil {
@@ -108,11 +113,13 @@ func (r *IdempotencyRepo) Store(ctx context.Context, record *middleware.Idempote
 	return nil
 }
 
-// DeleteExpired removes all expired idempotency records.
+// DeleteExpired removes all expired idempotency records of all tenants.
 // Returns the number of deleted records.
 func (r *IdempotencyRepo) DeleteExpired(ctx context.Context) (int64, error) {
 	const op = "idempotencyRepo.DeleteExpired"
 
+	ctx = CrossTenant(ctx)
+
 	pool := r.pool.Pool()
 	if pool == nil {
 		return 0, fmt.Errorf("%s: database not connected", op)
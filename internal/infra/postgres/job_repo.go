@@ -0,0 +1,267 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres/sqlcgen"
+)
+
+// JobRepo implements domain.JobRepository for PostgreSQL.
+type JobRepo struct{}
+
+// NewJobRepo creates a new JobRepo instance.
+func NewJobRepo() *JobRepo {
+	return &JobRepo{}
+}
+
+// jobRowError is the JSONB representation of domain.JobRowError.
+type jobRowError struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+// Create stores a new job for the tenant of ctx.
+func (r *JobRepo) Create(ctx context.Context, q domain.Querier, job *domain.Job) error {
+	const op = "jobRepo.Create"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	id, err := uuid.Parse(string(job.ID))
+	if err != nil {
+		return fmt.Errorf("%s: parse ID: %w", op, err)
+	}
+	createdBy, err := uuid.Parse(string(job.CreatedBy))
+	if err != nil {
+		return fmt.Errorf("%s: parse createdBy: %w", op, err)
+	}
+
+	err = queries.CreateJob(ctx, sqlcgen.CreateJobParams{
+		ID:        pgtype.UUID{Bytes: id, Valid: true},
+		Type:      string(job.Type),
+		Status:    string(job.Status),
+		CreatedBy: pgtype.UUID{Bytes: createdBy, Valid: true},
+		RequestID: pgtype.Text{String: job.RequestID, Valid: job.RequestID != ""},
+		Input:     job.Input,
+		TotalRows: int32(job.TotalRows),
+		CreatedAt: pgtype.Timestamptz{Time: job.CreatedAt, Valid: true},
+		UpdatedAt: pgtype.Timestamptz{Time: job.UpdatedAt, Valid: true},
+		TenantID:  tenantID(ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// GetByID retrieves a job without its input.
+// It returns domain.ErrJobNotFound if no job exists with the given ID.
+func (r *JobRepo) GetByID(ctx context.Context, q domain.Querier, id domain.ID) (*domain.Job, error) {
+	const op = "jobRepo.GetByID"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	uid, err := uuid.Parse(string(id))
+	if err != nil {
+		return nil, fmt.Errorf("%s: parse ID: %w", op, err)
+	}
+
+	row, err := queries.GetJobByID(ctx, sqlcgen.GetJobByIDParams{
+		TenantID: tenantID(ctx),
+		ID:       pgtype.UUID{Bytes: uid, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", op, domain.ErrJobNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	job, err := toDomainJob(sqlcgen.Job{
+		ID:            row.ID,
+		Type:          row.Type,
+		Status:        row.Status,
+		CreatedBy:     row.CreatedBy,
+		TenantID:      row.TenantID,
+		RequestID:     row.RequestID,
+		TotalRows:     row.TotalRows,
+		NextRow:       row.NextRow,
+		ProcessedRows: row.ProcessedRows,
+		FailedRows:    row.FailedRows,
+		Errors:        row.Errors,
+		Error:         row.Error,
+		LockedUntil:   row.LockedUntil,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+		StartedAt:     row.StartedAt,
+		FinishedAt:    row.FinishedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return job, nil
+}
+
+// ClaimNext leases the next available job of the given type, whatever its
+// tenant (with a pool querier, the claim runs cross-tenant). Callers process
+// the job within job.TenantID.
+// It returns domain.ErrJobNotFound if there is nothing to claim.
+func (r *JobRepo) ClaimNext(ctx context.Context, q domain.Querier, jobType domain.JobType, leaseUntil time.Time) (*domain.Job, error) {
+	const op = "jobRepo.ClaimNext"
+
+	ctx = CrossTenant(ctx)
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	row, err := queries.ClaimNextJob(ctx, sqlcgen.ClaimNextJobParams{
+		LeaseUntil: pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+		Type:       string(jobType),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", op, domain.ErrJobNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	job, err := toDomainJob(row)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return job, nil
+}
+
+// UpdateProgress saves the job's progress and extends its lease.
+// It returns domain.ErrJobLeaseLost if job.LockedUntil no longer matches the stored lease.
+// On success job.LockedUntil and job.UpdatedAt are set to the stored values.
+func (r *JobRepo) UpdateProgress(ctx context.Context, q domain.Querier, job *domain.Job, leaseUntil time.Time) error {
+	const op = "jobRepo.UpdateProgress"
+
+	if job.LockedUntil == nil {
+		return fmt.Errorf("%s: %w", op, domain.ErrJobLeaseLost)
+	}
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	uid, err := uuid.Parse(string(job.ID))
+	if err != nil {
+		return fmt.Errorf("%s: parse ID: %w", op, err)
+	}
+
+	rowErrors := make([]jobRowError, len(job.Errors))
+	for i, e := range job.Errors {
+		rowErrors[i] = jobRowError{Row: e.Row, Message: e.Message}
+	}
+	errorsJSON, err := json.Marshal(rowErrors)
+	if err != nil {
+		return fmt.Errorf("%s: marshal errors: %w", op, err)
+	}
+
+	// PostgreSQL stores microseconds; truncate so the next lease comparison matches.
+	lease := leaseUntil.Truncate(time.Microsecond)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	params := sqlcgen.UpdateJobProgressParams{
+		Status:        string(job.Status),
+		NextRow:       int32(job.NextRow),
+		ProcessedRows: int32(job.ProcessedRows),
+		FailedRows:    int32(job.FailedRows),
+		Errors:        errorsJSON,
+		Error:         pgtype.Text{String: job.Error, Valid: job.Error != ""},
+		LeaseUntil:    pgtype.Timestamptz{Time: lease, Valid: true},
+		UpdatedAt:     pgtype.Timestamptz{Time: now, Valid: true},
+		ID:            pgtype.UUID{Bytes: uid, Valid: true},
+		TenantID:      tenantID(ctx),
+		CurrentLease:  pgtype.Timestamptz{Time: *job.LockedUntil, Valid: true},
+	}
+	if job.FinishedAt != nil {
+		params.FinishedAt = pgtype.Timestamptz{Time: *job.FinishedAt, Valid: true}
+	}
+
+	affected, err := queries.UpdateJobProgress(ctx, params)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, domain.ErrJobLeaseLost)
+	}
+
+	job.LockedUntil = &lease
+	job.UpdatedAt = now
+	return nil
+}
+
+func toDomainJob(row sqlcgen.Job) (*domain.Job, error) {
+	id, err := uuid.FromBytes(row.ID.Bytes[:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid uuid from db: %w", err)
+	}
+	createdBy, err := uuid.FromBytes(row.CreatedBy.Bytes[:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid uuid from db: %w", err)
+	}
+
+	var rowErrors []jobRowError
+	if len(row.Errors) > 0 {
+		if err := json.Unmarshal(row.Errors, &rowErrors); err != nil {
+			return nil, fmt.Errorf("unmarshal errors: %w", err)
+		}
+	}
+
+	job := &domain.Job{
+		ID:            domain.ID(id.String()),
+		Type:          domain.JobType(row.Type),
+		Status:        domain.JobStatus(row.Status),
+		CreatedBy:     domain.ID(createdBy.String()),
+		TenantID:      row.TenantID,
+		RequestID:     row.RequestID.String,
+		Input:         row.Input,
+		TotalRows:     int(row.TotalRows),
+		NextRow:       int(row.NextRow),
+		ProcessedRows: int(row.ProcessedRows),
+		FailedRows:    int(row.FailedRows),
+		Errors:        make([]domain.JobRowError, len(rowErrors)),
+		Error:         row.Error.String,
+		LockedUntil:   optionalTime(row.LockedUntil),
+		CreatedAt:     row.CreatedAt.Time,
+		UpdatedAt:     row.UpdatedAt.Time,
+		StartedAt:     optionalTime(row.StartedAt),
+		FinishedAt:    optionalTime(row.FinishedAt),
+	}
+	for i, e := range rowErrors {
+		job.Errors[i] = domain.JobRowError{Row: e.Row, Message: e.Message}
+	}
+	return job, nil
+}
+
+func optionalTime(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
@@ -0,0 +1,124 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres"
+)
+
+func newTestJob(t *testing.T) *domain.Job {
+	t.Helper()
+
+	id, err := uuid.NewV7()
+	require.NoError(t, err)
+	actor, err := uuid.NewV7()
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	return &domain.Job{
+		ID:        domain.ID(id.String()),
+		Type:      domain.JobTypeUserImport,
+		Status:    domain.JobStatusPending,
+		CreatedBy: domain.ID(actor.String()),
+		RequestID: "req-1",
+		Input:     []byte("email,firstName,lastName\na@example.com,Ann,Lee\n"),
+		TotalRows: 1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func TestJobRepo_Lifecycle(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer func() { _, _ = pool.Exec(context.Background(), "DELETE FROM jobs") }()
+
+	ctx := context.Background()
+	repo := postgres.NewJobRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	job := newTestJob(t)
+	require.NoError(t, repo.Create(ctx, querier, job))
+
+	found, err := repo.GetByID(ctx, querier, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusPending, found.Status)
+	assert.Equal(t, job.CreatedBy, found.CreatedBy)
+	assert.Nil(t, found.Input, "GetByID must not load the input")
+	assert.Empty(t, found.Errors)
+
+	// Claim leases the job and returns its input.
+	lease := time.Now().Add(time.Minute)
+	claimed, err := repo.ClaimNext(ctx, querier, domain.JobTypeUserImport, lease)
+	require.NoError(t, err)
+	assert.Equal(t, job.ID, claimed.ID)
+	assert.Equal(t, domain.JobStatusRunning, claimed.Status)
+	assert.Equal(t, job.Input, claimed.Input)
+	require.NotNil(t, claimed.StartedAt)
+	require.NotNil(t, claimed.LockedUntil)
+
+	// A leased job cannot be claimed again.
+	_, err = repo.ClaimNext(ctx, querier, domain.JobTypeUserImport, lease)
+	assert.ErrorIs(t, err, domain.ErrJobNotFound)
+
+	finished := time.Now().UTC().Truncate(time.Microsecond)
+	claimed.Status = domain.JobStatusSucceeded
+	claimed.NextRow, claimed.ProcessedRows, claimed.FailedRows = 1, 1, 1
+	claimed.Errors = []domain.JobRowError{{Row: 1, Message: "email already exists"}}
+	claimed.FinishedAt = &finished
+	require.NoError(t, repo.UpdateProgress(ctx, querier, claimed, time.Now().Add(time.Minute)))
+
+	found, err = repo.GetByID(ctx, querier, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.JobStatusSucceeded, found.Status)
+	assert.Equal(t, 1, found.FailedRows)
+	assert.Equal(t, claimed.Errors, found.Errors)
+	require.NotNil(t, found.FinishedAt)
+	assert.True(t, finished.Equal(*found.FinishedAt))
+}
+
+func TestJobRepo_UpdateProgress_LeaseLost(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer func() { _, _ = pool.Exec(context.Background(), "DELETE FROM jobs") }()
+
+	ctx := context.Background()
+	repo := postgres.NewJobRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	require.NoError(t, repo.Create(ctx, querier, newTestJob(t)))
+
+	// An expired lease is reclaimed by another worker.
+	first, err := repo.ClaimNext(ctx, querier, domain.JobTypeUserImport, time.Now().Add(-time.Second))
+	require.NoError(t, err)
+	second, err := repo.ClaimNext(ctx, querier, domain.JobTypeUserImport, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, second.ID)
+
+	first.NextRow = 1
+	err = repo.UpdateProgress(ctx, querier, first, time.Now().Add(time.Minute))
+	assert.ErrorIs(t, err, domain.ErrJobLeaseLost)
+
+	second.NextRow = 1
+	assert.NoError(t, repo.UpdateProgress(ctx, querier, second, time.Now().Add(time.Minute)))
+}
+
+func TestJobRepo_GetByID_NotFound(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	id, err := uuid.NewV7()
+	require.NoError(t, err)
+
+	_, err = postgres.NewJobRepo().GetByID(context.Background(), postgres.NewPoolQuerier(&dbAdapter{p: pool}), domain.ID(id.String()))
+	assert.ErrorIs(t, err, domain.ErrJobNotFound)
+}
@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// keysetArgs converts a domain.Cursor into the (time, id) arguments of the
+// keyset queries (ListUsersAfter/Before, ListAuditEventsByEntityAfter/Before).
+func keysetArgs(c *domain.Cursor) (pgtype.Timestamptz, pgtype.UUID, error) {
+	id, err := uuid.Parse(string(c.ID))
+	if err != nil {
+		return pgtype.Timestamptz{}, pgtype.UUID{}, fmt.Errorf("parse cursor ID: %w", err)
+	}
+	return pgtype.Timestamptz{Time: c.Time, Valid: true}, pgtype.UUID{Bytes: id, Valid: true}, nil
+}
@@ -0,0 +1,65 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres"
+)
+
+func TestOAuthClientRepo_CreateAndGet(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer func() { _, _ = pool.Exec(context.Background(), "DELETE FROM oauth_clients") }()
+
+	ctx := context.Background()
+	repo := postgres.NewOAuthClientRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	id, err := uuid.NewV7()
+	require.NoError(t, err)
+	client := &domain.OAuthClient{
+		ID:         domain.ID(id.String()),
+		Name:       "billing",
+		SecretHash: "$2a$10$hash",
+		Role:       "user",
+		Scopes:     []string{"users:read"},
+		CreatedBy:  "admin-1",
+		CreatedAt:  time.Now().UTC().Truncate(time.Microsecond),
+	}
+	require.NoError(t, repo.Create(ctx, querier, client))
+
+	got, err := repo.GetByID(ctx, querier, client.ID)
+	require.NoError(t, err)
+	assert.Equal(t, client.ID, got.ID)
+	assert.Equal(t, client.Name, got.Name)
+	assert.Equal(t, client.SecretHash, got.SecretHash)
+	assert.Equal(t, client.Role, got.Role)
+	assert.Equal(t, client.Scopes, got.Scopes)
+	assert.Equal(t, client.CreatedBy, got.CreatedBy)
+	assert.Nil(t, got.DisabledAt)
+	assert.True(t, client.CreatedAt.Equal(got.CreatedAt))
+	assert.False(t, got.Disabled())
+}
+
+func TestOAuthClientRepo_GetByID_NotFound(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewOAuthClientRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	for _, id := range []domain.ID{"not-a-uuid", domain.ID(uuid.NewString())} {
+		_, err := repo.GetByID(ctx, querier, id)
+		assert.ErrorIs(t, err, domain.ErrOAuthClientNotFound, "id %q", id)
+	}
+}
@@ -66,6 +66,10 @@ func getPGXPoolConfig(databaseURL string, poolCfg PoolConfig) (*pgxpool.Config,
 		config.MaxConnLifetime = poolCfg.MaxConnLifetime
 	}
 
+	// Every query is scoped to the tenant of its context, in or out of a
+	// transaction, so row-level security never sees an unset tenant.
+	config.PrepareConn = scopeConn
+
 	return config, nil
 }
 
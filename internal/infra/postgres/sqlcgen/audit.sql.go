@@ -11,18 +11,43 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const anchorAuditChains = `-- name: AnchorAuditChains :exec
+INSERT INTO audit_chain_anchors (tenant_id, chain_seq, hash)
+SELECT DISTINCT ON (tenant_id) tenant_id, chain_seq, hash
+FROM audit_events
+WHERE timestamp >= $1 AND timestamp < $2 AND chain_seq IS NOT NULL
+ORDER BY tenant_id, chain_seq DESC
+ON CONFLICT (tenant_id) DO UPDATE
+SET chain_seq = EXCLUDED.chain_seq, hash = EXCLUDED.hash, updated_at = now()
+WHERE audit_chain_anchors.chain_seq < EXCLUDED.chain_seq
+`
+
+type AnchorAuditChainsParams struct {
+	FromTime pgtype.Timestamptz `db:"from_time" json:"from_time"`
+	ToTime   pgtype.Timestamptz `db:"to_time" json:"to_time"`
+}
+
+// Records the last chained event of each tenant with a timestamp in
+// [from_time, to_time) as its chain anchor, unless the anchor is further
+// along. Run in the transaction that removes the events. Not tenant-scoped.
+func (q *Queries) AnchorAuditChains(ctx context.Context, arg AnchorAuditChainsParams) error {
+	_, err := q.db.Exec(ctx, anchorAuditChains, arg.FromTime, arg.ToTime)
+	return err
+}
+
 const countAuditEventsByEntity = `-- name: CountAuditEventsByEntity :one
 SELECT COUNT(*) FROM audit_events
-WHERE entity_type = $1 AND entity_id = $2
+WHERE tenant_id = $1 AND entity_type = $2 AND entity_id = $3
 `
 
 type CountAuditEventsByEntityParams struct {
+	TenantID   string      `db:"tenant_id" json:"tenant_id"`
 	EntityType string      `db:"entity_type" json:"entity_type"`
 	EntityID   pgtype.UUID `db:"entity_id" json:"entity_id"`
 }
 
 func (q *Queries) CountAuditEventsByEntity(ctx context.Context, arg CountAuditEventsByEntityParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countAuditEventsByEntity, arg.EntityType, arg.EntityID)
+	row := q.db.QueryRow(ctx, countAuditEventsByEntity, arg.TenantID, arg.EntityType, arg.EntityID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -30,8 +55,8 @@ func (q *Queries) CountAuditEventsByEntity(ctx context.Context, arg CountAuditEv
 
 const createAuditEvent = `-- name: CreateAuditEvent :exec
 
-INSERT INTO audit_events (id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO audit_events (id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 `
 
 type CreateAuditEventParams struct {
@@ -43,10 +68,15 @@ type CreateAuditEventParams struct {
 	Payload    []byte             `db:"payload" json:"payload"`
 	Timestamp  pgtype.Timestamptz `db:"timestamp" json:"timestamp"`
 	RequestID  pgtype.Text        `db:"request_id" json:"request_id"`
+	TenantID   string             `db:"tenant_id" json:"tenant_id"`
+	ChainSeq   pgtype.Int8        `db:"chain_seq" json:"chain_seq"`
+	PrevHash   []byte             `db:"prev_hash" json:"prev_hash"`
+	Hash       []byte             `db:"hash" json:"hash"`
 }
 
 // Audit queries for sqlc
 // Story 5.4: Type-safe SQL queries for Audit module
+// Every query is scoped to one tenant (tenant_id).
 func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) error {
 	_, err := q.db.Exec(ctx, createAuditEvent,
 		arg.ID,
@@ -57,17 +87,72 @@ func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventPara
 		arg.Payload,
 		arg.Timestamp,
 		arg.RequestID,
+		arg.TenantID,
+		arg.ChainSeq,
+		arg.PrevHash,
+		arg.Hash,
 	)
 	return err
 }
 
+const getAuditChainAnchor = `-- name: GetAuditChainAnchor :one
+SELECT chain_seq, hash FROM audit_chain_anchors
+WHERE tenant_id = $1
+`
+
+type GetAuditChainAnchorRow struct {
+	ChainSeq int64  `db:"chain_seq" json:"chain_seq"`
+	Hash     []byte `db:"hash" json:"hash"`
+}
+
+// Last event of a tenant's chain removed by retention.
+func (q *Queries) GetAuditChainAnchor(ctx context.Context, tenantID string) (GetAuditChainAnchorRow, error) {
+	row := q.db.QueryRow(ctx, getAuditChainAnchor, tenantID)
+	var i GetAuditChainAnchorRow
+	err := row.Scan(&i.ChainSeq, &i.Hash)
+	return i, err
+}
+
+const getAuditChainHead = `-- name: GetAuditChainHead :one
+SELECT chain_seq, hash FROM (
+    (SELECT chain_seq, hash FROM audit_events
+     WHERE tenant_id = $1::text AND chain_seq IS NOT NULL
+     ORDER BY chain_seq DESC
+     LIMIT 1)
+    UNION ALL
+    SELECT chain_seq, hash FROM audit_chain_anchors
+    WHERE tenant_id = $1::text
+) head
+ORDER BY chain_seq DESC
+LIMIT 1
+`
+
+type GetAuditChainHeadRow struct {
+	ChainSeq pgtype.Int8 `db:"chain_seq" json:"chain_seq"`
+	Hash     []byte      `db:"hash" json:"hash"`
+}
+
+// Last link of a tenant's hash chain: its last event, or its anchor when
+// retention removed every event after it. Only valid under LockAuditChain.
+func (q *Queries) GetAuditChainHead(ctx context.Context, tenantID string) (GetAuditChainHeadRow, error) {
+	row := q.db.QueryRow(ctx, getAuditChainHead, tenantID)
+	var i GetAuditChainHeadRow
+	err := row.Scan(&i.ChainSeq, &i.Hash)
+	return i, err
+}
+
 const getAuditEventByID = `-- name: GetAuditEventByID :one
-SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id
-FROM audit_events WHERE id = $1
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
+FROM audit_events WHERE tenant_id = $1 AND id = $2
 `
 
-func (q *Queries) GetAuditEventByID(ctx context.Context, id pgtype.UUID) (AuditEvent, error) {
-	row := q.db.QueryRow(ctx, getAuditEventByID, id)
+type GetAuditEventByIDParams struct {
+	TenantID string      `db:"tenant_id" json:"tenant_id"`
+	ID       pgtype.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) GetAuditEventByID(ctx context.Context, arg GetAuditEventByIDParams) (AuditEvent, error) {
+	row := q.db.QueryRow(ctx, getAuditEventByID, arg.TenantID, arg.ID)
 	var i AuditEvent
 	err := row.Scan(
 		&i.ID,
@@ -78,19 +163,72 @@ func (q *Queries) GetAuditEventByID(ctx context.Context, id pgtype.UUID) (AuditE
 		&i.Payload,
 		&i.Timestamp,
 		&i.RequestID,
+		&i.TenantID,
+		&i.ChainSeq,
+		&i.PrevHash,
+		&i.Hash,
 	)
 	return i, err
 }
 
+const listAuditChain = `-- name: ListAuditChain :many
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
+FROM audit_events
+WHERE tenant_id = $1 AND chain_seq > $2::bigint
+ORDER BY chain_seq
+LIMIT $3
+`
+
+type ListAuditChainParams struct {
+	TenantID string `db:"tenant_id" json:"tenant_id"`
+	AfterSeq int64  `db:"after_seq" json:"after_seq"`
+	RowLimit int32  `db:"row_limit" json:"row_limit"`
+}
+
+// Chained events of a tenant after after_seq, in chain order.
+func (q *Queries) ListAuditChain(ctx context.Context, arg ListAuditChainParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditChain, arg.TenantID, arg.AfterSeq, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.ActorID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Payload,
+			&i.Timestamp,
+			&i.RequestID,
+			&i.TenantID,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listAuditEventsByEntity = `-- name: ListAuditEventsByEntity :many
-SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
 FROM audit_events
-WHERE entity_type = $1 AND entity_id = $2
+WHERE tenant_id = $1 AND entity_type = $2 AND entity_id = $3
 ORDER BY timestamp DESC, id DESC
-LIMIT $3 OFFSET $4
+LIMIT $4 OFFSET $5
 `
 
 type ListAuditEventsByEntityParams struct {
+	TenantID   string      `db:"tenant_id" json:"tenant_id"`
 	EntityType string      `db:"entity_type" json:"entity_type"`
 	EntityID   pgtype.UUID `db:"entity_id" json:"entity_id"`
 	Limit      int32       `db:"limit" json:"limit"`
@@ -99,6 +237,7 @@ type ListAuditEventsByEntityParams struct {
 
 func (q *Queries) ListAuditEventsByEntity(ctx context.Context, arg ListAuditEventsByEntityParams) ([]AuditEvent, error) {
 	rows, err := q.db.Query(ctx, listAuditEventsByEntity,
+		arg.TenantID,
 		arg.EntityType,
 		arg.EntityID,
 		arg.Limit,
@@ -120,6 +259,128 @@ func (q *Queries) ListAuditEventsByEntity(ctx context.Context, arg ListAuditEven
 			&i.Payload,
 			&i.Timestamp,
 			&i.RequestID,
+			&i.TenantID,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEventsByEntityAfter = `-- name: ListAuditEventsByEntityAfter :many
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
+FROM audit_events
+WHERE tenant_id = $1 AND entity_type = $2 AND entity_id = $3
+  AND (timestamp, id) < ($4::timestamptz, $5::uuid)
+ORDER BY timestamp DESC, id DESC
+LIMIT $6
+`
+
+type ListAuditEventsByEntityAfterParams struct {
+	TenantID   string             `db:"tenant_id" json:"tenant_id"`
+	EntityType string             `db:"entity_type" json:"entity_type"`
+	EntityID   pgtype.UUID        `db:"entity_id" json:"entity_id"`
+	CursorTime pgtype.Timestamptz `db:"cursor_time" json:"cursor_time"`
+	CursorID   pgtype.UUID        `db:"cursor_id" json:"cursor_id"`
+	RowLimit   int32              `db:"row_limit" json:"row_limit"`
+}
+
+// Keyset page of an entity's audit events strictly after the cursor, in list order.
+func (q *Queries) ListAuditEventsByEntityAfter(ctx context.Context, arg ListAuditEventsByEntityAfterParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditEventsByEntityAfter,
+		arg.TenantID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.ActorID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Payload,
+			&i.Timestamp,
+			&i.RequestID,
+			&i.TenantID,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEventsByEntityBefore = `-- name: ListAuditEventsByEntityBefore :many
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
+FROM audit_events
+WHERE tenant_id = $1 AND entity_type = $2 AND entity_id = $3
+  AND (timestamp, id) > ($4::timestamptz, $5::uuid)
+ORDER BY timestamp ASC, id ASC
+LIMIT $6
+`
+
+type ListAuditEventsByEntityBeforeParams struct {
+	TenantID   string             `db:"tenant_id" json:"tenant_id"`
+	EntityType string             `db:"entity_type" json:"entity_type"`
+	EntityID   pgtype.UUID        `db:"entity_id" json:"entity_id"`
+	CursorTime pgtype.Timestamptz `db:"cursor_time" json:"cursor_time"`
+	CursorID   pgtype.UUID        `db:"cursor_id" json:"cursor_id"`
+	RowLimit   int32              `db:"row_limit" json:"row_limit"`
+}
+
+// Keyset page of an entity's audit events strictly before the cursor, nearest first (reverse list order).
+func (q *Queries) ListAuditEventsByEntityBefore(ctx context.Context, arg ListAuditEventsByEntityBeforeParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditEventsByEntityBefore,
+		arg.TenantID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.ActorID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Payload,
+			&i.Timestamp,
+			&i.RequestID,
+			&i.TenantID,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.Hash,
 		); err != nil {
 			return nil, err
 		}
@@ -132,14 +393,19 @@ func (q *Queries) ListAuditEventsByEntity(ctx context.Context, arg ListAuditEven
 }
 
 const listAuditEventsByRequestID = `-- name: ListAuditEventsByRequestID :many
-SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
 FROM audit_events
-WHERE request_id = $1
+WHERE tenant_id = $1 AND request_id = $2
 ORDER BY timestamp DESC
 `
 
-func (q *Queries) ListAuditEventsByRequestID(ctx context.Context, requestID pgtype.Text) ([]AuditEvent, error) {
-	rows, err := q.db.Query(ctx, listAuditEventsByRequestID, requestID)
+type ListAuditEventsByRequestIDParams struct {
+	TenantID  string      `db:"tenant_id" json:"tenant_id"`
+	RequestID pgtype.Text `db:"request_id" json:"request_id"`
+}
+
+func (q *Queries) ListAuditEventsByRequestID(ctx context.Context, arg ListAuditEventsByRequestIDParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditEventsByRequestID, arg.TenantID, arg.RequestID)
 	if err != nil {
 		return nil, err
 	}
@@ -156,6 +422,10 @@ func (q *Queries) ListAuditEventsByRequestID(ctx context.Context, requestID pgty
 			&i.Payload,
 			&i.Timestamp,
 			&i.RequestID,
+			&i.TenantID,
+			&i.ChainSeq,
+			&i.PrevHash,
+			&i.Hash,
 		); err != nil {
 			return nil, err
 		}
@@ -166,3 +436,35 @@ func (q *Queries) ListAuditEventsByRequestID(ctx context.Context, requestID pgty
 	}
 	return items, nil
 }
+
+const lockAuditChain = `-- name: LockAuditChain :exec
+SELECT pg_advisory_xact_lock(hashtextextended('audit_events:' || $1::text, 0))
+`
+
+// Serializes appends to a tenant's hash chain until the transaction ends.
+func (q *Queries) LockAuditChain(ctx context.Context, tenantID string) error {
+	_, err := q.db.Exec(ctx, lockAuditChain, tenantID)
+	return err
+}
+
+const lockAuditPartitions = `-- name: LockAuditPartitions :exec
+SELECT pg_advisory_xact_lock(hashtextextended('audit_partitions', 0))
+`
+
+// Serializes audit_events partition maintenance until the transaction ends.
+func (q *Queries) LockAuditPartitions(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockAuditPartitions)
+	return err
+}
+
+const normalizeAuditPayload = `-- name: NormalizeAuditPayload :one
+SELECT $1::jsonb
+`
+
+// The payload as jsonb renders it, which is how it is read back and hashed.
+func (q *Queries) NormalizeAuditPayload(ctx context.Context, payload []byte) ([]byte, error) {
+	row := q.db.QueryRow(ctx, normalizeAuditPayload, payload)
+	var column_1 []byte
+	err := row.Scan(&column_1)
+	return column_1, err
+}
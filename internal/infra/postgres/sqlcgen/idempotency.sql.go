@@ -12,8 +12,8 @@ import (
 )
 
 const createIdempotencyKey = `-- name: CreateIdempotencyKey :exec
-INSERT INTO idempotency_keys (key, request_hash, status_code, response_headers, response_body, created_at, expires_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO idempotency_keys (key, request_hash, status_code, response_headers, response_body, created_at, expires_at, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
 type CreateIdempotencyKeyParams struct {
@@ -24,6 +24,7 @@ type CreateIdempotencyKeyParams struct {
 	ResponseBody    []byte             `db:"response_body" json:"response_body"`
 	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	ExpiresAt       pgtype.Timestamptz `db:"expires_at" json:"expires_at"`
+	TenantID        string             `db:"tenant_id" json:"tenant_id"`
 }
 
 // Stores a new idempotency key record
@@ -36,6 +37,7 @@ func (q *Queries) CreateIdempotencyKey(ctx context.Context, arg CreateIdempotenc
 		arg.ResponseBody,
 		arg.CreatedAt,
 		arg.ExpiresAt,
+		arg.TenantID,
 	)
 	return err
 }
@@ -44,7 +46,7 @@ const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :exe
 DELETE FROM idempotency_keys WHERE expires_at <= NOW()
 `
 
-// Removes all expired idempotency key records and returns the count of deleted rows
+// Removes all expired idempotency key records of every tenant and returns the count of deleted rows
 func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
 	result, err := q.db.Exec(ctx, deleteExpiredIdempotencyKeys)
 	if err != nil {
@@ -55,16 +57,21 @@ func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, erro
 
 const getIdempotencyKey = `-- name: GetIdempotencyKey :one
 
-SELECT key, request_hash, status_code, response_headers, response_body, created_at, expires_at
+SELECT key, request_hash, status_code, response_headers, response_body, created_at, expires_at, tenant_id
 FROM idempotency_keys
-WHERE key = $1 AND expires_at > NOW()
+WHERE tenant_id = $1 AND key = $2 AND expires_at > NOW()
 `
 
+type GetIdempotencyKeyParams struct {
+	TenantID string `db:"tenant_id" json:"tenant_id"`
+	Key      string `db:"key" json:"key"`
+}
+
 // Idempotency key queries for sqlc
 // Story 2.5: Idempotency Storage Implementation
-// Retrieves an idempotency key record if it exists and hasn't expired
-func (q *Queries) GetIdempotencyKey(ctx context.Context, key string) (IdempotencyKey, error) {
-	row := q.db.QueryRow(ctx, getIdempotencyKey, key)
+// Retrieves a tenant's idempotency key record if it exists and hasn't expired
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, arg.TenantID, arg.Key)
 	var i IdempotencyKey
 	err := row.Scan(
 		&i.Key,
@@ -74,6 +81,7 @@ func (q *Queries) GetIdempotencyKey(ctx context.Context, key string) (Idempotenc
 		&i.ResponseBody,
 		&i.CreatedAt,
 		&i.ExpiresAt,
+		&i.TenantID,
 	)
 	return i, err
 }
@@ -8,6 +8,28 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiKey struct {
+	ID         pgtype.UUID        `db:"id" json:"id"`
+	Name       string             `db:"name" json:"name"`
+	Prefix     string             `db:"prefix" json:"prefix"`
+	KeyHash    string             `db:"key_hash" json:"key_hash"`
+	Role       string             `db:"role" json:"role"`
+	CreatedBy  pgtype.Text        `db:"created_by" json:"created_by"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	ExpiresAt  pgtype.Timestamptz `db:"expires_at" json:"expires_at"`
+	RevokedAt  pgtype.Timestamptz `db:"revoked_at" json:"revoked_at"`
+	LastUsedAt pgtype.Timestamptz `db:"last_used_at" json:"last_used_at"`
+	TenantID   string             `db:"tenant_id" json:"tenant_id"`
+	Scopes     []string           `db:"scopes" json:"scopes"`
+}
+
+type AuditChainAnchor struct {
+	TenantID  string             `db:"tenant_id" json:"tenant_id"`
+	ChainSeq  int64              `db:"chain_seq" json:"chain_seq"`
+	Hash      []byte             `db:"hash" json:"hash"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
 type AuditEvent struct {
 	ID         pgtype.UUID        `db:"id" json:"id"`
 	EventType  string             `db:"event_type" json:"event_type"`
@@ -17,6 +39,32 @@ type AuditEvent struct {
 	Payload    []byte             `db:"payload" json:"payload"`
 	Timestamp  pgtype.Timestamptz `db:"timestamp" json:"timestamp"`
 	RequestID  pgtype.Text        `db:"request_id" json:"request_id"`
+	TenantID   string             `db:"tenant_id" json:"tenant_id"`
+	ChainSeq   pgtype.Int8        `db:"chain_seq" json:"chain_seq"`
+	PrevHash   []byte             `db:"prev_hash" json:"prev_hash"`
+	Hash       []byte             `db:"hash" json:"hash"`
+}
+
+type AuditEventsDefault struct {
+	ID         pgtype.UUID        `db:"id" json:"id"`
+	EventType  string             `db:"event_type" json:"event_type"`
+	ActorID    pgtype.UUID        `db:"actor_id" json:"actor_id"`
+	EntityType string             `db:"entity_type" json:"entity_type"`
+	EntityID   pgtype.UUID        `db:"entity_id" json:"entity_id"`
+	Payload    []byte             `db:"payload" json:"payload"`
+	Timestamp  pgtype.Timestamptz `db:"timestamp" json:"timestamp"`
+	RequestID  pgtype.Text        `db:"request_id" json:"request_id"`
+	TenantID   string             `db:"tenant_id" json:"tenant_id"`
+	ChainSeq   pgtype.Int8        `db:"chain_seq" json:"chain_seq"`
+	PrevHash   []byte             `db:"prev_hash" json:"prev_hash"`
+	Hash       []byte             `db:"hash" json:"hash"`
+}
+
+type AuditSinkCursor struct {
+	Sink      string             `db:"sink" json:"sink"`
+	TenantID  string             `db:"tenant_id" json:"tenant_id"`
+	ChainSeq  int64              `db:"chain_seq" json:"chain_seq"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
 type IdempotencyKey struct {
@@ -27,6 +75,40 @@ type IdempotencyKey struct {
 	ResponseBody    []byte             `db:"response_body" json:"response_body"`
 	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	ExpiresAt       pgtype.Timestamptz `db:"expires_at" json:"expires_at"`
+	TenantID        string             `db:"tenant_id" json:"tenant_id"`
+}
+
+type Job struct {
+	ID            pgtype.UUID        `db:"id" json:"id"`
+	Type          string             `db:"type" json:"type"`
+	Status        string             `db:"status" json:"status"`
+	CreatedBy     pgtype.UUID        `db:"created_by" json:"created_by"`
+	RequestID     pgtype.Text        `db:"request_id" json:"request_id"`
+	Input         []byte             `db:"input" json:"input"`
+	TotalRows     int32              `db:"total_rows" json:"total_rows"`
+	NextRow       int32              `db:"next_row" json:"next_row"`
+	ProcessedRows int32              `db:"processed_rows" json:"processed_rows"`
+	FailedRows    int32              `db:"failed_rows" json:"failed_rows"`
+	Errors        []byte             `db:"errors" json:"errors"`
+	Error         pgtype.Text        `db:"error" json:"error"`
+	LockedUntil   pgtype.Timestamptz `db:"locked_until" json:"locked_until"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	StartedAt     pgtype.Timestamptz `db:"started_at" json:"started_at"`
+	FinishedAt    pgtype.Timestamptz `db:"finished_at" json:"finished_at"`
+	TenantID      string             `db:"tenant_id" json:"tenant_id"`
+}
+
+type OauthClient struct {
+	ID         pgtype.UUID        `db:"id" json:"id"`
+	Name       string             `db:"name" json:"name"`
+	SecretHash string             `db:"secret_hash" json:"secret_hash"`
+	Role       string             `db:"role" json:"role"`
+	CreatedBy  pgtype.Text        `db:"created_by" json:"created_by"`
+	DisabledAt pgtype.Timestamptz `db:"disabled_at" json:"disabled_at"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	TenantID   string             `db:"tenant_id" json:"tenant_id"`
+	Scopes     []string           `db:"scopes" json:"scopes"`
 }
 
 type SchemaInfo struct {
@@ -36,6 +118,18 @@ type SchemaInfo struct {
 	InitializedAt pgtype.Timestamptz `db:"initialized_at" json:"initialized_at"`
 }
 
+type TokenRevocation struct {
+	ID           pgtype.UUID        `db:"id" json:"id"`
+	Jti          pgtype.Text        `db:"jti" json:"jti"`
+	Subject      pgtype.Text        `db:"subject" json:"subject"`
+	IssuedBefore pgtype.Timestamptz `db:"issued_before" json:"issued_before"`
+	ExpiresAt    pgtype.Timestamptz `db:"expires_at" json:"expires_at"`
+	RevokedBy    pgtype.Text        `db:"revoked_by" json:"revoked_by"`
+	RequestID    pgtype.Text        `db:"request_id" json:"request_id"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	TenantID     string             `db:"tenant_id" json:"tenant_id"`
+}
+
 type User struct {
 	ID        pgtype.UUID        `db:"id" json:"id"`
 	Email     string             `db:"email" json:"email"`
@@ -43,4 +137,7 @@ type User struct {
 	LastName  string             `db:"last_name" json:"last_name"`
 	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	DeletedAt pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	Version   int64              `db:"version" json:"version"`
+	TenantID  string             `db:"tenant_id" json:"tenant_id"`
 }
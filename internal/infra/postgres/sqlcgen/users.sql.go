@@ -12,20 +12,21 @@ import (
 )
 
 const countUsers = `-- name: CountUsers :one
-SELECT COUNT(*) FROM users
+SELECT COUNT(*) FROM users WHERE tenant_id = $1 AND deleted_at IS NULL
 `
 
-func (q *Queries) CountUsers(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countUsers)
+func (q *Queries) CountUsers(ctx context.Context, tenantID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countUsers, tenantID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
-const createUser = `-- name: CreateUser :exec
+const createUser = `-- name: CreateUser :one
 
-INSERT INTO users (id, email, first_name, last_name, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO users (id, email, first_name, last_name, created_at, updated_at, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING version
 `
 
 type CreateUserParams struct {
@@ -35,29 +36,39 @@ type CreateUserParams struct {
 	LastName  string             `db:"last_name" json:"last_name"`
 	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	TenantID  string             `db:"tenant_id" json:"tenant_id"`
 }
 
 // Users queries for sqlc
 // Story 5.3: Type-safe SQL queries
-func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
-	_, err := q.db.Exec(ctx, createUser,
+// Every query is scoped to one tenant (tenant_id).
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createUser,
 		arg.ID,
 		arg.Email,
 		arg.FirstName,
 		arg.LastName,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.TenantID,
 	)
-	return err
+	var version int64
+	err := row.Scan(&version)
+	return version, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, first_name, last_name, created_at, updated_at
-FROM users WHERE email = $1
+SELECT id, email, first_name, last_name, created_at, updated_at, deleted_at, version, tenant_id
+FROM users WHERE tenant_id = $1 AND email = $2 AND deleted_at IS NULL
 `
 
-func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
-	row := q.db.QueryRow(ctx, getUserByEmail, email)
+type GetUserByEmailParams struct {
+	TenantID string `db:"tenant_id" json:"tenant_id"`
+	Email    string `db:"email" json:"email"`
+}
+
+func (q *Queries) GetUserByEmail(ctx context.Context, arg GetUserByEmailParams) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, arg.TenantID, arg.Email)
 	var i User
 	err := row.Scan(
 		&i.ID,
@@ -66,17 +77,25 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.LastName,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Version,
+		&i.TenantID,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, first_name, last_name, created_at, updated_at
-FROM users WHERE id = $1
+SELECT id, email, first_name, last_name, created_at, updated_at, deleted_at, version, tenant_id
+FROM users WHERE tenant_id = $1 AND id = $2 AND deleted_at IS NULL
 `
 
-func (q *Queries) GetUserByID(ctx context.Context, id pgtype.UUID) (User, error) {
-	row := q.db.QueryRow(ctx, getUserByID, id)
+type GetUserByIDParams struct {
+	TenantID string      `db:"tenant_id" json:"tenant_id"`
+	ID       pgtype.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) GetUserByID(ctx context.Context, arg GetUserByIDParams) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByID, arg.TenantID, arg.ID)
 	var i User
 	err := row.Scan(
 		&i.ID,
@@ -85,24 +104,133 @@ func (q *Queries) GetUserByID(ctx context.Context, id pgtype.UUID) (User, error)
 		&i.LastName,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Version,
+		&i.TenantID,
 	)
 	return i, err
 }
 
 const listUsers = `-- name: ListUsers :many
-SELECT id, email, first_name, last_name, created_at, updated_at
+SELECT id, email, first_name, last_name, created_at, updated_at, deleted_at, version, tenant_id
 FROM users
+WHERE tenant_id = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC, id DESC
-LIMIT $1 OFFSET $2
+LIMIT $2 OFFSET $3
 `
 
 type ListUsersParams struct {
-	Limit  int32 `db:"limit" json:"limit"`
-	Offset int32 `db:"offset" json:"offset"`
+	TenantID string `db:"tenant_id" json:"tenant_id"`
+	Limit    int32  `db:"limit" json:"limit"`
+	Offset   int32  `db:"offset" json:"offset"`
 }
 
 func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
-	rows, err := q.db.Query(ctx, listUsers, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listUsers, arg.TenantID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Version,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersAfter = `-- name: ListUsersAfter :many
+SELECT id, email, first_name, last_name, created_at, updated_at, deleted_at, version, tenant_id
+FROM users
+WHERE tenant_id = $1 AND deleted_at IS NULL
+  AND (created_at, id) < ($2::timestamptz, $3::uuid)
+ORDER BY created_at DESC, id DESC
+LIMIT $4
+`
+
+type ListUsersAfterParams struct {
+	TenantID   string             `db:"tenant_id" json:"tenant_id"`
+	CursorTime pgtype.Timestamptz `db:"cursor_time" json:"cursor_time"`
+	CursorID   pgtype.UUID        `db:"cursor_id" json:"cursor_id"`
+	RowLimit   int32              `db:"row_limit" json:"row_limit"`
+}
+
+// Keyset page of active users strictly after the cursor, in list order.
+func (q *Queries) ListUsersAfter(ctx context.Context, arg ListUsersAfterParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, listUsersAfter,
+		arg.TenantID,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Version,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersBefore = `-- name: ListUsersBefore :many
+SELECT id, email, first_name, last_name, created_at, updated_at, deleted_at, version, tenant_id
+FROM users
+WHERE tenant_id = $1 AND deleted_at IS NULL
+  AND (created_at, id) > ($2::timestamptz, $3::uuid)
+ORDER BY created_at ASC, id ASC
+LIMIT $4
+`
+
+type ListUsersBeforeParams struct {
+	TenantID   string             `db:"tenant_id" json:"tenant_id"`
+	CursorTime pgtype.Timestamptz `db:"cursor_time" json:"cursor_time"`
+	CursorID   pgtype.UUID        `db:"cursor_id" json:"cursor_id"`
+	RowLimit   int32              `db:"row_limit" json:"row_limit"`
+}
+
+// Keyset page of active users strictly before the cursor, nearest first (reverse list order).
+func (q *Queries) ListUsersBefore(ctx context.Context, arg ListUsersBeforeParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, listUsersBefore,
+		arg.TenantID,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.RowLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +245,9 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 			&i.LastName,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Version,
+			&i.TenantID,
 		); err != nil {
 			return nil, err
 		}
@@ -127,3 +258,107 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 	}
 	return items, nil
 }
+
+const purgeDeletedUsers = `-- name: PurgeDeletedUsers :many
+DELETE FROM users
+WHERE tenant_id = $1 AND deleted_at IS NOT NULL AND deleted_at < $2
+RETURNING id
+`
+
+type PurgeDeletedUsersParams struct {
+	TenantID  string             `db:"tenant_id" json:"tenant_id"`
+	DeletedAt pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+}
+
+// Hard-deletes the tenant's users soft-deleted before the cutoff and returns their IDs.
+func (q *Queries) PurgeDeletedUsers(ctx context.Context, arg PurgeDeletedUsersParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, purgeDeletedUsers, arg.TenantID, arg.DeletedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restoreUser = `-- name: RestoreUser :execrows
+UPDATE users
+SET deleted_at = NULL, updated_at = NOW(), version = version + 1
+WHERE tenant_id = $1 AND id = $2 AND deleted_at IS NOT NULL
+`
+
+type RestoreUserParams struct {
+	TenantID string      `db:"tenant_id" json:"tenant_id"`
+	ID       pgtype.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) RestoreUser(ctx context.Context, arg RestoreUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreUser, arg.TenantID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeleteUser = `-- name: SoftDeleteUser :execrows
+UPDATE users
+SET deleted_at = NOW(), updated_at = NOW(), version = version + 1
+WHERE tenant_id = $1 AND id = $2 AND version = $3 AND deleted_at IS NULL
+`
+
+type SoftDeleteUserParams struct {
+	TenantID string      `db:"tenant_id" json:"tenant_id"`
+	ID       pgtype.UUID `db:"id" json:"id"`
+	Version  int64       `db:"version" json:"version"`
+}
+
+func (q *Queries) SoftDeleteUser(ctx context.Context, arg SoftDeleteUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteUser, arg.TenantID, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateUser = `-- name: UpdateUser :one
+UPDATE users
+SET email = $3, first_name = $4, last_name = $5, updated_at = $6, version = version + 1
+WHERE tenant_id = $1 AND id = $2 AND version = $7 AND deleted_at IS NULL
+RETURNING version
+`
+
+type UpdateUserParams struct {
+	TenantID  string             `db:"tenant_id" json:"tenant_id"`
+	ID        pgtype.UUID        `db:"id" json:"id"`
+	Email     string             `db:"email" json:"email"`
+	FirstName string             `db:"first_name" json:"first_name"`
+	LastName  string             `db:"last_name" json:"last_name"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version   int64              `db:"version" json:"version"`
+}
+
+// Applies the update only if the stored version still matches and returns the new version.
+func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (int64, error) {
+	row := q.db.QueryRow(ctx, updateUser,
+		arg.TenantID,
+		arg.ID,
+		arg.Email,
+		arg.FirstName,
+		arg.LastName,
+		arg.UpdatedAt,
+		arg.Version,
+	)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
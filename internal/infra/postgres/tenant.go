@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+)
+
+// tenantSetting is the setting holding the tenant of a connection or
+// transaction. The tenant_isolation row-level security policies only admit
+// rows of that tenant.
+const tenantSetting = "app.tenant_id"
+
+// crossTenantSetting is the setting that, when "on", makes the
+// tenant_isolation policies admit the rows of every tenant.
+const crossTenantSetting = "app.cross_tenant"
+
+// scopeSQL sets both settings; is_local=true (SET LOCAL) limits them to the
+// current transaction, false to the session.
+const scopeSQL = "SELECT set_config('" + tenantSetting + "', $1, $3), set_config('" + crossTenantSetting + "', $2, $3)"
+
+type crossTenantKey struct{}
+
+// CrossTenant returns a context whose database work is not scoped to a
+// tenant: pooled queries and transactions started with it see and write the
+// rows of every tenant. It is meant for background work over all tenants and
+// for lookups that establish the tenant of a caller, and must not be used to
+// serve requests.
+func CrossTenant(ctx context.Context) context.Context {
+	return context.WithValue(ctx, crossTenantKey{}, true)
+}
+
+// isCrossTenant reports whether ctx was returned by CrossTenant.
+func isCrossTenant(ctx context.Context) bool {
+	v, _ := ctx.Value(crossTenantKey{}).(bool)
+	return v
+}
+
+// tenantID returns the tenant that data access in ctx is scoped to.
+// Repositories filter every tenant-owned row by it.
+func tenantID(ctx context.Context) string {
+	return sharedctx.GetTenantID(ctx)
+}
+
+// scopeArgs returns the values of the tenant and cross-tenant settings for ctx.
+func scopeArgs(ctx context.Context) (tenant, crossTenant string) {
+	crossTenant = "off"
+	if isCrossTenant(ctx) {
+		crossTenant = "on"
+	}
+	return tenantID(ctx), crossTenant
+}
+
+// scopeConn scopes a pooled connection to the tenant of ctx (or to every
+// tenant for CrossTenant contexts) each time it is acquired, so queries run
+// outside TxManager.WithTx are subject to row-level security as well. It is
+// the pool's PrepareConn hook; the connection is discarded if it fails, so a
+// previous scope is never reused.
+func scopeConn(ctx context.Context, conn *pgx.Conn) (bool, error) {
+	tenant, crossTenant := scopeArgs(ctx)
+	if _, err := conn.Exec(ctx, scopeSQL, tenant, crossTenant, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// setLocalScope scopes tx to the tenant of ctx (or to every tenant for
+// CrossTenant contexts) until the transaction ends. It does not rely on the
+// pool's hook, which pools not created by NewPool lack.
+func setLocalScope(ctx context.Context, tx pgx.Tx) error {
+	tenant, crossTenant := scopeArgs(ctx)
+	_, err := tx.Exec(ctx, scopeSQL, tenant, crossTenant, true)
+	return err
+}
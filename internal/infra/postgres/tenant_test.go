@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+)
+
+func TestScopeArgs(t *testing.T) {
+	tenantCtx := sharedctx.SetTenantID(context.Background(), "tenant-a")
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		wantTenant      string
+		wantCrossTenant string
+	}{
+		{name: "tenant", ctx: tenantCtx, wantTenant: "tenant-a", wantCrossTenant: "off"},
+		{name: "no tenant", ctx: context.Background(), wantTenant: sharedctx.DefaultTenantID, wantCrossTenant: "off"},
+		{name: "cross-tenant", ctx: CrossTenant(tenantCtx), wantTenant: "tenant-a", wantCrossTenant: "on"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant, crossTenant := scopeArgs(tt.ctx)
+			assert.Equal(t, tt.wantTenant, tenant)
+			assert.Equal(t, tt.wantCrossTenant, crossTenant)
+		})
+	}
+}
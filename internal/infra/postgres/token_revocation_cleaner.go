@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// TokenRevocationCleanerConfig holds configuration for the cleaner.
+type TokenRevocationCleanerConfig struct {
+	// Interval is the duration between cleanup runs. Default: 1 hour.
+	Interval time.Duration
+}
+
+// TokenRevocationCleaner periodically removes revocations whose tokens have
+// expired, so the denylist loaded by every instance stays small.
+// It runs as a background goroutine and can be gracefully stopped.
+type TokenRevocationCleaner struct {
+	pool     Pooler
+	repo     *TokenRevocationRepo
+	interval time.Duration
+	logger   *slog.Logger
+
+	recordsDeleted prometheus.Counter
+	cleanupErrors  prometheus.Counter
+
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+// NewTokenRevocationCleaner creates a new cleaner instance and registers its
+// metrics with registry. If registry is nil, a new registry is created.
+func NewTokenRevocationCleaner(
+	pool Pooler,
+	cfg TokenRevocationCleanerConfig,
+	logger *slog.Logger,
+	registry *prometheus.Registry,
+) *TokenRevocationCleaner {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultCleanupInterval
+	}
+	if registry == nil {
+		registry = prometheus.NewRegistry()
+	}
+
+	recordsDeleted := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "token_revocation_cleanup_records_deleted_total",
+		Help: "Total number of expired token revocations deleted by the cleanup job",
+	})
+	cleanupErrors := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "token_revocation_cleanup_errors_total",
+		Help: "Total number of errors during token revocation cleanup job execution",
+	})
+	// Errors are intentionally ignored as they indicate metrics are already registered.
+	_ = registry.Register(recordsDeleted)
+	_ = registry.Register(cleanupErrors)
+
+	return &TokenRevocationCleaner{
+		pool:           pool,
+		repo:           NewTokenRevocationRepo(),
+		interval:       cfg.Interval,
+		logger:         logger,
+		recordsDeleted: recordsDeleted,
+		cleanupErrors:  cleanupErrors,
+		doneCh:         make(chan struct{}),
+	}
+}
+
+// Start begins the periodic cleanup loop.
+// This method returns immediately; the cleanup runs in a background goroutine
+// that outlives ctx until Stop is called.
+func (c *TokenRevocationCleaner) Start(ctx context.Context) error {
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	c.cancel = cancel
+	go c.run(runCtx)
+	c.logger.Info("token revocation cleaner started",
+		"interval", c.interval.String(),
+	)
+	return nil
+}
+
+// Stop gracefully stops the cleaner.
+// It waits for the current cleanup cycle to complete or until ctx is cancelled.
+func (c *TokenRevocationCleaner) Stop(ctx context.Context) error {
+	if c.cancel == nil {
+		return nil
+	}
+	c.cancel()
+	select {
+	case <-c.doneCh:
+		c.logger.Info("token revocation cleaner stopped")
+		return nil
+	case <-ctx.Done():
+		c.logger.Warn("token revocation cleaner stop timed out")
+		return ctx.Err()
+	}
+}
+
+func (c *TokenRevocationCleaner) run(ctx context.Context) {
+	defer close(c.doneCh)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	// Run cleanup immediately on start
+	c.cleanup(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.cleanup(ctx)
+		}
+	}
+}
+
+func (c *TokenRevocationCleaner) cleanup(ctx context.Context) {
+	if c.pool.Pool() == nil {
+		c.logger.Warn("token revocation cleanup skipped: database not connected")
+		return
+	}
+
+	deleted, err := c.repo.DeleteExpired(ctx, NewPoolQuerier(c.pool))
+	if err != nil {
+		if ctx.Err() == nil {
+			c.logger.Error("token revocation cleanup failed", "error", err)
+			c.cleanupErrors.Inc()
+		}
+		return
+	}
+
+	c.recordsDeleted.Add(float64(deleted))
+	// Log only if records were deleted to reduce noise
+	if deleted > 0 {
+		c.logger.Info("token revocation cleanup completed", "deleted", deleted)
+	}
+}
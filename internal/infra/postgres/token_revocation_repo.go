@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres/sqlcgen"
+)
+
+// TokenRevocationRepo implements domain.TokenRevocationRepository for PostgreSQL.
+// Revocations are created for the tenant of their context; ListActive and
+// DeleteExpired cover every tenant.
+type TokenRevocationRepo struct{}
+
+// NewTokenRevocationRepo creates a new TokenRevocationRepo instance.
+func NewTokenRevocationRepo() *TokenRevocationRepo {
+	return &TokenRevocationRepo{}
+}
+
+// Create stores a new token revocation for the tenant of ctx.
+func (r *TokenRevocationRepo) Create(ctx context.Context, q domain.Querier, revocation *domain.TokenRevocation) error {
+	const op = "tokenRevocationRepo.Create"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	id, err := uuid.Parse(string(revocation.ID))
+	if err != nil {
+		return fmt.Errorf("%s: parse ID: %w", op, err)
+	}
+
+	err = queries.CreateTokenRevocation(ctx, sqlcgen.CreateTokenRevocationParams{
+		ID:           pgtype.UUID{Bytes: id, Valid: true},
+		Jti:          pgtype.Text{String: revocation.JTI, Valid: revocation.JTI != ""},
+		Subject:      pgtype.Text{String: revocation.Subject, Valid: revocation.Subject != ""},
+		IssuedBefore: pgtype.Timestamptz{Time: revocation.IssuedBefore, Valid: !revocation.IssuedBefore.IsZero()},
+		ExpiresAt:    pgtype.Timestamptz{Time: revocation.ExpiresAt, Valid: true},
+		RevokedBy:    pgtype.Text{String: revocation.RevokedBy, Valid: revocation.RevokedBy != ""},
+		RequestID:    pgtype.Text{String: revocation.RequestID, Valid: revocation.RequestID != ""},
+		CreatedAt:    pgtype.Timestamptz{Time: revocation.CreatedAt, Valid: true},
+		TenantID:     tenantID(ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ListActive returns every revocation of every tenant that has not expired
+// yet, oldest first. With a pool querier the query runs cross-tenant.
+func (r *TokenRevocationRepo) ListActive(ctx context.Context, q domain.Querier) ([]domain.TokenRevocation, error) {
+	const op = "tokenRevocationRepo.ListActive"
+
+	ctx = CrossTenant(ctx)
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	rows, err := queries.ListActiveTokenRevocations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	revocations := make([]domain.TokenRevocation, 0, len(rows))
+	for _, row := range rows {
+		id, err := uuid.FromBytes(row.ID.Bytes[:])
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid ID: %w", op, err)
+		}
+		revocations = append(revocations, domain.TokenRevocation{
+			ID:           domain.ID(id.String()),
+			TenantID:     row.TenantID,
+			JTI:          row.Jti.String,
+			Subject:      row.Subject.String,
+			IssuedBefore: row.IssuedBefore.Time,
+			ExpiresAt:    row.ExpiresAt.Time,
+			RevokedBy:    row.RevokedBy.String,
+			RequestID:    row.RequestID.String,
+			CreatedAt:    row.CreatedAt.Time,
+		})
+	}
+	return revocations, nil
+}
+
+// DeleteExpired removes revocations of every tenant whose tokens have
+// expired. Returns the number of deleted revocations.
+func (r *TokenRevocationRepo) DeleteExpired(ctx context.Context, q domain.Querier) (int64, error) {
+	const op = "tokenRevocationRepo.DeleteExpired"
+
+	ctx = CrossTenant(ctx)
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	deleted, err := queries.DeleteExpiredTokenRevocations(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return deleted, nil
+}
+
+// Ensure TokenRevocationRepo implements domain.TokenRevocationRepository at compile time.
+var _ domain.TokenRevocationRepository = (*TokenRevocationRepo)(nil)
@@ -0,0 +1,97 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+)
+
+func newTestTokenRevocation(t *testing.T, expiresAt time.Time) *domain.TokenRevocation {
+	t.Helper()
+
+	id, err := uuid.NewV7()
+	require.NoError(t, err)
+
+	return &domain.TokenRevocation{
+		ID:        domain.ID(id.String()),
+		JTI:       "jti-" + id.String(),
+		ExpiresAt: expiresAt.UTC().Truncate(time.Microsecond),
+		RevokedBy: "admin-1",
+		RequestID: "req-1",
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+}
+
+func TestTokenRevocationRepo_Lifecycle(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer func() { _, _ = pool.Exec(context.Background(), "DELETE FROM token_revocations") }()
+
+	ctx := sharedctx.SetTenantID(context.Background(), "tenant-a")
+	otherTenant := sharedctx.SetTenantID(context.Background(), "tenant-b")
+	repo := postgres.NewTokenRevocationRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	byJTI := newTestTokenRevocation(t, time.Now().Add(time.Hour))
+	bySubject := newTestTokenRevocation(t, time.Now().Add(time.Hour))
+	bySubject.JTI = ""
+	bySubject.Subject = "user-1"
+	bySubject.IssuedBefore = time.Now().UTC().Truncate(time.Microsecond)
+	expired := newTestTokenRevocation(t, time.Now().Add(-time.Minute))
+
+	require.NoError(t, repo.Create(ctx, querier, byJTI))
+	require.NoError(t, repo.Create(otherTenant, querier, bySubject))
+	require.NoError(t, repo.Create(ctx, querier, expired))
+
+	// The denylist loads the revocations of every tenant.
+	active, err := repo.ListActive(ctx, querier)
+	require.NoError(t, err)
+	require.Len(t, active, 2)
+	assert.Equal(t, byJTI.ID, active[0].ID)
+	assert.Equal(t, "tenant-a", active[0].TenantID)
+	assert.Equal(t, byJTI.JTI, active[0].JTI)
+	assert.Empty(t, active[0].Subject)
+	assert.True(t, active[0].IssuedBefore.IsZero())
+	assert.True(t, byJTI.ExpiresAt.Equal(active[0].ExpiresAt))
+	assert.Equal(t, "admin-1", active[0].RevokedBy)
+	assert.Equal(t, "req-1", active[0].RequestID)
+	assert.Equal(t, bySubject.ID, active[1].ID)
+	assert.Equal(t, "tenant-b", active[1].TenantID)
+	assert.Empty(t, active[1].JTI)
+	assert.Equal(t, "user-1", active[1].Subject)
+	assert.True(t, bySubject.IssuedBefore.Equal(active[1].IssuedBefore))
+
+	deleted, err := repo.DeleteExpired(ctx, querier)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	active, err = repo.ListActive(ctx, querier)
+	require.NoError(t, err)
+	assert.Len(t, active, 2)
+}
+
+func TestTokenRevocationRepo_Create_RejectsAmbiguousTarget(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer func() { _, _ = pool.Exec(context.Background(), "DELETE FROM token_revocations") }()
+
+	ctx := context.Background()
+	repo := postgres.NewTokenRevocationRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	r := newTestTokenRevocation(t, time.Now().Add(time.Hour))
+	r.Subject = "user-1"
+	r.IssuedBefore = time.Now()
+
+	assert.Error(t, repo.Create(ctx, querier, r))
+}
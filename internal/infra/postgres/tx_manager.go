@@ -9,7 +9,8 @@ import (
 
 // TxManager implements domain.TxManager for PostgreSQL transactions.
 type TxManager struct {
-	pool Pooler
+	pool        Pooler
+	crossTenant bool
 }
 
 // NewTxManager creates a new TxManager from a Pooler.
@@ -17,7 +18,17 @@ func NewTxManager(pool Pooler) domain.TxManager {
 	return &TxManager{pool: pool}
 }
 
+// NewCrossTenantTxManager creates a TxManager whose transactions are not
+// scoped to a tenant: they opt in to seeing the rows of every tenant (see
+// CrossTenant). It is meant for background work over all tenants, such as
+// audit sink delivery, and must not serve requests.
+func NewCrossTenantTxManager(pool Pooler) domain.TxManager {
+	return &TxManager{pool: pool, crossTenant: true}
+}
+
 // WithTx executes the given function within a database transaction.
+// Unless the TxManager is cross-tenant, the transaction is scoped to the
+// tenant of ctx: row-level security hides and rejects rows of other tenants.
 // If fn returns an error or panics, the transaction is rolled back.
 // If fn succeeds, the transaction is committed.
 func (m *TxManager) WithTx(ctx context.Context, fn func(tx domain.Querier) error) (err error) {
@@ -27,6 +38,9 @@ func (m *TxManager) WithTx(ctx context.Context, fn func(tx domain.Querier) error
 	if pool == nil {
 		return fmt.Errorf("%s: database not connected", op)
 	}
+	if m.crossTenant {
+		ctx = CrossTenant(ctx)
+	}
 
 	tx, err := pool.Begin(ctx)
 	if err != nil {
@@ -50,5 +64,9 @@ func (m *TxManager) WithTx(ctx context.Context, fn func(tx domain.Querier) error
 		}
 	}()
 
+	if err = setLocalScope(ctx, tx); err != nil {
+		return fmt.Errorf("%s: set tenant: %w", op, err)
+	}
+
 	return fn(NewTxQuerier(tx))
 }
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres/sqlcgen"
+)
+
+// userExportFetchSize is the number of rows fetched from the export cursor per
+// round trip; it bounds the rows held in memory at once.
+const userExportFetchSize = 500
+
+// userExportCursor is the server-side cursor used by Export. Cursor names are
+// scoped to the transaction, so concurrent exports do not collide.
+const userExportCursor = "users_export"
+
+// Export streams every active user, oldest first, to fn.
+// It declares a cursor in the caller's transaction and fetches it in batches
+// of userExportFetchSize, so the result set is never materialized. The cursor
+// reads the snapshot taken when it is declared.
+func (r *UserRepo) Export(ctx context.Context, q domain.Querier, fn func(domain.User) error) error {
+	const op = "userRepo.Export"
+
+	txq, ok := q.(*TxQuerier)
+	if !ok {
+		return fmt.Errorf("%s: cursor requires a transaction, got %T", op, q)
+	}
+	tx := txq.tx
+
+	if _, err := tx.Exec(ctx, "DECLARE "+userExportCursor+" NO SCROLL CURSOR FOR SELECT "+userColumns+
+		" FROM users WHERE tenant_id = $1 AND deleted_at IS NULL ORDER BY created_at, id", tenantID(ctx)); err != nil {
+		return fmt.Errorf("%s: declare cursor: %w", op, err)
+	}
+
+	fetchSQL := fmt.Sprintf("FETCH %d FROM %s", userExportFetchSize, userExportCursor)
+	for {
+		n, err := r.exportBatch(ctx, tx, fetchSQL, fn)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if n < userExportFetchSize {
+			break
+		}
+	}
+
+	if _, err := tx.Exec(ctx, "CLOSE "+userExportCursor); err != nil {
+		return fmt.Errorf("%s: close cursor: %w", op, err)
+	}
+	return nil
+}
+
+// exportBatch fetches one batch from the export cursor and hands each row to fn.
+// Returns the number of rows fetched.
+func (r *UserRepo) exportBatch(ctx context.Context, dbtx sqlcgen.DBTX, fetchSQL string, fn func(domain.User) error) (int, error) {
+	rows, err := dbtx.Query(ctx, fetchSQL)
+	if err != nil {
+		return 0, fmt.Errorf("fetch: %w", err)
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return n, fmt.Errorf("fetch: %w", err)
+		}
+		n++
+
+		user, err := toDomainUser(u)
+		if err != nil {
+			return n, err
+		}
+		if err := fn(user); err != nil {
+			return n, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return n, fmt.Errorf("fetch: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres/sqlcgen"
+)
+
+// userSortColumns maps the domain sort allowlist to columns. It is the only
+// source of identifiers interpolated into filtered list queries; every value
+// goes through a bind parameter.
+var userSortColumns = map[domain.UserSortKey]string{
+	domain.UserSortCreatedAt: "created_at",
+	domain.UserSortUpdatedAt: "updated_at",
+	domain.UserSortEmail:     "email",
+	domain.UserSortFirstName: "first_name",
+	domain.UserSortLastName:  "last_name",
+}
+
+// userColumns is the select list shared with the sqlc user queries,
+// in sqlcgen.User field order.
+const userColumns = "id, email, first_name, last_name, created_at, updated_at, deleted_at, version, tenant_id"
+
+// userListQuery builds the SQL for user lists that sqlc's static queries
+// cannot express: optional filters and a caller-chosen order. Like the sqlc
+// queries, it only matches users of one tenant.
+// The expressions match the indexes in the add_users_search_indexes migration.
+type userListQuery struct {
+	where []string
+	args  []any
+}
+
+func newUserListQuery(tenantID string, filter domain.UserFilter) *userListQuery {
+	b := &userListQuery{}
+	b.where = append(b.where, "tenant_id = "+b.arg(tenantID), "deleted_at IS NULL")
+
+	if filter.Email != "" {
+		b.where = append(b.where, "email = "+b.arg(filter.Email))
+	}
+	if filter.Query != "" {
+		prefix := b.arg(escapeLike(strings.ToLower(filter.Query)) + "%")
+		query := b.arg(filter.Query)
+		b.where = append(b.where, "(lower(first_name) LIKE "+prefix+
+			" OR lower(last_name) LIKE "+prefix+
+			" OR lower(email::text) LIKE "+prefix+
+			" OR (first_name || ' ' || last_name) % "+query+")")
+	}
+	if !filter.CreatedAfter.IsZero() {
+		b.where = append(b.where, "created_at >= "+b.arg(pgtype.Timestamptz{Time: filter.CreatedAfter, Valid: true}))
+	}
+	if !filter.CreatedBefore.IsZero() {
+		b.where = append(b.where, "created_at < "+b.arg(pgtype.Timestamptz{Time: filter.CreatedBefore, Valid: true}))
+	}
+
+	return b
+}
+
+// arg binds v and returns its placeholder.
+func (b *userListQuery) arg(v any) string {
+	b.args = append(b.args, v)
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+func (b *userListQuery) whereClause() string {
+	return " WHERE " + strings.Join(b.where, " AND ")
+}
+
+// countSQL returns the query counting all matching users.
+func (b *userListQuery) countSQL() string {
+	return "SELECT COUNT(*) FROM users" + b.whereClause()
+}
+
+// pageSQL returns the query for one page. With a cursor it seeks by
+// (created_at, id) and reads up to params.KeysetLimit() rows, nearest first
+// for a Backward cursor; otherwise it uses LIMIT/OFFSET in the filter's order.
+func (b *userListQuery) pageSQL(filter domain.UserFilter, params domain.ListParams) (string, error) {
+	var order string
+	var limit int
+
+	if params.IsKeyset() {
+		if !filter.HasDefaultOrder() {
+			return "", fmt.Errorf("keyset pagination requires the default order")
+		}
+		cursorTime, cursorID, err := keysetArgs(params.Cursor)
+		if err != nil {
+			return "", err
+		}
+		cmp, dir := "<", "DESC"
+		if params.Cursor.Backward {
+			cmp, dir = ">", "ASC"
+		}
+		b.where = append(b.where, "(created_at, id) "+cmp+" ("+b.arg(cursorTime)+", "+b.arg(cursorID)+")")
+		order = "created_at " + dir + ", id " + dir
+		limit = params.KeysetLimit()
+	} else {
+		var err error
+		if order, err = userOrderBy(filter.Sort); err != nil {
+			return "", err
+		}
+		limit = params.Limit()
+	}
+
+	sql := "SELECT " + userColumns + " FROM users" + b.whereClause() +
+		" ORDER BY " + order + " LIMIT " + b.arg(int32(limit))
+	if !params.IsKeyset() {
+		sql += " OFFSET " + b.arg(int32(params.Offset()))
+	}
+	return sql, nil
+}
+
+// userOrderBy renders the ORDER BY terms for sort, defaulting to
+// created_at DESC and always ending with id as the tiebreaker.
+func userOrderBy(sort []domain.UserSort) (string, error) {
+	if len(sort) == 0 {
+		return "created_at DESC, id DESC", nil
+	}
+
+	terms := make([]string, 0, len(sort)+1)
+	for _, s := range sort {
+		col, ok := userSortColumns[s.Key]
+		if !ok {
+			return "", fmt.Errorf("unsupported sort key %q", s.Key)
+		}
+		dir := " ASC"
+		if s.Desc {
+			dir = " DESC"
+		}
+		terms = append(terms, col+dir)
+	}
+	last := " DESC"
+	if !sort[len(sort)-1].Desc {
+		last = " ASC"
+	}
+	terms = append(terms, "id"+last)
+	return strings.Join(terms, ", "), nil
+}
+
+// escapeLike escapes the LIKE wildcards in s so it matches literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// listFiltered runs a filtered user list; see UserRepo.List for the result contract.
+func (r *UserRepo) listFiltered(ctx context.Context, dbtx sqlcgen.DBTX, filter domain.UserFilter, params domain.ListParams) ([]domain.User, int, error) {
+	b := newUserListQuery(tenantID(ctx), filter)
+
+	total := domain.TotalCountUnknown
+	if !params.IsKeyset() {
+		var count int64
+		if err := dbtx.QueryRow(ctx, b.countSQL(), b.args...).Scan(&count); err != nil {
+			return nil, 0, fmt.Errorf("count: %w", err)
+		}
+		if count == 0 {
+			return []domain.User{}, 0, nil
+		}
+		total = int(count)
+	}
+
+	sql, err := b.pageSQL(filter, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbtx.Query(ctx, sql, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list: %w", err)
+	}
+	defer rows.Close()
+
+	var dbUsers []sqlcgen.User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("list: %w", err)
+		}
+		dbUsers = append(dbUsers, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("list: %w", err)
+	}
+
+	if params.IsKeyset() && params.Cursor.Backward {
+		// Rows come nearest-first; flip them back into list order.
+		slices.Reverse(dbUsers)
+	}
+
+	users, err := toDomainUsers(dbUsers)
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+// scanUser scans a row selected with userColumns.
+func scanUser(row pgx.Row) (sqlcgen.User, error) {
+	var u sqlcgen.User
+	err := row.Scan(
+		&u.ID,
+		&u.Email,
+		&u.FirstName,
+		&u.LastName,
+		&u.CreatedAt,
+		&u.UpdatedAt,
+		&u.DeletedAt,
+		&u.Version,
+		&u.TenantID,
+	)
+	return u, err
+}
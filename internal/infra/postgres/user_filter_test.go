@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// testTenant is the tenant the list queries under test are scoped to.
+const testTenant = "tenant-a"
+
+func TestUserListQuery_Filters(t *testing.T) {
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := after.Add(24 * time.Hour)
+	filter := domain.UserFilter{
+		Email:         "a@example.com",
+		Query:         "Jo_n%",
+		CreatedAfter:  after,
+		CreatedBefore: before,
+	}
+
+	b := newUserListQuery(testTenant, filter)
+
+	assert.Equal(t, "SELECT COUNT(*) FROM users WHERE tenant_id = $1 AND deleted_at IS NULL"+
+		" AND email = $2"+
+		" AND (lower(first_name) LIKE $3 OR lower(last_name) LIKE $3 OR lower(email::text) LIKE $3 OR (first_name || ' ' || last_name) % $4)"+
+		" AND created_at >= $5 AND created_at < $6", b.countSQL())
+	require.Len(t, b.args, 6)
+	assert.Equal(t, testTenant, b.args[0])
+	assert.Equal(t, "a@example.com", b.args[1])
+	assert.Equal(t, `jo\_n\%%`, b.args[2], "LIKE wildcards in the query must be escaped")
+	assert.Equal(t, "Jo_n%", b.args[3])
+}
+
+func TestUserListQuery_PageSQL(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    domain.UserFilter
+		params    domain.ListParams
+		wantSQL   string
+		wantLimit int32
+	}{
+		{
+			name:   "default order with offset",
+			filter: domain.UserFilter{Email: "a@example.com"},
+			params: domain.ListParams{Page: 2, PageSize: 10},
+			wantSQL: "SELECT " + userColumns + " FROM users WHERE tenant_id = $1 AND deleted_at IS NULL AND email = $2" +
+				" ORDER BY created_at DESC, id DESC LIMIT $3 OFFSET $4",
+			wantLimit: 10,
+		},
+		{
+			name: "custom multi-key order",
+			filter: domain.UserFilter{Sort: []domain.UserSort{
+				{Key: domain.UserSortCreatedAt},
+				{Key: domain.UserSortLastName, Desc: true},
+			}},
+			params: domain.ListParams{Page: 1, PageSize: 5},
+			wantSQL: "SELECT " + userColumns + " FROM users WHERE tenant_id = $1 AND deleted_at IS NULL" +
+				" ORDER BY created_at ASC, last_name DESC, id DESC LIMIT $2 OFFSET $3",
+			wantLimit: 5,
+		},
+		{
+			name:   "forward keyset",
+			filter: domain.UserFilter{Query: "jo"},
+			params: domain.ListParams{PageSize: 5, Cursor: &domain.Cursor{Time: time.Now(), ID: "019400a0-1234-7abc-8def-1234567890ab"}},
+			wantSQL: "SELECT " + userColumns + " FROM users WHERE tenant_id = $1 AND deleted_at IS NULL" +
+				" AND (lower(first_name) LIKE $2 OR lower(last_name) LIKE $2 OR lower(email::text) LIKE $2 OR (first_name || ' ' || last_name) % $3)" +
+				" AND (created_at, id) < ($4, $5) ORDER BY created_at DESC, id DESC LIMIT $6",
+			wantLimit: 6,
+		},
+		{
+			name:   "backward keyset",
+			filter: domain.UserFilter{Email: "a@example.com"},
+			params: domain.ListParams{PageSize: 5, Cursor: &domain.Cursor{Time: time.Now(), ID: "019400a0-1234-7abc-8def-1234567890ab", Backward: true}},
+			wantSQL: "SELECT " + userColumns + " FROM users WHERE tenant_id = $1 AND deleted_at IS NULL AND email = $2" +
+				" AND (created_at, id) > ($3, $4) ORDER BY created_at ASC, id ASC LIMIT $5",
+			wantLimit: 6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newUserListQuery(testTenant, tt.filter)
+			sql, err := b.pageSQL(tt.filter, tt.params)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+			assert.Contains(t, b.args, tt.wantLimit)
+		})
+	}
+}
+
+func TestUserListQuery_PageSQL_Errors(t *testing.T) {
+	t.Run("keyset with custom order", func(t *testing.T) {
+		filter := domain.UserFilter{Sort: []domain.UserSort{{Key: domain.UserSortEmail}}}
+		_, err := newUserListQuery(testTenant, filter).pageSQL(filter, domain.ListParams{Cursor: &domain.Cursor{ID: "019400a0-1234-7abc-8def-1234567890ab"}})
+		assert.Error(t, err)
+	})
+
+	t.Run("sort key outside the allowlist", func(t *testing.T) {
+		filter := domain.UserFilter{Sort: []domain.UserSort{{Key: "id; DROP TABLE users"}}}
+		_, err := newUserListQuery(testTenant, filter).pageSQL(filter, domain.ListParams{Page: 1})
+		assert.ErrorContains(t, err, "unsupported sort key")
+	})
+}
+
+func TestUserSortColumns_CoverAllowlist(t *testing.T) {
+	for _, k := range domain.UserSortKeys() {
+		assert.Contains(t, userSortColumns, k, "every allowlisted sort key needs a column")
+	}
+}
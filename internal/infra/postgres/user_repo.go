@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -20,6 +22,7 @@ const (
 )
 
 // UserRepo implements domain.UserRepository for PostgreSQL.
+// Every method is scoped to the tenant of its context.
 type UserRepo struct{}
 
 // NewUserRepo creates a new UserRepo instance.
@@ -44,7 +47,7 @@ func getDBTX(q domain.Querier) (sqlcgen.DBTX, error) {
 	}
 }
 
-// Create stores a new user in the database.
+// Create stores a new user in the database and sets user.Version to the stored version.
 // It returns domain.ErrEmailAlreadyExists if the email is already taken.
 func (r *UserRepo) Create(ctx context.Context, q domain.Querier, user *domain.User) error {
 	const op = "userRepo.Create"
@@ -69,9 +72,11 @@ func (r *UserRepo) Create(ctx context.Context, q domain.Querier, user *domain.Us
 		LastName:  user.LastName,
 		CreatedAt: pgtype.Timestamptz{Time: user.CreatedAt, Valid: true},
 		UpdatedAt: pgtype.Timestamptz{Time: user.UpdatedAt, Valid: true},
+		TenantID:  tenantID(ctx),
 	}
 
-	if err := queries.CreateUser(ctx, params); err != nil {
+	version, err := queries.CreateUser(ctx, params)
+	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
 			if pgErr.ConstraintName == "uniq_users_email" {
@@ -80,12 +85,13 @@ func (r *UserRepo) Create(ctx context.Context, q domain.Querier, user *domain.Us
 		}
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	user.Version = version
 
 	return nil
 }
 
-// GetByID retrieves a user by their ID.
-// It returns domain.ErrUserNotFound if no user exists with the given ID.
+// GetByID retrieves an active user by their ID.
+// It returns domain.ErrUserNotFound if no user exists with the given ID or the user is soft-deleted.
 func (r *UserRepo) GetByID(ctx context.Context, q domain.Querier, id domain.ID) (*domain.User, error) {
 	const op = "userRepo.GetByID"
 
@@ -100,7 +106,10 @@ func (r *UserRepo) GetByID(ctx context.Context, q domain.Querier, id domain.ID)
 		return nil, fmt.Errorf("%s: parse ID: %w", op, err)
 	}
 
-	dbUser, err := queries.GetUserByID(ctx, pgtype.UUID{Bytes: uid, Valid: true})
+	dbUser, err := queries.GetUserByID(ctx, sqlcgen.GetUserByIDParams{
+		TenantID: tenantID(ctx),
+		ID:       pgtype.UUID{Bytes: uid, Valid: true},
+	})
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, fmt.Errorf("%s: %w", op, domain.ErrUserNotFound)
 	}
@@ -108,38 +117,51 @@ func (r *UserRepo) GetByID(ctx context.Context, q domain.Querier, id domain.ID)
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Map generic UUID bytes back to string
-	// sqlc with pgx/v5 pgtype.UUID uses Bytes [16]byte.
-	// We need to convert that to uuid.UUID to get String().
-	uuidVal, err := uuid.FromBytes(dbUser.ID.Bytes[:])
+	user, err := toDomainUser(dbUser)
 	if err != nil {
-		return nil, fmt.Errorf("%s: invalid uuid from db: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-
-	return &domain.User{
-		ID:        domain.ID(uuidVal.String()),
-		Email:     dbUser.Email,
-		FirstName: dbUser.FirstName,
-		LastName:  dbUser.LastName,
-		CreatedAt: dbUser.CreatedAt.Time,
-		UpdatedAt: dbUser.UpdatedAt.Time,
-	}, nil
+	return &user, nil
 }
 
-// List retrieves users with pagination.
+// List retrieves active users matching filter with pagination. Soft-deleted users are excluded.
 // Returns the slice of users, total count of matching users, and any error.
-// Results are ordered by created_at DESC, id DESC.
-func (r *UserRepo) List(ctx context.Context, q domain.Querier, params domain.ListParams) ([]domain.User, int, error) {
+// Results are ordered by filter.Sort, by default created_at DESC, id DESC.
+//
+// With params.Cursor set the page is read by keyset: no count query is run
+// (the total is domain.TotalCountUnknown) and up to params.KeysetLimit() users
+// are returned in list order.
+//
+// Unfiltered lists use the static sqlc queries; filtered or re-ordered lists
+// are built by userListQuery.
+func (r *UserRepo) List(ctx context.Context, q domain.Querier, filter domain.UserFilter, params domain.ListParams) ([]domain.User, int, error) {
 	const op = "userRepo.List"
 
 	dbtx, err := getDBTX(q)
 	if err != nil {
 		return nil, 0, fmt.Errorf("%s: %w", op, err)
 	}
+
+	if !filter.IsZero() {
+		users, total, err := r.listFiltered(ctx, dbtx, filter, params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
+		}
+		return users, total, nil
+	}
+
 	queries := sqlcgen.New(dbtx)
 
+	if params.IsKeyset() {
+		users, err := r.listKeyset(ctx, queries, params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
+		}
+		return users, domain.TotalCountUnknown, nil
+	}
+
 	// Get total count
-	count, err := queries.CountUsers(ctx)
+	count, err := queries.CountUsers(ctx, tenantID(ctx))
 	if err != nil {
 		return nil, 0, fmt.Errorf("%s: count: %w", op, err)
 	}
@@ -150,8 +172,9 @@ func (r *UserRepo) List(ctx context.Context, q domain.Querier, params domain.Lis
 
 	// Get paginated results
 	listParams := sqlcgen.ListUsersParams{
-		Limit:  int32(params.Limit()),
-		Offset: int32(params.Offset()),
+		TenantID: tenantID(ctx),
+		Limit:    int32(params.Limit()),
+		Offset:   int32(params.Offset()),
 	}
 
 	dbUsers, err := queries.ListUsers(ctx, listParams)
@@ -159,23 +182,242 @@ func (r *UserRepo) List(ctx context.Context, q domain.Querier, params domain.Lis
 		return nil, 0, fmt.Errorf("%s: list: %w", op, err)
 	}
 
-	users := make([]domain.User, len(dbUsers))
-	for i, u := range dbUsers {
-		uuidVal, err := uuid.FromBytes(u.ID.Bytes[:])
-		if err != nil {
-			return nil, 0, fmt.Errorf("%s: invalid uuid from db: %w", op, err)
+	users, err := toDomainUsers(dbUsers)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return users, int(count), nil
+}
+
+// listKeyset reads the page after (or before) params.Cursor, returned in list order.
+func (r *UserRepo) listKeyset(ctx context.Context, queries *sqlcgen.Queries, params domain.ListParams) ([]domain.User, error) {
+	cursorTime, cursorID, err := keysetArgs(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var dbUsers []sqlcgen.User
+	if params.Cursor.Backward {
+		dbUsers, err = queries.ListUsersBefore(ctx, sqlcgen.ListUsersBeforeParams{
+			TenantID:   tenantID(ctx),
+			CursorTime: cursorTime,
+			CursorID:   cursorID,
+			RowLimit:   int32(params.KeysetLimit()),
+		})
+		// Rows come nearest-first; flip them back into list order.
+		slices.Reverse(dbUsers)
+	} else {
+		dbUsers, err = queries.ListUsersAfter(ctx, sqlcgen.ListUsersAfterParams{
+			TenantID:   tenantID(ctx),
+			CursorTime: cursorTime,
+			CursorID:   cursorID,
+			RowLimit:   int32(params.KeysetLimit()),
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list: %w", err)
+	}
+
+	return toDomainUsers(dbUsers)
+}
+
+// Update persists changes to an existing user's email and name fields.
+// The write only applies if the stored version equals user.Version; on success
+// user.Version is set to the incremented version.
+// It returns domain.ErrUserNotFound if no user exists with the given ID,
+// domain.ErrUserVersionMismatch if the stored version has moved on,
+// and domain.ErrEmailAlreadyExists if the new email is already taken.
+func (r *UserRepo) Update(ctx context.Context, q domain.Querier, user *domain.User) error {
+	const op = "userRepo.Update"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	uid, err := uuid.Parse(string(user.ID))
+	if err != nil {
+		return fmt.Errorf("%s: parse ID: %w", op, err)
+	}
+
+	params := sqlcgen.UpdateUserParams{
+		TenantID:  tenantID(ctx),
+		ID:        pgtype.UUID{Bytes: uid, Valid: true},
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		UpdatedAt: pgtype.Timestamptz{Time: user.UpdatedAt, Valid: true},
+		Version:   user.Version,
+	}
+
+	version, err := queries.UpdateUser(ctx, params)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%s: %w", op, missingOrStale(ctx, queries, params.ID))
+	}
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			if pgErr.ConstraintName == "uniq_users_email" {
+				return fmt.Errorf("%s: %w", op, domain.ErrEmailAlreadyExists)
+			}
 		}
-		users[i] = domain.User{
-			ID:        domain.ID(uuidVal.String()),
-			Email:     u.Email,
-			FirstName: u.FirstName,
-			LastName:  u.LastName,
-			CreatedAt: u.CreatedAt.Time,
-			UpdatedAt: u.UpdatedAt.Time,
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	user.Version = version
+
+	return nil
+}
+
+// Delete soft-deletes a user by setting deleted_at, provided the stored version matches.
+// It returns domain.ErrUserNotFound if no active user exists with the given ID
+// and domain.ErrUserVersionMismatch if the stored version has moved on.
+func (r *UserRepo) Delete(ctx context.Context, q domain.Querier, id domain.ID, version int64) error {
+	const op = "userRepo.Delete"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	uid, err := uuid.Parse(string(id))
+	if err != nil {
+		return fmt.Errorf("%s: parse ID: %w", op, err)
+	}
+
+	pgID := pgtype.UUID{Bytes: uid, Valid: true}
+	rows, err := queries.SoftDeleteUser(ctx, sqlcgen.SoftDeleteUserParams{TenantID: tenantID(ctx), ID: pgID, Version: version})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, missingOrStale(ctx, queries, pgID))
+	}
+
+	return nil
+}
+
+// Restore clears deleted_at on a soft-deleted user.
+// It returns domain.ErrUserNotFound if no soft-deleted user exists with the given ID
+// and domain.ErrEmailAlreadyExists if an active user has since taken the email.
+func (r *UserRepo) Restore(ctx context.Context, q domain.Querier, id domain.ID) error {
+	const op = "userRepo.Restore"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	uid, err := uuid.Parse(string(id))
+	if err != nil {
+		return fmt.Errorf("%s: parse ID: %w", op, err)
+	}
+
+	rows, err := queries.RestoreUser(ctx, sqlcgen.RestoreUserParams{
+		TenantID: tenantID(ctx),
+		ID:       pgtype.UUID{Bytes: uid, Valid: true},
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			if pgErr.ConstraintName == "uniq_users_email" {
+				return fmt.Errorf("%s: %w", op, domain.ErrEmailAlreadyExists)
+			}
 		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, domain.ErrUserNotFound)
 	}
 
-	return users, int(count), nil
+	return nil
+}
+
+// PurgeDeleted hard-deletes users of the tenant of ctx that were soft-deleted before the given cutoff.
+// Returns the IDs of the purged users.
+func (r *UserRepo) PurgeDeleted(ctx context.Context, q domain.Querier, before time.Time) ([]domain.ID, error) {
+	const op = "userRepo.PurgeDeleted"
+
+	dbtx, err := getDBTX(q)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	queries := sqlcgen.New(dbtx)
+
+	dbIDs, err := queries.PurgeDeletedUsers(ctx, sqlcgen.PurgeDeletedUsersParams{
+		TenantID:  tenantID(ctx),
+		DeletedAt: pgtype.Timestamptz{Time: before, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ids := make([]domain.ID, len(dbIDs))
+	for i, dbID := range dbIDs {
+		uuidVal, err := uuid.FromBytes(dbID.Bytes[:])
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid uuid from db: %w", op, err)
+		}
+		ids[i] = domain.ID(uuidVal.String())
+	}
+
+	return ids, nil
+}
+
+// missingOrStale explains why a versioned write matched no rows: the user is
+// either gone (domain.ErrUserNotFound) or at a different version
+// (domain.ErrUserVersionMismatch).
+func missingOrStale(ctx context.Context, queries *sqlcgen.Queries, id pgtype.UUID) error {
+	_, err := queries.GetUserByID(ctx, sqlcgen.GetUserByIDParams{TenantID: tenantID(ctx), ID: id})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrUserNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return domain.ErrUserVersionMismatch
+}
+
+// toDomainUser maps a sqlc user row to the domain entity.
+func toDomainUser(u sqlcgen.User) (domain.User, error) {
+	// Map generic UUID bytes back to string
+	// sqlc with pgx/v5 pgtype.UUID uses Bytes [16]byte.
+	// We need to convert that to uuid.UUID to get String().
+	uuidVal, err := uuid.FromBytes(u.ID.Bytes[:])
+	if err != nil {
+		return domain.User{}, fmt.Errorf("invalid uuid from db: %w", err)
+	}
+
+	user := domain.User{
+		ID:        domain.ID(uuidVal.String()),
+		Email:     u.Email,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		CreatedAt: u.CreatedAt.Time,
+		UpdatedAt: u.UpdatedAt.Time,
+		Version:   u.Version,
+	}
+	if u.DeletedAt.Valid {
+		deletedAt := u.DeletedAt.Time
+		user.DeletedAt = &deletedAt
+	}
+	return user, nil
+}
+
+// toDomainUsers maps a slice of sqlc rows, never returning nil.
+func toDomainUsers(rows []sqlcgen.User) ([]domain.User, error) {
+	users := make([]domain.User, len(rows))
+	for i, u := range rows {
+		var err error
+		users[i], err = toDomainUser(u)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return users, nil
 }
 
 // Ensure UserRepo implements domain.UserRepository at compile time.
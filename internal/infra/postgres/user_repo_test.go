@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/iruldev/golang-api-hexagonal/internal/domain"
 	"github.com/iruldev/golang-api-hexagonal/internal/infra/postgres"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
 	"github.com/iruldev/golang-api-hexagonal/internal/testutil/containers"
 )
 
@@ -83,6 +85,8 @@ func setupTestDB(t *testing.T) (*pgxpool.Pool, func()) {
 
 			// We can use the Truncate helper if available, or just manual delete
 			_, _ = pool.Exec(ctx, "DELETE FROM audit_events")
+			_, _ = pool.Exec(ctx, "DELETE FROM audit_chain_anchors")
+			_, _ = pool.Exec(ctx, "DELETE FROM audit_sink_cursors")
 			_, _ = pool.Exec(ctx, "DELETE FROM users")
 
 			// Pool close is handled by NewPostgres t.Cleanup, but we can close it here too if we want explicit control.
@@ -113,6 +117,8 @@ func setupTestDB(t *testing.T) (*pgxpool.Pool, func()) {
 		// This coupling is necessary because audit_events may reference users (though not via FK, logic might vary)
 		// and we want a clean state for integration tests.
 		_, _ = pool.Exec(ctx, "DELETE FROM audit_events")
+		_, _ = pool.Exec(ctx, "DELETE FROM audit_chain_anchors")
+		_, _ = pool.Exec(ctx, "DELETE FROM audit_sink_cursors")
 		_, _ = pool.Exec(ctx, "DELETE FROM users")
 		db.Close()
 		pool.Close()
@@ -278,26 +284,191 @@ func TestUserRepo_List_WithPagination(t *testing.T) {
 
 	// Test first page
 	params := domain.ListParams{Page: 1, PageSize: 10}
-	users, totalCount, err := repo.List(ctx, querier, params)
+	users, totalCount, err := repo.List(ctx, querier, domain.UserFilter{}, params)
 	assert.NoError(t, err)
 	assert.Equal(t, 25, totalCount)
 	assert.Len(t, users, 10)
 
 	// Test second page
 	params = domain.ListParams{Page: 2, PageSize: 10}
-	users, totalCount, err = repo.List(ctx, querier, params)
+	users, totalCount, err = repo.List(ctx, querier, domain.UserFilter{}, params)
 	assert.NoError(t, err)
 	assert.Equal(t, 25, totalCount)
 	assert.Len(t, users, 10)
 
 	// Test third page (partial)
 	params = domain.ListParams{Page: 3, PageSize: 10}
-	users, totalCount, err = repo.List(ctx, querier, params)
+	users, totalCount, err = repo.List(ctx, querier, domain.UserFilter{}, params)
 	assert.NoError(t, err)
 	assert.Equal(t, 25, totalCount)
 	assert.Len(t, users, 5)
 }
 
+func TestUserRepo_List_Keyset(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	// 7 users; the last two share created_at so the id tiebreaker is exercised.
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	for i := 0; i < 7; i++ {
+		id, err := uuid.NewV7()
+		require.NoError(t, err)
+		createdAt := now.Add(time.Duration(min(i, 5)) * time.Second)
+		require.NoError(t, repo.Create(ctx, querier, &domain.User{
+			ID:        domain.ID(id.String()),
+			Email:     fmt.Sprintf("keyset%d@example.com", i),
+			FirstName: "Keyset",
+			LastName:  "User",
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		}))
+	}
+
+	all, total, err := repo.List(ctx, querier, domain.UserFilter{}, domain.ListParams{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Equal(t, 7, total)
+	cursorAt := func(u domain.User, backward bool) *domain.Cursor {
+		return &domain.Cursor{Time: u.CreatedAt, ID: u.ID, Backward: backward}
+	}
+	ids := func(users []domain.User) []domain.ID {
+		out := make([]domain.ID, len(users))
+		for i, u := range users {
+			out[i] = u.ID
+		}
+		return out
+	}
+
+	// Forward from the 2nd user: fetches PageSize+1 to probe for a next page.
+	users, total, err := repo.List(ctx, querier, domain.UserFilter{}, domain.ListParams{PageSize: 3, Cursor: cursorAt(all[1], false)})
+	require.NoError(t, err)
+	assert.Equal(t, domain.TotalCountUnknown, total)
+	assert.Equal(t, ids(all[2:6]), ids(users))
+
+	// Forward near the end returns fewer than the probe size.
+	users, _, err = repo.List(ctx, querier, domain.UserFilter{}, domain.ListParams{PageSize: 3, Cursor: cursorAt(all[4], false)})
+	require.NoError(t, err)
+	assert.Equal(t, ids(all[5:]), ids(users))
+
+	// Backward from the 6th user returns the preceding users in list order.
+	users, _, err = repo.List(ctx, querier, domain.UserFilter{}, domain.ListParams{PageSize: 3, Cursor: cursorAt(all[5], true)})
+	require.NoError(t, err)
+	assert.Equal(t, ids(all[1:5]), ids(users))
+
+	// Soft-deleted users are skipped in keyset mode too.
+	require.NoError(t, repo.Delete(ctx, querier, all[3].ID, all[3].Version))
+	users, _, err = repo.List(ctx, querier, domain.UserFilter{}, domain.ListParams{PageSize: 10, Cursor: cursorAt(all[1], false)})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ID{all[2].ID, all[4].ID, all[5].ID, all[6].ID}, ids(users))
+}
+
+func TestUserRepo_List_Filters(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	seed := []struct{ email, first, last string }{
+		{"sarah.johnson@techcorp.io", "Sarah", "Johnson"},
+		{"alex.chen@innovate.dev", "Alex", "Chen"},
+		{"sara_h@example.com", "Sara", "Hughes"},
+		{"priya.sharma@globaltech.com", "Priya", "Sharma"},
+	}
+	created := make([]*domain.User, len(seed))
+	for i, u := range seed {
+		id, err := uuid.NewV7()
+		require.NoError(t, err)
+		created[i] = &domain.User{
+			ID:        domain.ID(id.String()),
+			Email:     u.email,
+			FirstName: u.first,
+			LastName:  u.last,
+			CreatedAt: now.Add(time.Duration(i) * time.Hour),
+			UpdatedAt: now.Add(time.Duration(i) * time.Hour),
+		}
+		require.NoError(t, repo.Create(ctx, querier, created[i]))
+	}
+	emails := func(users []domain.User) []string {
+		out := make([]string, len(users))
+		for i, u := range users {
+			out[i] = u.Email
+		}
+		return out
+	}
+	page := domain.ListParams{Page: 1, PageSize: 10}
+
+	t.Run("email is case-insensitive exact match", func(t *testing.T) {
+		users, total, err := repo.List(ctx, querier, domain.UserFilter{Email: "ALEX.CHEN@innovate.dev"}, page)
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, []string{"alex.chen@innovate.dev"}, emails(users))
+	})
+
+	t.Run("q matches name prefixes newest first", func(t *testing.T) {
+		users, total, err := repo.List(ctx, querier, domain.UserFilter{Query: "sar"}, page)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, []string{"sara_h@example.com", "sarah.johnson@techcorp.io"}, emails(users))
+	})
+
+	t.Run("q treats LIKE wildcards literally", func(t *testing.T) {
+		users, _, err := repo.List(ctx, querier, domain.UserFilter{Query: "sara_"}, page)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sara_h@example.com"}, emails(users))
+	})
+
+	t.Run("q matches similar full names", func(t *testing.T) {
+		users, _, err := repo.List(ctx, querier, domain.UserFilter{Query: "Priya Sharm"}, page)
+		require.NoError(t, err)
+		assert.Contains(t, emails(users), "priya.sharma@globaltech.com")
+	})
+
+	t.Run("created range is half-open", func(t *testing.T) {
+		users, total, err := repo.List(ctx, querier, domain.UserFilter{
+			CreatedAfter:  created[1].CreatedAt,
+			CreatedBefore: created[3].CreatedAt,
+		}, page)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, []string{"sara_h@example.com", "alex.chen@innovate.dev"}, emails(users))
+	})
+
+	t.Run("sort by last name ascending", func(t *testing.T) {
+		users, _, err := repo.List(ctx, querier, domain.UserFilter{
+			Sort: []domain.UserSort{{Key: domain.UserSortLastName}},
+		}, page)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"alex.chen@innovate.dev",
+			"sara_h@example.com",
+			"sarah.johnson@techcorp.io",
+			"priya.sharma@globaltech.com",
+		}, emails(users))
+	})
+
+	t.Run("filtered keyset page", func(t *testing.T) {
+		cursor := &domain.Cursor{Time: created[2].CreatedAt, ID: created[2].ID}
+		users, total, err := repo.List(ctx, querier, domain.UserFilter{Query: "sar"}, domain.ListParams{PageSize: 10, Cursor: cursor})
+		require.NoError(t, err)
+		assert.Equal(t, domain.TotalCountUnknown, total)
+		assert.Equal(t, []string{"sarah.johnson@techcorp.io"}, emails(users))
+	})
+
+	t.Run("soft-deleted users are excluded", func(t *testing.T) {
+		require.NoError(t, repo.Delete(ctx, querier, created[1].ID, created[1].Version))
+		users, total, err := repo.List(ctx, querier, domain.UserFilter{Query: "alex"}, page)
+		require.NoError(t, err)
+		assert.Equal(t, 0, total)
+		assert.Empty(t, users)
+	})
+}
+
 func TestUserRepo_List_OrderByCreatedAtDesc(t *testing.T) {
 	pool, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -335,7 +506,7 @@ func TestUserRepo_List_OrderByCreatedAtDesc(t *testing.T) {
 
 	// List should return user2 first (newer created_at)
 	params := domain.ListParams{Page: 1, PageSize: 10}
-	users, _, err := repo.List(ctx, querier, params)
+	users, _, err := repo.List(ctx, querier, domain.UserFilter{}, params)
 	assert.NoError(t, err)
 	assert.Len(t, users, 2)
 	assert.Equal(t, "second@example.com", users[0].Email) // Newest first
@@ -377,7 +548,7 @@ func TestUserRepo_List_OrderByIDDescWhenCreatedAtEqual(t *testing.T) {
 	require.NoError(t, repo.Create(ctx, querier, user2))
 
 	params := domain.ListParams{Page: 1, PageSize: 10}
-	users, _, err := repo.List(ctx, querier, params)
+	users, _, err := repo.List(ctx, querier, domain.UserFilter{}, params)
 	require.NoError(t, err)
 	require.Len(t, users, 2)
 
@@ -386,6 +557,354 @@ func TestUserRepo_List_OrderByIDDescWhenCreatedAtEqual(t *testing.T) {
 	assert.Equal(t, domain.ID(id1.String()), users[1].ID)
 }
 
+func TestUserRepo_Update_Success(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	id, err := uuid.NewV7()
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	user := &domain.User{
+		ID:        domain.ID(id.String()),
+		Email:     "update@example.com",
+		FirstName: "Alice",
+		LastName:  "Wonder",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	require.NoError(t, repo.Create(ctx, querier, user))
+	assert.Equal(t, int64(1), user.Version, "new users start at version 1")
+
+	user.Email = "updated@example.com"
+	user.FirstName = "Alicia"
+	user.UpdatedAt = now.Add(time.Minute)
+	require.NoError(t, repo.Update(ctx, querier, user))
+	assert.Equal(t, int64(2), user.Version, "update must bump the version")
+
+	found, err := repo.GetByID(ctx, querier, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "updated@example.com", found.Email)
+	assert.Equal(t, "Alicia", found.FirstName)
+	assert.Equal(t, "Wonder", found.LastName)
+	assert.True(t, found.UpdatedAt.Equal(user.UpdatedAt))
+	assert.True(t, found.CreatedAt.Equal(now), "created_at must not change on update")
+	assert.Equal(t, int64(2), found.Version)
+}
+
+func TestUserRepo_Update_StaleVersion(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	id, err := uuid.NewV7()
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	user := &domain.User{
+		ID:        domain.ID(id.String()),
+		Email:     "stale@example.com",
+		FirstName: "Stale",
+		LastName:  "Writer",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	require.NoError(t, repo.Create(ctx, querier, user))
+
+	// Two writers read version 1; the first one wins.
+	first, second := *user, *user
+	first.FirstName = "First"
+	require.NoError(t, repo.Update(ctx, querier, &first))
+
+	second.FirstName = "Second"
+	err = repo.Update(ctx, querier, &second)
+	assert.True(t, errors.Is(err, domain.ErrUserVersionMismatch), "expected ErrUserVersionMismatch, got: %v", err)
+
+	err = repo.Delete(ctx, querier, user.ID, user.Version)
+	assert.True(t, errors.Is(err, domain.ErrUserVersionMismatch), "expected ErrUserVersionMismatch, got: %v", err)
+
+	found, err := repo.GetByID(ctx, querier, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "First", found.FirstName, "stale write must not overwrite")
+	assert.Equal(t, int64(2), found.Version)
+}
+
+func TestUserRepo_Update_NotFound(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	id, err := uuid.NewV7()
+	require.NoError(t, err)
+
+	err = repo.Update(ctx, querier, &domain.User{
+		ID:        domain.ID(id.String()),
+		Email:     "ghost@example.com",
+		FirstName: "Ghost",
+		LastName:  "User",
+		UpdatedAt: time.Now().UTC(),
+		Version:   1,
+	})
+	assert.True(t, errors.Is(err, domain.ErrUserNotFound), "expected ErrUserNotFound, got: %v", err)
+}
+
+func TestUserRepo_Update_DuplicateEmail(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	var users []*domain.User
+	for _, email := range []string{"first@example.com", "second@example.com"} {
+		id, err := uuid.NewV7()
+		require.NoError(t, err)
+		u := &domain.User{
+			ID:        domain.ID(id.String()),
+			Email:     email,
+			FirstName: "Test",
+			LastName:  "User",
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		require.NoError(t, repo.Create(ctx, querier, u))
+		users = append(users, u)
+	}
+
+	users[1].Email = "FIRST@example.com" // CITEXT: case-insensitive conflict
+	err := repo.Update(ctx, querier, users[1])
+	assert.True(t, errors.Is(err, domain.ErrEmailAlreadyExists), "expected ErrEmailAlreadyExists, got: %v", err)
+}
+
+func TestUserRepo_Delete(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	id, err := uuid.NewV7()
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	user := &domain.User{
+		ID:        domain.ID(id.String()),
+		Email:     "delete@example.com",
+		FirstName: "Del",
+		LastName:  "Ete",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	require.NoError(t, repo.Create(ctx, querier, user))
+
+	require.NoError(t, repo.Delete(ctx, querier, user.ID, user.Version))
+
+	// Soft-deleted users are hidden from reads
+	_, err = repo.GetByID(ctx, querier, user.ID)
+	assert.True(t, errors.Is(err, domain.ErrUserNotFound), "expected ErrUserNotFound, got: %v", err)
+
+	users, total, err := repo.List(ctx, querier, domain.UserFilter{}, domain.ListParams{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 0, total)
+	assert.Empty(t, users)
+
+	// The row is kept until purged
+	var deletedAt *time.Time
+	err = pool.QueryRow(ctx, "SELECT deleted_at FROM users WHERE id = $1", id).Scan(&deletedAt)
+	require.NoError(t, err)
+	assert.NotNil(t, deletedAt)
+
+	// Deleting again reports not found
+	err = repo.Delete(ctx, querier, user.ID, user.Version+1)
+	assert.True(t, errors.Is(err, domain.ErrUserNotFound), "expected ErrUserNotFound, got: %v", err)
+}
+
+func TestUserRepo_Restore(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	id, err := uuid.NewV7()
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	user := &domain.User{
+		ID:        domain.ID(id.String()),
+		Email:     "restore@example.com",
+		FirstName: "Re",
+		LastName:  "Store",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	require.NoError(t, repo.Create(ctx, querier, user))
+
+	// Restoring an active user reports not found
+	err = repo.Restore(ctx, querier, user.ID)
+	assert.True(t, errors.Is(err, domain.ErrUserNotFound), "expected ErrUserNotFound, got: %v", err)
+
+	require.NoError(t, repo.Delete(ctx, querier, user.ID, user.Version))
+	require.NoError(t, repo.Restore(ctx, querier, user.ID))
+
+	found, err := repo.GetByID(ctx, querier, user.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.DeletedAt)
+	assert.Equal(t, int64(3), found.Version, "delete and restore each bump the version")
+}
+
+func TestUserRepo_Restore_EmailReRegistered(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	newUser := func() *domain.User {
+		id, err := uuid.NewV7()
+		require.NoError(t, err)
+		return &domain.User{
+			ID:        domain.ID(id.String()),
+			Email:     "reused@example.com",
+			FirstName: "Re",
+			LastName:  "Used",
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	}
+
+	original := newUser()
+	require.NoError(t, repo.Create(ctx, querier, original))
+	require.NoError(t, repo.Delete(ctx, querier, original.ID, original.Version))
+
+	// Email of a soft-deleted user can be registered again
+	replacement := newUser()
+	require.NoError(t, repo.Create(ctx, querier, replacement))
+
+	err := repo.Restore(ctx, querier, original.ID)
+	assert.True(t, errors.Is(err, domain.ErrEmailAlreadyExists), "expected ErrEmailAlreadyExists, got: %v", err)
+}
+
+func TestUserRepo_PurgeDeleted(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	var ids []domain.ID
+	for _, email := range []string{"old@example.com", "recent@example.com", "active@example.com"} {
+		id, err := uuid.NewV7()
+		require.NoError(t, err)
+		u := &domain.User{
+			ID:        domain.ID(id.String()),
+			Email:     email,
+			FirstName: "Purge",
+			LastName:  "Test",
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		require.NoError(t, repo.Create(ctx, querier, u))
+		ids = append(ids, u.ID)
+	}
+	require.NoError(t, repo.Delete(ctx, querier, ids[0], 1))
+	require.NoError(t, repo.Delete(ctx, querier, ids[1], 1))
+
+	// Backdate the first soft delete past the retention window
+	_, err := pool.Exec(ctx, "UPDATE users SET deleted_at = $2 WHERE id = $1", string(ids[0]), now.Add(-48*time.Hour))
+	require.NoError(t, err)
+
+	purged, err := repo.PurgeDeleted(ctx, querier, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ID{ids[0]}, purged)
+
+	var remaining int
+	require.NoError(t, pool.QueryRow(ctx, "SELECT COUNT(*) FROM users").Scan(&remaining))
+	assert.Equal(t, 2, remaining, "recently deleted and active users must be kept")
+}
+
+func TestUserRepo_Export(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+	txManager := postgres.NewTxManager(&dbAdapter{p: pool})
+
+	// More rows than one cursor fetch so batching is exercised
+	const total = 1203
+	base := time.Now().UTC().Truncate(time.Microsecond)
+	var want []domain.ID
+	for i := 0; i < total; i++ {
+		id, err := uuid.NewV7()
+		require.NoError(t, err)
+		u := &domain.User{
+			ID:        domain.ID(id.String()),
+			Email:     fmt.Sprintf("export-%04d@example.com", i),
+			FirstName: "Export",
+			LastName:  "Test",
+			CreatedAt: base.Add(time.Duration(i) * time.Millisecond),
+			UpdatedAt: base,
+		}
+		require.NoError(t, repo.Create(ctx, querier, u))
+		want = append(want, u.ID)
+	}
+	require.NoError(t, repo.Delete(ctx, querier, want[0], 1))
+	want = want[1:]
+
+	t.Run("streams active users oldest first", func(t *testing.T) {
+		var got []domain.ID
+		err := txManager.WithTx(ctx, func(tx domain.Querier) error {
+			return repo.Export(ctx, tx, func(u domain.User) error {
+				got = append(got, u.ID)
+				return nil
+			})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("callback error stops iteration", func(t *testing.T) {
+		stop := errors.New("stop")
+		calls := 0
+		err := txManager.WithTx(ctx, func(tx domain.Querier) error {
+			return repo.Export(ctx, tx, func(domain.User) error {
+				calls++
+				if calls == 10 {
+					return stop
+				}
+				return nil
+			})
+		})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 10, calls)
+	})
+
+	t.Run("requires a transaction", func(t *testing.T) {
+		err := repo.Export(ctx, querier, func(domain.User) error { return nil })
+		assert.Error(t, err)
+	})
+}
+
 func TestTxManager_WithTx_Rollback(t *testing.T) {
 	pool, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -459,3 +978,163 @@ func TestTxManager_WithTx_Commit(t *testing.T) {
 	assert.NotNil(t, found)
 	assert.Equal(t, user.Email, found.Email)
 }
+
+func TestUserRepo_TenantIsolation(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+	tenantA := sharedctx.SetTenantID(context.Background(), "tenant-a")
+	tenantB := sharedctx.SetTenantID(context.Background(), "tenant-b")
+
+	newUser := func(email string) *domain.User {
+		id, err := uuid.NewV7()
+		require.NoError(t, err)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		return &domain.User{ID: domain.ID(id.String()), Email: email, FirstName: "Ten", LastName: "Ant", CreatedAt: now, UpdatedAt: now}
+	}
+
+	userA := newUser("shared@example.com")
+	require.NoError(t, repo.Create(tenantA, querier, userA))
+	// Emails are unique per tenant.
+	userB := newUser("shared@example.com")
+	require.NoError(t, repo.Create(tenantB, querier, userB))
+
+	_, err := repo.GetByID(tenantB, querier, userA.ID)
+	assert.ErrorIs(t, err, domain.ErrUserNotFound, "a tenant must not read another tenant's users")
+	assert.ErrorIs(t, repo.Delete(tenantB, querier, userA.ID, 1), domain.ErrUserNotFound)
+
+	users, total, err := repo.List(tenantA, querier, domain.UserFilter{}, domain.ListParams{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, users, 1)
+	assert.Equal(t, userA.ID, users[0].ID)
+}
+
+func TestTxManager_WithTx_RowLevelSecurity(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+	txManager := postgres.NewTxManager(&dbAdapter{p: pool})
+
+	for _, tenant := range []string{"tenant-a", "tenant-b"} {
+		id, err := uuid.NewV7()
+		require.NoError(t, err)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		require.NoError(t, repo.Create(sharedctx.SetTenantID(ctx, tenant), querier, &domain.User{
+			ID: domain.ID(id.String()), Email: tenant + "@example.com", FirstName: "Row", LastName: "Level", CreatedAt: now, UpdatedAt: now,
+		}))
+	}
+
+	// Superusers bypass row-level security, so query as an ordinary role.
+	_, err := pool.Exec(ctx, `DO $$ BEGIN
+		IF NOT EXISTS (SELECT FROM pg_roles WHERE rolname = 'rls_test_app') THEN CREATE ROLE rls_test_app NOLOGIN; END IF;
+	END $$`)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, "GRANT SELECT, INSERT ON users TO rls_test_app")
+	require.NoError(t, err)
+
+	err = txManager.WithTx(sharedctx.SetTenantID(ctx, "tenant-b"), func(tx domain.Querier) error {
+		if _, err := tx.Exec(ctx, "SET LOCAL ROLE rls_test_app"); err != nil {
+			return err
+		}
+
+		// No tenant filter: the policy alone hides the other tenant's rows.
+		rows, err := tx.Query(ctx, "SELECT email FROM users")
+		if err != nil {
+			return err
+		}
+		emails, err := pgx.CollectRows(rows.(pgx.Rows), pgx.RowTo[string])
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, []string{"tenant-b@example.com"}, emails)
+
+		now := time.Now().UTC()
+		_, err = tx.Exec(ctx, `INSERT INTO users (id, email, first_name, last_name, created_at, updated_at, tenant_id)
+			VALUES ($1, 'intruder@example.com', 'In', 'Truder', $2, $2, 'tenant-a')`, uuid.New(), now)
+		return err
+	})
+	assert.ErrorContains(t, err, "row-level security", "rows of another tenant must not be written")
+}
+
+func TestRowLevelSecurity_FailsClosed(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := postgres.NewUserRepo()
+	querier := postgres.NewPoolQuerier(&dbAdapter{p: pool})
+	for _, tenant := range []string{"tenant-a", "tenant-b"} {
+		id, err := uuid.NewV7()
+		require.NoError(t, err)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		require.NoError(t, repo.Create(sharedctx.SetTenantID(ctx, tenant), querier, &domain.User{
+			ID: domain.ID(id.String()), Email: tenant + "@example.com", FirstName: "Fail", LastName: "Closed", CreatedAt: now, UpdatedAt: now,
+		}))
+	}
+
+	// Superusers bypass row-level security, so query as an ordinary role.
+	_, err := pool.Exec(ctx, `DO $$ BEGIN
+		IF NOT EXISTS (SELECT FROM pg_roles WHERE rolname = 'rls_test_app') THEN CREATE ROLE rls_test_app NOLOGIN; END IF;
+	END $$`)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, "GRANT SELECT ON users TO rls_test_app")
+	require.NoError(t, err)
+
+	countUsers := func(settings ...string) int {
+		var count int
+		err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+			if _, err := tx.Exec(ctx, "SET LOCAL ROLE rls_test_app"); err != nil {
+				return err
+			}
+			for _, setting := range settings {
+				if _, err := tx.Exec(ctx, setting); err != nil {
+					return err
+				}
+			}
+			return tx.QueryRow(ctx, "SELECT count(*) FROM users").Scan(&count)
+		})
+		require.NoError(t, err)
+		return count
+	}
+
+	assert.Zero(t, countUsers(), "without a tenant no rows are visible")
+	assert.Zero(t, countUsers("SET LOCAL app.tenant_id = ''"), "an empty tenant is no tenant")
+	assert.Equal(t, 1, countUsers("SET LOCAL app.tenant_id = 'tenant-a'"))
+	assert.Equal(t, 2, countUsers("SET LOCAL app.cross_tenant = 'on'"), "cross-tenant work opts in explicitly")
+}
+
+func TestNewPool_ScopesPooledQueries(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	scoped, err := postgres.NewPool(ctx, pool.Config().ConnString(), postgres.PoolConfig{MaxConns: 1})
+	require.NoError(t, err)
+	defer scoped.Close()
+	querier := postgres.NewPoolQuerier(scoped)
+
+	settings := func(ctx context.Context) (tenant, crossTenant string) {
+		row := querier.QueryRow(ctx, "SELECT current_setting('app.tenant_id'), current_setting('app.cross_tenant')").(pgx.Row)
+		require.NoError(t, row.Scan(&tenant, &crossTenant))
+		return tenant, crossTenant
+	}
+
+	// The single connection is reused; each acquisition rescopes it.
+	tenant, crossTenant := settings(sharedctx.SetTenantID(ctx, "tenant-a"))
+	assert.Equal(t, "tenant-a", tenant)
+	assert.Equal(t, "off", crossTenant)
+
+	tenant, crossTenant = settings(postgres.CrossTenant(sharedctx.SetTenantID(ctx, "tenant-b")))
+	assert.Equal(t, "tenant-b", tenant)
+	assert.Equal(t, "on", crossTenant)
+
+	tenant, crossTenant = settings(ctx)
+	assert.Equal(t, sharedctx.DefaultTenantID, tenant)
+	assert.Equal(t, "off", crossTenant)
+}
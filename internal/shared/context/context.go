@@ -1,5 +1,6 @@
 // Package context provides cross-cutting context utilities for storing and
-// retrieving request-scoped values such as Request IDs and Trace IDs.
+// retrieving request-scoped values such as Request IDs, Trace IDs and the
+// tenant ID.
 //
 // This package exists in the shared layer to allow both app and transport
 // layers to access request context without violating architecture boundaries.
@@ -20,8 +21,14 @@ type (
 	requestIDKey struct{}
 	traceIDKey   struct{}
 	spanIDKey    struct{}
+	tenantIDKey  struct{}
 )
 
+// DefaultTenantID is the tenant of contexts without a tenant ID, e.g. requests
+// served with authentication disabled. Data created before multi-tenancy
+// belongs to it.
+const DefaultTenantID = "default"
+
 // Empty ID constants for trace validation.
 const (
 	// EmptyTraceID is a 32-character string of zeros representing an invalid trace ID.
@@ -71,3 +78,18 @@ func GetSpanID(ctx context.Context) string {
 func SetSpanID(ctx context.Context, spanID string) context.Context {
 	return context.WithValue(ctx, spanIDKey{}, spanID)
 }
+
+// GetTenantID retrieves the tenant ID from the context.
+// Returns DefaultTenantID if no tenant ID is present.
+func GetTenantID(ctx context.Context) string {
+	if id, ok := ctx.Value(tenantIDKey{}).(string); ok && id != "" {
+		return id
+	}
+	return DefaultTenantID
+}
+
+// SetTenantID returns a new context with the given tenant ID. Data access in
+// the returned context is scoped to that tenant.
+func SetTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantIDKey{}, tenantID)
+}
@@ -0,0 +1,11 @@
+package metrics
+
+// AuthMetrics defines the contract needed by authentication middleware to record
+// which key verified a token. Operators watch it to know when a rotated key
+// no longer verifies any traffic and can be retired.
+type AuthMetrics interface {
+	// IncTokenVerified counts a successfully verified token. keyID identifies the
+	// verifying key; match is how it was selected ("kid" or "trial", or
+	// "introspection" for both with token introspection).
+	IncTokenVerified(keyID, match string)
+}
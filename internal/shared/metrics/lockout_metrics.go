@@ -0,0 +1,13 @@
+package metrics
+
+// LockoutMetrics defines the contract needed by the authentication lockout to
+// record failures, lockouts and the requests rejected while locked out.
+// keyType is what the failures were counted by: "ip" or "subject".
+type LockoutMetrics interface {
+	// IncAuthFailure counts a failed authentication attempt.
+	IncAuthFailure(keyType string)
+	// IncLockout counts a key locked out after repeated failures.
+	IncLockout(keyType string)
+	// IncLockoutRejected counts a request rejected because its key is locked out.
+	IncLockoutRejected(keyType string)
+}
@@ -0,0 +1,39 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/testutil/mocks"
+)
+
+// AuditEvents holds the audit events created through a repository returned
+// by RecordAuditEvents.
+type AuditEvents struct {
+	// Events are the created events, in order.
+	Events []*domain.AuditEvent
+	// Err, when set, fails every further Create without recording the event.
+	Err error
+}
+
+// RecordAuditEvents returns a mocks.MockAuditEventRepository that accepts any
+// number of Create calls and records them in the returned AuditEvents. Calls
+// to its other methods fail the test.
+func RecordAuditEvents(t testing.TB) (*mocks.MockAuditEventRepository, *AuditEvents) {
+	t.Helper()
+	repo := mocks.NewMockAuditEventRepository(gomock.NewController(t))
+	recorded := &AuditEvents{}
+	repo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ domain.Querier, event *domain.AuditEvent) error {
+			if recorded.Err != nil {
+				return recorded.Err
+			}
+			recorded.Events = append(recorded.Events, event)
+			return nil
+		}).
+		AnyTimes()
+	return repo, recorded
+}
@@ -54,6 +54,66 @@ func (mr *MockAuditEventRepositoryMockRecorder) Create(arg0, arg1, arg2 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditEventRepository)(nil).Create), arg0, arg1, arg2)
 }
 
+// GetByID mocks base method.
+func (m *MockAuditEventRepository) GetByID(arg0 context.Context, arg1 domain.Querier, arg2 domain.ID) (*domain.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAuditEventRepositoryMockRecorder) GetByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAuditEventRepository)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// GetChainAnchor mocks base method.
+func (m *MockAuditEventRepository) GetChainAnchor(arg0 context.Context, arg1 domain.Querier) (*domain.AuditChainAnchor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChainAnchor", arg0, arg1)
+	ret0, _ := ret[0].(*domain.AuditChainAnchor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChainAnchor indicates an expected call of GetChainAnchor.
+func (mr *MockAuditEventRepositoryMockRecorder) GetChainAnchor(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainAnchor", reflect.TypeOf((*MockAuditEventRepository)(nil).GetChainAnchor), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockAuditEventRepository) List(arg0 context.Context, arg1 domain.Querier, arg2 domain.AuditEventFilter, arg3 domain.ListParams) ([]domain.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]domain.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditEventRepositoryMockRecorder) List(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditEventRepository)(nil).List), arg0, arg1, arg2, arg3)
+}
+
+// ListChain mocks base method.
+func (m *MockAuditEventRepository) ListChain(arg0 context.Context, arg1 domain.Querier, arg2 int64, arg3 int) ([]domain.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChain", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]domain.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChain indicates an expected call of ListChain.
+func (mr *MockAuditEventRepositoryMockRecorder) ListChain(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChain", reflect.TypeOf((*MockAuditEventRepository)(nil).ListChain), arg0, arg1, arg2, arg3)
+}
+
 // ListByEntityID mocks base method.
 func (m *MockAuditEventRepository) ListByEntityID(arg0 context.Context, arg1 domain.Querier, arg2 string, arg3 domain.ID, arg4 domain.ListParams) ([]domain.AuditEvent, int, error) {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/iruldev/golang-api-hexagonal/internal/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -54,6 +55,34 @@ func (mr *MockUserRepositoryMockRecorder) Create(arg0, arg1, arg2 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRepository)(nil).Create), arg0, arg1, arg2)
 }
 
+// Delete mocks base method.
+func (m *MockUserRepository) Delete(arg0 context.Context, arg1 domain.Querier, arg2 domain.ID, arg3 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserRepositoryMockRecorder) Delete(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepository)(nil).Delete), arg0, arg1, arg2, arg3)
+}
+
+// Export mocks base method.
+func (m *MockUserRepository) Export(arg0 context.Context, arg1 domain.Querier, arg2 func(domain.User) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockUserRepositoryMockRecorder) Export(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockUserRepository)(nil).Export), arg0, arg1, arg2)
+}
+
 // GetByID mocks base method.
 func (m *MockUserRepository) GetByID(arg0 context.Context, arg1 domain.Querier, arg2 domain.ID) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -70,9 +99,9 @@ func (mr *MockUserRepositoryMockRecorder) GetByID(arg0, arg1, arg2 any) *gomock.
 }
 
 // List mocks base method.
-func (m *MockUserRepository) List(arg0 context.Context, arg1 domain.Querier, arg2 domain.ListParams) ([]domain.User, int, error) {
+func (m *MockUserRepository) List(arg0 context.Context, arg1 domain.Querier, arg2 domain.UserFilter, arg3 domain.ListParams) ([]domain.User, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]domain.User)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
@@ -80,7 +109,50 @@ func (m *MockUserRepository) List(arg0 context.Context, arg1 domain.Querier, arg
 }
 
 // List indicates an expected call of List.
-func (mr *MockUserRepositoryMockRecorder) List(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) List(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), arg0, arg1, arg2, arg3)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockUserRepository) PurgeDeleted(arg0 context.Context, arg1 domain.Querier, arg2 time.Time) ([]domain.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]domain.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockUserRepositoryMockRecorder) PurgeDeleted(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockUserRepository)(nil).PurgeDeleted), arg0, arg1, arg2)
+}
+
+// Restore mocks base method.
+func (m *MockUserRepository) Restore(arg0 context.Context, arg1 domain.Querier, arg2 domain.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockUserRepositoryMockRecorder) Restore(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockUserRepository)(nil).Restore), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockUserRepository) Update(arg0 context.Context, arg1 domain.Querier, arg2 *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserRepositoryMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), arg0, arg1, arg2)
 }
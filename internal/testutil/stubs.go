@@ -0,0 +1,46 @@
+package testutil
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// Querier is a domain.Querier for unit tests whose repositories are mocked:
+// every call succeeds without doing anything.
+type Querier struct{}
+
+// Exec implements domain.Querier.
+func (Querier) Exec(_ context.Context, _ string, _ ...any) (any, error) { return nil, nil }
+
+// Query implements domain.Querier.
+func (Querier) Query(_ context.Context, _ string, _ ...any) (any, error) { return nil, nil }
+
+// QueryRow implements domain.Querier.
+func (Querier) QueryRow(_ context.Context, _ string, _ ...any) any { return nil }
+
+// TxManager is a domain.TxManager that runs fn right away with a Querier as
+// its transaction, for use cases whose repositories are mocked.
+type TxManager struct{}
+
+// WithTx implements domain.TxManager.
+func (TxManager) WithTx(_ context.Context, fn func(tx domain.Querier) error) error {
+	return fn(Querier{})
+}
+
+// Redactor is a domain.Redactor that returns its input unchanged.
+type Redactor struct{}
+
+// RedactMap implements domain.Redactor.
+func (Redactor) RedactMap(data map[string]any) map[string]any { return data }
+
+// Redact implements domain.Redactor.
+func (Redactor) Redact(data any) any { return data }
+
+// NewAuthorizer returns the role-based app.Authorizer with the built-in
+// roles, logging its decisions to log.
+func NewAuthorizer(log *slog.Logger) app.Authorizer {
+	return app.NewRoleAuthorizer(app.DefaultRolePermissions(), log)
+}
@@ -3,6 +3,9 @@
 // This package contains common testing utilities used across the codebase:
 //   - TestContext: provides a context with test timeout
 //   - RunWithGoleak: runs tests with goroutine leak detection
+//   - Querier, TxManager, Redactor, NewAuthorizer: stand-ins for use case
+//     dependencies in unit tests
+//   - RecordAuditEvents: a mocked audit event repository recording its events
 //
 // Subpackages:
 //   - assert: test assertion helpers using go-cmp
@@ -0,0 +1,70 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// CreateAPIKeyRequest represents the HTTP body for creating an API key.
+type CreateAPIKeyRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+	// Role is the role of callers authenticated with the key.
+	Role string `json:"role" validate:"required,oneof=user admin"`
+	// Scopes are the scopes granted to callers authenticated with the key.
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,required"`
+	// ExpiresAt is when the key stops working. Omitted means never.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// APIKeyResponse represents an API key in HTTP responses.
+type APIKeyResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Prefix is the start of the key, to recognise it without revealing it.
+	Prefix string `json:"prefix"`
+	Role   string `json:"role"`
+	// Scopes are omitted for keys created before keys had scopes.
+	Scopes []string `json:"scopes,omitempty"`
+	// Key is only returned when the key is created or rotated.
+	Key        string     `json:"key,omitempty"`
+	CreatedBy  string     `json:"createdBy"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+// RotateAPIKeyResponse represents the key created by a rotation and the key it
+// replaces, which keeps working until its expiresAt.
+type RotateAPIKeyResponse struct {
+	APIKeyResponse
+	Replaced APIKeyResponse `json:"replaced"`
+}
+
+// ToAPIKeyResponse converts a domain.APIKey into a response DTO.
+// The key hash is never included.
+func ToAPIKeyResponse(k domain.APIKey, secret string) APIKeyResponse {
+	return APIKeyResponse{
+		ID:         string(k.ID),
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Role:       k.Role,
+		Scopes:     k.Scopes,
+		Key:        secret,
+		CreatedBy:  k.CreatedBy,
+		CreatedAt:  k.CreatedAt,
+		ExpiresAt:  k.ExpiresAt,
+		RevokedAt:  k.RevokedAt,
+		LastUsedAt: k.LastUsedAt,
+	}
+}
+
+// ToAPIKeyResponses converts domain.APIKeys into response DTOs.
+func ToAPIKeyResponses(keys []domain.APIKey) []APIKeyResponse {
+	resp := make([]APIKeyResponse, len(keys))
+	for i, k := range keys {
+		resp[i] = ToAPIKeyResponse(k, "")
+	}
+	return resp
+}
@@ -0,0 +1,191 @@
+package contract
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+const (
+	// maxAuditEntityTypeLength, maxAuditEventTypeLength and
+	// maxAuditRequestIDLength match the audit_events column sizes.
+	maxAuditEntityTypeLength = 50
+	maxAuditEventTypeLength  = 100
+	maxAuditRequestIDLength  = 64
+)
+
+// auditEventTypePattern matches "entity.action" event types and their
+// "entity.*" wildcards.
+var auditEventTypePattern = regexp.MustCompile(`^[a-z0-9_]+(\.[a-z0-9_]+)*(\.\*)?$`)
+
+// AuditEventResponse represents an audit event in HTTP responses.
+// Payload is the event data as recorded, with PII already redacted.
+// ChainSeq, PrevHash and Hash (hex) are omitted for events recorded before
+// the audit log was hash-chained.
+type AuditEventResponse struct {
+	ID         string          `json:"id"`
+	EventType  string          `json:"eventType"`
+	ActorID    string          `json:"actorId,omitempty"`
+	EntityType string          `json:"entityType"`
+	EntityID   string          `json:"entityId"`
+	Payload    json.RawMessage `json:"payload"`
+	Timestamp  time.Time       `json:"timestamp"`
+	RequestID  string          `json:"requestId,omitempty"`
+	ChainSeq   int64           `json:"chainSeq,omitempty"`
+	PrevHash   string          `json:"prevHash,omitempty"`
+	Hash       string          `json:"hash,omitempty"`
+}
+
+// ListAuditEventsResponse represents the list audit events response body.
+// Audit lists are paginated by cursor only, so Pagination.Page is always 0
+// and TotalItems/TotalPages are -1.
+type ListAuditEventsResponse struct {
+	Data       []AuditEventResponse `json:"data"`
+	Pagination PaginationResponse   `json:"pagination"`
+}
+
+// ToAuditEventResponse converts a domain.AuditEvent into a response DTO.
+func ToAuditEventResponse(e domain.AuditEvent) AuditEventResponse {
+	payload := json.RawMessage(e.Payload)
+	if !json.Valid(payload) {
+		payload = json.RawMessage("null")
+	}
+	return AuditEventResponse{
+		ID:         string(e.ID),
+		EventType:  e.EventType,
+		ActorID:    string(e.ActorID),
+		EntityType: e.EntityType,
+		EntityID:   string(e.EntityID),
+		Payload:    payload,
+		Timestamp:  e.Timestamp,
+		RequestID:  e.RequestID,
+		ChainSeq:   e.ChainSeq,
+		PrevHash:   hex.EncodeToString(e.PrevHash),
+		Hash:       hex.EncodeToString(e.Hash),
+	}
+}
+
+// AuditChainVerificationResponse represents the result of verifying the
+// audit log hash chain. AnchorSeq is the position verification started after
+// (the last event removed by retention); HeadSeq and HeadHash identify the
+// last event that verified. BrokenLink is only set when Valid is false.
+type AuditChainVerificationResponse struct {
+	Valid      bool                     `json:"valid"`
+	AnchorSeq  int64                    `json:"anchorSeq,omitempty"`
+	HeadSeq    int64                    `json:"headSeq"`
+	HeadHash   string                   `json:"headHash,omitempty"`
+	BrokenLink *AuditChainBreakResponse `json:"brokenLink,omitempty"`
+}
+
+// AuditChainBreakResponse describes the first broken link of the chain.
+type AuditChainBreakResponse struct {
+	Seq     int64  `json:"seq"`
+	EventID string `json:"eventId"`
+	Reason  string `json:"reason"`
+}
+
+// NewAuditChainVerificationResponse creates a verification response from the
+// anchor position, the last verified link and the break found after it, if any.
+func NewAuditChainVerificationResponse(anchorSeq, headSeq int64, headHash []byte, brk *domain.AuditChainBreak) AuditChainVerificationResponse {
+	resp := AuditChainVerificationResponse{
+		Valid:     brk == nil,
+		AnchorSeq: anchorSeq,
+		HeadSeq:   headSeq,
+		HeadHash:  hex.EncodeToString(headHash),
+	}
+	if brk != nil {
+		resp.BrokenLink = &AuditChainBreakResponse{
+			Seq:     brk.Seq,
+			EventID: string(brk.EventID),
+			Reason:  brk.Reason,
+		}
+	}
+	return resp
+}
+
+// NewListAuditEventsResponse creates a list response from domain data.
+func NewListAuditEventsResponse(events []domain.AuditEvent, pageSize int) ListAuditEventsResponse {
+	data := make([]AuditEventResponse, len(events))
+	for i, e := range events {
+		data[i] = ToAuditEventResponse(e)
+	}
+	return ListAuditEventsResponse{
+		Data:       data,
+		Pagination: NewPaginationResponse(0, pageSize, domain.TotalCountUnknown),
+	}
+}
+
+// ParseAuditEventFilter reads the GET /api/v1/audit-events filter parameters:
+//
+//	entityType=<type>       exact match, e.g. user
+//	entityId=<UUID>         exact match
+//	actorId=<UUID>          exact match
+//	eventType=<type>        exact match (user.created) or prefix wildcard (user.*)
+//	requestId=<id>          exact match
+//	from=<RFC 3339>         inclusive lower bound on timestamp
+//	to=<RFC 3339>           exclusive upper bound on timestamp
+//
+// Every invalid parameter is reported as a field error; the filter is only
+// meaningful when no errors are returned.
+func ParseAuditEventFilter(query url.Values) (domain.AuditEventFilter, []ValidationError) {
+	var filter domain.AuditEventFilter
+	var errs []ValidationError
+
+	if entityType := strings.TrimSpace(query.Get("entityType")); entityType != "" {
+		if len(entityType) > maxAuditEntityTypeLength {
+			errs = append(errs, ValidationError{Field: "entityType", Message: "must be at most 50 characters", Code: CodeValTooLong})
+		} else {
+			filter.EntityType = entityType
+		}
+	}
+
+	filter.EntityID, errs = parseUUIDParam(query, "entityId", errs)
+	filter.ActorID, errs = parseUUIDParam(query, "actorId", errs)
+
+	if eventType := strings.TrimSpace(query.Get("eventType")); eventType != "" {
+		switch {
+		case len(eventType) > maxAuditEventTypeLength:
+			errs = append(errs, ValidationError{Field: "eventType", Message: "must be at most 100 characters", Code: CodeValTooLong})
+		case !auditEventTypePattern.MatchString(eventType):
+			errs = append(errs, ValidationError{Field: "eventType", Message: "must be an event type such as user.created, or a prefix such as user.*", Code: CodeValInvalidFormat})
+		default:
+			filter.EventType = eventType
+		}
+	}
+
+	if requestID := strings.TrimSpace(query.Get("requestId")); requestID != "" {
+		if len(requestID) > maxAuditRequestIDLength {
+			errs = append(errs, ValidationError{Field: "requestId", Message: "must be at most 64 characters", Code: CodeValTooLong})
+		} else {
+			filter.RequestID = requestID
+		}
+	}
+
+	filter.From, errs = parseTimeParam(query, "from", errs)
+	filter.To, errs = parseTimeParam(query, "to", errs)
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		errs = append(errs, ValidationError{Field: "to", Message: "must be after from", Code: CodeValOutOfRange})
+	}
+
+	return filter, errs
+}
+
+// parseUUIDParam parses an optional UUID query parameter.
+func parseUUIDParam(query url.Values, name string, errs []ValidationError) (domain.ID, []ValidationError) {
+	raw := strings.TrimSpace(query.Get(name))
+	if raw == "" {
+		return "", errs
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return "", append(errs, ValidationError{Field: name, Message: "must be a valid UUID", Code: CodeValInvalidUUID})
+	}
+	return domain.ID(id.String()), errs
+}
@@ -0,0 +1,166 @@
+//go:build !integration
+
+package contract
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+func TestParseAuditEventFilter_Valid(t *testing.T) {
+	t.Parallel()
+
+	query := url.Values{
+		"entityType": {"user"},
+		"entityId":   {"019400A0-1234-7ABC-8DEF-1234567890AB"},
+		"actorId":    {"019400a0-1234-7abc-8def-1234567890ac"},
+		"eventType":  {"user.*"},
+		"requestId":  {"req-123"},
+		"from":       {"2026-01-01T00:00:00Z"},
+		"to":         {"2026-02-01T00:00:00+07:00"},
+	}
+
+	filter, errs := ParseAuditEventFilter(query)
+
+	require.Empty(t, errs)
+	assert.Equal(t, domain.AuditEventFilter{
+		EntityType: "user",
+		EntityID:   "019400a0-1234-7abc-8def-1234567890ab",
+		ActorID:    "019400a0-1234-7abc-8def-1234567890ac",
+		EventType:  "user.*",
+		RequestID:  "req-123",
+		From:       filter.From,
+		To:         filter.To,
+	}, filter)
+	assert.True(t, filter.From.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, filter.To.Equal(time.Date(2026, 1, 31, 17, 0, 0, 0, time.UTC)))
+}
+
+func TestParseAuditEventFilter_Empty(t *testing.T) {
+	t.Parallel()
+
+	filter, errs := ParseAuditEventFilter(url.Values{"pageSize": {"10"}})
+
+	assert.Empty(t, errs)
+	assert.Equal(t, domain.AuditEventFilter{}, filter)
+}
+
+func TestParseAuditEventFilter_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		query     url.Values
+		wantField string
+		wantCode  string
+	}{
+		{name: "entity type too long", query: url.Values{"entityType": {strings.Repeat("a", 51)}}, wantField: "entityType", wantCode: CodeValTooLong},
+		{name: "malformed entity ID", query: url.Values{"entityId": {"42"}}, wantField: "entityId", wantCode: CodeValInvalidUUID},
+		{name: "malformed actor ID", query: url.Values{"actorId": {"admin"}}, wantField: "actorId", wantCode: CodeValInvalidUUID},
+		{name: "event type too long", query: url.Values{"eventType": {strings.Repeat("a", 101)}}, wantField: "eventType", wantCode: CodeValTooLong},
+		{name: "wildcard inside event type", query: url.Values{"eventType": {"user.*.created"}}, wantField: "eventType", wantCode: CodeValInvalidFormat},
+		{name: "bare wildcard", query: url.Values{"eventType": {"*"}}, wantField: "eventType", wantCode: CodeValInvalidFormat},
+		{name: "LIKE wildcard in event type", query: url.Values{"eventType": {"user%"}}, wantField: "eventType", wantCode: CodeValInvalidFormat},
+		{name: "request ID too long", query: url.Values{"requestId": {strings.Repeat("a", 65)}}, wantField: "requestId", wantCode: CodeValTooLong},
+		{name: "from not RFC 3339", query: url.Values{"from": {"2026-01-01"}}, wantField: "from", wantCode: CodeValInvalidFormat},
+		{
+			name:      "empty time range",
+			query:     url.Values{"from": {"2026-01-01T00:00:00Z"}, "to": {"2026-01-01T00:00:00Z"}},
+			wantField: "to",
+			wantCode:  CodeValOutOfRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := ParseAuditEventFilter(tt.query)
+
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.wantField, errs[0].Field)
+			assert.Equal(t, tt.wantCode, errs[0].Code)
+		})
+	}
+}
+
+func TestToAuditEventResponse_RendersPayloadAsJSON(t *testing.T) {
+	t.Parallel()
+
+	resp := ToAuditEventResponse(domain.AuditEvent{
+		ID:         "event-1",
+		EventType:  domain.EventUserCreated,
+		EntityType: "user",
+		EntityID:   "user-1",
+		Payload:    []byte(`{"email":"[REDACTED]","firstName":"Sarah"}`),
+		Timestamp:  time.Date(2026, 1, 14, 9, 0, 0, 0, time.UTC),
+	})
+
+	body, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "event-1",
+		"eventType": "user.created",
+		"entityType": "user",
+		"entityId": "user-1",
+		"payload": {"email": "[REDACTED]", "firstName": "Sarah"},
+		"timestamp": "2026-01-14T09:00:00Z"
+	}`, string(body))
+}
+
+func TestToAuditEventResponse_RendersChainAsHex(t *testing.T) {
+	t.Parallel()
+
+	resp := ToAuditEventResponse(domain.AuditEvent{
+		ID:       "event-1",
+		Payload:  []byte(`{}`),
+		ChainSeq: 1,
+		PrevHash: domain.AuditChainGenesis(),
+		Hash:     []byte{0xab, 0xcd},
+	})
+
+	assert.Equal(t, int64(1), resp.ChainSeq)
+	assert.Equal(t, strings.Repeat("0", 64), resp.PrevHash)
+	assert.Equal(t, "abcd", resp.Hash)
+}
+
+func TestNewAuditChainVerificationResponse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		resp := NewAuditChainVerificationResponse(0, 3, []byte{0x01}, nil)
+
+		assert.Equal(t, AuditChainVerificationResponse{Valid: true, HeadSeq: 3, HeadHash: "01"}, resp)
+	})
+
+	t.Run("valid after anchor", func(t *testing.T) {
+		t.Parallel()
+
+		resp := NewAuditChainVerificationResponse(2, 3, []byte{0x01}, nil)
+
+		assert.Equal(t, AuditChainVerificationResponse{Valid: true, AnchorSeq: 2, HeadSeq: 3, HeadHash: "01"}, resp)
+	})
+
+	t.Run("broken", func(t *testing.T) {
+		t.Parallel()
+
+		resp := NewAuditChainVerificationResponse(0, 1, []byte{0x01}, &domain.AuditChainBreak{
+			Seq:     2,
+			EventID: "event-2",
+			Reason:  domain.AuditChainHashMismatch,
+		})
+
+		assert.False(t, resp.Valid)
+		assert.Equal(t, &AuditChainBreakResponse{Seq: 2, EventID: "event-2", Reason: "hash_mismatch"}, resp.BrokenLink)
+	})
+}
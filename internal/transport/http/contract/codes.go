@@ -12,8 +12,10 @@
 // |----------|--------|---------|-------------|------------------------------------|
 // | AUTH     | AUTH   | 001-099 | 401         | Authentication (token, credentials)|
 // | AUTHZ    | AUTHZ  | 001-099 | 403         | Authorization (permissions)        |
-// | VAL      | VAL    | 001-199 | 400         | Validation (input errors)          |
-// | USR      | USR    | 001-099 | 400/404/409 | User domain specific               |
+// | VAL      | VAL    | 001-199 | 400/406/428 | Validation (input errors)          |
+// | USR      | USR    | 001-099 | 400-424     | User domain specific               |
+// | JOB      | JOB    | 001-099 | 404         | Background jobs                    |
+// | KEY      | KEY    | 001-099 | 404         | API keys                           |
 // | DB       | DB     | 001-099 | 500/503     | Database operations                |
 // | SYS      | SYS    | 001-099 | 500/503     | System/infrastructure              |
 // | RATE     | RATE   | 001-099 | 429         | Rate limiting                      |
@@ -51,8 +53,13 @@ const (
 	// CodeAuthMissingToken indicates no authentication token was provided.
 	CodeAuthMissingToken = "AUTH-003"
 
-	// CodeAuthInvalidCredentials indicates invalid username/password combination.
+	// CodeAuthInvalidCredentials indicates invalid credentials, e.g. an unknown
+	// OAuth client or a wrong client secret.
 	CodeAuthInvalidCredentials = "AUTH-004"
+
+	// CodeAuthAccountLocked indicates the subject is locked out after repeated
+	// authentication failures.
+	CodeAuthAccountLocked = "AUTH-005"
 )
 
 // -----------------------------------------------------------------------------
@@ -106,6 +113,12 @@ const (
 	// CodeValInvalidUUID indicates an invalid UUID format.
 	CodeValInvalidUUID = "VAL-010"
 
+	// CodeValPreconditionRequired indicates a required If-Match header is missing (HTTP 428).
+	CodeValPreconditionRequired = "VAL-011"
+
+	// CodeValNotAcceptable indicates no representation matches the Accept header (HTTP 406).
+	CodeValNotAcceptable = "VAL-012"
+
 	// CodeValIdempotencyConflict indicates an idempotency key conflict.
 	// Reserved for Story 2.4: Idempotency Key Middleware.
 	CodeValIdempotencyConflict = "VAL-100"
@@ -118,7 +131,8 @@ const (
 // -----------------------------------------------------------------------------
 // USR - User domain errors
 // Reserved range: USR-001 to USR-099
-// HTTP status varies: 400 for validation, 404 for not found, 409 for conflict
+// HTTP status varies: 400 for validation, 404 for not found, 409 for conflict,
+// 412 for a failed If-Match precondition, 424 for an aborted batch item
 // -----------------------------------------------------------------------------
 
 const (
@@ -130,6 +144,43 @@ const (
 
 	// CodeUsrInvalidField indicates an invalid user field value (HTTP 400).
 	CodeUsrInvalidField = "USR-003"
+
+	// CodeUsrVersionMismatch indicates the If-Match version is stale (HTTP 412).
+	CodeUsrVersionMismatch = "USR-004"
+
+	// CodeUsrBatchAborted indicates a batch item was not created because another
+	// item of the same atomic batch failed (HTTP 424).
+	CodeUsrBatchAborted = "USR-005"
+)
+
+// -----------------------------------------------------------------------------
+// JOB - Background job errors
+// Reserved range: JOB-001 to JOB-099
+// -----------------------------------------------------------------------------
+
+const (
+	// CodeJobNotFound indicates the requested job was not found (HTTP 404).
+	CodeJobNotFound = "JOB-001"
+)
+
+// -----------------------------------------------------------------------------
+// KEY - API key errors
+// Reserved range: KEY-001 to KEY-099
+// -----------------------------------------------------------------------------
+
+const (
+	// CodeAPIKeyNotFound indicates the requested API key was not found (HTTP 404).
+	CodeAPIKeyNotFound = "KEY-001"
+)
+
+// -----------------------------------------------------------------------------
+// AUD - Audit log errors
+// Reserved range: AUD-001 to AUD-099
+// -----------------------------------------------------------------------------
+
+const (
+	// CodeAuditEventNotFound indicates the requested audit event was not found (HTTP 404).
+	CodeAuditEventNotFound = "AUD-001"
 )
 
 // -----------------------------------------------------------------------------
@@ -172,6 +223,10 @@ const (
 const (
 	// CodeRateLimitExceeded indicates the rate limit has been exceeded.
 	CodeRateLimitExceeded = "RATE-001"
+
+	// CodeRateTooManyAuthFailures indicates the client is locked out after
+	// repeated authentication failures.
+	CodeRateTooManyAuthFailures = "RATE-002"
 )
 
 // -----------------------------------------------------------------------------
@@ -272,7 +327,15 @@ var errorCodeInfoRegistry = map[string]ErrorCodeInfo{
 		Code:            CodeAuthInvalidCredentials,
 		Category:        "AUTH",
 		Title:           "Invalid Credentials",
-		DetailTemplate:  "The username or password is incorrect",
+		DetailTemplate:  "The provided credentials are incorrect",
+		HTTPStatus:      http.StatusUnauthorized,
+		ProblemTypeSlug: ProblemTypeUnauthorizedSlug,
+	},
+	CodeAuthAccountLocked: {
+		Code:            CodeAuthAccountLocked,
+		Category:        "AUTH",
+		Title:           "Account Locked",
+		DetailTemplate:  "Too many failed authentication attempts. Please retry later.",
 		HTTPStatus:      http.StatusUnauthorized,
 		ProblemTypeSlug: ProblemTypeUnauthorizedSlug,
 	},
@@ -376,6 +439,22 @@ var errorCodeInfoRegistry = map[string]ErrorCodeInfo{
 		HTTPStatus:      http.StatusBadRequest,
 		ProblemTypeSlug: ProblemTypeValidationErrorSlug,
 	},
+	CodeValPreconditionRequired: {
+		Code:            CodeValPreconditionRequired,
+		Category:        "VAL",
+		Title:           "Precondition Required",
+		DetailTemplate:  "The request must be conditional; send If-Match with the current ETag",
+		HTTPStatus:      http.StatusPreconditionRequired,
+		ProblemTypeSlug: ProblemTypePreconditionRequiredSlug,
+	},
+	CodeValNotAcceptable: {
+		Code:            CodeValNotAcceptable,
+		Category:        "VAL",
+		Title:           "Not Acceptable",
+		DetailTemplate:  "None of the media types in the Accept header can be produced",
+		HTTPStatus:      http.StatusNotAcceptable,
+		ProblemTypeSlug: ProblemTypeNotAcceptableSlug,
+	},
 	CodeValIdempotencyConflict: {
 		Code:            CodeValIdempotencyConflict,
 		Category:        "VAL",
@@ -418,6 +497,52 @@ var errorCodeInfoRegistry = map[string]ErrorCodeInfo{
 		HTTPStatus:      http.StatusBadRequest,
 		ProblemTypeSlug: ProblemTypeValidationErrorSlug,
 	},
+	CodeUsrVersionMismatch: {
+		Code:            CodeUsrVersionMismatch,
+		Category:        "USR",
+		Title:           "Precondition Failed",
+		DetailTemplate:  "The user was modified since it was last read",
+		HTTPStatus:      http.StatusPreconditionFailed,
+		ProblemTypeSlug: ProblemTypePreconditionFailedSlug,
+	},
+	CodeUsrBatchAborted: {
+		Code:            CodeUsrBatchAborted,
+		Category:        "USR",
+		Title:           "Batch Aborted",
+		DetailTemplate:  "The item was not created because another item in the atomic batch failed",
+		HTTPStatus:      http.StatusFailedDependency,
+		ProblemTypeSlug: ProblemTypeFailedDependencySlug,
+	},
+
+	// JOB codes
+	CodeJobNotFound: {
+		Code:            CodeJobNotFound,
+		Category:        "JOB",
+		Title:           "Job Not Found",
+		DetailTemplate:  "The requested job was not found",
+		HTTPStatus:      http.StatusNotFound,
+		ProblemTypeSlug: ProblemTypeNotFoundSlug,
+	},
+
+	// KEY codes
+	CodeAPIKeyNotFound: {
+		Code:            CodeAPIKeyNotFound,
+		Category:        "KEY",
+		Title:           "API Key Not Found",
+		DetailTemplate:  "The requested API key was not found",
+		HTTPStatus:      http.StatusNotFound,
+		ProblemTypeSlug: ProblemTypeNotFoundSlug,
+	},
+
+	// AUD codes
+	CodeAuditEventNotFound: {
+		Code:            CodeAuditEventNotFound,
+		Category:        "AUD",
+		Title:           "Audit Event Not Found",
+		DetailTemplate:  "The requested audit event was not found",
+		HTTPStatus:      http.StatusNotFound,
+		ProblemTypeSlug: ProblemTypeNotFoundSlug,
+	},
 
 	// DB codes
 	CodeDBConnection: {
@@ -480,6 +605,14 @@ var errorCodeInfoRegistry = map[string]ErrorCodeInfo{
 		HTTPStatus:      http.StatusTooManyRequests,
 		ProblemTypeSlug: ProblemTypeRateLimitSlug,
 	},
+	CodeRateTooManyAuthFailures: {
+		Code:            CodeRateTooManyAuthFailures,
+		Category:        "RATE",
+		Title:           "Too Many Authentication Failures",
+		DetailTemplate:  "Too many failed authentication attempts. Please retry later.",
+		HTTPStatus:      http.StatusTooManyRequests,
+		ProblemTypeSlug: ProblemTypeRateLimitSlug,
+	},
 
 	// RES codes (mirrors resilience package codes)
 	CodeResCircuitOpen: {
@@ -522,8 +655,18 @@ var errorCodeInfoRegistry = map[string]ErrorCodeInfo{
 // Deprecated: New code should use the new {CATEGORY}-{NNN} format directly.
 var legacyToNewCode = map[string]string{
 	// User domain errors
-	"ERR_USER_NOT_FOUND":    CodeUsrNotFound,
-	"ERR_USER_EMAIL_EXISTS": CodeUsrEmailExists,
+	"ERR_USER_NOT_FOUND":        CodeUsrNotFound,
+	"ERR_USER_EMAIL_EXISTS":     CodeUsrEmailExists,
+	"ERR_USER_VERSION_MISMATCH": CodeUsrVersionMismatch,
+
+	// Job errors
+	"ERR_JOB_NOT_FOUND": CodeJobNotFound,
+
+	// API key errors
+	"ERR_API_KEY_NOT_FOUND": CodeAPIKeyNotFound,
+
+	// Audit errors
+	"ERR_AUDIT_NOT_FOUND": CodeAuditEventNotFound,
 
 	// Validation errors
 	"ERR_VALIDATION":              CodeValRequired,
@@ -532,15 +675,22 @@ var legacyToNewCode = map[string]string{
 	"ERR_USER_INVALID_LAST_NAME":  CodeUsrInvalidField,
 
 	// Auth errors
-	"ERR_UNAUTHORIZED": CodeAuthExpiredToken,
-	"ERR_FORBIDDEN":    CodeAuthzForbidden,
+	"ERR_UNAUTHORIZED":             CodeAuthExpiredToken,
+	"ERR_FORBIDDEN":                CodeAuthzForbidden,
+	"ERR_INSUFFICIENT_PERMISSIONS": CodeAuthzInsufficientPermissions,
+	"ERR_INVALID_CREDENTIALS":      CodeAuthInvalidCredentials,
+	"ERR_ACCOUNT_LOCKED":           CodeAuthAccountLocked,
 
 	// System errors
-	"ERR_INTERNAL":            CodeSysInternal,
-	"ERR_NOT_FOUND":           CodeUsrNotFound,
-	"ERR_CONFLICT":            CodeUsrEmailExists,
-	"ERR_REQUEST_TOO_LARGE":   CodeValRequestTooLarge,
-	"ERR_RATE_LIMIT_EXCEEDED": CodeRateLimitExceeded,
+	"ERR_INTERNAL":               CodeSysInternal,
+	"ERR_NOT_FOUND":              CodeUsrNotFound,
+	"ERR_CONFLICT":               CodeUsrEmailExists,
+	"ERR_REQUEST_TOO_LARGE":      CodeValRequestTooLarge,
+	"ERR_RATE_LIMIT_EXCEEDED":    CodeRateLimitExceeded,
+	"ERR_TOO_MANY_AUTH_FAILURES": CodeRateTooManyAuthFailures,
+	"ERR_PRECONDITION_REQUIRED":  CodeValPreconditionRequired,
+	"ERR_BATCH_ABORTED":          CodeUsrBatchAborted,
+	"ERR_NOT_ACCEPTABLE":         CodeValNotAcceptable,
 
 	// Additional Validation errors (mapped to generic codes)
 	// Additional Validation errors (mapped to generic codes)
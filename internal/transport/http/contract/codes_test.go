@@ -20,6 +20,7 @@ func TestAllCodesHaveValidFormat(t *testing.T) {
 		{"CodeAuthInvalidToken", CodeAuthInvalidToken},
 		{"CodeAuthMissingToken", CodeAuthMissingToken},
 		{"CodeAuthInvalidCredentials", CodeAuthInvalidCredentials},
+		{"CodeAuthAccountLocked", CodeAuthAccountLocked},
 
 		// AUTHZ codes
 		{"CodeAuthzForbidden", CodeAuthzForbidden},
@@ -36,12 +37,25 @@ func TestAllCodesHaveValidFormat(t *testing.T) {
 		{"CodeValInvalidJSON", CodeValInvalidJSON},
 		{"CodeValRequestTooLarge", CodeValRequestTooLarge},
 		{"CodeValInvalidUUID", CodeValInvalidUUID},
+		{"CodeValPreconditionRequired", CodeValPreconditionRequired},
+		{"CodeValNotAcceptable", CodeValNotAcceptable},
 		{"CodeValIdempotencyConflict", CodeValIdempotencyConflict},
 
 		// USR codes
 		{"CodeUsrNotFound", CodeUsrNotFound},
 		{"CodeUsrEmailExists", CodeUsrEmailExists},
 		{"CodeUsrInvalidField", CodeUsrInvalidField},
+		{"CodeUsrVersionMismatch", CodeUsrVersionMismatch},
+		{"CodeUsrBatchAborted", CodeUsrBatchAborted},
+
+		// JOB codes
+		{"CodeJobNotFound", CodeJobNotFound},
+
+		// KEY codes
+		{"CodeAPIKeyNotFound", CodeAPIKeyNotFound},
+
+		// AUD codes
+		{"CodeAuditEventNotFound", CodeAuditEventNotFound},
 
 		// DB codes
 		{"CodeDBConnection", CodeDBConnection},
@@ -55,6 +69,7 @@ func TestAllCodesHaveValidFormat(t *testing.T) {
 
 		// RATE codes
 		{"CodeRateLimitExceeded", CodeRateLimitExceeded},
+		{"CodeRateTooManyAuthFailures", CodeRateTooManyAuthFailures},
 
 		// RES codes
 		{"CodeResCircuitOpen", CodeResCircuitOpen},
@@ -85,6 +100,7 @@ func TestGetErrorCodeInfo(t *testing.T) {
 		{CodeAuthInvalidToken, http.StatusUnauthorized, "Invalid Token", "AUTH"},
 		{CodeAuthMissingToken, http.StatusUnauthorized, "Missing Token", "AUTH"},
 		{CodeAuthInvalidCredentials, http.StatusUnauthorized, "Invalid Credentials", "AUTH"},
+		{CodeAuthAccountLocked, http.StatusUnauthorized, "Account Locked", "AUTH"},
 
 		// AUTHZ
 		{CodeAuthzForbidden, http.StatusForbidden, "Forbidden", "AUTHZ"},
@@ -96,11 +112,24 @@ func TestGetErrorCodeInfo(t *testing.T) {
 		{CodeValInvalidEmail, http.StatusBadRequest, "Invalid Email", "VAL"},
 		{CodeValRequestTooLarge, http.StatusRequestEntityTooLarge, "Request Too Large", "VAL"},
 		{CodeValIdempotencyConflict, http.StatusConflict, "Idempotency Conflict", "VAL"},
+		{CodeValPreconditionRequired, http.StatusPreconditionRequired, "Precondition Required", "VAL"},
+		{CodeValNotAcceptable, http.StatusNotAcceptable, "Not Acceptable", "VAL"},
 
 		// USR
 		{CodeUsrNotFound, http.StatusNotFound, "User Not Found", "USR"},
 		{CodeUsrEmailExists, http.StatusConflict, "Email Already Exists", "USR"},
 		{CodeUsrInvalidField, http.StatusBadRequest, "Invalid User Field", "USR"},
+		{CodeUsrVersionMismatch, http.StatusPreconditionFailed, "Precondition Failed", "USR"},
+		{CodeUsrBatchAborted, http.StatusFailedDependency, "Batch Aborted", "USR"},
+
+		// JOB
+		{CodeJobNotFound, http.StatusNotFound, "Job Not Found", "JOB"},
+
+		// KEY
+		{CodeAPIKeyNotFound, http.StatusNotFound, "API Key Not Found", "KEY"},
+
+		// AUD
+		{CodeAuditEventNotFound, http.StatusNotFound, "Audit Event Not Found", "AUD"},
 
 		// DB
 		{CodeDBConnection, http.StatusServiceUnavailable, "Database Connection Failed", "DB"},
@@ -114,6 +143,7 @@ func TestGetErrorCodeInfo(t *testing.T) {
 
 		// RATE
 		{CodeRateLimitExceeded, http.StatusTooManyRequests, "Rate Limit Exceeded", "RATE"},
+		{CodeRateTooManyAuthFailures, http.StatusTooManyRequests, "Too Many Authentication Failures", "RATE"},
 
 		// RES
 		{CodeResCircuitOpen, http.StatusServiceUnavailable, "Circuit Breaker Open", "RES"},
@@ -256,6 +286,7 @@ func TestLegacyCodeMapping(t *testing.T) {
 		// User domain
 		{"ERR_USER_NOT_FOUND", http.StatusNotFound, "User Not Found"},
 		{"ERR_USER_EMAIL_EXISTS", http.StatusConflict, "Email Already Exists"},
+		{"ERR_USER_VERSION_MISMATCH", http.StatusPreconditionFailed, "Precondition Failed"},
 
 		// Validation
 		{"ERR_VALIDATION", http.StatusBadRequest, "Required Field Missing"},
@@ -264,11 +295,20 @@ func TestLegacyCodeMapping(t *testing.T) {
 		// Auth
 		{"ERR_UNAUTHORIZED", http.StatusUnauthorized, "Token Expired"},
 		{"ERR_FORBIDDEN", http.StatusForbidden, "Forbidden"},
+		{"ERR_INSUFFICIENT_PERMISSIONS", http.StatusForbidden, "Insufficient Permissions"},
 
 		// System
 		{"ERR_INTERNAL", http.StatusInternalServerError, "Internal Server Error"},
 		{"ERR_REQUEST_TOO_LARGE", http.StatusRequestEntityTooLarge, "Request Too Large"},
 		{"ERR_RATE_LIMIT_EXCEEDED", http.StatusTooManyRequests, "Rate Limit Exceeded"},
+		{"ERR_TOO_MANY_AUTH_FAILURES", http.StatusTooManyRequests, "Too Many Authentication Failures"},
+		{"ERR_ACCOUNT_LOCKED", http.StatusUnauthorized, "Account Locked"},
+		{"ERR_PRECONDITION_REQUIRED", http.StatusPreconditionRequired, "Precondition Required"},
+		{"ERR_BATCH_ABORTED", http.StatusFailedDependency, "Batch Aborted"},
+		{"ERR_NOT_ACCEPTABLE", http.StatusNotAcceptable, "Not Acceptable"},
+		{"ERR_JOB_NOT_FOUND", http.StatusNotFound, "Job Not Found"},
+		{"ERR_API_KEY_NOT_FOUND", http.StatusNotFound, "API Key Not Found"},
+		{"ERR_AUDIT_NOT_FOUND", http.StatusNotFound, "Audit Event Not Found"},
 	}
 
 	for _, tc := range tests {
@@ -299,6 +339,9 @@ func TestCategoryForCode(t *testing.T) {
 		{CodeAuthzForbidden, "AUTHZ"},
 		{CodeValRequired, "VAL"},
 		{CodeUsrNotFound, "USR"},
+		{CodeJobNotFound, "JOB"},
+		{CodeAPIKeyNotFound, "KEY"},
+		{CodeAuditEventNotFound, "AUD"},
 		{CodeDBConnection, "DB"},
 		{CodeSysInternal, "SYS"},
 		{CodeRateLimitExceeded, "RATE"},
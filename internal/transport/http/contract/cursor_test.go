@@ -0,0 +1,115 @@
+//go:build !integration
+
+package contract
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+func TestCursorCodec_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	codec := NewCursorCodec([]byte("0123456789abcdef0123456789abcdef"))
+	cur := domain.Cursor{
+		Time:     time.Date(2026, 1, 2, 10, 30, 0, 123456000, time.UTC),
+		ID:       "019400a0-1234-7abc-8def-1234567890ab",
+		Backward: true,
+	}
+
+	token := codec.Encode(cur, "scope")
+	assert.NotContains(t, token, string(cur.ID), "token must be opaque")
+	assert.NotContains(t, token, "scope", "token must be opaque")
+
+	got, err := codec.Decode(token, "scope")
+	require.NoError(t, err)
+	assert.True(t, cur.Time.Equal(got.Time))
+	assert.Equal(t, cur.ID, got.ID)
+	assert.True(t, got.Backward)
+	assert.Equal(t, token, codec.Encode(got, "scope"), "encoding must be deterministic")
+}
+
+func TestCursorCodec_RejectsInvalidTokens(t *testing.T) {
+	t.Parallel()
+
+	codec := NewCursorCodec([]byte("0123456789abcdef0123456789abcdef"))
+	token := codec.Encode(domain.Cursor{Time: time.Now(), ID: "id"}, "scope")
+	payload, sig, _ := strings.Cut(token, ".")
+
+	otherToken := NewCursorCodec([]byte("another-key-another-key-another!!")).Encode(domain.Cursor{Time: time.Now(), ID: "id"}, "scope")
+	forgedPayload, _, _ := strings.Cut(NewCursorCodec(nil).Encode(domain.Cursor{Time: time.Now(), ID: "other"}, "scope"), ".")
+
+	tests := map[string]string{
+		"other scope":       codec.Encode(domain.Cursor{Time: time.Now(), ID: "id"}, "other"),
+		"empty":             "",
+		"no signature":      payload,
+		"bad base64":        "!!!." + sig,
+		"other key":         otherToken,
+		"swapped payload":   forgedPayload + "." + sig,
+		"truncated sig":     payload + "." + sig[:10],
+		"garbage signature": payload + ".AAAA",
+	}
+	for name, tok := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := codec.Decode(tok, "scope")
+			assert.ErrorIs(t, err, ErrInvalidCursor)
+		})
+	}
+}
+
+func TestCursorCodec_EncodeOptional(t *testing.T) {
+	t.Parallel()
+
+	codec := NewCursorCodec(nil)
+	assert.Empty(t, codec.EncodeOptional(nil, "scope"))
+	assert.NotEmpty(t, codec.EncodeOptional(&domain.Cursor{Time: time.Now(), ID: "id"}, "scope"))
+}
+
+func TestUserListCursorScope(t *testing.T) {
+	t.Parallel()
+
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	base := domain.UserFilter{Email: "Ada@Example.com", Query: "ada", CreatedAfter: after}
+	scope := UserListCursorScope("acme", base)
+
+	same := base
+	same.Email = "ada@example.com"
+	same.CreatedAfter = after.In(time.FixedZone("WIB", 7*60*60))
+	same.Sort = []domain.UserSort{{Key: domain.UserSortCreatedAt, Desc: true}}
+	assert.Equal(t, scope, UserListCursorScope("acme", same), "equivalent filters share a scope")
+
+	otherQuery := base
+	otherQuery.Query = "bob"
+	otherSort := base
+	otherSort.Sort = []domain.UserSort{{Key: domain.UserSortEmail}}
+	otherRange := base
+	otherRange.CreatedBefore = after.Add(time.Hour)
+	for name, other := range map[string]string{
+		"tenant": UserListCursorScope("globex", base),
+		"query":  UserListCursorScope("acme", otherQuery),
+		"sort":   UserListCursorScope("acme", otherSort),
+		"range":  UserListCursorScope("acme", otherRange),
+		"audit":  AuditEventCursorScope("acme", domain.AuditEventFilter{}),
+	} {
+		assert.NotEqual(t, scope, other, name)
+	}
+}
+
+func TestAuditEventCursorScope(t *testing.T) {
+	t.Parallel()
+
+	base := domain.AuditEventFilter{EntityType: "user", EventType: "user.*"}
+	scope := AuditEventCursorScope("acme", base)
+	assert.Equal(t, scope, AuditEventCursorScope("acme", base))
+
+	other := base
+	other.EventType = "user.created"
+	assert.NotEqual(t, scope, AuditEventCursorScope("acme", other))
+	assert.NotEqual(t, scope, AuditEventCursorScope("globex", base))
+}
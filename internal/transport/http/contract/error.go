@@ -106,20 +106,25 @@ func safeValidationMessage(appErr *app.AppError) string {
 }
 
 // WriteProblemJSON writes an RFC 7807 error response by converting the error to a Problem.
+// See ProblemFromError for the mapping.
+//
+// This ensures all errors are standardized as application/problem+json responses.
+func WriteProblemJSON(w http.ResponseWriter, r *http.Request, err error) {
+	WriteProblem(w, ProblemFromError(r, err))
+}
+
+// ProblemFromError converts an error to a Problem without writing it, e.g. for
+// embedding per-item errors in a batch response.
 //
 // It handles error mapping in the following priority:
 //  1. DomainError: Converted via FromDomainError (preserves domain codes)
 //  2. AppError: Converted via FromAppError (maps app codes to problem details)
 //  3. Unknown error: Mapped to a generic 500 Internal Server Error (details hidden)
-//
-// This ensures all errors are standardized as application/problem+json responses.
-func WriteProblemJSON(w http.ResponseWriter, r *http.Request, err error) {
+func ProblemFromError(r *http.Request, err error) *Problem {
 	// Priority 1: Check for DomainError (Story 3.2)
 	var domainErr *domainerrors.DomainError
 	if errors.As(err, &domainErr) {
-		problem := FromDomainError(r, domainErr)
-		WriteProblem(w, problem)
-		return
+		return FromDomainError(r, domainErr)
 	}
 
 	var appErr *app.AppError
@@ -133,8 +138,7 @@ func WriteProblemJSON(w http.ResponseWriter, r *http.Request, err error) {
 		}
 	}
 
-	problem := FromAppError(r, appErr)
-	WriteProblem(w, problem)
+	return FromAppError(r, appErr)
 }
 
 // NewValidationProblem creates a ProblemDetail for validation errors.
@@ -249,10 +249,10 @@ func TestWriteProblemJSON_DomainErrors(t *testing.T) {
 				Code:    domainerrors.ErrCodeAuditNotFound,
 				Message: "Audit event not found",
 			},
-			wantStatus: http.StatusInternalServerError,
-			wantCode:   CodeSysInternal,
-			wantTitle:  "Internal Server Error",
-			wantType:   ProblemBaseURL + "internal-error",
+			wantStatus: http.StatusNotFound,
+			wantCode:   CodeAuditEventNotFound,
+			wantTitle:  "Audit Event Not Found",
+			wantType:   ProblemBaseURL + "not-found",
 		},
 		{
 			name: "Domain InvalidEventType maps to 400",
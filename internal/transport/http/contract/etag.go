@@ -0,0 +1,47 @@
+package contract
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// VersionETag formats a resource version as a strong entity tag (RFC 9110 §8.8.3),
+// e.g. version 3 becomes "3" including the quotes.
+func VersionETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// SetVersionETag sets the ETag response header for the given resource version.
+// Must be called before the response status is written.
+func SetVersionETag(w http.ResponseWriter, version int64) {
+	w.Header().Set("ETag", VersionETag(version))
+}
+
+// ParseIfMatchVersion extracts the resource version from an If-Match header.
+//
+// present reports whether the header was sent at all. ok reports whether it
+// contains a strong entity tag produced by VersionETag; weak tags (W/"...")
+// never match under the strong comparison required for If-Match, and "*" is
+// not accepted because it would make the write unconditional. When several
+// tags are listed, the first valid one is used.
+func ParseIfMatchVersion(r *http.Request) (version int64, present, ok bool) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" {
+		return 0, false, false
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if len(tag) < 3 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			continue
+		}
+		v, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+		if err != nil || v < 1 {
+			continue
+		}
+		return v, true, true
+	}
+
+	return 0, true, false
+}
@@ -0,0 +1,47 @@
+package contract
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionETag(t *testing.T) {
+	assert.Equal(t, `"1"`, VersionETag(1))
+	assert.Equal(t, `"42"`, VersionETag(42))
+}
+
+func TestParseIfMatchVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		header      string
+		wantVersion int64
+		wantPresent bool
+		wantOK      bool
+	}{
+		{name: "missing", header: ""},
+		{name: "strong tag", header: `"7"`, wantVersion: 7, wantPresent: true, wantOK: true},
+		{name: "surrounding whitespace", header: `  "7" `, wantVersion: 7, wantPresent: true, wantOK: true},
+		{name: "first valid tag in list", header: `W/"1", "5", "6"`, wantVersion: 5, wantPresent: true, wantOK: true},
+		{name: "weak tag", header: `W/"7"`, wantPresent: true},
+		{name: "wildcard", header: "*", wantPresent: true},
+		{name: "unquoted", header: "7", wantPresent: true},
+		{name: "not a number", header: `"abc"`, wantPresent: true},
+		{name: "zero version", header: `"0"`, wantPresent: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("PUT", "/", nil)
+			if tt.header != "" {
+				req.Header.Set("If-Match", tt.header)
+			}
+
+			version, present, ok := ParseIfMatchVersion(req)
+			assert.Equal(t, tt.wantVersion, version)
+			assert.Equal(t, tt.wantPresent, present)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// FieldSet is a sparse fieldset selected with ?fields=a,b (JSON:API style).
+// The zero value selects every field.
+type FieldSet struct {
+	// names holds the selected JSON member names in declaration order of the
+	// resource struct, so projected objects keep the usual member order.
+	names []string
+}
+
+// All reports whether every field is selected.
+func (fs FieldSet) All() bool {
+	return len(fs.names) == 0
+}
+
+// Names returns the selected JSON member names, or nil when all are selected.
+func (fs FieldSet) Names() []string {
+	return fs.names
+}
+
+// jsonFieldCache memoizes the JSON member names of resource types.
+var jsonFieldCache sync.Map // reflect.Type -> []string
+
+// JSONFieldNames returns the JSON member names of the struct T (or of the
+// element type if T is a slice, array or pointer), in declaration order.
+// Fields tagged json:"-" are excluded; untagged fields use their Go name.
+func JSONFieldNames[T any]() []string {
+	t := reflect.TypeFor[T]()
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if cached, ok := jsonFieldCache.Load(t); ok {
+		return cached.([]string)
+	}
+
+	var names []string
+	if t.Kind() == reflect.Struct {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			names = append(names, name)
+		}
+	}
+
+	jsonFieldCache.Store(t, names)
+	return names
+}
+
+// ParseFields reads ?fields= for responses whose data is T (or a list of T).
+// The allowlist is derived from T's JSON tags, so new resources get sparse
+// fieldsets without extra code. Unknown or empty field names are reported
+// as VAL-002 field errors on "fields".
+func ParseFields[T any](query url.Values) (FieldSet, []ValidationError) {
+	raw, ok := query["fields"]
+	if !ok {
+		return FieldSet{}, nil
+	}
+
+	allowed := JSONFieldNames[T]()
+	requested := make(map[string]bool)
+	for _, part := range strings.Split(strings.Join(raw, ","), ",") {
+		name := strings.TrimSpace(part)
+		if name == "" {
+			return FieldSet{}, []ValidationError{{
+				Field:   "fields",
+				Message: "must be a comma-separated list of field names",
+				Code:    CodeValInvalidFormat,
+			}}
+		}
+		if !slices.Contains(allowed, name) {
+			return FieldSet{}, []ValidationError{{
+				Field:   "fields",
+				Message: fmt.Sprintf("unknown field '%s'; allowed: %s", name, strings.Join(allowed, ", ")),
+				Code:    CodeValInvalidFormat,
+			}}
+		}
+		requested[name] = true
+	}
+
+	names := make([]string, 0, len(requested))
+	for _, name := range allowed {
+		if requested[name] {
+			names = append(names, name)
+		}
+	}
+	return FieldSet{names: names}, nil
+}
+
+// SelectFields projects the "data" member of a response envelope such as
+// DataResponse[T] or ListUsersResponse onto fs: a data object keeps only the
+// selected members, and each object of a data array does too. Other envelope
+// members (e.g. pagination) are kept unchanged.
+//
+// The result is ready for WriteJSON / WriteConditionalJSON. When fs selects
+// every field, envelope is returned as is.
+func SelectFields(envelope any, fs FieldSet) (any, error) {
+	if fs.All() {
+		return envelope, nil
+	}
+
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, err
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil {
+		return nil, fmt.Errorf("select fields: envelope is not an object: %w", err)
+	}
+	data, ok := members["data"]
+	if !ok {
+		return nil, fmt.Errorf("select fields: envelope has no data member")
+	}
+
+	switch bytes.TrimSpace(data)[0] {
+	case '{':
+		members["data"], err = projectObject(data, fs.names)
+	case '[':
+		var items []json.RawMessage
+		if err = json.Unmarshal(data, &items); err != nil {
+			return nil, err
+		}
+		for i, item := range items {
+			if items[i], err = projectObject(item, fs.names); err != nil {
+				return nil, err
+			}
+		}
+		members["data"], err = json.Marshal(items)
+	default:
+		err = fmt.Errorf("select fields: data is neither an object nor an array")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// projectObject re-encodes a JSON object with only the named members, in the
+// given order. Members missing from the object (e.g. omitempty) are skipped.
+func projectObject(obj json.RawMessage, names []string) (json.RawMessage, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(obj, &members); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	for _, name := range names {
+		value, ok := members[name]
+		if !ok {
+			continue
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,127 @@
+//go:build !integration
+
+package contract
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONFieldNames(t *testing.T) {
+	t.Parallel()
+
+	want := []string{"id", "email", "firstName", "lastName", "createdAt", "updatedAt"}
+	assert.Equal(t, want, JSONFieldNames[UserResponse]())
+	assert.Equal(t, want, JSONFieldNames[[]UserResponse](), "slice element type is used")
+
+	type tagged struct {
+		Visible  string `json:"visible,omitempty"`
+		Hidden   string `json:"-"`
+		Untagged string
+		private  string //nolint:unused // verifies unexported fields are skipped
+	}
+	assert.Equal(t, []string{"visible", "Untagged"}, JSONFieldNames[*tagged]())
+}
+
+func TestParseFields(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		query     url.Values
+		wantNames []string
+		wantErr   bool
+	}{
+		{name: "absent selects all", query: url.Values{}, wantNames: nil},
+		{name: "declaration order", query: url.Values{"fields": {"email,id"}}, wantNames: []string{"id", "email"}},
+		{name: "whitespace and duplicates", query: url.Values{"fields": {" email , email"}}, wantNames: []string{"email"}},
+		{name: "repeated parameter", query: url.Values{"fields": {"id", "lastName"}}, wantNames: []string{"id", "lastName"}},
+		{name: "unknown field", query: url.Values{"fields": {"id,password"}}, wantErr: true},
+		{name: "empty value", query: url.Values{"fields": {""}}, wantErr: true},
+		{name: "empty item", query: url.Values{"fields": {"id,,email"}}, wantErr: true},
+		{name: "case sensitive", query: url.Values{"fields": {"ID"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fs, errs := ParseFields[UserResponse](tt.query)
+			if tt.wantErr {
+				require.Len(t, errs, 1)
+				assert.Equal(t, "fields", errs[0].Field)
+				assert.Equal(t, CodeValInvalidFormat, errs[0].Code)
+				assert.True(t, fs.All())
+				return
+			}
+			require.Empty(t, errs)
+			assert.Equal(t, tt.wantNames, fs.Names())
+			assert.Equal(t, tt.wantNames == nil, fs.All())
+		})
+	}
+}
+
+func TestParseFields_UnknownFieldMessageListsAllowed(t *testing.T) {
+	t.Parallel()
+
+	_, errs := ParseFields[UserResponse](url.Values{"fields": {"password"}})
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Message, "'password'")
+	assert.Contains(t, errs[0].Message, "id, email, firstName, lastName, createdAt, updatedAt")
+}
+
+func TestSelectFields(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	u := UserResponse{ID: "u1", Email: "a@example.com", FirstName: "A", LastName: "B", CreatedAt: now, UpdatedAt: now}
+	fs, errs := ParseFields[UserResponse](url.Values{"fields": {"email,id"}})
+	require.Empty(t, errs)
+
+	t.Run("all fields returns envelope unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		env := DataResponse[UserResponse]{Data: u}
+		got, err := SelectFields(env, FieldSet{})
+		require.NoError(t, err)
+		assert.Equal(t, env, got)
+	})
+
+	t.Run("object", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := SelectFields(DataResponse[UserResponse]{Data: u}, fs)
+		require.NoError(t, err)
+		body, err := json.Marshal(got)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"data":{"id":"u1","email":"a@example.com"}}`, string(body))
+		assert.Contains(t, string(body), `{"id":"u1","email":"a@example.com"}`, "members keep declaration order")
+	})
+
+	t.Run("array keeps other envelope members", func(t *testing.T) {
+		t.Parallel()
+
+		list := NewListUsersResponse(nil, 1, 10, 0)
+		list.Data = []UserResponse{u, u}
+		got, err := SelectFields(list, fs)
+		require.NoError(t, err)
+		body, err := json.Marshal(got)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"data":[{"id":"u1","email":"a@example.com"},{"id":"u1","email":"a@example.com"}],
+			"pagination":{"page":1,"pageSize":10,"totalItems":0,"totalPages":0}
+		}`, string(body))
+	})
+
+	t.Run("envelope without data", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := SelectFields(PurgeUsersResponse{}, fs)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,54 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// JobResponse represents a background job in HTTP responses.
+// Errors lists the first failed rows; FailedRows counts all of them.
+// Error is set only when the job as a whole failed.
+type JobResponse struct {
+	ID            string             `json:"id"`
+	Type          string             `json:"type"`
+	Status        string             `json:"status"`
+	TotalRows     int                `json:"totalRows"`
+	ProcessedRows int                `json:"processedRows"`
+	FailedRows    int                `json:"failedRows"`
+	Errors        []JobErrorResponse `json:"errors"`
+	Error         string             `json:"error,omitempty"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	UpdatedAt     time.Time          `json:"updatedAt"`
+	StartedAt     *time.Time         `json:"startedAt,omitempty"`
+	FinishedAt    *time.Time         `json:"finishedAt,omitempty"`
+}
+
+// JobErrorResponse describes why one input row failed.
+// Row is the 1-based data row number, not counting the CSV header.
+type JobErrorResponse struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+// ToJobResponse converts a domain.Job into a response DTO.
+func ToJobResponse(j domain.Job) JobResponse {
+	errs := make([]JobErrorResponse, len(j.Errors))
+	for i, e := range j.Errors {
+		errs[i] = JobErrorResponse{Row: e.Row, Message: e.Message}
+	}
+	return JobResponse{
+		ID:            string(j.ID),
+		Type:          string(j.Type),
+		Status:        string(j.Status),
+		TotalRows:     j.TotalRows,
+		ProcessedRows: j.ProcessedRows,
+		FailedRows:    j.FailedRows,
+		Errors:        errs,
+		Error:         j.Error,
+		CreatedAt:     j.CreatedAt,
+		UpdatedAt:     j.UpdatedAt,
+		StartedAt:     j.StartedAt,
+		FinishedAt:    j.FinishedAt,
+	}
+}
@@ -0,0 +1,71 @@
+package contract
+
+import (
+	"strings"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// GrantTypeClientCredentials is the only grant_type accepted by the token endpoint.
+const GrantTypeClientCredentials = "client_credentials"
+
+// TokenTypeBearer is the token_type of issued access tokens.
+const TokenTypeBearer = "Bearer"
+
+// TokenResponse is the RFC 6749 section 5.1 access token response. It is
+// written as is, not wrapped in DataResponse, so OAuth client libraries can
+// read it.
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	// ExpiresIn is the lifetime of the token in seconds.
+	ExpiresIn int64 `json:"expires_in"`
+	// Scope is the space-delimited scope granted to the token.
+	Scope string `json:"scope,omitempty"`
+}
+
+// NewTokenResponse converts a signed domain.AccessToken into a TokenResponse.
+func NewTokenResponse(signed string, token domain.AccessToken) TokenResponse {
+	return TokenResponse{
+		AccessToken: signed,
+		TokenType:   TokenTypeBearer,
+		ExpiresIn:   int64(token.ExpiresAt.Sub(token.IssuedAt) / time.Second),
+		Scope:       strings.Join(token.Scopes, " "),
+	}
+}
+
+// CreateOAuthClientRequest represents the HTTP body for registering an OAuth client.
+type CreateOAuthClientRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+	// Role is the role claim of the client's tokens.
+	Role string `json:"role" validate:"required,oneof=user admin"`
+	// Scopes are the scopes the client may be issued.
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,required"`
+}
+
+// OAuthClientResponse represents an OAuth client in HTTP responses.
+type OAuthClientResponse struct {
+	// ClientID is the client_id to present to the token endpoint.
+	ClientID string `json:"clientId"`
+	Name     string `json:"name"`
+	Role     string `json:"role"`
+	// Scopes are omitted for clients created before clients had scopes.
+	Scopes []string `json:"scopes,omitempty"`
+	// ClientSecret is only returned when the client is created.
+	ClientSecret string    `json:"clientSecret,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// ToOAuthClientResponse converts a domain.OAuthClient into a response DTO.
+// The secret hash is never included.
+func ToOAuthClientResponse(c domain.OAuthClient, secret string) OAuthClientResponse {
+	return OAuthClientResponse{
+		ClientID:     string(c.ID),
+		Name:         c.Name,
+		Role:         c.Role,
+		Scopes:       c.Scopes,
+		ClientSecret: secret,
+		CreatedAt:    c.CreatedAt,
+	}
+}
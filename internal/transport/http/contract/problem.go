@@ -17,14 +17,18 @@ import (
 )
 
 const (
-	ProblemTypeValidationErrorSlug    = "validation-error"
-	ProblemTypeNotFoundSlug           = "not-found"
-	ProblemTypeConflictSlug           = "conflict"
-	ProblemTypeInternalErrorSlug      = "internal-error"
-	ProblemTypeUnauthorizedSlug       = "unauthorized"
-	ProblemTypeForbiddenSlug          = "forbidden"
-	ProblemTypeRateLimitSlug          = "rate-limit-exceeded"
-	ProblemTypeServiceUnavailableSlug = "service-unavailable"
+	ProblemTypeValidationErrorSlug      = "validation-error"
+	ProblemTypeNotFoundSlug             = "not-found"
+	ProblemTypeConflictSlug             = "conflict"
+	ProblemTypeInternalErrorSlug        = "internal-error"
+	ProblemTypeUnauthorizedSlug         = "unauthorized"
+	ProblemTypeForbiddenSlug            = "forbidden"
+	ProblemTypeRateLimitSlug            = "rate-limit-exceeded"
+	ProblemTypeServiceUnavailableSlug   = "service-unavailable"
+	ProblemTypePreconditionFailedSlug   = "precondition-failed"
+	ProblemTypePreconditionRequiredSlug = "precondition-required"
+	ProblemTypeNotAcceptableSlug        = "not-acceptable"
+	ProblemTypeFailedDependencySlug     = "failed-dependency"
 
 	ContentTypeProblemJSON = "application/problem+json"
 )
@@ -65,6 +69,10 @@ type Problem struct {
 
 	// ValidationErrors is for backward compatibility
 	ValidationErrors []ValidationError `json:"validation_errors,omitempty"`
+
+	// RequiredScopes lists the OAuth scopes a route requires, on 403
+	// responses to tokens lacking some of them.
+	RequiredScopes []string `json:"required_scopes,omitempty"`
 }
 
 // NewProblem creates a new Problem from a problems.DefaultProblem.
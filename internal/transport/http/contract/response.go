@@ -1,8 +1,12 @@
 package contract
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
+	"strings"
+	"time"
 )
 
 // DataResponse is a generic wrapper for success responses.
@@ -16,3 +20,85 @@ func WriteJSON(w http.ResponseWriter, status int, data any) error {
 	w.WriteHeader(status)
 	return json.NewEncoder(w).Encode(data)
 }
+
+// Validators are the cache validators of a representation (RFC 9110 §8.8).
+// ETag must be a complete entity tag (quoted, optionally W/-prefixed).
+// An empty ETag or zero LastModified is not sent and not evaluated.
+type Validators struct {
+	ETag         string
+	LastModified time.Time
+}
+
+// WeakETag derives a weak entity tag from the given parts.
+// Use it for representations that have no single version, such as list pages:
+// any change to a part produces a different tag.
+func WeakETag(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return `W/"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+// WriteConditionalJSON writes a JSON response like WriteJSON, but sets the
+// validators and answers 304 Not Modified without a body when the request's
+// If-None-Match / If-Modified-Since show the client's copy is still current.
+//
+// Responses default to "Cache-Control: private, no-cache" so that clients
+// always revalidate; set Cache-Control beforehand to override.
+func WriteConditionalJSON(w http.ResponseWriter, r *http.Request, status int, v Validators, data any) error {
+	if v.ETag != "" {
+		w.Header().Set("ETag", v.ETag)
+	}
+	if !v.LastModified.IsZero() {
+		w.Header().Set("Last-Modified", v.LastModified.UTC().Format(http.TimeFormat))
+	}
+	if w.Header().Get("Cache-Control") == "" {
+		w.Header().Set("Cache-Control", "private, no-cache")
+	}
+
+	if status == http.StatusOK && NotModified(r, v) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+	return WriteJSON(w, status, data)
+}
+
+// NotModified evaluates If-None-Match and If-Modified-Since for a GET or HEAD
+// request against the current validators (RFC 9110 §13.2.2).
+// If-None-Match takes precedence and uses weak comparison;
+// If-Modified-Since is only considered when If-None-Match is absent.
+func NotModified(r *http.Request, v Validators) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		return v.ETag != "" && etagListMatchesWeak(inm, v.ETag)
+	}
+
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !v.LastModified.IsZero() {
+		since, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		// HTTP dates have second precision.
+		return !v.LastModified.Truncate(time.Second).After(since)
+	}
+
+	return false
+}
+
+// etagListMatchesWeak reports whether any tag in an If-None-Match list
+// matches etag under weak comparison (the W/ prefix is ignored).
+func etagListMatchesWeak(list, etag string) bool {
+	current := strings.TrimPrefix(etag, "W/")
+	for _, tag := range strings.Split(list, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == current {
+			return true
+		}
+	}
+	return false
+}
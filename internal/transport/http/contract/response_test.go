@@ -0,0 +1,115 @@
+//go:build !integration
+
+package contract
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeakETag(t *testing.T) {
+	t.Parallel()
+
+	tag := WeakETag("a", "b")
+	assert.Regexp(t, `^W/"[0-9a-f]{32}"$`, tag)
+	assert.Equal(t, tag, WeakETag("a", "b"))
+	assert.NotEqual(t, tag, WeakETag("ab"), "part boundaries must be significant")
+	assert.NotEqual(t, tag, WeakETag("b", "a"))
+}
+
+func TestNotModified(t *testing.T) {
+	t.Parallel()
+
+	lastModified := time.Date(2026, 1, 2, 10, 30, 0, 500_000_000, time.UTC)
+	v := Validators{ETag: `"3"`, LastModified: lastModified}
+
+	tests := []struct {
+		name   string
+		method string
+		header map[string]string
+		v      Validators
+		want   bool
+	}{
+		{name: "no conditional headers", method: http.MethodGet, v: v},
+		{name: "matching strong tag", method: http.MethodGet, header: map[string]string{"If-None-Match": `"3"`}, v: v, want: true},
+		{name: "weak comparison ignores W/ prefix", method: http.MethodGet, header: map[string]string{"If-None-Match": `W/"3"`}, v: v, want: true},
+		{name: "tag in list", method: http.MethodGet, header: map[string]string{"If-None-Match": `"1", "3"`}, v: v, want: true},
+		{name: "wildcard", method: http.MethodGet, header: map[string]string{"If-None-Match": "*"}, v: v, want: true},
+		{name: "different tag", method: http.MethodGet, header: map[string]string{"If-None-Match": `"2"`}, v: v},
+		{
+			name:   "If-None-Match takes precedence over If-Modified-Since",
+			method: http.MethodGet,
+			header: map[string]string{"If-None-Match": `"2"`, "If-Modified-Since": lastModified.Format(http.TimeFormat)},
+			v:      v,
+		},
+		{name: "not modified since", method: http.MethodGet, header: map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)}, v: v, want: true},
+		{name: "modified since", method: http.MethodGet, header: map[string]string{"If-Modified-Since": lastModified.Add(-time.Second).Format(http.TimeFormat)}, v: v},
+		{name: "invalid date", method: http.MethodGet, header: map[string]string{"If-Modified-Since": "yesterday"}, v: v},
+		{name: "If-Modified-Since without Last-Modified", method: http.MethodGet, header: map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)}, v: Validators{ETag: `"3"`}},
+		{name: "HEAD is conditional", method: http.MethodHead, header: map[string]string{"If-None-Match": `"3"`}, v: v, want: true},
+		{name: "unsafe methods are never 304", method: http.MethodPost, header: map[string]string{"If-None-Match": `"3"`}, v: v},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, "/", nil)
+			for k, val := range tt.header {
+				req.Header.Set(k, val)
+			}
+			assert.Equal(t, tt.want, NotModified(req, tt.v))
+		})
+	}
+}
+
+func TestWriteConditionalJSON(t *testing.T) {
+	t.Parallel()
+
+	lastModified := time.Date(2026, 1, 2, 10, 30, 0, 0, time.UTC)
+	v := Validators{ETag: `W/"abc"`, LastModified: lastModified}
+
+	t.Run("writes body and validators", func(t *testing.T) {
+		t.Parallel()
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, WriteConditionalJSON(rec, req, http.StatusOK, v, DataResponse[string]{Data: "ok"}))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `W/"abc"`, rec.Header().Get("ETag"))
+		assert.Equal(t, "Fri, 02 Jan 2026 10:30:00 GMT", rec.Header().Get("Last-Modified"))
+		assert.Equal(t, "private, no-cache", rec.Header().Get("Cache-Control"))
+		assert.JSONEq(t, `{"data":"ok"}`, rec.Body.String())
+	})
+
+	t.Run("answers 304 without body", func(t *testing.T) {
+		t.Parallel()
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("If-None-Match", `W/"abc"`)
+		require.NoError(t, WriteConditionalJSON(rec, req, http.StatusOK, v, DataResponse[string]{Data: "ok"}))
+
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Equal(t, `W/"abc"`, rec.Header().Get("ETag"), "304 must repeat the validators")
+		assert.Empty(t, rec.Body.String())
+		assert.Empty(t, rec.Header().Get("Content-Type"))
+	})
+
+	t.Run("keeps caller Cache-Control", func(t *testing.T) {
+		t.Parallel()
+
+		rec := httptest.NewRecorder()
+		rec.Header().Set("Cache-Control", "no-store")
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, WriteConditionalJSON(rec, req, http.StatusOK, v, DataResponse[string]{Data: "ok"}))
+
+		assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	})
+}
@@ -0,0 +1,47 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// RevokeTokensRequest represents the HTTP body for revoking access tokens.
+// Exactly one of Jti and Subject must be set; the use case enforces this.
+type RevokeTokensRequest struct {
+	// Jti revokes the single token with this jti claim.
+	Jti string `json:"jti" validate:"omitempty,max=255"`
+	// Subject revokes every token of this sub claim issued before IssuedBefore.
+	Subject string `json:"subject" validate:"omitempty,max=255"`
+	// IssuedBefore is the cutoff for a subject revocation. Default: now.
+	IssuedBefore *time.Time `json:"issuedBefore"`
+	// ExpiresAt is when the revoked tokens expire (e.g. the token's exp).
+	// Default: the configured retention after the revocation or cutoff.
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// TokenRevocationResponse represents a stored token revocation.
+type TokenRevocationResponse struct {
+	ID           string     `json:"id"`
+	Jti          string     `json:"jti,omitempty"`
+	Subject      string     `json:"subject,omitempty"`
+	IssuedBefore *time.Time `json:"issuedBefore,omitempty"`
+	ExpiresAt    time.Time  `json:"expiresAt"`
+	RevokedAt    time.Time  `json:"revokedAt"`
+}
+
+// ToTokenRevocationResponse converts a domain.TokenRevocation into a response DTO.
+func ToTokenRevocationResponse(r domain.TokenRevocation) TokenRevocationResponse {
+	resp := TokenRevocationResponse{
+		ID:        string(r.ID),
+		Jti:       r.JTI,
+		Subject:   r.Subject,
+		ExpiresAt: r.ExpiresAt,
+		RevokedAt: r.CreatedAt,
+	}
+	if !r.IssuedBefore.IsZero() {
+		issuedBefore := r.IssuedBefore
+		resp.IssuedBefore = &issuedBefore
+	}
+	return resp
+}
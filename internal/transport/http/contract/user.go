@@ -1,6 +1,8 @@
 package contract
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/iruldev/golang-api-hexagonal/internal/domain"
@@ -13,6 +15,22 @@ type CreateUserRequest struct {
 	LastName  string `json:"lastName" validate:"required,min=1,max=100"`
 }
 
+// UpdateUserRequest represents the HTTP body for replacing a user (PUT).
+// All mutable fields are required.
+type UpdateUserRequest struct {
+	Email     string `json:"email" validate:"required,email,max=255"`
+	FirstName string `json:"firstName" validate:"required,min=1,max=100"`
+	LastName  string `json:"lastName" validate:"required,min=1,max=100"`
+}
+
+// PatchUserRequest represents the HTTP body for partially updating a user
+// with JSON Merge Patch (PATCH). Absent fields are left unchanged.
+type PatchUserRequest struct {
+	Email     *string `json:"email" validate:"omitnil,email,max=255"`
+	FirstName *string `json:"firstName" validate:"omitnil,min=1,max=100"`
+	LastName  *string `json:"lastName" validate:"omitnil,min=1,max=100"`
+}
+
 // UserResponse represents a user in HTTP responses.
 type UserResponse struct {
 	ID        string    `json:"id"`
@@ -45,17 +63,26 @@ func ToUserResponses(users []domain.User) []UserResponse {
 }
 
 // PaginationResponse represents pagination metadata in list responses.
+// NextCursor and PrevCursor are opaque tokens for the neighbouring pages and
+// are omitted when there is no page in that direction. For pages requested by
+// cursor, Page is 0 and TotalItems/TotalPages are -1 because counting is skipped.
 type PaginationResponse struct {
-	Page       int `json:"page"`
-	PageSize   int `json:"pageSize"`
-	TotalItems int `json:"totalItems"`
-	TotalPages int `json:"totalPages"`
+	Page       int    `json:"page"`
+	PageSize   int    `json:"pageSize"`
+	TotalItems int    `json:"totalItems"`
+	TotalPages int    `json:"totalPages"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
 }
 
 // NewPaginationResponse builds pagination metadata with calculated total pages.
+// A negative totalItems (domain.TotalCountUnknown) yields TotalPages -1.
 func NewPaginationResponse(page, pageSize, totalItems int) PaginationResponse {
 	totalPages := 0
-	if pageSize > 0 && totalItems > 0 {
+	switch {
+	case totalItems < 0:
+		totalPages = domain.TotalCountUnknown
+	case pageSize > 0 && totalItems > 0:
 		totalPages = (totalItems + pageSize - 1) / pageSize
 	}
 
@@ -80,3 +107,30 @@ func NewListUsersResponse(users []domain.User, page, pageSize, totalItems int) L
 		Pagination: NewPaginationResponse(page, pageSize, totalItems),
 	}
 }
+
+// UserListETag derives a weak ETag for a page of users from the page contents
+// (each user's ID and UpdatedAt) and the pagination metadata, so any change to
+// a listed user, the page window, the total count or the page links yields a new tag.
+// fields is the selected sparse fieldset, if any; each fieldset is a distinct
+// representation and gets its own tag.
+func UserListETag(resp ListUsersResponse, fields ...string) string {
+	parts := make([]string, 0, len(resp.Data)*2+6)
+	for _, u := range resp.Data {
+		parts = append(parts, u.ID, u.UpdatedAt.UTC().Format(time.RFC3339Nano))
+	}
+	parts = append(parts,
+		strconv.Itoa(resp.Pagination.Page),
+		strconv.Itoa(resp.Pagination.PageSize),
+		strconv.Itoa(resp.Pagination.TotalItems),
+		resp.Pagination.NextCursor,
+		resp.Pagination.PrevCursor,
+		strings.Join(fields, ","),
+	)
+	return WeakETag(parts...)
+}
+
+// PurgeUsersResponse represents the result of purging soft-deleted users.
+type PurgeUsersResponse struct {
+	PurgedCount   int       `json:"purgedCount"`
+	DeletedBefore time.Time `json:"deletedBefore"`
+}
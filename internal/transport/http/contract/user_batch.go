@@ -0,0 +1,47 @@
+package contract
+
+// Batch modes accepted by BatchCreateUsersRequest.Mode.
+const (
+	BatchModeAtomic     = "atomic"
+	BatchModeBestEffort = "bestEffort"
+)
+
+// BatchCreateUsersRequest represents the HTTP body for creating users in bulk.
+// Items are validated one by one so that each failure is reported against its
+// own item instead of rejecting the whole request.
+type BatchCreateUsersRequest struct {
+	// Mode is "atomic" (default) or "bestEffort".
+	Mode  string              `json:"mode" validate:"omitempty,oneof=atomic bestEffort"`
+	Items []CreateUserRequest `json:"items" validate:"required,min=1"`
+}
+
+// BatchCreateUsersResponse is the 207 Multi-Status body of a batch create.
+type BatchCreateUsersResponse struct {
+	Mode    string            `json:"mode"`
+	Created int               `json:"created"`
+	Failed  int               `json:"failed"`
+	Results []BatchItemResult `json:"results"`
+}
+
+// BatchItemResult is the outcome of one batch item, in request order.
+// Status is the HTTP status the item would have had as a single request;
+// Data is set on success and Error (RFC 7807) on failure.
+type BatchItemResult struct {
+	Index  int           `json:"index"`
+	Status int           `json:"status"`
+	Data   *UserResponse `json:"data,omitempty"`
+	Error  *Problem      `json:"error,omitempty"`
+}
+
+// NewBatchCreateUsersResponse builds the response for results and counts them.
+func NewBatchCreateUsersResponse(mode string, results []BatchItemResult) BatchCreateUsersResponse {
+	resp := BatchCreateUsersResponse{Mode: mode, Results: results}
+	for _, r := range results {
+		if r.Error == nil {
+			resp.Created++
+		} else {
+			resp.Failed++
+		}
+	}
+	return resp
+}
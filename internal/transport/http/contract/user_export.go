@@ -0,0 +1,119 @@
+package contract
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// Media types produced by the user export.
+const (
+	MediaTypeNDJSON = "application/x-ndjson"
+	MediaTypeCSV    = "text/csv"
+)
+
+// userExportCSVHeader is the first CSV record; columns match the JSON field
+// names of UserResponse.
+var userExportCSVHeader = []string{"id", "email", "firstName", "lastName", "createdAt", "updatedAt"}
+
+// NegotiateExportMediaType selects the user export format from an Accept
+// header (RFC 9110 §12.5.1). An empty header, */* and application/* select
+// NDJSON; text/* selects CSV. Among acceptable types the highest q-value wins,
+// ties going to the one listed first. Returns "" when no supported type is
+// acceptable.
+func NegotiateExportMediaType(accept string) string {
+	if strings.TrimSpace(accept) == "" {
+		return MediaTypeNDJSON
+	}
+
+	best, bestQ := "", 0.0
+	for _, part := range strings.Split(accept, ",") {
+		mediaRange, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+
+		var candidate string
+		switch mediaRange {
+		case MediaTypeNDJSON, "*/*", "application/*":
+			candidate = MediaTypeNDJSON
+		case MediaTypeCSV, "text/*":
+			candidate = MediaTypeCSV
+		default:
+			continue
+		}
+		if q > bestQ {
+			best, bestQ = candidate, q
+		}
+	}
+	return best
+}
+
+// UserExportEncoder writes users one record at a time in the negotiated
+// export format. CSV output is buffered until Flush; NDJSON is written to the
+// underlying writer as each user is encoded.
+type UserExportEncoder struct {
+	json *json.Encoder
+	csv  *csv.Writer
+}
+
+// NewUserExportEncoder creates an encoder for a media type returned by
+// NegotiateExportMediaType.
+func NewUserExportEncoder(w io.Writer, mediaType string) *UserExportEncoder {
+	if mediaType == MediaTypeCSV {
+		return &UserExportEncoder{csv: csv.NewWriter(w)}
+	}
+	return &UserExportEncoder{json: json.NewEncoder(w)}
+}
+
+// Begin writes the CSV header record. It is a no-op for NDJSON.
+func (e *UserExportEncoder) Begin() error {
+	if e.csv == nil {
+		return nil
+	}
+	return e.csv.Write(userExportCSVHeader)
+}
+
+// Encode writes a single user.
+func (e *UserExportEncoder) Encode(u domain.User) error {
+	if e.csv == nil {
+		return e.json.Encode(ToUserResponse(u))
+	}
+	return e.csv.Write([]string{
+		string(u.ID),
+		u.Email,
+		u.FirstName,
+		u.LastName,
+		u.CreatedAt.UTC().Format(time.RFC3339Nano),
+		u.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	})
+}
+
+// Flush writes any buffered records to the underlying writer.
+func (e *UserExportEncoder) Flush() error {
+	if e.csv == nil {
+		return nil
+	}
+	e.csv.Flush()
+	return e.csv.Error()
+}
+
+// Filename returns the suggested download file name for the export.
+func (e *UserExportEncoder) Filename() string {
+	if e.csv != nil {
+		return "users.csv"
+	}
+	return "users.ndjson"
+}
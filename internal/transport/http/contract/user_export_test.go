@@ -0,0 +1,85 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+func TestNegotiateExportMediaType(t *testing.T) {
+	tests := []struct {
+		name   string
+		accept string
+		want   string
+	}{
+		{name: "missing header defaults to NDJSON", accept: "", want: MediaTypeNDJSON},
+		{name: "any", accept: "*/*", want: MediaTypeNDJSON},
+		{name: "ndjson", accept: "application/x-ndjson", want: MediaTypeNDJSON},
+		{name: "csv", accept: "text/csv", want: MediaTypeCSV},
+		{name: "csv with charset", accept: "text/csv; charset=utf-8", want: MediaTypeCSV},
+		{name: "text wildcard", accept: "text/*", want: MediaTypeCSV},
+		{name: "higher q wins", accept: "application/x-ndjson;q=0.5, text/csv", want: MediaTypeCSV},
+		{name: "first listed wins a tie", accept: "text/csv, application/x-ndjson", want: MediaTypeCSV},
+		{name: "q=0 excludes", accept: "text/csv;q=0, */*;q=0.1", want: MediaTypeNDJSON},
+		{name: "unsupported", accept: "application/json", want: ""},
+		{name: "only excluded types", accept: "text/csv;q=0", want: ""},
+		{name: "malformed entries are skipped", accept: "garbage, text/csv", want: MediaTypeCSV},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NegotiateExportMediaType(tt.accept))
+		})
+	}
+}
+
+func TestUserExportEncoder(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 600, time.UTC)
+	users := []domain.User{
+		{ID: "id-1", Email: "a@example.com", FirstName: "Ann", LastName: "Lee, Jr.", CreatedAt: created, UpdatedAt: created},
+		{ID: "id-2", Email: "b@example.com", FirstName: "Bob", LastName: `O"Neil`, CreatedAt: created, UpdatedAt: created},
+	}
+
+	encode := func(t *testing.T, mediaType string) *bytes.Buffer {
+		t.Helper()
+		var buf bytes.Buffer
+		enc := NewUserExportEncoder(&buf, mediaType)
+		require.NoError(t, enc.Begin())
+		for _, u := range users {
+			require.NoError(t, enc.Encode(u))
+		}
+		require.NoError(t, enc.Flush())
+		return &buf
+	}
+
+	t.Run("ndjson writes one user object per line", func(t *testing.T) {
+		buf := encode(t, MediaTypeNDJSON)
+
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		require.Len(t, lines, 2)
+		var got UserResponse
+		require.NoError(t, json.Unmarshal([]byte(lines[1]), &got))
+		assert.Equal(t, ToUserResponse(users[1]), got)
+		assert.Equal(t, "users.ndjson", NewUserExportEncoder(buf, MediaTypeNDJSON).Filename())
+	})
+
+	t.Run("csv writes a header and quoted records", func(t *testing.T) {
+		buf := encode(t, MediaTypeCSV)
+
+		records, err := csv.NewReader(buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, []string{"id", "email", "firstName", "lastName", "createdAt", "updatedAt"}, records[0])
+		assert.Equal(t, []string{"id-1", "a@example.com", "Ann", "Lee, Jr.", "2026-01-02T03:04:05.0000006Z", "2026-01-02T03:04:05.0000006Z"}, records[1])
+		assert.Equal(t, `O"Neil`, records[2][3])
+		assert.Equal(t, "users.csv", NewUserExportEncoder(buf, MediaTypeCSV).Filename())
+	})
+}
@@ -0,0 +1,116 @@
+package contract
+
+import (
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+const (
+	// maxUserQueryLength bounds the ?q= search term.
+	maxUserQueryLength = 100
+	// maxUserSortTerms bounds the number of ?sort= terms.
+	maxUserSortTerms = 5
+)
+
+// ParseUserListFilter reads the GET /api/v1/users filter parameters:
+//
+//	email=<address>             exact, case-insensitive match
+//	q=<text>                    prefix or fuzzy match on names and email
+//	createdAfter=<RFC 3339>     inclusive lower bound on createdAt
+//	createdBefore=<RFC 3339>    exclusive upper bound on createdAt
+//	sort=createdAt,-lastName    comma-separated allowlisted fields, "-" for descending
+//
+// Every invalid parameter is reported as a field error; the filter is only
+// meaningful when no errors are returned.
+func ParseUserListFilter(query url.Values) (domain.UserFilter, []ValidationError) {
+	var filter domain.UserFilter
+	var errs []ValidationError
+
+	if email := strings.TrimSpace(query.Get("email")); email != "" {
+		if err := validate.Var(email, "email,max=255"); err != nil {
+			errs = append(errs, ValidationError{Field: "email", Message: "must be a valid email address", Code: CodeValInvalidEmail})
+		} else {
+			filter.Email = email
+		}
+	}
+
+	if q := strings.TrimSpace(query.Get("q")); q != "" {
+		if utf8.RuneCountInString(q) > maxUserQueryLength {
+			errs = append(errs, ValidationError{Field: "q", Message: "must be at most 100 characters", Code: CodeValTooLong})
+		} else {
+			filter.Query = q
+		}
+	}
+
+	filter.CreatedAfter, errs = parseTimeParam(query, "createdAfter", errs)
+	filter.CreatedBefore, errs = parseTimeParam(query, "createdBefore", errs)
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+		errs = append(errs, ValidationError{Field: "createdBefore", Message: "must be after createdAfter", Code: CodeValOutOfRange})
+	}
+
+	if raw, ok := query["sort"]; ok {
+		filter.Sort, errs = parseUserSort(strings.Join(raw, ","), errs)
+	}
+
+	return filter, errs
+}
+
+// parseTimeParam parses an optional RFC 3339 query parameter.
+func parseTimeParam(query url.Values, name string, errs []ValidationError) (time.Time, []ValidationError) {
+	raw := strings.TrimSpace(query.Get(name))
+	if raw == "" {
+		return time.Time{}, errs
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, append(errs, ValidationError{Field: name, Message: "must be an RFC 3339 timestamp", Code: CodeValInvalidFormat})
+	}
+	return t, errs
+}
+
+// parseUserSort parses "createdAt,-lastName" against the domain allowlist.
+func parseUserSort(raw string, errs []ValidationError) ([]domain.UserSort, []ValidationError) {
+	terms := strings.Split(raw, ",")
+	if len(terms) > maxUserSortTerms {
+		return nil, append(errs, ValidationError{Field: "sort", Message: "must have at most 5 fields", Code: CodeValOutOfRange})
+	}
+
+	sort := make([]domain.UserSort, 0, len(terms))
+	seen := make(map[domain.UserSortKey]bool, len(terms))
+	for _, term := range terms {
+		term = strings.TrimSpace(term)
+		desc := strings.HasPrefix(term, "-")
+		key := domain.UserSortKey(strings.TrimPrefix(term, "-"))
+
+		switch {
+		case !key.Valid():
+			return nil, append(errs, ValidationError{
+				Field:   "sort",
+				Message: "unknown sort field '" + term + "'; allowed: " + userSortKeyList(),
+				Code:    CodeValInvalidFormat,
+			})
+		case seen[key]:
+			return nil, append(errs, ValidationError{
+				Field:   "sort",
+				Message: "duplicate sort field '" + string(key) + "'",
+				Code:    CodeValInvalidFormat,
+			})
+		}
+		seen[key] = true
+		sort = append(sort, domain.UserSort{Key: key, Desc: desc})
+	}
+	return sort, errs
+}
+
+func userSortKeyList() string {
+	keys := domain.UserSortKeys()
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = string(k)
+	}
+	return strings.Join(names, ", ")
+}
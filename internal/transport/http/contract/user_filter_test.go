@@ -0,0 +1,109 @@
+//go:build !integration
+
+package contract
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+func TestParseUserListFilter_Valid(t *testing.T) {
+	t.Parallel()
+
+	query := url.Values{
+		"email":         {"Sarah@Example.com"},
+		"q":             {" jo "},
+		"createdAfter":  {"2026-01-01T00:00:00Z"},
+		"createdBefore": {"2026-02-01T00:00:00+07:00"},
+		"sort":          {"createdAt,-lastName"},
+	}
+
+	filter, errs := ParseUserListFilter(query)
+
+	require.Empty(t, errs)
+	assert.Equal(t, "Sarah@Example.com", filter.Email)
+	assert.Equal(t, "jo", filter.Query)
+	assert.True(t, filter.CreatedAfter.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, filter.CreatedBefore.Equal(time.Date(2026, 1, 31, 17, 0, 0, 0, time.UTC)))
+	assert.Equal(t, []domain.UserSort{
+		{Key: domain.UserSortCreatedAt},
+		{Key: domain.UserSortLastName, Desc: true},
+	}, filter.Sort)
+}
+
+func TestParseUserListFilter_Empty(t *testing.T) {
+	t.Parallel()
+
+	filter, errs := ParseUserListFilter(url.Values{"page": {"2"}})
+
+	assert.Empty(t, errs)
+	assert.True(t, filter.IsZero())
+}
+
+func TestParseUserListFilter_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		query     url.Values
+		wantField string
+		wantCode  string
+	}{
+		{name: "malformed email", query: url.Values{"email": {"not-an-email"}}, wantField: "email", wantCode: CodeValInvalidEmail},
+		{name: "query too long", query: url.Values{"q": {strings.Repeat("a", 101)}}, wantField: "q", wantCode: CodeValTooLong},
+		{name: "createdAfter not RFC 3339", query: url.Values{"createdAfter": {"2026-01-01"}}, wantField: "createdAfter", wantCode: CodeValInvalidFormat},
+		{name: "createdBefore not RFC 3339", query: url.Values{"createdBefore": {"yesterday"}}, wantField: "createdBefore", wantCode: CodeValInvalidFormat},
+		{
+			name:      "empty time range",
+			query:     url.Values{"createdAfter": {"2026-01-02T00:00:00Z"}, "createdBefore": {"2026-01-01T00:00:00Z"}},
+			wantField: "createdBefore",
+			wantCode:  CodeValOutOfRange,
+		},
+		{name: "unknown sort field", query: url.Values{"sort": {"password"}}, wantField: "sort", wantCode: CodeValInvalidFormat},
+		{name: "column name is not a sort field", query: url.Values{"sort": {"created_at"}}, wantField: "sort", wantCode: CodeValInvalidFormat},
+		{name: "empty sort", query: url.Values{"sort": {""}}, wantField: "sort", wantCode: CodeValInvalidFormat},
+		{name: "duplicate sort field", query: url.Values{"sort": {"email,-email"}}, wantField: "sort", wantCode: CodeValInvalidFormat},
+		{name: "too many sort fields", query: url.Values{"sort": {"createdAt,updatedAt,email,firstName,lastName,createdAt"}}, wantField: "sort", wantCode: CodeValOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := ParseUserListFilter(tt.query)
+
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.wantField, errs[0].Field)
+			assert.Equal(t, tt.wantCode, errs[0].Code)
+		})
+	}
+}
+
+func TestParseUserListFilter_ReportsAllErrors(t *testing.T) {
+	t.Parallel()
+
+	_, errs := ParseUserListFilter(url.Values{
+		"email":        {"bad"},
+		"createdAfter": {"bad"},
+		"sort":         {"bad"},
+	})
+
+	assert.Len(t, errs, 3)
+}
+
+func TestParseUserListFilter_UnknownSortListsAllowedFields(t *testing.T) {
+	t.Parallel()
+
+	_, errs := ParseUserListFilter(url.Values{"sort": {"-password"}})
+
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Message, "'-password'")
+	assert.Contains(t, errs[0].Message, "createdAt, updatedAt, email, firstName, lastName")
+}
@@ -169,6 +169,56 @@ func TestNewListUsersResponse(t *testing.T) {
 	assert.Equal(t, 3, resp.Pagination.TotalPages)
 }
 
+func TestNewPaginationResponse_UnknownTotal(t *testing.T) {
+	t.Parallel()
+
+	resp := NewPaginationResponse(0, 10, domain.TotalCountUnknown)
+
+	assert.Equal(t, -1, resp.TotalItems)
+	assert.Equal(t, -1, resp.TotalPages)
+}
+
+func TestPaginationResponse_CursorsOmittedWhenEmpty(t *testing.T) {
+	t.Parallel()
+
+	body, err := json.Marshal(NewPaginationResponse(1, 10, 5))
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), "Cursor")
+
+	p := NewPaginationResponse(1, 10, 5)
+	p.NextCursor = "next"
+	body, err = json.Marshal(p)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"nextCursor":"next"`)
+	assert.NotContains(t, string(body), "prevCursor")
+}
+
+func TestUserListETag(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2026, 1, 2, 10, 30, 0, 0, time.UTC)
+	users := []domain.User{
+		{ID: domain.ID("1"), Email: "a@example.com", UpdatedAt: updatedAt},
+		{ID: domain.ID("2"), Email: "b@example.com", UpdatedAt: updatedAt},
+	}
+	base := UserListETag(NewListUsersResponse(users, 1, 10, 2))
+
+	assert.True(t, strings.HasPrefix(base, `W/"`), "list ETag must be weak")
+	assert.Equal(t, base, UserListETag(NewListUsersResponse(users, 1, 10, 2)), "ETag must be deterministic")
+
+	touched := append([]domain.User(nil), users...)
+	touched[1].UpdatedAt = updatedAt.Add(time.Millisecond)
+	assert.NotEqual(t, base, UserListETag(NewListUsersResponse(touched, 1, 10, 2)), "updated user changes ETag")
+	assert.NotEqual(t, base, UserListETag(NewListUsersResponse(users, 1, 10, 3)), "total count changes ETag")
+	assert.NotEqual(t, base, UserListETag(NewListUsersResponse(users[:1], 1, 10, 2)), "page contents change ETag")
+	assert.NotEqual(t, base, UserListETag(NewListUsersResponse(users, 2, 10, 2)), "page window changes ETag")
+
+	linked := NewListUsersResponse(users, 1, 10, 2)
+	linked.Pagination.NextCursor = "next"
+	assert.NotEqual(t, base, UserListETag(linked), "page links change ETag")
+	assert.NotEqual(t, base, UserListETag(NewListUsersResponse(users, 1, 10, 2), "id", "email"), "fieldset changes ETag")
+}
+
 func TestWriteJSON(t *testing.T) {
 	t.Parallel()
 
@@ -1,10 +1,13 @@
 package contract
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
 	"reflect"
+	"sort"
 	"strings"
 	"unicode"
 
@@ -67,6 +70,44 @@ func ValidateRequestBody[T any](r *http.Request, dst *T) []ValidationError {
 	return errs
 }
 
+// ValidateMergePatchBody decodes and validates a JSON Merge Patch (RFC 7386) body.
+// Members that are absent are left untouched in dst, so patch DTOs should use
+// pointer fields with "omitnil" validation tags. Because a merge patch null means
+// "remove this member", explicit nulls are rejected for resources whose fields
+// are all required.
+func ValidateMergePatchBody[T any](r *http.Request, dst *T) []ValidationError {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return []ValidationError{{
+			Field:   "body",
+			Message: "invalid request body",
+		}}
+	}
+
+	// Detect explicit null members before strict decoding, since decoding null
+	// into a pointer is indistinguishable from an absent member.
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err == nil {
+		var nullErrs []ValidationError
+		for name, raw := range members {
+			if string(bytes.TrimSpace(raw)) == "null" {
+				nullErrs = append(nullErrs, ValidationError{
+					Field:   name,
+					Message: "cannot be null",
+					Code:    CodeValRequired,
+				})
+			}
+		}
+		if len(nullErrs) > 0 {
+			sort.Slice(nullErrs, func(i, j int) bool { return nullErrs[i].Field < nullErrs[j].Field })
+			return nullErrs
+		}
+	}
+
+	errs, _ := DecodeAndValidateJSON(bytes.NewReader(body), dst)
+	return errs
+}
+
 // Validate validates a struct using go-playground/validator rules.
 // Returns a slice of ValidationError with camelCase field names.
 func Validate(v any) []ValidationError {
@@ -18,13 +18,41 @@ type Claims struct {
 	jwt.RegisteredClaims
 	// Role for authorization (e.g., "admin", "user")
 	Role string `json:"role,omitempty"`
+	// Scope is the space-delimited OAuth scope claim (RFC 9068).
+	Scope string `json:"scope,omitempty"`
+	// Scp is the scope claim as issued by some identity providers: an array of
+	// scopes, or a single space-delimited string.
+	Scp jwt.ClaimStrings `json:"scp,omitempty"`
+	// TenantID is the tenant whose data the bearer may access. Tokens
+	// without it belong to the default tenant.
+	TenantID string `json:"tenant_id,omitempty"`
 	// Custom claims can be added here as the application evolves.
 }
 
+// Scopes returns the scopes granted by the scope and scp claims, without
+// duplicates. It returns nil if the token carries neither claim.
+func (c *Claims) Scopes() []string {
+	if c == nil {
+		return nil
+	}
+	var scopes []string
+	seen := make(map[string]bool)
+	for _, value := range append([]string{c.Scope}, c.Scp...) {
+		for _, scope := range strings.Fields(value) {
+			if !seen[scope] {
+				seen[scope] = true
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes
+}
+
 // SetClaims stores the claims in the context.
 func SetClaims(ctx context.Context, claims *Claims) context.Context {
 	if claims != nil {
 		claims.Role = strings.ToLower(strings.TrimSpace(claims.Role))
+		claims.TenantID = strings.TrimSpace(claims.TenantID)
 	}
 	return context.WithValue(ctx, claimsKey{}, claims)
 }
@@ -4,6 +4,7 @@ package ctxutil
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -113,3 +114,40 @@ func TestClaims_EmptyRole(t *testing.T) {
 	require.NotNil(t, got)
 	assert.Equal(t, "", got.Role)
 }
+
+func TestClaims_TenantID(t *testing.T) {
+	var claims Claims
+	require.NoError(t, json.Unmarshal([]byte(`{"sub":"user-123","tenant_id":" tenant-a "}`), &claims))
+
+	got := GetClaims(SetClaims(context.Background(), &claims))
+
+	require.NotNil(t, got)
+	assert.Equal(t, "tenant-a", got.TenantID)
+}
+
+func TestClaims_Scopes(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    []string
+	}{
+		{name: "no scope claims", payload: `{"sub":"user-1"}`, want: nil},
+		{name: "space-delimited scope", payload: `{"scope":"users:read  users:write"}`, want: []string{"users:read", "users:write"}},
+		{name: "scp array", payload: `{"scp":["users:read","jobs:read"]}`, want: []string{"users:read", "jobs:read"}},
+		{name: "scp string", payload: `{"scp":"users:read jobs:read"}`, want: []string{"users:read", "jobs:read"}},
+		{
+			name:    "both claims are merged without duplicates",
+			payload: `{"scope":"users:read users:write","scp":["users:write","jobs:read"]}`,
+			want:    []string{"users:read", "users:write", "jobs:read"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var claims Claims
+			require.NoError(t, json.Unmarshal([]byte(tt.payload), &claims))
+
+			assert.Equal(t, tt.want, claims.Scopes())
+		})
+	}
+}
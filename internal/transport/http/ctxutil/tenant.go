@@ -0,0 +1,12 @@
+package ctxutil
+
+import (
+	"context"
+
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+)
+
+// GetTenantID retrieves the tenant ID from the context.
+func GetTenantID(ctx context.Context) string {
+	return sharedctx.GetTenantID(ctx)
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app/apikey"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+type createAPIKeyExecutor interface {
+	Execute(ctx context.Context, req apikey.CreateAPIKeyRequest) (apikey.CreateAPIKeyResponse, error)
+}
+
+type listAPIKeysExecutor interface {
+	Execute(ctx context.Context) (apikey.ListAPIKeysResponse, error)
+}
+
+type revokeAPIKeyExecutor interface {
+	Execute(ctx context.Context, req apikey.RevokeAPIKeyRequest) (apikey.RevokeAPIKeyResponse, error)
+}
+
+type rotateAPIKeyExecutor interface {
+	Execute(ctx context.Context, req apikey.RotateAPIKeyRequest) (apikey.RotateAPIKeyResponse, error)
+}
+
+// APIKeyHandler handles admin-only API key management.
+type APIKeyHandler struct {
+	createUC createAPIKeyExecutor
+	listUC   listAPIKeysExecutor
+	revokeUC revokeAPIKeyExecutor
+	rotateUC rotateAPIKeyExecutor
+}
+
+// NewAPIKeyHandler creates a new APIKeyHandler.
+func NewAPIKeyHandler(
+	createUC createAPIKeyExecutor,
+	listUC listAPIKeysExecutor,
+	revokeUC revokeAPIKeyExecutor,
+	rotateUC rotateAPIKeyExecutor,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		createUC: createUC,
+		listUC:   listUC,
+		revokeUC: revokeUC,
+		rotateUC: rotateUC,
+	}
+}
+
+// CreateAPIKey handles POST /api/v1/api-keys.
+// The key is only returned here and cannot be retrieved again.
+func (h *APIKeyHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	var req contract.CreateAPIKeyRequest
+	if errs := contract.ValidateRequestBody(r, &req); len(errs) > 0 {
+		contract.WriteValidationError(w, r, errs)
+		return
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+
+	reqID, actorID := auditContext(r)
+	resp, err := h.createUC.Execute(r.Context(), apikey.CreateAPIKeyRequest{
+		Name:      req.Name,
+		Role:      req.Role,
+		Scopes:    req.Scopes,
+		ExpiresAt: expiresAt,
+		RequestID: reqID,
+		ActorID:   actorID,
+	})
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	_ = contract.WriteJSON(w, http.StatusCreated, contract.DataResponse[contract.APIKeyResponse]{
+		Data: contract.ToAPIKeyResponse(resp.Key, resp.Secret),
+	})
+}
+
+// ListAPIKeys handles GET /api/v1/api-keys.
+// It lists all keys, newest first, including revoked and expired ones.
+func (h *APIKeyHandler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.listUC.Execute(r.Context())
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	_ = contract.WriteJSON(w, http.StatusOK, contract.DataResponse[[]contract.APIKeyResponse]{
+		Data: contract.ToAPIKeyResponses(resp.Keys),
+	})
+}
+
+// RevokeAPIKey handles POST /api/v1/api-keys/{id}:revoke.
+// Revoking a revoked key succeeds and returns it unchanged.
+func (h *APIKeyHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseAPIKeyID(w, r)
+	if !ok {
+		return
+	}
+
+	reqID, actorID := auditContext(r)
+	resp, err := h.revokeUC.Execute(r.Context(), apikey.RevokeAPIKeyRequest{
+		ID:        id,
+		RequestID: reqID,
+		ActorID:   actorID,
+	})
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	_ = contract.WriteJSON(w, http.StatusOK, contract.DataResponse[contract.APIKeyResponse]{
+		Data: contract.ToAPIKeyResponse(resp.Key, ""),
+	})
+}
+
+// RotateAPIKey handles POST /api/v1/api-keys/{id}:rotate.
+// It returns the new key, which cannot be retrieved again, and the replaced
+// key with the end of its grace period as expiresAt.
+func (h *APIKeyHandler) RotateAPIKey(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseAPIKeyID(w, r)
+	if !ok {
+		return
+	}
+
+	reqID, actorID := auditContext(r)
+	resp, err := h.rotateUC.Execute(r.Context(), apikey.RotateAPIKeyRequest{
+		ID:        id,
+		RequestID: reqID,
+		ActorID:   actorID,
+	})
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	_ = contract.WriteJSON(w, http.StatusCreated, contract.DataResponse[contract.RotateAPIKeyResponse]{
+		Data: contract.RotateAPIKeyResponse{
+			APIKeyResponse: contract.ToAPIKeyResponse(resp.Key, resp.Secret),
+			Replaced:       contract.ToAPIKeyResponse(resp.Replaced, ""),
+		},
+	})
+}
+
+// parseAPIKeyID extracts and validates the {id} URL parameter.
+// API key IDs come from the same UUID v7 generator as user IDs.
+func parseAPIKeyID(w http.ResponseWriter, r *http.Request) (domain.ID, bool) {
+	return parseUserID(w, r)
+}
@@ -0,0 +1,244 @@
+//go:build !integration
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/apikey"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// =============================================================================
+// APIKeyHandler Tests
+// =============================================================================
+
+const testAPIKeyID = domain.ID("019400a0-1234-7abc-8def-1234567890ab")
+
+var testAPIKeyCreatedAt = time.Date(2026, 1, 12, 9, 30, 0, 0, time.UTC)
+
+func testAPIKey() domain.APIKey {
+	return domain.APIKey{
+		ID:        testAPIKeyID,
+		Name:      "partner",
+		Prefix:    "ak_abcdefgh",
+		KeyHash:   "secret-digest",
+		Role:      app.RoleUser,
+		CreatedBy: "admin-id",
+		CreatedAt: testAPIKeyCreatedAt,
+	}
+}
+
+type apiKeyMocks struct {
+	create *MockCreateAPIKeyUseCase
+	list   *MockListAPIKeysUseCase
+	revoke *MockRevokeAPIKeyUseCase
+	rotate *MockRotateAPIKeyUseCase
+}
+
+func newAPIKeyHandler() (*APIKeyHandler, apiKeyMocks) {
+	m := apiKeyMocks{
+		create: new(MockCreateAPIKeyUseCase),
+		list:   new(MockListAPIKeysUseCase),
+		revoke: new(MockRevokeAPIKeyUseCase),
+		rotate: new(MockRotateAPIKeyUseCase),
+	}
+	return NewAPIKeyHandler(m.create, m.list, m.revoke, m.rotate), m
+}
+
+func newAPIKeyRequest(method, id, body string) *http.Request {
+	req := httptest.NewRequest(method, "/api/v1/api-keys", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if id == "" {
+		return req
+	}
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestAPIKeyHandler_CreateAPIKey(t *testing.T) {
+	expiresAt := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	h, m := newAPIKeyHandler()
+	key := testAPIKey()
+	key.ExpiresAt = &expiresAt
+	m.create.On("Execute", mock.Anything, mock.MatchedBy(func(req apikey.CreateAPIKeyRequest) bool {
+		return req.Name == "partner" && req.Role == app.RoleUser && slices.Equal(req.Scopes, []string{"users:read"}) && req.ExpiresAt.Equal(expiresAt)
+	})).Return(apikey.CreateAPIKeyResponse{Key: key, Secret: "ak_generated"}, nil)
+	rr := httptest.NewRecorder()
+
+	h.CreateAPIKey(rr, newAPIKeyRequest(http.MethodPost, "", `{"name":"partner","role":"user","scopes":["users:read"],"expiresAt":"2026-04-01T00:00:00Z"}`))
+
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+	var resp contract.DataResponse[contract.APIKeyResponse]
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, string(testAPIKeyID), resp.Data.ID)
+	assert.Equal(t, "ak_generated", resp.Data.Key)
+	assert.Equal(t, "ak_abcdefgh", resp.Data.Prefix)
+	require.NotNil(t, resp.Data.ExpiresAt)
+	assert.True(t, expiresAt.Equal(*resp.Data.ExpiresAt))
+	assert.NotContains(t, rr.Body.String(), "secret-digest")
+	m.create.AssertExpectations(t)
+}
+
+func TestAPIKeyHandler_CreateAPIKey_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		ucErr      error
+		wantStatus int
+	}{
+		{name: "missing name", body: `{"role":"user"}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown role", body: `{"name":"partner","role":"root"}`, wantStatus: http.StatusBadRequest},
+		{name: "missing scopes", body: `{"name":"partner","role":"user"}`, wantStatus: http.StatusBadRequest},
+		{name: "empty scopes", body: `{"name":"partner","role":"user","scopes":[]}`, wantStatus: http.StatusBadRequest},
+		{name: "invalid expiresAt", body: `{"name":"partner","role":"user","scopes":["users:read"],"expiresAt":"tomorrow"}`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "forbidden",
+			body:       `{"name":"partner","role":"user","scopes":["users:read"]}`,
+			ucErr:      &app.AppError{Op: apikey.OpCreateAPIKey, Code: app.CodeForbidden, Message: "Access denied"},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, m := newAPIKeyHandler()
+			if tt.ucErr != nil {
+				m.create.On("Execute", mock.Anything, mock.Anything).Return(apikey.CreateAPIKeyResponse{}, tt.ucErr)
+			}
+			rr := httptest.NewRecorder()
+
+			h.CreateAPIKey(rr, newAPIKeyRequest(http.MethodPost, "", tt.body))
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.ucErr == nil {
+				m.create.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestAPIKeyHandler_ListAPIKeys(t *testing.T) {
+	h, m := newAPIKeyHandler()
+	lastUsed := testAPIKeyCreatedAt.Add(time.Hour)
+	key := testAPIKey()
+	key.LastUsedAt = &lastUsed
+	m.list.On("Execute", mock.Anything).Return(apikey.ListAPIKeysResponse{Keys: []domain.APIKey{key}}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ListAPIKeys(rr, newAPIKeyRequest(http.MethodGet, "", ""))
+
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var resp contract.DataResponse[[]contract.APIKeyResponse]
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp.Data, 1)
+	assert.Empty(t, resp.Data[0].Key)
+	require.NotNil(t, resp.Data[0].LastUsedAt)
+	assert.True(t, lastUsed.Equal(*resp.Data[0].LastUsedAt))
+	assert.NotContains(t, rr.Body.String(), "secret-digest")
+}
+
+func TestAPIKeyHandler_RevokeAPIKey(t *testing.T) {
+	h, m := newAPIKeyHandler()
+	revokedAt := testAPIKeyCreatedAt.Add(time.Hour)
+	key := testAPIKey()
+	key.RevokedAt = &revokedAt
+	m.revoke.On("Execute", mock.Anything, mock.MatchedBy(func(req apikey.RevokeAPIKeyRequest) bool {
+		return req.ID == testAPIKeyID
+	})).Return(apikey.RevokeAPIKeyResponse{Key: key}, nil)
+	rr := httptest.NewRecorder()
+
+	h.RevokeAPIKey(rr, newAPIKeyRequest(http.MethodPost, string(testAPIKeyID), ""))
+
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var resp contract.DataResponse[contract.APIKeyResponse]
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.NotNil(t, resp.Data.RevokedAt)
+	assert.True(t, revokedAt.Equal(*resp.Data.RevokedAt))
+	m.revoke.AssertExpectations(t)
+}
+
+func TestAPIKeyHandler_RevokeAPIKey_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		ucErr      error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "invalid id", id: "not-a-uuid", wantStatus: http.StatusBadRequest},
+		{
+			name:       "not found",
+			id:         string(testAPIKeyID),
+			ucErr:      &app.AppError{Op: apikey.OpRevokeAPIKey, Code: app.CodeAPIKeyNotFound, Message: "API key not found"},
+			wantStatus: http.StatusNotFound,
+			wantCode:   contract.CodeAPIKeyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, m := newAPIKeyHandler()
+			if tt.ucErr != nil {
+				m.revoke.On("Execute", mock.Anything, mock.Anything).Return(apikey.RevokeAPIKeyResponse{}, tt.ucErr)
+			}
+			rr := httptest.NewRecorder()
+
+			h.RevokeAPIKey(rr, newAPIKeyRequest(http.MethodPost, tt.id, ""))
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantCode != "" {
+				var problem testProblemDetail
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+				assert.Equal(t, tt.wantCode, problem.Code)
+			}
+			if tt.ucErr == nil {
+				m.revoke.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestAPIKeyHandler_RotateAPIKey(t *testing.T) {
+	h, m := newAPIKeyHandler()
+	graceEnd := testAPIKeyCreatedAt.Add(24 * time.Hour)
+	replaced := testAPIKey()
+	replaced.ExpiresAt = &graceEnd
+	newKey := testAPIKey()
+	newKey.ID = "019400a0-1234-7abc-8def-1234567890ac"
+	newKey.Prefix = "ak_ijklmnop"
+	m.rotate.On("Execute", mock.Anything, mock.MatchedBy(func(req apikey.RotateAPIKeyRequest) bool {
+		return req.ID == testAPIKeyID
+	})).Return(apikey.RotateAPIKeyResponse{Key: newKey, Secret: "ak_rotated", Replaced: replaced}, nil)
+	rr := httptest.NewRecorder()
+
+	h.RotateAPIKey(rr, newAPIKeyRequest(http.MethodPost, string(testAPIKeyID), ""))
+
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+	var resp contract.DataResponse[contract.RotateAPIKeyResponse]
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, string(newKey.ID), resp.Data.ID)
+	assert.Equal(t, "ak_rotated", resp.Data.Key)
+	assert.Equal(t, string(testAPIKeyID), resp.Data.Replaced.ID)
+	assert.Empty(t, resp.Data.Replaced.Key)
+	require.NotNil(t, resp.Data.Replaced.ExpiresAt)
+	assert.True(t, graceEnd.Equal(*resp.Data.Replaced.ExpiresAt))
+	m.rotate.AssertExpectations(t)
+}
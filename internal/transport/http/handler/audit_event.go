@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
+)
+
+type getAuditEventExecutor interface {
+	Execute(ctx context.Context, req audit.GetAuditEventRequest) (audit.GetAuditEventResponse, error)
+}
+
+type listAuditEventsExecutor interface {
+	Execute(ctx context.Context, req audit.ListAuditEventsRequest) (audit.ListAuditEventsResponse, error)
+}
+
+type verifyAuditChainExecutor interface {
+	Execute(ctx context.Context, req audit.VerifyAuditChainRequest) (audit.VerifyAuditChainResponse, error)
+}
+
+// AuditEventHandler handles admin-only reads of the audit log.
+// Authorization (audit:read) is enforced by the use cases.
+type AuditEventHandler struct {
+	getUC    getAuditEventExecutor
+	listUC   listAuditEventsExecutor
+	verifyUC verifyAuditChainExecutor
+	cursors  *contract.CursorCodec
+}
+
+// NewAuditEventHandler creates a new AuditEventHandler. A nil cursors uses a
+// codec with a random key.
+func NewAuditEventHandler(getUC getAuditEventExecutor, listUC listAuditEventsExecutor, verifyUC verifyAuditChainExecutor, cursors *contract.CursorCodec) *AuditEventHandler {
+	if cursors == nil {
+		cursors = contract.NewCursorCodec(nil)
+	}
+	return &AuditEventHandler{
+		getUC:    getUC,
+		listUC:   listUC,
+		verifyUC: verifyUC,
+		cursors:  cursors,
+	}
+}
+
+// GetAuditEvent handles GET /api/v1/audit-events/{id}.
+func (h *AuditEventHandler) GetAuditEvent(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseAuditEventID(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.getUC.Execute(r.Context(), audit.GetAuditEventRequest{ID: id})
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	_ = contract.WriteJSON(w, http.StatusOK, contract.DataResponse[contract.AuditEventResponse]{
+		Data: contract.ToAuditEventResponse(resp.Event),
+	})
+}
+
+// ListAuditEvents handles GET /api/v1/audit-events.
+// Supports the filters parsed by contract.ParseAuditEventFilter. Events are
+// listed newest first and paginated by the opaque cursor from a previous
+// response's nextCursor/prevCursor only; a cursor is rejected unless used
+// with the same filters as the page that returned it.
+func (h *AuditEventHandler) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	pageSize := domain.DefaultPageSize
+	if raw := query.Get("pageSize"); raw != "" {
+		ps, err := strconv.Atoi(raw)
+		if err != nil || ps < 1 {
+			contract.WriteValidationError(w, r, []contract.ValidationError{
+				{Field: "pageSize", Message: "must be a positive integer", Code: contract.CodeValOutOfRange},
+			})
+			return
+		}
+		pageSize = min(ps, domain.MaxPageSize)
+	}
+
+	filter, errs := contract.ParseAuditEventFilter(query)
+	if len(errs) > 0 {
+		contract.WriteValidationError(w, r, errs)
+		return
+	}
+
+	req := audit.ListAuditEventsRequest{Filter: filter, PageSize: pageSize}
+	cursorScope := contract.AuditEventCursorScope(ctxutil.GetTenantID(r.Context()), filter)
+	if raw := query.Get("cursor"); raw != "" {
+		cursor, err := h.cursors.Decode(raw, cursorScope)
+		if err != nil {
+			contract.WriteValidationError(w, r, []contract.ValidationError{
+				{Field: "cursor", Message: "must be a cursor returned by a previous page", Code: contract.CodeValInvalidFormat},
+			})
+			return
+		}
+		req.Cursor = &cursor
+	}
+
+	resp, err := h.listUC.Execute(r.Context(), req)
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	listResp := contract.NewListAuditEventsResponse(resp.Events, resp.PageSize)
+	listResp.Pagination.NextCursor = h.cursors.EncodeOptional(resp.NextCursor, cursorScope)
+	listResp.Pagination.PrevCursor = h.cursors.EncodeOptional(resp.PrevCursor, cursorScope)
+	_ = contract.WriteJSON(w, http.StatusOK, listResp)
+}
+
+// VerifyAuditChain handles GET /api/v1/audit-events:verify.
+// Walks the hash chain of the caller's tenant and reports the first broken
+// link; a broken chain is still 200 with valid=false.
+func (h *AuditEventHandler) VerifyAuditChain(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.verifyUC.Execute(r.Context(), audit.VerifyAuditChainRequest{})
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	_ = contract.WriteJSON(w, http.StatusOK, contract.DataResponse[contract.AuditChainVerificationResponse]{
+		Data: contract.NewAuditChainVerificationResponse(resp.AnchorSeq, resp.HeadSeq, resp.HeadHash, resp.Break),
+	})
+}
+
+// parseAuditEventID extracts and validates the {id} URL parameter.
+// Audit event IDs come from the same UUID v7 generator as user IDs.
+func parseAuditEventID(w http.ResponseWriter, r *http.Request) (domain.ID, bool) {
+	return parseUserID(w, r)
+}
@@ -0,0 +1,234 @@
+//go:build !integration
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// =============================================================================
+// AuditEventHandler Tests
+// =============================================================================
+
+const testAuditEventID = domain.ID("019400a0-1234-7abc-8def-1234567890ab")
+
+func testAuditEvent() domain.AuditEvent {
+	return domain.AuditEvent{
+		ID:         testAuditEventID,
+		EventType:  domain.EventUserCreated,
+		ActorID:    "019400a0-1234-7abc-8def-1234567890ac",
+		EntityType: "user",
+		EntityID:   "019400a0-1234-7abc-8def-1234567890ad",
+		Payload:    []byte(`{"email":"[REDACTED]","firstName":"John"}`),
+		Timestamp:  time.Date(2026, 1, 14, 9, 0, 0, 0, time.UTC),
+		RequestID:  "req-123",
+	}
+}
+
+type auditEventMocks struct {
+	get    *MockGetAuditEventUseCase
+	list   *MockListAuditEventsUseCase
+	verify *MockVerifyAuditChainUseCase
+}
+
+func newAuditEventHandler(cursors *contract.CursorCodec) (*AuditEventHandler, auditEventMocks) {
+	m := auditEventMocks{
+		get:    new(MockGetAuditEventUseCase),
+		list:   new(MockListAuditEventsUseCase),
+		verify: new(MockVerifyAuditChainUseCase),
+	}
+	return NewAuditEventHandler(m.get, m.list, m.verify, cursors), m
+}
+
+func newAuditEventRequest(target, id string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if id == "" {
+		return req
+	}
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestAuditEventHandler_GetAuditEvent(t *testing.T) {
+	h, m := newAuditEventHandler(nil)
+	m.get.On("Execute", mock.Anything, audit.GetAuditEventRequest{ID: testAuditEventID}).
+		Return(audit.GetAuditEventResponse{Event: testAuditEvent()}, nil)
+	rr := httptest.NewRecorder()
+
+	h.GetAuditEvent(rr, newAuditEventRequest("/api/v1/audit-events/"+string(testAuditEventID), string(testAuditEventID)))
+
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.JSONEq(t, `{"data": {
+		"id": "019400a0-1234-7abc-8def-1234567890ab",
+		"eventType": "user.created",
+		"actorId": "019400a0-1234-7abc-8def-1234567890ac",
+		"entityType": "user",
+		"entityId": "019400a0-1234-7abc-8def-1234567890ad",
+		"payload": {"email": "[REDACTED]", "firstName": "John"},
+		"timestamp": "2026-01-14T09:00:00Z",
+		"requestId": "req-123"
+	}}`, rr.Body.String())
+}
+
+func TestAuditEventHandler_GetAuditEvent_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		ucErr      error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "invalid id", id: "not-a-uuid", wantStatus: http.StatusBadRequest},
+		{
+			name:       "not found",
+			id:         string(testAuditEventID),
+			ucErr:      &app.AppError{Op: audit.OpGetAuditEvent, Code: app.CodeAuditEventNotFound, Message: "Audit event not found"},
+			wantStatus: http.StatusNotFound,
+			wantCode:   contract.CodeAuditEventNotFound,
+		},
+		{
+			name:       "forbidden",
+			id:         string(testAuditEventID),
+			ucErr:      &app.AppError{Op: audit.OpGetAuditEvent, Code: app.CodeInsufficientPermissions, Message: "Access denied"},
+			wantStatus: http.StatusForbidden,
+			wantCode:   contract.CodeAuthzInsufficientPermissions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, m := newAuditEventHandler(nil)
+			if tt.ucErr != nil {
+				m.get.On("Execute", mock.Anything, mock.Anything).Return(audit.GetAuditEventResponse{}, tt.ucErr)
+			}
+			rr := httptest.NewRecorder()
+
+			h.GetAuditEvent(rr, newAuditEventRequest("/api/v1/audit-events/"+tt.id, tt.id))
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantCode != "" {
+				var problem testProblemDetail
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+				assert.Equal(t, tt.wantCode, problem.Code)
+			}
+			if tt.ucErr == nil {
+				m.get.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestAuditEventHandler_ListAuditEvents(t *testing.T) {
+	cursors := contract.NewCursorCodec([]byte("test-key"))
+	h, m := newAuditEventHandler(cursors)
+	event := testAuditEvent()
+	prev := domain.Cursor{Time: event.Timestamp.Add(time.Hour), ID: "019400a0-1234-7abc-8def-1234567890ae"}
+	next := &domain.Cursor{Time: event.Timestamp, ID: event.ID}
+	scope := contract.AuditEventCursorScope(sharedctx.DefaultTenantID, domain.AuditEventFilter{EventType: "user.*", ActorID: event.ActorID})
+	m.list.On("Execute", mock.Anything, mock.MatchedBy(func(req audit.ListAuditEventsRequest) bool {
+		return req.Filter.EventType == "user.*" &&
+			req.Filter.ActorID == event.ActorID &&
+			req.PageSize == 100 &&
+			req.Cursor != nil && *req.Cursor == prev
+	})).Return(audit.ListAuditEventsResponse{Events: []domain.AuditEvent{event}, PageSize: 100, NextCursor: next}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ListAuditEvents(rr, newAuditEventRequest("/api/v1/audit-events?eventType=user.*&actorId="+string(event.ActorID)+
+		"&pageSize=500&cursor="+cursors.Encode(prev, scope), ""))
+
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var resp contract.ListAuditEventsResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp.Data, 1)
+	assert.JSONEq(t, `{"email":"[REDACTED]","firstName":"John"}`, string(resp.Data[0].Payload))
+	assert.Equal(t, 100, resp.Pagination.PageSize)
+	assert.Equal(t, domain.TotalCountUnknown, resp.Pagination.TotalItems)
+	assert.Empty(t, resp.Pagination.PrevCursor)
+	decoded, err := cursors.Decode(resp.Pagination.NextCursor, scope)
+	require.NoError(t, err)
+	assert.Equal(t, *next, decoded)
+	m.list.AssertExpectations(t)
+}
+
+func TestAuditEventHandler_ListAuditEvents_Invalid(t *testing.T) {
+	cursors := contract.NewCursorCodec([]byte("test-key"))
+	otherFilter := cursors.Encode(domain.Cursor{Time: time.Now(), ID: testAuditEventID},
+		contract.AuditEventCursorScope(sharedctx.DefaultTenantID, domain.AuditEventFilter{EventType: "user.created"}))
+
+	tests := []struct {
+		name      string
+		query     string
+		wantField string
+	}{
+		{name: "non-numeric page size", query: "pageSize=ten", wantField: "pageSize"},
+		{name: "zero page size", query: "pageSize=0", wantField: "pageSize"},
+		{name: "invalid filter", query: "eventType=user%25", wantField: "eventType"},
+		{name: "forged cursor", query: "cursor=abc.def", wantField: "cursor"},
+		{name: "cursor from another filter", query: "eventType=user.*&cursor=" + otherFilter, wantField: "cursor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, m := newAuditEventHandler(cursors)
+			rr := httptest.NewRecorder()
+
+			h.ListAuditEvents(rr, newAuditEventRequest("/api/v1/audit-events?"+tt.query, ""))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), `"field":"`+tt.wantField+`"`)
+			m.list.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestAuditEventHandler_VerifyAuditChain(t *testing.T) {
+	t.Run("broken chain", func(t *testing.T) {
+		h, m := newAuditEventHandler(nil)
+		m.verify.On("Execute", mock.Anything, audit.VerifyAuditChainRequest{}).Return(audit.VerifyAuditChainResponse{
+			AnchorSeq: 20,
+			HeadSeq:   41,
+			HeadHash:  []byte{0xde, 0xad},
+			Break:     &domain.AuditChainBreak{Seq: 42, EventID: testAuditEventID, Reason: domain.AuditChainHashMismatch},
+		}, nil)
+		rr := httptest.NewRecorder()
+
+		h.VerifyAuditChain(rr, httptest.NewRequest(http.MethodGet, "/api/v1/audit-events:verify", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		assert.JSONEq(t, `{"data": {
+			"valid": false,
+			"anchorSeq": 20,
+			"headSeq": 41,
+			"headHash": "dead",
+			"brokenLink": {"seq": 42, "eventId": "019400a0-1234-7abc-8def-1234567890ab", "reason": "hash_mismatch"}
+		}}`, rr.Body.String())
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		h, m := newAuditEventHandler(nil)
+		m.verify.On("Execute", mock.Anything, mock.Anything).Return(audit.VerifyAuditChainResponse{},
+			&app.AppError{Op: audit.OpVerifyAuditChain, Code: app.CodeInsufficientPermissions, Message: "Access denied"})
+		rr := httptest.NewRecorder()
+
+		h.VerifyAuditChain(rr, httptest.NewRequest(http.MethodGet, "/api/v1/audit-events:verify", nil))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
@@ -6,6 +6,11 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/iruldev/golang-api-hexagonal/internal/app/apikey"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/audit"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/job"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/oauth"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/token"
 	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
 	"github.com/iruldev/golang-api-hexagonal/internal/domain"
 	httpTransport "github.com/iruldev/golang-api-hexagonal/internal/transport/http"
@@ -41,6 +46,182 @@ func (m *MockListUsersUseCase) Execute(ctx context.Context, req user.ListUsersRe
 	return args.Get(0).(user.ListUsersResponse), args.Error(1)
 }
 
+// MockUpdateUserUseCase mocks the UpdateUserUseCase.
+type MockUpdateUserUseCase struct {
+	mock.Mock
+}
+
+func (m *MockUpdateUserUseCase) Execute(ctx context.Context, req user.UpdateUserRequest) (user.UpdateUserResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(user.UpdateUserResponse), args.Error(1)
+}
+
+// MockDeleteUserUseCase mocks the DeleteUserUseCase.
+type MockDeleteUserUseCase struct {
+	mock.Mock
+}
+
+func (m *MockDeleteUserUseCase) Execute(ctx context.Context, req user.DeleteUserRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+// MockRestoreUserUseCase mocks the RestoreUserUseCase.
+type MockRestoreUserUseCase struct {
+	mock.Mock
+}
+
+func (m *MockRestoreUserUseCase) Execute(ctx context.Context, req user.RestoreUserRequest) (user.RestoreUserResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(user.RestoreUserResponse), args.Error(1)
+}
+
+// MockPurgeDeletedUsersUseCase mocks the PurgeDeletedUsersUseCase.
+type MockPurgeDeletedUsersUseCase struct {
+	mock.Mock
+}
+
+func (m *MockPurgeDeletedUsersUseCase) Execute(ctx context.Context, req user.PurgeDeletedUsersRequest) (user.PurgeDeletedUsersResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(user.PurgeDeletedUsersResponse), args.Error(1)
+}
+
+type MockBatchCreateUsersUseCase struct {
+	mock.Mock
+}
+
+func (m *MockBatchCreateUsersUseCase) Execute(ctx context.Context, req user.BatchCreateUsersRequest) (user.BatchCreateUsersResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(user.BatchCreateUsersResponse), args.Error(1)
+}
+
+// MockExportUsersUseCase mocks the ExportUsersUseCase.
+// Use Run to feed users to the export callback (third argument).
+type MockExportUsersUseCase struct {
+	mock.Mock
+}
+
+func (m *MockExportUsersUseCase) Execute(ctx context.Context, req user.ExportUsersRequest, fn func(domain.User) error) (user.ExportUsersResponse, error) {
+	args := m.Called(ctx, req, fn)
+	return args.Get(0).(user.ExportUsersResponse), args.Error(1)
+}
+
+type MockStartUserImportUseCase struct {
+	mock.Mock
+}
+
+func (m *MockStartUserImportUseCase) Execute(ctx context.Context, req user.StartUserImportRequest) (user.StartUserImportResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(user.StartUserImportResponse), args.Error(1)
+}
+
+type MockGetJobUseCase struct {
+	mock.Mock
+}
+
+func (m *MockGetJobUseCase) Execute(ctx context.Context, req job.GetJobRequest) (job.GetJobResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(job.GetJobResponse), args.Error(1)
+}
+
+type MockRevokeTokensUseCase struct {
+	mock.Mock
+}
+
+func (m *MockRevokeTokensUseCase) Execute(ctx context.Context, req token.RevokeTokensRequest) (token.RevokeTokensResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(token.RevokeTokensResponse), args.Error(1)
+}
+
+type MockIssueTokenUseCase struct {
+	mock.Mock
+}
+
+func (m *MockIssueTokenUseCase) Execute(ctx context.Context, req oauth.IssueTokenRequest) (oauth.IssueTokenResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(oauth.IssueTokenResponse), args.Error(1)
+}
+
+func (m *MockIssueTokenUseCase) ClientExists(ctx context.Context, clientID string) bool {
+	args := m.Called(ctx, clientID)
+	return args.Bool(0)
+}
+
+type MockCreateOAuthClientUseCase struct {
+	mock.Mock
+}
+
+func (m *MockCreateOAuthClientUseCase) Execute(ctx context.Context, req oauth.CreateClientRequest) (oauth.CreateClientResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(oauth.CreateClientResponse), args.Error(1)
+}
+
+type MockCreateAPIKeyUseCase struct {
+	mock.Mock
+}
+
+func (m *MockCreateAPIKeyUseCase) Execute(ctx context.Context, req apikey.CreateAPIKeyRequest) (apikey.CreateAPIKeyResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(apikey.CreateAPIKeyResponse), args.Error(1)
+}
+
+type MockListAPIKeysUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListAPIKeysUseCase) Execute(ctx context.Context) (apikey.ListAPIKeysResponse, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(apikey.ListAPIKeysResponse), args.Error(1)
+}
+
+type MockRevokeAPIKeyUseCase struct {
+	mock.Mock
+}
+
+func (m *MockRevokeAPIKeyUseCase) Execute(ctx context.Context, req apikey.RevokeAPIKeyRequest) (apikey.RevokeAPIKeyResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(apikey.RevokeAPIKeyResponse), args.Error(1)
+}
+
+type MockRotateAPIKeyUseCase struct {
+	mock.Mock
+}
+
+func (m *MockRotateAPIKeyUseCase) Execute(ctx context.Context, req apikey.RotateAPIKeyRequest) (apikey.RotateAPIKeyResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(apikey.RotateAPIKeyResponse), args.Error(1)
+}
+
+// MockGetAuditEventUseCase mocks the GetAuditEventUseCase.
+type MockGetAuditEventUseCase struct {
+	mock.Mock
+}
+
+func (m *MockGetAuditEventUseCase) Execute(ctx context.Context, req audit.GetAuditEventRequest) (audit.GetAuditEventResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(audit.GetAuditEventResponse), args.Error(1)
+}
+
+// MockListAuditEventsUseCase mocks the ListAuditEventsUseCase.
+type MockListAuditEventsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListAuditEventsUseCase) Execute(ctx context.Context, req audit.ListAuditEventsRequest) (audit.ListAuditEventsResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(audit.ListAuditEventsResponse), args.Error(1)
+}
+
+// MockVerifyAuditChainUseCase mocks the VerifyAuditChainUseCase.
+type MockVerifyAuditChainUseCase struct {
+	mock.Mock
+}
+
+func (m *MockVerifyAuditChainUseCase) Execute(ctx context.Context, req audit.VerifyAuditChainRequest) (audit.VerifyAuditChainResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(audit.VerifyAuditChainResponse), args.Error(1)
+}
+
 // Helpers for creating test users.
 var testUserResourcePath = httpTransport.BasePath + "/users"
 
@@ -52,5 +233,6 @@ func createTestUser() domain.User {
 		LastName:  "Doe",
 		CreatedAt: time.Now().UTC(),
 		UpdatedAt: time.Now().UTC(),
+		Version:   1,
 	}
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
 	"github.com/iruldev/golang-api-hexagonal/internal/domain"
 	httpTransport "github.com/iruldev/golang-api-hexagonal/internal/transport/http"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
 )
 
 // mockRepoForIDOR is a minimal mock repository for IDOR testing.
@@ -38,11 +39,34 @@ func (m *mockRepoForIDOR) GetByID(ctx context.Context, db domain.Querier, id dom
 	return &u, args.Error(1)
 }
 
-func (m *mockRepoForIDOR) List(ctx context.Context, db domain.Querier, p domain.ListParams) ([]domain.User, int, error) {
-	args := m.Called(ctx, db, p)
+func (m *mockRepoForIDOR) List(ctx context.Context, db domain.Querier, f domain.UserFilter, p domain.ListParams) ([]domain.User, int, error) {
+	args := m.Called(ctx, db, f, p)
 	return args.Get(0).([]domain.User), args.Int(1), args.Error(2)
 }
 
+func (m *mockRepoForIDOR) Update(ctx context.Context, db domain.Querier, u *domain.User) error {
+	return m.Called(ctx, db, u).Error(0)
+}
+
+func (m *mockRepoForIDOR) Delete(ctx context.Context, db domain.Querier, id domain.ID, version int64) error {
+	return m.Called(ctx, db, id, version).Error(0)
+}
+
+func (m *mockRepoForIDOR) Restore(ctx context.Context, db domain.Querier, id domain.ID) error {
+	return m.Called(ctx, db, id).Error(0)
+}
+
+func (m *mockRepoForIDOR) PurgeDeleted(ctx context.Context, db domain.Querier, before time.Time) ([]domain.ID, error) {
+	args := m.Called(ctx, db, before)
+	ids, _ := args.Get(0).([]domain.ID)
+	return ids, args.Error(1)
+}
+
+func (m *mockRepoForIDOR) Export(ctx context.Context, db domain.Querier, fn func(domain.User) error) error {
+	args := m.Called(ctx, db, fn)
+	return args.Error(0)
+}
+
 type mockQuerier struct{}
 
 func (m *mockQuerier) Exec(ctx context.Context, query string, args ...any) (any, error) {
@@ -72,14 +96,14 @@ func TestIntegration_IDORPrevention(t *testing.T) {
 	metricsReg, httpMetrics := newTestMetricsRegistry()
 
 	// Real UseCase (NOT mocked) to verify the logic inside UseCase is called
-	realUseCase := user.NewGetUserUseCase(mockRepo, mockDB, logger)
+	realUseCase := user.NewGetUserUseCase(mockRepo, mockDB, app.NewRoleAuthorizer(app.DefaultRolePermissions(), logger), logger)
 
 	// Mock other use cases required by NewUserHandler
 	mockCreateUC := new(MockCreateUserUseCase) // from user_test.go
 	mockListUC := new(MockListUsersUseCase)    // from user_test.go
 
 	// Real Handler
-	userHandler := NewUserHandler(mockCreateUC, realUseCase, mockListUC, httpTransport.BasePath+"/users")
+	userHandler := NewUserHandler(mockCreateUC, realUseCase, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), httpTransport.BasePath+"/users")
 	livenessHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(`{"status":"alive"}`))
@@ -123,9 +147,11 @@ func TestIntegration_IDORPrevention(t *testing.T) {
 
 	// 4. Create and Sign JWT for User A
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub":  userAID,
-		"role": app.RoleUser,
-		"exp":  time.Now().Add(time.Hour).Unix(),
+		"sub":       userAID,
+		"role":      app.RoleUser,
+		"scope":     "users:read", // pass the route's scope check to reach the use case
+		"tenant_id": "acme",
+		"exp":       time.Now().Add(time.Hour).Unix(),
 	})
 	tokenString, err := token.SignedString(jwtSecret)
 	assert.NoError(t, err)
@@ -140,6 +166,7 @@ func TestIntegration_IDORPrevention(t *testing.T) {
 	// 6. Assertions
 	// Expect 403 Forbidden
 	assert.Equal(t, http.StatusForbidden, rec.Code, "Expected 403 Forbidden when User A accesses User B")
+	assert.Contains(t, rec.Body.String(), contract.CodeAuthzInsufficientPermissions)
 
 	// Verify Check: Ensure repo was NOT called (proving access denied at UseCase layer)
 	mockRepo.AssertNotCalled(t, "GetByID")
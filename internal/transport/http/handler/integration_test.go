@@ -294,7 +294,7 @@ func TestIntegration_CreateUser_LocationHeader(t *testing.T) {
 		Return(user.CreateUserResponse{User: expectedUser}, nil)
 
 	// 2. Setup Router
-	userHandler := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, httpTransport.BasePath+"/users")
+	userHandler := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), httpTransport.BasePath+"/users")
 	livenessHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(`{}`))
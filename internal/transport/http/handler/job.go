@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app/job"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+type getJobExecutor interface {
+	Execute(ctx context.Context, req job.GetJobRequest) (job.GetJobResponse, error)
+}
+
+// JobHandler handles background job status HTTP requests.
+// Authorization (creator or admin) is enforced by the use case.
+type JobHandler struct {
+	getUC getJobExecutor
+}
+
+// NewJobHandler creates a new JobHandler.
+func NewJobHandler(getUC getJobExecutor) *JobHandler {
+	return &JobHandler{getUC: getUC}
+}
+
+// GetJob handles GET /api/v1/jobs/{id}.
+// The response is not cacheable since a running job changes with every chunk.
+func (h *JobHandler) GetJob(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseJobID(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.getUC.Execute(r.Context(), job.GetJobRequest{ID: id})
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	_ = contract.WriteJSON(w, http.StatusOK, contract.DataResponse[contract.JobResponse]{
+		Data: contract.ToJobResponse(resp.Job),
+	})
+}
+
+// parseJobID extracts and validates the {id} URL parameter.
+// Job IDs come from the same UUID v7 generator as user IDs.
+func parseJobID(w http.ResponseWriter, r *http.Request) (domain.ID, bool) {
+	return parseUserID(w, r)
+}
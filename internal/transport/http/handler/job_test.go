@@ -0,0 +1,92 @@
+//go:build !integration
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/job"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// =============================================================================
+// GetJob Handler Tests
+// =============================================================================
+
+func newGetJobRequest(id string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, testJobsPath+"/"+id, nil)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestJobHandler_GetJob_Success(t *testing.T) {
+	finished := time.Now().UTC()
+	stored := createTestJob()
+	stored.Status = domain.JobStatusSucceeded
+	stored.ProcessedRows = 2
+	stored.FailedRows = 1
+	stored.Errors = []domain.JobRowError{{Row: 2, Message: "email already exists"}}
+	stored.FinishedAt = &finished
+
+	mockUC := new(MockGetJobUseCase)
+	mockUC.On("Execute", mock.Anything, job.GetJobRequest{ID: stored.ID}).
+		Return(job.GetJobResponse{Job: stored}, nil)
+
+	h := NewJobHandler(mockUC)
+	rr := httptest.NewRecorder()
+
+	h.GetJob(rr, newGetJobRequest(string(stored.ID)))
+
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+
+	var resp contract.DataResponse[contract.JobResponse]
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "succeeded", resp.Data.Status)
+	assert.Equal(t, 2, resp.Data.ProcessedRows)
+	assert.Equal(t, 1, resp.Data.FailedRows)
+	assert.Equal(t, []contract.JobErrorResponse{{Row: 2, Message: "email already exists"}}, resp.Data.Errors)
+	require.NotNil(t, resp.Data.FinishedAt)
+	mockUC.AssertExpectations(t)
+}
+
+func TestJobHandler_GetJob_NotFound(t *testing.T) {
+	id := "019400a0-5678-7abc-8def-1234567890ab"
+	mockUC := new(MockGetJobUseCase)
+	mockUC.On("Execute", mock.Anything, job.GetJobRequest{ID: domain.ID(id)}).
+		Return(job.GetJobResponse{}, &app.AppError{Op: job.OpGetJob, Code: app.CodeJobNotFound, Message: "Job not found"})
+
+	h := NewJobHandler(mockUC)
+	rr := httptest.NewRecorder()
+
+	h.GetJob(rr, newGetJobRequest(id))
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	var problem testProblemDetail
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+	assert.Equal(t, contract.CodeJobNotFound, problem.Code)
+}
+
+func TestJobHandler_GetJob_InvalidID(t *testing.T) {
+	mockUC := new(MockGetJobUseCase)
+	h := NewJobHandler(mockUC)
+	rr := httptest.NewRecorder()
+
+	h.GetJob(rr, newGetJobRequest("not-a-uuid"))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	mockUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
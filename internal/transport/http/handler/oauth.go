@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app/oauth"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+type issueTokenExecutor interface {
+	Execute(ctx context.Context, req oauth.IssueTokenRequest) (oauth.IssueTokenResponse, error)
+	ClientExists(ctx context.Context, clientID string) bool
+}
+
+type createOAuthClientExecutor interface {
+	Execute(ctx context.Context, req oauth.CreateClientRequest) (oauth.CreateClientResponse, error)
+}
+
+// OAuthHandler handles the OAuth 2.0 token endpoint and OAuth client registration.
+type OAuthHandler struct {
+	issueUC  issueTokenExecutor
+	createUC createOAuthClientExecutor
+}
+
+// NewOAuthHandler creates a new OAuthHandler.
+func NewOAuthHandler(issueUC issueTokenExecutor, createUC createOAuthClientExecutor) *OAuthHandler {
+	return &OAuthHandler{issueUC: issueUC, createUC: createUC}
+}
+
+// errBothClientAuthMethods is returned when a token request authenticates
+// with HTTP Basic and form parameters at once (RFC 6749 section 2.3).
+var errBothClientAuthMethods = errors.New("client credentials must be sent either with HTTP Basic or in the body, not both")
+
+// IssueToken handles POST /oauth/token.
+// It implements the client_credentials grant of RFC 6749 section 4.4 with an
+// application/x-www-form-urlencoded body. The client authenticates with HTTP
+// Basic or with the client_id and client_secret parameters, and may narrow
+// the token to some of its scopes with the scope parameter.
+func (h *OAuthHandler) IssueToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		contract.WriteValidationError(w, r, []contract.ValidationError{{Field: "body", Message: "must be a form-encoded body"}})
+		return
+	}
+	if grantType := r.PostForm.Get("grant_type"); grantType != contract.GrantTypeClientCredentials {
+		contract.WriteValidationError(w, r, []contract.ValidationError{{
+			Field:   "grant_type",
+			Message: "must be " + contract.GrantTypeClientCredentials,
+		}})
+		return
+	}
+
+	clientID, clientSecret, err := clientCredentials(r)
+	if err != nil {
+		contract.WriteValidationError(w, r, []contract.ValidationError{{Field: "client_id", Message: err.Error()}})
+		return
+	}
+
+	resp, err := h.issueUC.Execute(r.Context(), oauth.IssueTokenRequest{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scope:        r.PostForm.Get("scope"),
+	})
+	if err != nil {
+		problem := contract.ProblemFromError(r, err)
+		if problem.Status == http.StatusUnauthorized {
+			w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+		}
+		contract.WriteProblem(w, problem)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	_ = contract.WriteJSON(w, http.StatusOK, contract.NewTokenResponse(resp.AccessToken, resp.Token))
+}
+
+// ClientExists reports whether clientID names a registered client. The router
+// passes it to CredentialLockout so only existing client_ids are tracked.
+func (h *OAuthHandler) ClientExists(ctx context.Context, clientID string) bool {
+	return h.issueUC.ClientExists(ctx, clientID)
+}
+
+// clientCredentials returns the client_id and client_secret of a token request.
+func clientCredentials(r *http.Request) (id, secret string, err error) {
+	formID, formSecret := r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	if basicID, basicSecret, ok := r.BasicAuth(); ok {
+		if formID != "" || formSecret != "" {
+			return "", "", errBothClientAuthMethods
+		}
+		return basicID, basicSecret, nil
+	}
+	return formID, formSecret, nil
+}
+
+// CreateClient handles POST /api/v1/oauth-clients.
+// It registers a client and returns its client_id and secret. The secret is
+// only returned here and cannot be retrieved again.
+func (h *OAuthHandler) CreateClient(w http.ResponseWriter, r *http.Request) {
+	var req contract.CreateOAuthClientRequest
+	if errs := contract.ValidateRequestBody(r, &req); len(errs) > 0 {
+		contract.WriteValidationError(w, r, errs)
+		return
+	}
+
+	reqID, actorID := auditContext(r)
+	resp, err := h.createUC.Execute(r.Context(), oauth.CreateClientRequest{
+		Name:      req.Name,
+		Role:      req.Role,
+		Scopes:    req.Scopes,
+		RequestID: reqID,
+		ActorID:   actorID,
+	})
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	_ = contract.WriteJSON(w, http.StatusCreated, contract.DataResponse[contract.OAuthClientResponse]{
+		Data: contract.ToOAuthClientResponse(resp.Client, resp.Secret),
+	})
+}
@@ -0,0 +1,253 @@
+//go:build !integration
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/oauth"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// =============================================================================
+// IssueToken Handler Tests
+// =============================================================================
+
+func newTokenRequest(form url.Values) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func issuedToken() oauth.IssueTokenResponse {
+	issuedAt := time.Date(2026, 1, 11, 9, 30, 0, 0, time.UTC)
+	return oauth.IssueTokenResponse{
+		AccessToken: "signed-token",
+		Token: domain.AccessToken{
+			ID:        "jti-1",
+			Subject:   "client-1",
+			Role:      app.RoleUser,
+			Scopes:    []string{"users:read"},
+			IssuedAt:  issuedAt,
+			ExpiresAt: issuedAt.Add(15 * time.Minute),
+		},
+	}
+}
+
+func TestOAuthHandler_IssueToken(t *testing.T) {
+	tests := []struct {
+		name string
+		req  func() *http.Request
+	}{
+		{
+			name: "credentials in body",
+			req: func() *http.Request {
+				return newTokenRequest(url.Values{
+					"grant_type":    {"client_credentials"},
+					"client_id":     {"client-1"},
+					"client_secret": {"s3cret"},
+					"scope":         {"users:read"},
+				})
+			},
+		},
+		{
+			name: "HTTP Basic",
+			req: func() *http.Request {
+				req := newTokenRequest(url.Values{"grant_type": {"client_credentials"}, "scope": {"users:read"}})
+				req.SetBasicAuth("client-1", "s3cret")
+				return req
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUC := new(MockIssueTokenUseCase)
+			mockUC.On("Execute", mock.Anything, oauth.IssueTokenRequest{ClientID: "client-1", ClientSecret: "s3cret", Scope: "users:read"}).
+				Return(issuedToken(), nil)
+
+			h := NewOAuthHandler(mockUC, new(MockCreateOAuthClientUseCase))
+			rr := httptest.NewRecorder()
+
+			h.IssueToken(rr, tt.req())
+
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+			assert.Equal(t, "no-cache", rr.Header().Get("Pragma"))
+			assert.JSONEq(t, `{"access_token":"signed-token","token_type":"Bearer","expires_in":900,"scope":"users:read"}`, rr.Body.String())
+			mockUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOAuthHandler_IssueToken_InvalidCredentials(t *testing.T) {
+	mockUC := new(MockIssueTokenUseCase)
+	mockUC.On("Execute", mock.Anything, mock.Anything).Return(oauth.IssueTokenResponse{}, &app.AppError{
+		Op:      oauth.OpIssueToken,
+		Code:    app.CodeInvalidCredentials,
+		Message: "Invalid client credentials",
+	})
+
+	h := NewOAuthHandler(mockUC, new(MockCreateOAuthClientUseCase))
+	rr := httptest.NewRecorder()
+
+	h.IssueToken(rr, newTokenRequest(url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {"client-1"},
+		"client_secret": {"wrong"},
+	}))
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Equal(t, `Basic realm="oauth"`, rr.Header().Get("WWW-Authenticate"))
+	var problem testProblemDetail
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+	assert.Equal(t, contract.CodeAuthInvalidCredentials, problem.Code)
+	assert.NotContains(t, rr.Body.String(), "wrong")
+}
+
+func TestOAuthHandler_IssueToken_BadRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		req  func() *http.Request
+	}{
+		{
+			name: "missing grant_type",
+			req: func() *http.Request {
+				return newTokenRequest(url.Values{"client_id": {"client-1"}, "client_secret": {"s3cret"}})
+			},
+		},
+		{
+			name: "unsupported grant_type",
+			req: func() *http.Request {
+				return newTokenRequest(url.Values{"grant_type": {"password"}, "client_id": {"client-1"}, "client_secret": {"s3cret"}})
+			},
+		},
+		{
+			name: "grant_type in query string",
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/oauth/token?grant_type=client_credentials", nil)
+				req.SetBasicAuth("client-1", "s3cret")
+				return req
+			},
+		},
+		{
+			name: "HTTP Basic and body credentials",
+			req: func() *http.Request {
+				req := newTokenRequest(url.Values{"grant_type": {"client_credentials"}, "client_secret": {"s3cret"}})
+				req.SetBasicAuth("client-1", "s3cret")
+				return req
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUC := new(MockIssueTokenUseCase)
+			h := NewOAuthHandler(mockUC, new(MockCreateOAuthClientUseCase))
+			rr := httptest.NewRecorder()
+
+			h.IssueToken(rr, tt.req())
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			mockUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// =============================================================================
+// CreateClient Handler Tests
+// =============================================================================
+
+func newCreateOAuthClientRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/oauth-clients", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestOAuthHandler_CreateClient(t *testing.T) {
+	createdAt := time.Date(2026, 1, 11, 9, 30, 0, 0, time.UTC)
+	mockUC := new(MockCreateOAuthClientUseCase)
+	mockUC.On("Execute", mock.Anything, mock.MatchedBy(func(req oauth.CreateClientRequest) bool {
+		return req.Name == "billing" && req.Role == app.RoleAdmin && slices.Equal(req.Scopes, []string{"users:read"})
+	})).Return(oauth.CreateClientResponse{
+		Client: domain.OAuthClient{
+			ID:         "client-1",
+			Name:       "billing",
+			SecretHash: "$2a$10$hash",
+			Role:       app.RoleAdmin,
+			Scopes:     []string{"users:read"},
+			CreatedAt:  createdAt,
+		},
+		Secret: "generated-secret",
+	}, nil)
+
+	h := NewOAuthHandler(new(MockIssueTokenUseCase), mockUC)
+	rr := httptest.NewRecorder()
+
+	h.CreateClient(rr, newCreateOAuthClientRequest(`{"name":"billing","role":"admin","scopes":["users:read"]}`))
+
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+	var resp contract.DataResponse[contract.OAuthClientResponse]
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "client-1", resp.Data.ClientID)
+	assert.Equal(t, "billing", resp.Data.Name)
+	assert.Equal(t, app.RoleAdmin, resp.Data.Role)
+	assert.Equal(t, []string{"users:read"}, resp.Data.Scopes)
+	assert.Equal(t, "generated-secret", resp.Data.ClientSecret)
+	assert.True(t, createdAt.Equal(resp.Data.CreatedAt))
+	assert.NotContains(t, rr.Body.String(), "$2a$")
+	mockUC.AssertExpectations(t)
+}
+
+func TestOAuthHandler_CreateClient_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		ucErr      error
+		wantStatus int
+	}{
+		{name: "missing name", body: `{"role":"user"}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown role", body: `{"name":"billing","role":"root"}`, wantStatus: http.StatusBadRequest},
+		{name: "missing scopes", body: `{"name":"billing","role":"user"}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown field", body: `{"name":"billing","role":"user","scopes":["users:read"],"secret":"x"}`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "forbidden",
+			body:       `{"name":"billing","role":"user","scopes":["users:read"]}`,
+			ucErr:      &app.AppError{Op: oauth.OpCreateClient, Code: app.CodeForbidden, Message: "Access denied"},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUC := new(MockCreateOAuthClientUseCase)
+			if tt.ucErr != nil {
+				mockUC.On("Execute", mock.Anything, mock.Anything).Return(oauth.CreateClientResponse{}, tt.ucErr)
+			}
+
+			h := NewOAuthHandler(new(MockIssueTokenUseCase), mockUC)
+			rr := httptest.NewRecorder()
+
+			h.CreateClient(rr, newCreateOAuthClientRequest(tt.body))
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.ucErr == nil {
+				mockUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
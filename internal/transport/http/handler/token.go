@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app/token"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+type revokeTokensExecutor interface {
+	Execute(ctx context.Context, req token.RevokeTokensRequest) (token.RevokeTokensResponse, error)
+}
+
+// TokenHandler handles admin-only access token HTTP requests.
+// Authorization (admin role) is enforced by the use cases.
+type TokenHandler struct {
+	revokeUC revokeTokensExecutor
+}
+
+// NewTokenHandler creates a new TokenHandler.
+func NewTokenHandler(revokeUC revokeTokensExecutor) *TokenHandler {
+	return &TokenHandler{revokeUC: revokeUC}
+}
+
+// RevokeTokens handles POST /api/v1/tokens:revoke.
+// It revokes one token by jti, or all tokens of a subject issued before a
+// cutoff. Revoked tokens are rejected by every instance within the denylist
+// cache TTL.
+func (h *TokenHandler) RevokeTokens(w http.ResponseWriter, r *http.Request) {
+	var req contract.RevokeTokensRequest
+	if errs := contract.ValidateRequestBody(r, &req); len(errs) > 0 {
+		contract.WriteValidationError(w, r, errs)
+		return
+	}
+
+	reqID, actorID := auditContext(r)
+	ucReq := token.RevokeTokensRequest{
+		JTI:       req.Jti,
+		Subject:   req.Subject,
+		RequestID: reqID,
+		ActorID:   actorID,
+	}
+	if req.IssuedBefore != nil {
+		ucReq.IssuedBefore = *req.IssuedBefore
+	}
+	if req.ExpiresAt != nil {
+		ucReq.ExpiresAt = *req.ExpiresAt
+	}
+
+	resp, err := h.revokeUC.Execute(r.Context(), ucReq)
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	_ = contract.WriteJSON(w, http.StatusCreated, contract.DataResponse[contract.TokenRevocationResponse]{
+		Data: contract.ToTokenRevocationResponse(resp.Revocation),
+	})
+}
@@ -0,0 +1,155 @@
+//go:build !integration
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/token"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// =============================================================================
+// RevokeTokens Handler Tests
+// =============================================================================
+
+func newRevokeTokensRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tokens:revoke", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestTokenHandler_RevokeTokens_ByJTI(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(time.Hour)
+	mockUC := new(MockRevokeTokensUseCase)
+	mockUC.On("Execute", mock.Anything, mock.MatchedBy(func(req token.RevokeTokensRequest) bool {
+		return req.JTI == "jti-1" && req.Subject == "" && req.IssuedBefore.IsZero() && req.ExpiresAt.Equal(expiresAt)
+	})).Return(token.RevokeTokensResponse{Revocation: domain.TokenRevocation{
+		ID:        "revocation-1",
+		JTI:       "jti-1",
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}}, nil)
+
+	h := NewTokenHandler(mockUC)
+	rr := httptest.NewRecorder()
+
+	h.RevokeTokens(rr, newRevokeTokensRequest(`{"jti":"jti-1","expiresAt":"2026-01-10T13:00:00Z"}`))
+
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var resp contract.DataResponse[contract.TokenRevocationResponse]
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "revocation-1", resp.Data.ID)
+	assert.Equal(t, "jti-1", resp.Data.Jti)
+	assert.Empty(t, resp.Data.Subject)
+	assert.Nil(t, resp.Data.IssuedBefore)
+	assert.True(t, expiresAt.Equal(resp.Data.ExpiresAt))
+	assert.True(t, now.Equal(resp.Data.RevokedAt))
+	assert.NotContains(t, rr.Body.String(), "issuedBefore")
+	mockUC.AssertExpectations(t)
+}
+
+func TestTokenHandler_RevokeTokens_BySubject(t *testing.T) {
+	cutoff := time.Date(2026, 1, 10, 11, 0, 0, 0, time.UTC)
+	mockUC := new(MockRevokeTokensUseCase)
+	mockUC.On("Execute", mock.Anything, mock.MatchedBy(func(req token.RevokeTokensRequest) bool {
+		return req.JTI == "" && req.Subject == "user-1" && req.IssuedBefore.Equal(cutoff) && req.ExpiresAt.IsZero()
+	})).Return(token.RevokeTokensResponse{Revocation: domain.TokenRevocation{
+		ID:           "revocation-1",
+		Subject:      "user-1",
+		IssuedBefore: cutoff,
+		ExpiresAt:    cutoff.Add(24 * time.Hour),
+	}}, nil)
+
+	h := NewTokenHandler(mockUC)
+	rr := httptest.NewRecorder()
+
+	h.RevokeTokens(rr, newRevokeTokensRequest(`{"subject":"user-1","issuedBefore":"2026-01-10T11:00:00Z"}`))
+
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var resp contract.DataResponse[contract.TokenRevocationResponse]
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "user-1", resp.Data.Subject)
+	require.NotNil(t, resp.Data.IssuedBefore)
+	assert.True(t, cutoff.Equal(*resp.Data.IssuedBefore))
+	mockUC.AssertExpectations(t)
+}
+
+func TestTokenHandler_RevokeTokens_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		ucErr      error
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "forbidden",
+			body:       `{"jti":"jti-1"}`,
+			ucErr:      &app.AppError{Op: token.OpRevokeTokens, Code: app.CodeForbidden, Message: "Access denied"},
+			wantStatus: http.StatusForbidden,
+			wantCode:   contract.CodeAuthzForbidden,
+		},
+		{
+			name:       "use case validation",
+			body:       `{"jti":"jti-1","subject":"user-1"}`,
+			ucErr:      &app.AppError{Op: token.OpRevokeTokens, Code: app.CodeValidationError, Message: "exactly one of jti and subject must be set"},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   contract.CodeValRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUC := new(MockRevokeTokensUseCase)
+			mockUC.On("Execute", mock.Anything, mock.Anything).Return(token.RevokeTokensResponse{}, tt.ucErr)
+
+			h := NewTokenHandler(mockUC)
+			rr := httptest.NewRecorder()
+
+			h.RevokeTokens(rr, newRevokeTokensRequest(tt.body))
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			var problem testProblemDetail
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+			assert.Equal(t, tt.wantCode, problem.Code)
+		})
+	}
+}
+
+func TestTokenHandler_RevokeTokens_InvalidBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "malformed JSON", body: `{"jti":`},
+		{name: "unknown field", body: `{"token":"abc"}`},
+		{name: "jti too long", body: `{"jti":"` + strings.Repeat("a", 256) + `"}`},
+		{name: "invalid timestamp", body: `{"subject":"user-1","issuedBefore":"yesterday"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUC := new(MockRevokeTokensUseCase)
+			h := NewTokenHandler(mockUC)
+			rr := httptest.NewRecorder()
+
+			h.RevokeTokens(rr, newRevokeTokensRequest(tt.body))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			mockUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -29,12 +29,34 @@ type listUsersExecutor interface {
 	Execute(ctx context.Context, req user.ListUsersRequest) (user.ListUsersResponse, error)
 }
 
+type updateUserExecutor interface {
+	Execute(ctx context.Context, req user.UpdateUserRequest) (user.UpdateUserResponse, error)
+}
+
+type deleteUserExecutor interface {
+	Execute(ctx context.Context, req user.DeleteUserRequest) error
+}
+
 // UserHandler handles user-related HTTP requests.
 type UserHandler struct {
 	createUC     createUserExecutor
 	getUC        getUserExecutor
 	listUC       listUsersExecutor
+	updateUC     updateUserExecutor
+	deleteUC     deleteUserExecutor
 	resourcePath string
+	cursors      *contract.CursorCodec
+}
+
+// UserHandlerOption configures optional UserHandler dependencies.
+type UserHandlerOption func(*UserHandler)
+
+// WithCursorCodec sets the codec used to sign and verify pagination cursors.
+// Without it, a codec with a random per-process key is used.
+func WithCursorCodec(c *contract.CursorCodec) UserHandlerOption {
+	return func(h *UserHandler) {
+		h.cursors = c
+	}
 }
 
 // NewUserHandler creates a new UserHandler.
@@ -42,14 +64,26 @@ func NewUserHandler(
 	createUC createUserExecutor,
 	getUC getUserExecutor,
 	listUC listUsersExecutor,
+	updateUC updateUserExecutor,
+	deleteUC deleteUserExecutor,
 	resourcePath string,
+	opts ...UserHandlerOption,
 ) *UserHandler {
-	return &UserHandler{
+	h := &UserHandler{
 		createUC:     createUC,
 		getUC:        getUC,
 		listUC:       listUC,
+		updateUC:     updateUC,
+		deleteUC:     deleteUC,
 		resourcePath: resourcePath,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	if h.cursors == nil {
+		h.cursors = contract.NewCursorCodec(nil)
+	}
+	return h
 }
 
 // CreateUser handles POST /api/v1/users.
@@ -103,31 +137,29 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	// Set Location header for 201 Created (before writing response body)
 	location := fmt.Sprintf("%s/%s", h.resourcePath, resp.User.ID)
 	w.Header().Set("Location", location)
+	contract.SetVersionETag(w, resp.User.Version)
 
 	_ = contract.WriteJSON(w, http.StatusCreated, contract.DataResponse[contract.UserResponse]{Data: userResp})
 }
 
 // GetUser handles GET /api/v1/users/{id}.
+// The response carries a strong ETag that clients send back in If-Match on writes,
+// and a Last-Modified header; conditional requests are answered with 304.
+// ?fields= selects a sparse fieldset (see contract.ParseFields).
 func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
-	idParam := chi.URLParam(r, "id")
-
-	// Validate UUID format and version
-	parsedID, err := uuid.Parse(idParam)
-	if err != nil {
-		contract.WriteValidationError(w, r, []contract.ValidationError{
-			{Field: "id", Message: "must be a valid UUID"},
-		})
+	id, ok := parseUserID(w, r)
+	if !ok {
 		return
 	}
-	if parsedID.Version() != 7 {
-		contract.WriteValidationError(w, r, []contract.ValidationError{
-			{Field: "id", Message: "must be UUID v7 (time-ordered)"},
-		})
+
+	fields, errs := contract.ParseFields[contract.UserResponse](r.URL.Query())
+	if len(errs) > 0 {
+		contract.WriteValidationError(w, r, errs)
 		return
 	}
 
 	// Execute use case
-	resp, err := h.getUC.Execute(r.Context(), user.GetUserRequest{ID: domain.ID(parsedID.String())})
+	resp, err := h.getUC.Execute(r.Context(), user.GetUserRequest{ID: id})
 	if err != nil {
 		contract.WriteProblemJSON(w, r, err)
 		return
@@ -135,14 +167,31 @@ func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 
 	// Map to response
 	userResp := contract.ToUserResponse(resp.User)
-	_ = contract.WriteJSON(w, http.StatusOK, contract.DataResponse[contract.UserResponse]{Data: userResp})
+	body, err := contract.SelectFields(contract.DataResponse[contract.UserResponse]{Data: userResp}, fields)
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+	_ = contract.WriteConditionalJSON(w, r, http.StatusOK, contract.Validators{
+		ETag:         contract.VersionETag(resp.User.Version),
+		LastModified: resp.User.UpdatedAt,
+	}, body)
 }
 
 // ListUsers handles GET /api/v1/users.
+// Supports the filters and sort order parsed by contract.ParseUserListFilter.
+// Pages are selected either by page number or by an opaque cursor from a
+// previous response's nextCursor/prevCursor; the two cannot be combined, and
+// cursors require the default sort order. ?fields= selects a sparse fieldset
+// for each user (see contract.ParseFields).
+// The response carries a weak ETag over the page; conditional requests with
+// If-None-Match are answered with 304. No Last-Modified is sent because removals
+// from the list would not advance it.
 func (h *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
 	// Parse pagination params
 	pageStr := r.URL.Query().Get("page")
 	pageSizeStr := r.URL.Query().Get("pageSize")
+	cursorStr := r.URL.Query().Get("cursor")
 
 	// Defaults
 	page := 1
@@ -176,9 +225,47 @@ func (h *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
 		pageSize = ps
 	}
 
+	filter, errs := contract.ParseUserListFilter(r.URL.Query())
+	if len(errs) > 0 {
+		contract.WriteValidationError(w, r, errs)
+		return
+	}
+
+	fields, errs := contract.ParseFields[contract.UserResponse](r.URL.Query())
+	if len(errs) > 0 {
+		contract.WriteValidationError(w, r, errs)
+		return
+	}
+
 	req := user.ListUsersRequest{
 		Page:     page,
 		PageSize: pageSize,
+		Filter:   filter,
+	}
+	cursorScope := contract.UserListCursorScope(ctxutil.GetTenantID(r.Context()), filter)
+
+	if cursorStr != "" {
+		if pageStr != "" {
+			contract.WriteValidationError(w, r, []contract.ValidationError{
+				{Field: "page", Message: "cannot be combined with cursor", Code: contract.CodeValInvalidFormat},
+			})
+			return
+		}
+		if !filter.HasDefaultOrder() {
+			contract.WriteValidationError(w, r, []contract.ValidationError{
+				{Field: "sort", Message: "cannot be combined with cursor", Code: contract.CodeValInvalidFormat},
+			})
+			return
+		}
+		cursor, err := h.cursors.Decode(cursorStr, cursorScope)
+		if err != nil {
+			contract.WriteValidationError(w, r, []contract.ValidationError{
+				{Field: "cursor", Message: "must be a cursor returned by a previous page", Code: contract.CodeValInvalidFormat},
+			})
+			return
+		}
+		req.Page = 0
+		req.Cursor = &cursor
 	}
 
 	// Execute use case
@@ -189,6 +276,169 @@ func (h *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Build response
-	listResp := contract.NewListUsersResponse(resp.Users, page, pageSize, resp.TotalCount)
-	_ = contract.WriteJSON(w, http.StatusOK, listResp)
+	listResp := contract.NewListUsersResponse(resp.Users, req.Page, pageSize, resp.TotalCount)
+	listResp.Pagination.NextCursor = h.cursors.EncodeOptional(resp.NextCursor, cursorScope)
+	listResp.Pagination.PrevCursor = h.cursors.EncodeOptional(resp.PrevCursor, cursorScope)
+	body, err := contract.SelectFields(listResp, fields)
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+	_ = contract.WriteConditionalJSON(w, r, http.StatusOK, contract.Validators{
+		ETag: contract.UserListETag(listResp, fields.Names()...),
+	}, body)
+}
+
+// UpdateUser handles PUT /api/v1/users/{id}.
+// The request body replaces all mutable fields of the user.
+// Requires If-Match with the user's current ETag.
+func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUserID(w, r)
+	if !ok {
+		return
+	}
+	version, ok := requireIfMatch(w, r, user.OpUpdateUser)
+	if !ok {
+		return
+	}
+
+	var req contract.UpdateUserRequest
+	if errs := contract.ValidateRequestBody(r, &req); len(errs) > 0 {
+		contract.WriteValidationError(w, r, errs)
+		return
+	}
+
+	h.executeUpdate(w, r, user.UpdateUserRequest{
+		ID:        id,
+		Email:     &req.Email,
+		FirstName: &req.FirstName,
+		LastName:  &req.LastName,
+		Version:   version,
+	})
+}
+
+// PatchUser handles PATCH /api/v1/users/{id}.
+// The request body is a JSON Merge Patch (RFC 7386); absent fields are left unchanged.
+// Requires If-Match with the user's current ETag.
+func (h *UserHandler) PatchUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUserID(w, r)
+	if !ok {
+		return
+	}
+	version, ok := requireIfMatch(w, r, user.OpUpdateUser)
+	if !ok {
+		return
+	}
+
+	var req contract.PatchUserRequest
+	if errs := contract.ValidateMergePatchBody(r, &req); len(errs) > 0 {
+		contract.WriteValidationError(w, r, errs)
+		return
+	}
+
+	h.executeUpdate(w, r, user.UpdateUserRequest{
+		ID:        id,
+		Email:     req.Email,
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		Version:   version,
+	})
+}
+
+// executeUpdate runs the update use case shared by PUT and PATCH and writes the response.
+func (h *UserHandler) executeUpdate(w http.ResponseWriter, r *http.Request, appReq user.UpdateUserRequest) {
+	appReq.RequestID, appReq.ActorID = auditContext(r)
+
+	resp, err := h.updateUC.Execute(r.Context(), appReq)
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	userResp := contract.ToUserResponse(resp.User)
+	contract.SetVersionETag(w, resp.User.Version)
+	_ = contract.WriteJSON(w, http.StatusOK, contract.DataResponse[contract.UserResponse]{Data: userResp})
+}
+
+// DeleteUser handles DELETE /api/v1/users/{id}.
+// Requires If-Match with the user's current ETag.
+func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUserID(w, r)
+	if !ok {
+		return
+	}
+	version, ok := requireIfMatch(w, r, user.OpDeleteUser)
+	if !ok {
+		return
+	}
+
+	reqID, actorID := auditContext(r)
+	if err := h.deleteUC.Execute(r.Context(), user.DeleteUserRequest{
+		ID:        id,
+		Version:   version,
+		RequestID: reqID,
+		ActorID:   actorID,
+	}); err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseUserID extracts the {id} URL parameter and validates that it is a UUID v7.
+// On failure it writes a validation problem response and returns false.
+func parseUserID(w http.ResponseWriter, r *http.Request) (domain.ID, bool) {
+	idParam := chi.URLParam(r, "id")
+
+	// Validate UUID format and version
+	parsedID, err := uuid.Parse(idParam)
+	if err != nil {
+		contract.WriteValidationError(w, r, []contract.ValidationError{
+			{Field: "id", Message: "must be a valid UUID"},
+		})
+		return "", false
+	}
+	if parsedID.Version() != 7 {
+		contract.WriteValidationError(w, r, []contract.ValidationError{
+			{Field: "id", Message: "must be UUID v7 (time-ordered)"},
+		})
+		return "", false
+	}
+
+	return domain.ID(parsedID.String()), true
+}
+
+// requireIfMatch reads the expected user version from the If-Match header.
+// A missing header yields 428 Precondition Required; a header that cannot match
+// any version yields 412 Precondition Failed. In both cases the problem response
+// is written and false is returned.
+func requireIfMatch(w http.ResponseWriter, r *http.Request, op string) (int64, bool) {
+	version, present, ok := contract.ParseIfMatchVersion(r)
+	if !present {
+		contract.WriteProblemJSON(w, r, &app.AppError{
+			Op:      op,
+			Code:    app.CodePreconditionRequired,
+			Message: "If-Match header with the current ETag is required",
+		})
+		return 0, false
+	}
+	if !ok {
+		contract.WriteProblemJSON(w, r, &app.AppError{
+			Op:      op,
+			Code:    app.CodeVersionMismatch,
+			Message: "If-Match does not match the current version",
+		})
+		return 0, false
+	}
+	return version, true
+}
+
+// auditContext extracts the request ID and acting subject for the audit trail.
+func auditContext(r *http.Request) (string, domain.ID) {
+	var actorID domain.ID
+	if authCtx := app.GetAuthContext(r.Context()); authCtx != nil {
+		actorID = domain.ID(authCtx.SubjectID)
+	}
+	return ctxutil.GetRequestID(r.Context()), actorID
 }
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+type restoreUserExecutor interface {
+	Execute(ctx context.Context, req user.RestoreUserRequest) (user.RestoreUserResponse, error)
+}
+
+type purgeDeletedUsersExecutor interface {
+	Execute(ctx context.Context, req user.PurgeDeletedUsersRequest) (user.PurgeDeletedUsersResponse, error)
+}
+
+// UserAdminHandler handles admin-only user lifecycle HTTP requests.
+// Authorization (admin role) is enforced by the use cases.
+type UserAdminHandler struct {
+	restoreUC restoreUserExecutor
+	purgeUC   purgeDeletedUsersExecutor
+}
+
+// NewUserAdminHandler creates a new UserAdminHandler.
+func NewUserAdminHandler(
+	restoreUC restoreUserExecutor,
+	purgeUC purgeDeletedUsersExecutor,
+) *UserAdminHandler {
+	return &UserAdminHandler{
+		restoreUC: restoreUC,
+		purgeUC:   purgeUC,
+	}
+}
+
+// RestoreUser handles POST /api/v1/users/{id}:restore.
+func (h *UserAdminHandler) RestoreUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUserID(w, r)
+	if !ok {
+		return
+	}
+
+	reqID, actorID := auditContext(r)
+	resp, err := h.restoreUC.Execute(r.Context(), user.RestoreUserRequest{
+		ID:        id,
+		RequestID: reqID,
+		ActorID:   actorID,
+	})
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	userResp := contract.ToUserResponse(resp.User)
+	contract.SetVersionETag(w, resp.User.Version)
+	_ = contract.WriteJSON(w, http.StatusOK, contract.DataResponse[contract.UserResponse]{Data: userResp})
+}
+
+// PurgeUsers handles POST /api/v1/users:purge.
+// Permanently removes users soft-deleted longer ago than the configured retention window.
+func (h *UserAdminHandler) PurgeUsers(w http.ResponseWriter, r *http.Request) {
+	reqID, actorID := auditContext(r)
+	resp, err := h.purgeUC.Execute(r.Context(), user.PurgeDeletedUsersRequest{
+		RequestID: reqID,
+		ActorID:   actorID,
+	})
+	if err != nil {
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	_ = contract.WriteJSON(w, http.StatusOK, contract.DataResponse[contract.PurgeUsersResponse]{
+		Data: contract.PurgeUsersResponse{
+			PurgedCount:   len(resp.PurgedIDs),
+			DeletedBefore: resp.DeletedBefore,
+		},
+	})
+}
@@ -0,0 +1,95 @@
+//go:build !integration
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+)
+
+// =============================================================================
+// UserAdminHandler Tests (restore, purge)
+// =============================================================================
+
+func TestUserAdminHandler_RestoreUser_Success(t *testing.T) {
+	mockRestoreUC := new(MockRestoreUserUseCase)
+	expectedUser := createTestUser()
+
+	mockRestoreUC.On("Execute", mock.Anything, mock.MatchedBy(func(req user.RestoreUserRequest) bool {
+		return req.ID == expectedUser.ID
+	})).Return(user.RestoreUserResponse{User: expectedUser}, nil)
+
+	h := NewUserAdminHandler(mockRestoreUC, new(MockPurgeDeletedUsersUseCase))
+	req := newUserIDRequest(http.MethodPost, string(expectedUser.ID), "")
+	rr := httptest.NewRecorder()
+
+	h.RestoreUser(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, string(expectedUser.ID), data["id"])
+
+	mockRestoreUC.AssertExpectations(t)
+}
+
+func TestUserAdminHandler_RestoreUser_Forbidden(t *testing.T) {
+	mockRestoreUC := new(MockRestoreUserUseCase)
+	mockRestoreUC.On("Execute", mock.Anything, mock.Anything).
+		Return(user.RestoreUserResponse{}, &app.AppError{
+			Op:      user.OpRestoreUser,
+			Code:    app.CodeForbidden,
+			Message: "Access denied",
+		})
+
+	h := NewUserAdminHandler(mockRestoreUC, new(MockPurgeDeletedUsersUseCase))
+	req := newUserIDRequest(http.MethodPost, "019400a0-1234-7abc-8def-1234567890ab", "")
+	rr := httptest.NewRecorder()
+
+	h.RestoreUser(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+}
+
+func TestUserAdminHandler_PurgeUsers_Success(t *testing.T) {
+	mockPurgeUC := new(MockPurgeDeletedUsersUseCase)
+	cutoff := time.Date(2026, 1, 30, 0, 0, 0, 0, time.UTC)
+
+	mockPurgeUC.On("Execute", mock.Anything, mock.Anything).
+		Return(user.PurgeDeletedUsersResponse{
+			PurgedIDs:     []domain.ID{"a", "b"},
+			DeletedBefore: cutoff,
+		}, nil)
+
+	h := NewUserAdminHandler(new(MockRestoreUserUseCase), mockPurgeUC)
+	req := httptest.NewRequest(http.MethodPost, testUserResourcePath+":purge", nil)
+	rr := httptest.NewRecorder()
+
+	h.PurgeUsers(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp struct {
+		Data struct {
+			PurgedCount   int       `json:"purgedCount"`
+			DeletedBefore time.Time `json:"deletedBefore"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, 2, resp.Data.PurgedCount)
+	assert.True(t, cutoff.Equal(resp.Data.DeletedBefore))
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+type batchCreateUsersExecutor interface {
+	Execute(ctx context.Context, req user.BatchCreateUsersRequest) (user.BatchCreateUsersResponse, error)
+}
+
+// UserBatchHandler handles bulk user HTTP requests.
+type UserBatchHandler struct {
+	batchCreateUC batchCreateUsersExecutor
+	maxItems      int
+}
+
+// NewUserBatchHandler creates a new UserBatchHandler.
+// maxItems is the largest batch accepted; larger batches are rejected with 400.
+func NewUserBatchHandler(batchCreateUC batchCreateUsersExecutor, maxItems int) *UserBatchHandler {
+	if maxItems <= 0 {
+		maxItems = user.DefaultBatchMaxItems
+	}
+	return &UserBatchHandler{
+		batchCreateUC: batchCreateUC,
+		maxItems:      maxItems,
+	}
+}
+
+// BatchCreateUsers handles POST /api/v1/users:batchCreate.
+//
+// Each item is validated like a POST /api/v1/users body. The response is
+// 207 Multi-Status with one result per item in request order: 201 with the
+// user, or the item's Problem Details. In atomic mode (default) a single
+// failure means no user is created and the other items report USR-005; in
+// bestEffort mode valid items are created independently of failed ones.
+//
+// Request-level problems (malformed JSON, empty or oversized batch, unknown
+// mode) are answered with 400 like any other request. Body size limits and
+// Idempotency-Key replay are applied by the router middleware.
+func (h *UserBatchHandler) BatchCreateUsers(w http.ResponseWriter, r *http.Request) {
+	var req contract.BatchCreateUsersRequest
+	if errs := contract.ValidateRequestBody(r, &req); len(errs) > 0 {
+		contract.WriteValidationError(w, r, errs)
+		return
+	}
+	if len(req.Items) > h.maxItems {
+		contract.WriteValidationError(w, r, []contract.ValidationError{{
+			Field:   "items",
+			Message: fmt.Sprintf("must contain at most %d items", h.maxItems),
+			Code:    contract.CodeValOutOfRange,
+		}})
+		return
+	}
+	if req.Mode == "" {
+		req.Mode = contract.BatchModeAtomic
+	}
+
+	reqID, actorID := auditContext(r)
+	results := make([]contract.BatchItemResult, len(req.Items))
+	var (
+		items   []user.CreateUserRequest
+		indexes []int // request index of each entry in items
+		invalid = -1  // first item that failed transport validation
+	)
+	for i, item := range req.Items {
+		results[i].Index = i
+		if errs := contract.Validate(item); len(errs) > 0 {
+			results[i].Error = contract.NewValidationProblem(r, errs)
+			results[i].Status = results[i].Error.Status
+			if invalid < 0 {
+				invalid = i
+			}
+			continue
+		}
+
+		// Generate UUID v7 at transport boundary, as for single creates
+		id, err := uuid.NewV7()
+		if err != nil {
+			contract.WriteProblemJSON(w, r, &app.AppError{
+				Op:      user.OpBatchCreateUsers,
+				Code:    app.CodeInternalError,
+				Message: "Failed to generate user ID",
+				Err:     err,
+			})
+			return
+		}
+		items = append(items, user.CreateUserRequest{
+			ID:        domain.ID(id.String()),
+			Email:     item.Email,
+			FirstName: item.FirstName,
+			LastName:  item.LastName,
+			RequestID: reqID,
+			ActorID:   actorID,
+		})
+		indexes = append(indexes, i)
+	}
+
+	switch {
+	case invalid >= 0 && req.Mode == contract.BatchModeAtomic:
+		aborted := &app.AppError{
+			Op:      user.OpBatchCreateUsers,
+			Code:    app.CodeBatchAborted,
+			Message: fmt.Sprintf("Not created because item %d failed and the batch is atomic", invalid),
+		}
+		for _, i := range indexes {
+			results[i].Error = contract.ProblemFromError(r, aborted)
+			results[i].Status = results[i].Error.Status
+		}
+
+	case len(items) > 0:
+		resp, err := h.batchCreateUC.Execute(r.Context(), user.BatchCreateUsersRequest{
+			Items: items,
+			Mode:  user.BatchMode(req.Mode),
+		})
+		if err != nil {
+			contract.WriteProblemJSON(w, r, err)
+			return
+		}
+		for j, res := range resp.Results {
+			i := indexes[j]
+			if res.Err != nil {
+				results[i].Error = contract.ProblemFromError(r, res.Err)
+				results[i].Status = results[i].Error.Status
+				continue
+			}
+			userResp := contract.ToUserResponse(res.User)
+			results[i].Data = &userResp
+			results[i].Status = http.StatusCreated
+		}
+	}
+
+	_ = contract.WriteJSON(w, http.StatusMultiStatus, contract.DataResponse[contract.BatchCreateUsersResponse]{
+		Data: contract.NewBatchCreateUsersResponse(req.Mode, results),
+	})
+}
@@ -0,0 +1,187 @@
+//go:build !integration
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// =============================================================================
+// BatchCreateUsers Handler Tests
+// =============================================================================
+
+type testBatchItemResult struct {
+	Index  int                    `json:"index"`
+	Status int                    `json:"status"`
+	Data   *contract.UserResponse `json:"data"`
+	Error  *testProblemDetail     `json:"error"`
+}
+
+type testBatchResponse struct {
+	Data struct {
+		Mode    string                `json:"mode"`
+		Created int                   `json:"created"`
+		Failed  int                   `json:"failed"`
+		Results []testBatchItemResult `json:"results"`
+	} `json:"data"`
+}
+
+func newBatchCreateRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, testUserResourcePath+":batchCreate", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func decodeBatchResponse(t *testing.T, rr *httptest.ResponseRecorder) testBatchResponse {
+	t.Helper()
+	require.Equal(t, http.StatusMultiStatus, rr.Code, rr.Body.String())
+	var resp testBatchResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	return resp
+}
+
+func TestUserBatchHandler_BatchCreateUsers_BestEffort(t *testing.T) {
+	created := createTestUser()
+	mockUC := new(MockBatchCreateUsersUseCase)
+	mockUC.On("Execute", mock.Anything, mock.MatchedBy(func(req user.BatchCreateUsersRequest) bool {
+		// The invalid item 1 never reaches the use case
+		return req.Mode == user.BatchModeBestEffort && len(req.Items) == 2 &&
+			req.Items[0].Email == "a@example.com" && req.Items[1].Email == "c@example.com" &&
+			!req.Items[0].ID.IsEmpty() && req.Items[0].ID != req.Items[1].ID
+	})).Return(user.BatchCreateUsersResponse{
+		Results: []user.BatchCreateUserResult{
+			{User: created},
+			{Err: &app.AppError{Op: "CreateUser", Code: app.CodeEmailExists, Message: "Email already exists", Err: domain.ErrEmailAlreadyExists}},
+		},
+		Created: 1,
+	}, nil)
+
+	h := NewUserBatchHandler(mockUC, 10)
+	rr := httptest.NewRecorder()
+
+	h.BatchCreateUsers(rr, newBatchCreateRequest(`{"mode":"bestEffort","items":[
+		{"email":"a@example.com","firstName":"A","lastName":"A"},
+		{"email":"not-an-email","firstName":"B","lastName":"B"},
+		{"email":"c@example.com","firstName":"C","lastName":"C"}
+	]}`))
+
+	resp := decodeBatchResponse(t, rr)
+	assert.Equal(t, "bestEffort", resp.Data.Mode)
+	assert.Equal(t, 1, resp.Data.Created)
+	assert.Equal(t, 2, resp.Data.Failed)
+	require.Len(t, resp.Data.Results, 3)
+
+	assert.Equal(t, http.StatusCreated, resp.Data.Results[0].Status)
+	require.NotNil(t, resp.Data.Results[0].Data)
+	assert.Equal(t, string(created.ID), resp.Data.Results[0].Data.ID)
+
+	assert.Equal(t, 1, resp.Data.Results[1].Index)
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Results[1].Status)
+	require.NotNil(t, resp.Data.Results[1].Error)
+	assert.Equal(t, "email", resp.Data.Results[1].Error.ValidationErrors[0].Field)
+
+	assert.Equal(t, http.StatusConflict, resp.Data.Results[2].Status)
+	require.NotNil(t, resp.Data.Results[2].Error)
+	assert.Equal(t, contract.CodeUsrEmailExists, resp.Data.Results[2].Error.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestUserBatchHandler_BatchCreateUsers_AtomicDefault(t *testing.T) {
+	mockUC := new(MockBatchCreateUsersUseCase)
+	mockUC.On("Execute", mock.Anything, mock.MatchedBy(func(req user.BatchCreateUsersRequest) bool {
+		return req.Mode == user.BatchModeAtomic && len(req.Items) == 1
+	})).Return(user.BatchCreateUsersResponse{
+		Results: []user.BatchCreateUserResult{{User: createTestUser()}},
+		Created: 1,
+	}, nil)
+
+	h := NewUserBatchHandler(mockUC, 10)
+	rr := httptest.NewRecorder()
+
+	h.BatchCreateUsers(rr, newBatchCreateRequest(`{"items":[{"email":"a@example.com","firstName":"A","lastName":"A"}]}`))
+
+	resp := decodeBatchResponse(t, rr)
+	assert.Equal(t, "atomic", resp.Data.Mode)
+	assert.Equal(t, 1, resp.Data.Created)
+	assert.Equal(t, http.StatusCreated, resp.Data.Results[0].Status)
+	mockUC.AssertExpectations(t)
+}
+
+func TestUserBatchHandler_BatchCreateUsers_AtomicInvalidItem(t *testing.T) {
+	mockUC := new(MockBatchCreateUsersUseCase)
+	h := NewUserBatchHandler(mockUC, 10)
+	rr := httptest.NewRecorder()
+
+	h.BatchCreateUsers(rr, newBatchCreateRequest(`{"mode":"atomic","items":[
+		{"email":"a@example.com","firstName":"A","lastName":"A"},
+		{"email":"b@example.com","firstName":"","lastName":"B"}
+	]}`))
+
+	resp := decodeBatchResponse(t, rr)
+	assert.Equal(t, 0, resp.Data.Created)
+	assert.Equal(t, 2, resp.Data.Failed)
+	assert.Equal(t, http.StatusFailedDependency, resp.Data.Results[0].Status)
+	assert.Equal(t, contract.CodeUsrBatchAborted, resp.Data.Results[0].Error.Code)
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Results[1].Status)
+	mockUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestUserBatchHandler_BatchCreateUsers_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantField string
+	}{
+		{name: "missing items", body: `{"mode":"atomic"}`, wantField: "items"},
+		{name: "empty items", body: `{"items":[]}`, wantField: "items"},
+		{name: "unknown mode", body: `{"mode":"parallel","items":[{"email":"a@example.com","firstName":"A","lastName":"A"}]}`, wantField: "mode"},
+		{name: "too many items", body: `{"items":[{},{},{}]}`, wantField: "items"},
+		{name: "unknown item field", body: `{"items":[{"email":"a@example.com","role":"admin"}]}`, wantField: "role"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUC := new(MockBatchCreateUsersUseCase)
+			h := NewUserBatchHandler(mockUC, 2)
+			rr := httptest.NewRecorder()
+
+			h.BatchCreateUsers(rr, newBatchCreateRequest(tt.body))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			var problem testProblemDetail
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+			require.NotEmpty(t, problem.ValidationErrors)
+			assert.Equal(t, tt.wantField, problem.ValidationErrors[0].Field)
+			mockUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUserBatchHandler_BatchCreateUsers_UseCaseError(t *testing.T) {
+	mockUC := new(MockBatchCreateUsersUseCase)
+	mockUC.On("Execute", mock.Anything, mock.Anything).Return(user.BatchCreateUsersResponse{}, &app.AppError{
+		Op:      user.OpBatchCreateUsers,
+		Code:    app.CodeInternalError,
+		Message: "boom",
+	})
+
+	h := NewUserBatchHandler(mockUC, 10)
+	rr := httptest.NewRecorder()
+
+	h.BatchCreateUsers(rr, newBatchCreateRequest(`{"items":[{"email":"a@example.com","firstName":"A","lastName":"A"}]}`))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
@@ -35,7 +35,7 @@ func TestUserHandler_CreateUser_PropagatesRequestIDAndActorID(t *testing.T) {
 		return true
 	})).Return(user.CreateUserResponse{User: expectedUser}, nil)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request
 	body := `{"email":"test@example.com","firstName":"John","lastName":"Doe"}`
@@ -82,7 +82,7 @@ func TestUserHandler_CreateUser_EmptyActorIDWhenNoAuthContext(t *testing.T) {
 		return true
 	})).Return(user.CreateUserResponse{User: expectedUser}, nil)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	body := `{"email":"test@example.com","firstName":"John","lastName":"Doe"}`
 	req := httptest.NewRequest(http.MethodPost, testUserResourcePath, bytes.NewBufferString(body))
@@ -40,7 +40,7 @@ func TestUserHandler_CreateUser_Success(t *testing.T) {
 			req.LastName == "Doe"
 	})).Return(user.CreateUserResponse{User: expectedUser}, nil)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request
 	body := `{"email":"test@example.com","firstName":"John","lastName":"Doe"}`
@@ -81,7 +81,7 @@ func TestUserHandler_CreateUser_InvalidEmail(t *testing.T) {
 	mockGetUC := new(MockGetUserUseCase)
 	mockListUC := new(MockListUsersUseCase)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request with invalid email
 	body := `{"email":"invalid-email","firstName":"John","lastName":"Doe"}`
@@ -128,7 +128,7 @@ func TestUserHandler_CreateUser_DuplicateEmail(t *testing.T) {
 			Err:     domain.ErrEmailAlreadyExists,
 		})
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request
 	body := `{"email":"existing@example.com","firstName":"John","lastName":"Doe"}`
@@ -158,7 +158,7 @@ func TestUserHandler_CreateUser_InvalidJSONBody(t *testing.T) {
 	mockGetUC := new(MockGetUserUseCase)
 	mockListUC := new(MockListUsersUseCase)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request with invalid JSON
 	body := `{"email": invalid}`
@@ -178,7 +178,7 @@ func TestUserHandler_CreateUser_UnknownField(t *testing.T) {
 	mockGetUC := new(MockGetUserUseCase)
 	mockListUC := new(MockListUsersUseCase)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request with unknown field
 	body := `{"email":"test@example.com","firstName":"John","lastName":"Doe","unknownField":"val"}`
@@ -211,7 +211,7 @@ func TestUserHandler_CreateUser_TrailingData(t *testing.T) {
 	mockGetUC := new(MockGetUserUseCase)
 	mockListUC := new(MockListUsersUseCase)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request with trailing data
 	body := `{"email":"test@example.com","firstName":"John","lastName":"Doe"}extra`
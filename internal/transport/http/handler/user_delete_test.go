@@ -0,0 +1,110 @@
+//go:build !integration
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// =============================================================================
+// DeleteUser Handler Tests
+// =============================================================================
+
+func newUserHandlerForDelete(mockDeleteUC *MockDeleteUserUseCase) *UserHandler {
+	return NewUserHandler(
+		new(MockCreateUserUseCase),
+		new(MockGetUserUseCase),
+		new(MockListUsersUseCase),
+		new(MockUpdateUserUseCase),
+		mockDeleteUC,
+		testUserResourcePath,
+	)
+}
+
+func TestUserHandler_DeleteUser_Success(t *testing.T) {
+	mockDeleteUC := new(MockDeleteUserUseCase)
+	userID := "019400a0-1234-7abc-8def-1234567890ab"
+
+	mockDeleteUC.On("Execute", mock.Anything, mock.MatchedBy(func(req user.DeleteUserRequest) bool {
+		return req.ID == domain.ID(userID) && req.Version == 1
+	})).Return(nil)
+
+	h := newUserHandlerForDelete(mockDeleteUC)
+	req := newUserIDRequest(http.MethodDelete, userID, "")
+	req.Header.Set("If-Match", `"1"`)
+	rr := httptest.NewRecorder()
+
+	h.DeleteUser(rr, req)
+
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	assert.Empty(t, rr.Body.String())
+	mockDeleteUC.AssertExpectations(t)
+}
+
+func TestUserHandler_DeleteUser_NotFound(t *testing.T) {
+	mockDeleteUC := new(MockDeleteUserUseCase)
+	mockDeleteUC.On("Execute", mock.Anything, mock.Anything).
+		Return(&app.AppError{
+			Op:      user.OpDeleteUser,
+			Code:    app.CodeUserNotFound,
+			Message: "User not found",
+			Err:     domain.ErrUserNotFound,
+		})
+
+	h := newUserHandlerForDelete(mockDeleteUC)
+	req := newUserIDRequest(http.MethodDelete, "019400a0-1234-7abc-8def-1234567890ab", "")
+	req.Header.Set("If-Match", `"1"`)
+	rr := httptest.NewRecorder()
+
+	h.DeleteUser(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	var problemResp testProblemDetail
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problemResp))
+	assert.Equal(t, contract.CodeUsrNotFound, problemResp.Code)
+}
+
+func TestUserHandler_DeleteUser_NonV7ID(t *testing.T) {
+	mockDeleteUC := new(MockDeleteUserUseCase)
+	h := newUserHandlerForDelete(mockDeleteUC)
+
+	// UUID v4
+	req := newUserIDRequest(http.MethodDelete, "550e8400-e29b-41d4-a716-446655440000", "")
+	req.Header.Set("If-Match", `"1"`)
+	rr := httptest.NewRecorder()
+
+	h.DeleteUser(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	mockDeleteUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestUserHandler_DeleteUser_MissingIfMatch(t *testing.T) {
+	mockDeleteUC := new(MockDeleteUserUseCase)
+	h := newUserHandlerForDelete(mockDeleteUC)
+
+	req := newUserIDRequest(http.MethodDelete, "019400a0-1234-7abc-8def-1234567890ab", "")
+	rr := httptest.NewRecorder()
+
+	h.DeleteUser(rr, req)
+
+	assert.Equal(t, http.StatusPreconditionRequired, rr.Code)
+
+	var problemResp testProblemDetail
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problemResp))
+	assert.Equal(t, contract.CodeValPreconditionRequired, problemResp.Code)
+	mockDeleteUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// Export flush policy: buffered rows are pushed to the client after
+// exportFlushRows rows or exportFlushInterval, whichever comes first.
+const (
+	exportFlushRows     = 256
+	exportFlushInterval = time.Second
+)
+
+type exportUsersExecutor interface {
+	Execute(ctx context.Context, req user.ExportUsersRequest, fn func(domain.User) error) (user.ExportUsersResponse, error)
+}
+
+// UserExportHandler handles streaming user export HTTP requests.
+// Authorization (admin role) is enforced by the use case.
+type UserExportHandler struct {
+	exportUC     exportUsersExecutor
+	writeTimeout time.Duration
+	maxDuration  time.Duration
+}
+
+// NewUserExportHandler creates a new UserExportHandler. Each flush must finish
+// within writeTimeout (unbounded if zero), and the whole export within maxDuration.
+func NewUserExportHandler(exportUC exportUsersExecutor, writeTimeout, maxDuration time.Duration) *UserExportHandler {
+	return &UserExportHandler{exportUC: exportUC, writeTimeout: writeTimeout, maxDuration: maxDuration}
+}
+
+// ExportUsers handles GET /api/v1/users:export.
+//
+// All active users are streamed oldest first as NDJSON (default) or CSV,
+// selected by the Accept header; unsupported types get 406. Rows are written
+// as they are read from the database, so memory use is constant.
+//
+// A full dump may take longer than HTTP_WRITE_TIMEOUT, so the server's write
+// deadline is replaced by a rolling one: every flush must finish within
+// writeTimeout, which stops a client that reads too slowly from holding the
+// database cursor open. The export as a whole is cut off after maxDuration.
+// A client disconnect or the cutoff cancels the context, which stops the
+// database cursor.
+//
+// Errors before the first row (e.g. 403 for non-admins) are answered with
+// Problem Details. Once streaming has started the status can no longer change,
+// so a failure aborts the connection instead of ending the body cleanly; the
+// client sees a truncated transfer rather than a short but valid export.
+func (h *UserExportHandler) ExportUsers(w http.ResponseWriter, r *http.Request) {
+	mediaType := contract.NegotiateExportMediaType(r.Header.Get("Accept"))
+	if mediaType == "" {
+		contract.WriteProblemJSON(w, r, &app.AppError{
+			Op:      user.OpExportUsers,
+			Code:    app.CodeNotAcceptable,
+			Message: "Export is available as " + contract.MediaTypeNDJSON + " or " + contract.MediaTypeCSV,
+		})
+		return
+	}
+
+	deadline := time.Now().Add(h.maxDuration)
+	ctx, cancel := context.WithDeadline(r.Context(), deadline)
+	defer cancel()
+
+	// The read deadline also bounds the server's background read that detects
+	// client disconnects; it would cancel the request context when it expires.
+	rc := http.NewResponseController(w)
+	_ = rc.SetReadDeadline(deadline)
+	extendWriteDeadline := func() {
+		if h.writeTimeout <= 0 {
+			_ = rc.SetWriteDeadline(deadline)
+			return
+		}
+		_ = rc.SetWriteDeadline(minTime(time.Now().Add(h.writeTimeout), deadline))
+	}
+
+	enc := contract.NewUserExportEncoder(w, mediaType)
+	started := false
+	start := func() error {
+		if started {
+			return nil
+		}
+		started = true
+		w.Header().Set("Content-Type", mediaType)
+		w.Header().Set("Content-Disposition", `attachment; filename="`+enc.Filename()+`"`)
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(http.StatusOK)
+		return enc.Begin()
+	}
+	flush := func() error {
+		extendWriteDeadline()
+		if err := enc.Flush(); err != nil {
+			return err
+		}
+		if err := rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		return nil
+	}
+
+	pending, lastFlush := 0, time.Now()
+	reqID, _ := auditContext(r)
+	_, err := h.exportUC.Execute(ctx, user.ExportUsersRequest{RequestID: reqID}, func(u domain.User) error {
+		if err := start(); err != nil {
+			return err
+		}
+		if err := enc.Encode(u); err != nil {
+			return err
+		}
+		pending++
+		if pending >= exportFlushRows || time.Since(lastFlush) >= exportFlushInterval {
+			pending, lastFlush = 0, time.Now()
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		if !started {
+			extendWriteDeadline()
+			contract.WriteProblemJSON(w, r, err)
+			return
+		}
+		panic(http.ErrAbortHandler)
+	}
+
+	if err := start(); err != nil {
+		panic(http.ErrAbortHandler)
+	}
+	if err := flush(); err != nil {
+		panic(http.ErrAbortHandler)
+	}
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
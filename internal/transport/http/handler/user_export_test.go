@@ -0,0 +1,245 @@
+//go:build !integration
+
+package handler
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// =============================================================================
+// UserExportHandler Tests
+// =============================================================================
+
+const (
+	testExportWriteTimeout = 15 * time.Second
+	testExportMaxDuration  = time.Hour
+)
+
+// deadlineRecorder is a ResponseRecorder that records the deadlines set
+// through http.ResponseController.
+type deadlineRecorder struct {
+	*httptest.ResponseRecorder
+	readDeadline   time.Time
+	writeDeadlines []time.Time
+}
+
+func (r *deadlineRecorder) SetReadDeadline(t time.Time) error {
+	r.readDeadline = t
+	return nil
+}
+
+func (r *deadlineRecorder) SetWriteDeadline(t time.Time) error {
+	r.writeDeadlines = append(r.writeDeadlines, t)
+	return nil
+}
+
+// exportUsers makes the mock use case pass users to the export callback,
+// failing with err after all users were written when err is non-nil.
+func exportUsers(m *MockExportUsersUseCase, users []domain.User, err error) {
+	m.On("Execute", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func(domain.User) error)
+			for _, u := range users {
+				if fn(u) != nil {
+					return
+				}
+			}
+		}).
+		Return(user.ExportUsersResponse{Count: len(users)}, err)
+}
+
+func newExportRequest(accept string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+":export", nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	return req
+}
+
+func exportTestUsers(n int) []domain.User {
+	users := make([]domain.User, n)
+	for i := range users {
+		users[i] = createTestUser()
+		users[i].ID = domain.ID(string(rune('a'+i%26)) + "-user")
+	}
+	return users
+}
+
+func TestUserExportHandler_ExportUsers_NDJSON(t *testing.T) {
+	mockUC := new(MockExportUsersUseCase)
+	users := exportTestUsers(exportFlushRows + 3)
+	exportUsers(mockUC, users, nil)
+
+	h := NewUserExportHandler(mockUC, testExportWriteTimeout, testExportMaxDuration)
+	rr := httptest.NewRecorder()
+
+	h.ExportUsers(rr, newExportRequest(""))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, contract.MediaTypeNDJSON, rr.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="users.ndjson"`, rr.Header().Get("Content-Disposition"))
+	assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+	assert.True(t, rr.Flushed, "rows must be flushed while streaming")
+
+	lines := strings.Split(strings.TrimSuffix(rr.Body.String(), "\n"), "\n")
+	require.Len(t, lines, len(users))
+	var first contract.UserResponse
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	assert.Equal(t, string(users[0].ID), first.ID)
+
+	mockUC.AssertExpectations(t)
+}
+
+func TestUserExportHandler_ExportUsers_CSV(t *testing.T) {
+	mockUC := new(MockExportUsersUseCase)
+	users := exportTestUsers(2)
+	exportUsers(mockUC, users, nil)
+
+	h := NewUserExportHandler(mockUC, testExportWriteTimeout, testExportMaxDuration)
+	rr := httptest.NewRecorder()
+
+	h.ExportUsers(rr, newExportRequest("text/csv"))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, contract.MediaTypeCSV, rr.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="users.csv"`, rr.Header().Get("Content-Disposition"))
+
+	records, err := csv.NewReader(rr.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, "id", records[0][0])
+	assert.Equal(t, string(users[1].ID), records[2][0])
+}
+
+func TestUserExportHandler_ExportUsers_EmptyCSVHasHeader(t *testing.T) {
+	mockUC := new(MockExportUsersUseCase)
+	exportUsers(mockUC, nil, nil)
+
+	h := NewUserExportHandler(mockUC, testExportWriteTimeout, testExportMaxDuration)
+	rr := httptest.NewRecorder()
+
+	h.ExportUsers(rr, newExportRequest("text/csv"))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "id,email,firstName,lastName,createdAt,updatedAt\n", rr.Body.String())
+}
+
+func TestUserExportHandler_ExportUsers_NotAcceptable(t *testing.T) {
+	mockUC := new(MockExportUsersUseCase)
+
+	h := NewUserExportHandler(mockUC, testExportWriteTimeout, testExportMaxDuration)
+	rr := httptest.NewRecorder()
+
+	h.ExportUsers(rr, newExportRequest("application/json"))
+
+	assert.Equal(t, http.StatusNotAcceptable, rr.Code)
+	assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+
+	var problem contract.Problem
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+	assert.Equal(t, contract.CodeValNotAcceptable, problem.Code)
+	mockUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserExportHandler_ExportUsers_Forbidden(t *testing.T) {
+	mockUC := new(MockExportUsersUseCase)
+	exportUsers(mockUC, nil, &app.AppError{
+		Op:      user.OpExportUsers,
+		Code:    app.CodeForbidden,
+		Message: "Access denied",
+	})
+
+	h := NewUserExportHandler(mockUC, testExportWriteTimeout, testExportMaxDuration)
+	rr := httptest.NewRecorder()
+
+	h.ExportUsers(rr, newExportRequest(""))
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+}
+
+func TestUserExportHandler_ExportUsers_FailureAfterStartAbortsResponse(t *testing.T) {
+	mockUC := new(MockExportUsersUseCase)
+	exportUsers(mockUC, exportTestUsers(1), errors.New("connection reset"))
+
+	h := NewUserExportHandler(mockUC, testExportWriteTimeout, testExportMaxDuration)
+	rr := httptest.NewRecorder()
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		h.ExportUsers(rr, newExportRequest(""))
+	})
+	assert.Equal(t, http.StatusOK, rr.Code, "status was already sent")
+	assert.NotContains(t, rr.Body.String(), "problem", "no error body may be appended to the stream")
+}
+
+func TestUserExportHandler_ExportUsers_RollingWriteDeadline(t *testing.T) {
+	mockUC := new(MockExportUsersUseCase)
+	exportUsers(mockUC, exportTestUsers(2*exportFlushRows), nil)
+
+	h := NewUserExportHandler(mockUC, testExportWriteTimeout, testExportMaxDuration)
+	rr := &deadlineRecorder{ResponseRecorder: httptest.NewRecorder()}
+
+	before := time.Now()
+	h.ExportUsers(rr, newExportRequest(""))
+	after := time.Now()
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.WithinRange(t, rr.readDeadline, before.Add(testExportMaxDuration), after.Add(testExportMaxDuration),
+		"the read deadline must end with the export")
+	require.Len(t, rr.writeDeadlines, 3, "one deadline per flush")
+	for _, d := range rr.writeDeadlines {
+		assert.WithinRange(t, d, before.Add(testExportWriteTimeout), after.Add(testExportWriteTimeout))
+	}
+}
+
+func TestUserExportHandler_ExportUsers_WriteDeadlineCappedByMaxDuration(t *testing.T) {
+	mockUC := new(MockExportUsersUseCase)
+	exportUsers(mockUC, exportTestUsers(1), nil)
+
+	h := NewUserExportHandler(mockUC, testExportWriteTimeout, time.Second)
+	rr := &deadlineRecorder{ResponseRecorder: httptest.NewRecorder()}
+
+	h.ExportUsers(rr, newExportRequest(""))
+
+	require.NotEmpty(t, rr.writeDeadlines)
+	for _, d := range rr.writeDeadlines {
+		assert.Equal(t, rr.readDeadline, d)
+	}
+}
+
+func TestUserExportHandler_ExportUsers_MaxDurationCancelsExport(t *testing.T) {
+	mockUC := new(MockExportUsersUseCase)
+	var exportCtx context.Context
+	mockUC.On("Execute", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			exportCtx = args.Get(0).(context.Context)
+			<-exportCtx.Done()
+		}).
+		Return(user.ExportUsersResponse{}, context.DeadlineExceeded)
+
+	h := NewUserExportHandler(mockUC, testExportWriteTimeout, 10*time.Millisecond)
+	rr := httptest.NewRecorder()
+
+	h.ExportUsers(rr, newExportRequest(""))
+
+	require.NotNil(t, exportCtx)
+	assert.ErrorIs(t, exportCtx.Err(), context.DeadlineExceeded)
+	assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+}
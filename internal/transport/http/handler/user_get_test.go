@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
@@ -35,7 +36,7 @@ func TestUserHandler_GetUser_Success(t *testing.T) {
 	mockGetUC.On("Execute", mock.Anything, user.GetUserRequest{ID: expectedUser.ID}).
 		Return(user.GetUserResponse{User: expectedUser}, nil)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request with chi router context
 	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+"/"+userID, nil)
@@ -52,6 +53,7 @@ func TestUserHandler_GetUser_Success(t *testing.T) {
 	// Assert
 	assert.Equal(t, http.StatusOK, rr.Code)
 	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	assert.Equal(t, `"1"`, rr.Header().Get("ETag"))
 
 	var resp map[string]interface{}
 	err := json.Unmarshal(rr.Body.Bytes(), &resp)
@@ -79,7 +81,7 @@ func TestUserHandler_GetUser_NotFound(t *testing.T) {
 			Err:     domain.ErrUserNotFound,
 		})
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request with chi router context
 	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+"/"+userID, nil)
@@ -111,7 +113,7 @@ func TestUserHandler_GetUser_InvalidUUID(t *testing.T) {
 	mockGetUC := new(MockGetUserUseCase)
 	mockListUC := new(MockListUsersUseCase)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request with invalid UUID
 	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+"/invalid-uuid", nil)
@@ -141,7 +143,7 @@ func TestUserHandler_GetUser_InvalidUUIDVersion(t *testing.T) {
 	mockGetUC := new(MockGetUserUseCase)
 	mockListUC := new(MockListUsersUseCase)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	nonV7 := "550e8400-e29b-41d4-a716-446655440000" // uuid v4 format
 	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+"/"+nonV7, nil)
@@ -162,7 +164,7 @@ func TestUserHandler_GetUser_EmptyID(t *testing.T) {
 	mockGetUC := new(MockGetUserUseCase)
 	mockListUC := new(MockListUsersUseCase)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request with empty ID
 	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+"/", nil)
@@ -204,7 +206,7 @@ func TestUserHandler_GetUser_MixedCaseUUID(t *testing.T) {
 	mockGetUC.On("Execute", mock.Anything, user.GetUserRequest{ID: userID}).
 		Return(user.GetUserResponse{User: expectedUser}, nil)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request with MIXED CASE UUID
 	mixedCaseUUID := "019400A0-1234-7ABC-8DEF-1234567890AB"
@@ -229,7 +231,7 @@ func TestUserHandler_GetUser_NilUUID(t *testing.T) {
 	mockGetUC := new(MockGetUserUseCase)
 	mockListUC := new(MockListUsersUseCase)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Nil UUID (all zeros) - technically valid format but version 0, so should fail v7 check
 	nilUUID := "00000000-0000-0000-0000-000000000000"
@@ -253,3 +255,79 @@ func TestUserHandler_GetUser_NilUUID(t *testing.T) {
 
 	assert.Equal(t, "must be UUID v7 (time-ordered)", problemResp.ValidationErrors[0].Message)
 }
+
+func TestUserHandler_GetUser_ConditionalGet(t *testing.T) {
+	expectedUser := createTestUser()
+	expectedUser.Version = 4
+	expectedUser.UpdatedAt = time.Date(2026, 1, 2, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		header     string
+		value      string
+		wantStatus int
+	}{
+		{name: "If-None-Match with current ETag", header: "If-None-Match", value: `"4"`, wantStatus: http.StatusNotModified},
+		{name: "If-None-Match with stale ETag", header: "If-None-Match", value: `"3"`, wantStatus: http.StatusOK},
+		{name: "If-Modified-Since at Last-Modified", header: "If-Modified-Since", value: "Fri, 02 Jan 2026 10:30:00 GMT", wantStatus: http.StatusNotModified},
+		{name: "If-Modified-Since before Last-Modified", header: "If-Modified-Since", value: "Fri, 02 Jan 2026 10:29:59 GMT", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGetUC := new(MockGetUserUseCase)
+			mockGetUC.On("Execute", mock.Anything, user.GetUserRequest{ID: expectedUser.ID}).
+				Return(user.GetUserResponse{User: expectedUser}, nil)
+
+			h := NewUserHandler(new(MockCreateUserUseCase), mockGetUC, new(MockListUsersUseCase), new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
+			req := newUserIDRequest(http.MethodGet, string(expectedUser.ID), "")
+			req.Header.Set(tt.header, tt.value)
+			rr := httptest.NewRecorder()
+
+			h.GetUser(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			assert.Equal(t, `"4"`, rr.Header().Get("ETag"))
+			assert.Equal(t, "Fri, 02 Jan 2026 10:30:00 GMT", rr.Header().Get("Last-Modified"))
+			if tt.wantStatus == http.StatusNotModified {
+				assert.Empty(t, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestUserHandler_GetUser_Fields(t *testing.T) {
+	expectedUser := createTestUser()
+	mockGetUC := new(MockGetUserUseCase)
+	mockGetUC.On("Execute", mock.Anything, user.GetUserRequest{ID: expectedUser.ID}).
+		Return(user.GetUserResponse{User: expectedUser}, nil)
+
+	h := NewUserHandler(new(MockCreateUserUseCase), mockGetUC, new(MockListUsersUseCase), new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
+	req := newUserIDRequest(http.MethodGet, string(expectedUser.ID), "")
+	req.URL.RawQuery = "fields=id,email"
+	rr := httptest.NewRecorder()
+
+	h.GetUser(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, `"1"`, rr.Header().Get("ETag"))
+	assert.JSONEq(t, `{"data":{"id":"`+string(expectedUser.ID)+`","email":"test@example.com"}}`, rr.Body.String())
+}
+
+func TestUserHandler_GetUser_UnknownField(t *testing.T) {
+	mockGetUC := new(MockGetUserUseCase)
+	h := NewUserHandler(new(MockCreateUserUseCase), mockGetUC, new(MockListUsersUseCase), new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
+	req := newUserIDRequest(http.MethodGet, "019400a0-1234-7abc-8def-1234567890ab", "")
+	req.URL.RawQuery = "fields=id,passwordHash"
+	rr := httptest.NewRecorder()
+
+	h.GetUser(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	var problem testProblemDetail
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+	require.Len(t, problem.ValidationErrors, 1)
+	assert.Equal(t, "fields", problem.ValidationErrors[0].Field)
+	assert.Equal(t, contract.CodeValInvalidFormat, problem.ValidationErrors[0].Code)
+	mockGetUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+type startUserImportExecutor interface {
+	Execute(ctx context.Context, req user.StartUserImportRequest) (user.StartUserImportResponse, error)
+}
+
+// UserImportHandler handles CSV user import HTTP requests.
+// Authorization (admin role) is enforced by the use case.
+type UserImportHandler struct {
+	startUC       startUserImportExecutor
+	jobsPath      string
+	maxUploadSize int64
+}
+
+// NewUserImportHandler creates a new UserImportHandler.
+// jobsPath is the base path of the job status resource, used for Location headers.
+// maxUploadSize is the largest CSV file accepted, in bytes.
+func NewUserImportHandler(startUC startUserImportExecutor, jobsPath string, maxUploadSize int64) *UserImportHandler {
+	return &UserImportHandler{
+		startUC:       startUC,
+		jobsPath:      jobsPath,
+		maxUploadSize: maxUploadSize,
+	}
+}
+
+// MaxUploadSize returns the body size limit of ImportUsers, which the router
+// applies instead of MAX_REQUEST_SIZE without buffering the body.
+func (h *UserImportHandler) MaxUploadSize() int64 {
+	return h.maxUploadSize
+}
+
+// ImportUsers handles POST /api/v1/users:import.
+//
+// The body is a CSV file (text/csv) whose header names the columns email,
+// firstName and lastName. The file is checked and queued, and 202 Accepted is
+// returned right away with the pending job and a Location header pointing to
+// GET /api/v1/jobs/{id}, where progress and per-row errors are reported.
+// The body is streamed into the use case rather than read up front; it is
+// bounded by MaxUploadSize (USER_IMPORT_MAX_SIZE) instead of MAX_REQUEST_SIZE,
+// and larger uploads get 413.
+func (h *UserImportHandler) ImportUsers(w http.ResponseWriter, r *http.Request) {
+	reqID, _ := auditContext(r)
+	resp, err := h.startUC.Execute(r.Context(), user.StartUserImportRequest{
+		CSV:       r.Body,
+		RequestID: reqID,
+	})
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			err = &app.AppError{
+				Op:      user.OpStartUserImport,
+				Code:    app.CodeRequestTooLarge,
+				Message: "Request body too large",
+				Err:     err,
+			}
+		}
+		contract.WriteProblemJSON(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", h.jobsPath+"/"+string(resp.Job.ID))
+	_ = contract.WriteJSON(w, http.StatusAccepted, contract.DataResponse[contract.JobResponse]{
+		Data: contract.ToJobResponse(resp.Job),
+	})
+}
@@ -0,0 +1,149 @@
+//go:build !integration
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// =============================================================================
+// ImportUsers Handler Tests
+// =============================================================================
+
+const testJobsPath = "/api/v1/jobs"
+
+func createTestJob() domain.Job {
+	now := time.Now().UTC()
+	return domain.Job{
+		ID:        domain.ID("019400a0-5678-7abc-8def-1234567890ab"),
+		Type:      domain.JobTypeUserImport,
+		Status:    domain.JobStatusPending,
+		CreatedBy: "admin-id",
+		TotalRows: 2,
+		Errors:    []domain.JobRowError{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func TestUserImportHandler_ImportUsers_Accepted(t *testing.T) {
+	const body = "email,firstName,lastName\na@example.com,Ann,Lee\nb@example.com,Bob,Ray\n"
+	queued := createTestJob()
+	var received []byte
+	mockUC := new(MockStartUserImportUseCase)
+	mockUC.On("Execute", mock.Anything, mock.AnythingOfType("user.StartUserImportRequest")).
+		Run(func(args mock.Arguments) {
+			received, _ = io.ReadAll(args.Get(1).(user.StartUserImportRequest).CSV)
+		}).
+		Return(user.StartUserImportResponse{Job: queued}, nil)
+
+	h := NewUserImportHandler(mockUC, testJobsPath, 1024)
+	req := httptest.NewRequest(http.MethodPost, testUserResourcePath+":import", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv")
+	rr := httptest.NewRecorder()
+
+	h.ImportUsers(rr, req)
+
+	require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+	assert.Equal(t, testJobsPath+"/"+string(queued.ID), rr.Header().Get("Location"))
+
+	var resp contract.DataResponse[contract.JobResponse]
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, string(queued.ID), resp.Data.ID)
+	assert.Equal(t, "user_import", resp.Data.Type)
+	assert.Equal(t, "pending", resp.Data.Status)
+	assert.Equal(t, 2, resp.Data.TotalRows)
+	assert.NotNil(t, resp.Data.Errors)
+	assert.Nil(t, resp.Data.FinishedAt)
+	assert.Equal(t, body, string(received))
+	mockUC.AssertExpectations(t)
+}
+
+// streamingImportUseCase reads the whole CSV stream the way
+// user.StartUserImportUseCase does and reports read failures as internal errors.
+type streamingImportUseCase struct{}
+
+func (streamingImportUseCase) Execute(_ context.Context, req user.StartUserImportRequest) (user.StartUserImportResponse, error) {
+	if _, err := io.ReadAll(req.CSV); err != nil {
+		return user.StartUserImportResponse{}, &app.AppError{
+			Op:      user.OpStartUserImport,
+			Code:    app.CodeInternalError,
+			Message: "Failed to read CSV file",
+			Err:     err,
+		}
+	}
+	return user.StartUserImportResponse{Job: createTestJob()}, nil
+}
+
+func TestUserImportHandler_ImportUsers_TooLarge(t *testing.T) {
+	h := NewUserImportHandler(streamingImportUseCase{}, testJobsPath, 16)
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, testUserResourcePath+":import",
+		strings.NewReader("email,firstName,lastName\na@example.com,Ann,Lee\n"))
+	req.Body = http.MaxBytesReader(rr, req.Body, h.MaxUploadSize())
+
+	h.ImportUsers(rr, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+	assert.Empty(t, rr.Header().Get("Location"))
+	var problem testProblemDetail
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+	assert.Equal(t, contract.CodeValRequestTooLarge, problem.Code)
+}
+
+func TestUserImportHandler_ImportUsers_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "invalid file",
+			err:        &app.AppError{Op: user.OpStartUserImport, Code: app.CodeValidationError, Message: "CSV file has no data rows"},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   contract.CodeValRequired,
+		},
+		{
+			name:       "not an admin",
+			err:        &app.AppError{Op: user.OpStartUserImport, Code: app.CodeForbidden, Message: "Access denied"},
+			wantStatus: http.StatusForbidden,
+			wantCode:   contract.CodeAuthzForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUC := new(MockStartUserImportUseCase)
+			mockUC.On("Execute", mock.Anything, mock.Anything).Return(user.StartUserImportResponse{}, tt.err)
+
+			h := NewUserImportHandler(mockUC, testJobsPath, 1024)
+			req := httptest.NewRequest(http.MethodPost, testUserResourcePath+":import", strings.NewReader("email\n"))
+			rr := httptest.NewRecorder()
+
+			h.ImportUsers(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			assert.Empty(t, rr.Header().Get("Location"))
+			var problem testProblemDetail
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+			assert.Equal(t, tt.wantCode, problem.Code)
+		})
+	}
+}
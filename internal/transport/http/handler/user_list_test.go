@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
 	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
 	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
 )
 
@@ -37,7 +40,7 @@ func TestUserHandler_ListUsers_Success(t *testing.T) {
 			PageSize:   10,
 		}, nil)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request with query params
 	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+"?page=1&pageSize=10", nil)
@@ -79,7 +82,7 @@ func TestUserHandler_ListUsers_DefaultPagination(t *testing.T) {
 			PageSize:   20,
 		}, nil)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Create request without query params (uses defaults)
 	req := httptest.NewRequest(http.MethodGet, testUserResourcePath, nil)
@@ -115,7 +118,7 @@ func TestUserHandler_ListUsers_MaxPageSize(t *testing.T) {
 			PageSize:   100,
 		}, nil)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+"?page=1&pageSize=500", nil)
 	rr := httptest.NewRecorder()
@@ -134,7 +137,7 @@ func TestUserHandler_ListUsers_InvalidPage(t *testing.T) {
 	mockGetUC := new(MockGetUserUseCase)
 	mockListUC := new(MockListUsersUseCase)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Invalid page (0)
 	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+"?page=0", nil)
@@ -156,7 +159,7 @@ func TestUserHandler_ListUsers_InvalidPageSize(t *testing.T) {
 	mockGetUC := new(MockGetUserUseCase)
 	mockListUC := new(MockListUsersUseCase)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	// Invalid pageSize (negative)
 	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+"?pageSize=-1", nil)
@@ -186,7 +189,7 @@ func TestUserHandler_ListUsers_ExplicitDefaultPagination(t *testing.T) {
 			PageSize:   20,
 		}, nil)
 
-	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, testUserResourcePath)
+	h := NewUserHandler(mockCreateUC, mockGetUC, mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
 
 	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+"?page=1&pageSize=20", nil)
 	rr := httptest.NewRecorder()
@@ -196,3 +199,199 @@ func TestUserHandler_ListUsers_ExplicitDefaultPagination(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rr.Code)
 	mockListUC.AssertExpectations(t)
 }
+
+func TestUserHandler_ListUsers_ConditionalGet(t *testing.T) {
+	users := []domain.User{createTestUser()}
+	newHandler := func(total int) *UserHandler {
+		mockListUC := new(MockListUsersUseCase)
+		mockListUC.On("Execute", mock.Anything, user.ListUsersRequest{Page: 1, PageSize: 20}).
+			Return(user.ListUsersResponse{Users: users, TotalCount: total, Page: 1, PageSize: 20}, nil)
+		return NewUserHandler(new(MockCreateUserUseCase), new(MockGetUserUseCase), mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
+	}
+
+	// First request returns the page with a weak ETag.
+	rr := httptest.NewRecorder()
+	newHandler(1).ListUsers(rr, httptest.NewRequest(http.MethodGet, testUserResourcePath, nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	etag := rr.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.True(t, strings.HasPrefix(etag, `W/"`), "list ETag must be weak")
+	assert.Empty(t, rr.Header().Get("Last-Modified"))
+
+	// Revalidating an unchanged page yields 304.
+	req := httptest.NewRequest(http.MethodGet, testUserResourcePath, nil)
+	req.Header.Set("If-None-Match", etag)
+	rr = httptest.NewRecorder()
+	newHandler(1).ListUsers(rr, req)
+	assert.Equal(t, http.StatusNotModified, rr.Code)
+	assert.Empty(t, rr.Body.String())
+
+	// A change in TotalCount invalidates the cached page.
+	req = httptest.NewRequest(http.MethodGet, testUserResourcePath, nil)
+	req.Header.Set("If-None-Match", etag)
+	rr = httptest.NewRecorder()
+	newHandler(2).ListUsers(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.NotEqual(t, etag, rr.Header().Get("ETag"))
+}
+
+func TestUserHandler_ListUsers_Cursor(t *testing.T) {
+	codec := contract.NewCursorCodec([]byte("0123456789abcdef0123456789abcdef"))
+	u := createTestUser()
+	position := domain.Cursor{Time: u.CreatedAt, ID: u.ID}
+	next := &domain.Cursor{Time: u.CreatedAt.Add(-time.Hour), ID: "next-id"}
+	prev := &domain.Cursor{Time: u.CreatedAt, ID: u.ID, Backward: true}
+	scope := contract.UserListCursorScope(sharedctx.DefaultTenantID, domain.UserFilter{})
+
+	mockListUC := new(MockListUsersUseCase)
+	mockListUC.On("Execute", mock.Anything, mock.MatchedBy(func(req user.ListUsersRequest) bool {
+		return req.Cursor != nil && req.Cursor.ID == position.ID && req.Cursor.Time.Equal(position.Time) &&
+			!req.Cursor.Backward && req.PageSize == 5 && req.Page == 0
+	})).Return(user.ListUsersResponse{
+		Users:      []domain.User{u},
+		TotalCount: domain.TotalCountUnknown,
+		PageSize:   5,
+		NextCursor: next,
+		PrevCursor: prev,
+	}, nil)
+
+	h := NewUserHandler(new(MockCreateUserUseCase), new(MockGetUserUseCase), mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath, WithCursorCodec(codec))
+	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+"?pageSize=5&cursor="+codec.Encode(position, scope), nil)
+	rr := httptest.NewRecorder()
+
+	h.ListUsers(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp contract.ListUsersResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Len(t, resp.Data, 1)
+	assert.Equal(t, 0, resp.Pagination.Page)
+	assert.Equal(t, 5, resp.Pagination.PageSize)
+	assert.Equal(t, -1, resp.Pagination.TotalItems)
+
+	gotNext, err := codec.Decode(resp.Pagination.NextCursor, scope)
+	require.NoError(t, err)
+	assert.Equal(t, next.ID, gotNext.ID)
+	gotPrev, err := codec.Decode(resp.Pagination.PrevCursor, scope)
+	require.NoError(t, err)
+	assert.True(t, gotPrev.Backward)
+	mockListUC.AssertExpectations(t)
+}
+
+func TestUserHandler_ListUsers_InvalidCursor(t *testing.T) {
+	codec := contract.NewCursorCodec([]byte("0123456789abcdef0123456789abcdef"))
+	scope := contract.UserListCursorScope(sharedctx.DefaultTenantID, domain.UserFilter{})
+	valid := codec.Encode(domain.Cursor{Time: time.Now(), ID: "id"}, scope)
+	foreign := contract.NewCursorCodec(nil).Encode(domain.Cursor{Time: time.Now(), ID: "id"}, scope)
+	otherTenant := codec.Encode(domain.Cursor{Time: time.Now(), ID: "id"}, contract.UserListCursorScope("acme", domain.UserFilter{}))
+
+	tests := []struct {
+		name      string
+		query     string
+		wantField string
+	}{
+		{name: "garbage cursor", query: "?cursor=not-a-cursor", wantField: "cursor"},
+		{name: "cursor signed with another key", query: "?cursor=" + foreign, wantField: "cursor"},
+		{name: "cursor combined with page", query: "?page=2&cursor=" + valid, wantField: "page"},
+		{name: "cursor from another filter", query: "?q=ada&cursor=" + valid, wantField: "cursor"},
+		{name: "cursor from another tenant", query: "?cursor=" + otherTenant, wantField: "cursor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockListUC := new(MockListUsersUseCase)
+			h := NewUserHandler(new(MockCreateUserUseCase), new(MockGetUserUseCase), mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath, WithCursorCodec(codec))
+			rr := httptest.NewRecorder()
+
+			h.ListUsers(rr, httptest.NewRequest(http.MethodGet, testUserResourcePath+tt.query, nil))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			var problem testProblemDetail
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+			require.NotEmpty(t, problem.ValidationErrors)
+			assert.Equal(t, tt.wantField, problem.ValidationErrors[0].Field)
+			assert.Equal(t, contract.CodeValInvalidFormat, problem.ValidationErrors[0].Code)
+			mockListUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUserHandler_ListUsers_Filters(t *testing.T) {
+	mockListUC := new(MockListUsersUseCase)
+	mockListUC.On("Execute", mock.Anything, mock.MatchedBy(func(req user.ListUsersRequest) bool {
+		f := req.Filter
+		return f.Email == "sarah@example.com" && f.Query == "sar" &&
+			f.CreatedAfter.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) &&
+			len(f.Sort) == 2 && f.Sort[0] == domain.UserSort{Key: domain.UserSortLastName, Desc: true} &&
+			f.Sort[1] == domain.UserSort{Key: domain.UserSortCreatedAt}
+	})).Return(user.ListUsersResponse{Users: []domain.User{}, Page: 1, PageSize: 20}, nil)
+
+	h := NewUserHandler(new(MockCreateUserUseCase), new(MockGetUserUseCase), mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
+	req := httptest.NewRequest(http.MethodGet, testUserResourcePath+"?email=sarah@example.com&q=sar&createdAfter=2026-01-01T00:00:00Z&sort=-lastName,createdAt", nil)
+	rr := httptest.NewRecorder()
+
+	h.ListUsers(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	mockListUC.AssertExpectations(t)
+}
+
+func TestUserHandler_ListUsers_InvalidFilters(t *testing.T) {
+	codec := contract.NewCursorCodec(nil)
+	cursor := codec.Encode(domain.Cursor{Time: time.Now(), ID: "id"}, contract.UserListCursorScope(sharedctx.DefaultTenantID, domain.UserFilter{}))
+
+	tests := []struct {
+		name      string
+		query     string
+		wantField string
+		wantCode  string
+	}{
+		{name: "unknown sort field", query: "?sort=password", wantField: "sort", wantCode: contract.CodeValInvalidFormat},
+		{name: "invalid email", query: "?email=nope", wantField: "email", wantCode: contract.CodeValInvalidEmail},
+		{name: "invalid createdBefore", query: "?createdBefore=tomorrow", wantField: "createdBefore", wantCode: contract.CodeValInvalidFormat},
+		{name: "custom sort with cursor", query: "?sort=lastName&cursor=" + cursor, wantField: "sort", wantCode: contract.CodeValInvalidFormat},
+		{name: "unknown field", query: "?fields=id,password", wantField: "fields", wantCode: contract.CodeValInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockListUC := new(MockListUsersUseCase)
+			h := NewUserHandler(new(MockCreateUserUseCase), new(MockGetUserUseCase), mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath, WithCursorCodec(codec))
+			rr := httptest.NewRecorder()
+
+			h.ListUsers(rr, httptest.NewRequest(http.MethodGet, testUserResourcePath+tt.query, nil))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			var problem testProblemDetail
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+			require.Len(t, problem.ValidationErrors, 1)
+			assert.Equal(t, tt.wantField, problem.ValidationErrors[0].Field)
+			assert.Equal(t, tt.wantCode, problem.ValidationErrors[0].Code)
+			mockListUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUserHandler_ListUsers_Fields(t *testing.T) {
+	mockListUC := new(MockListUsersUseCase)
+	mockListUC.On("Execute", mock.Anything, mock.Anything).
+		Return(user.ListUsersResponse{Users: []domain.User{createTestUser()}, TotalCount: 1, Page: 1, PageSize: 20}, nil)
+
+	h := NewUserHandler(new(MockCreateUserUseCase), new(MockGetUserUseCase), mockListUC, new(MockUpdateUserUseCase), new(MockDeleteUserUseCase), testUserResourcePath)
+	rr := httptest.NewRecorder()
+
+	h.ListUsers(rr, httptest.NewRequest(http.MethodGet, testUserResourcePath+"?fields=email,id", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var resp struct {
+		Data       []map[string]any            `json:"data"`
+		Pagination contract.PaginationResponse `json:"pagination"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp.Data, 1)
+	assert.Len(t, resp.Data[0], 2)
+	assert.Equal(t, "test@example.com", resp.Data[0]["email"])
+	assert.Contains(t, resp.Data[0], "id")
+	assert.Equal(t, 1, resp.Pagination.TotalItems, "pagination is not affected by fields")
+	assert.NotEmpty(t, rr.Header().Get("ETag"))
+}
@@ -0,0 +1,295 @@
+//go:build !integration
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/user"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// =============================================================================
+// UpdateUser (PUT) and PatchUser (PATCH) Handler Tests
+// =============================================================================
+
+func newUserIDRequest(method, userID, body string) *http.Request {
+	req := httptest.NewRequest(method, testUserResourcePath+"/"+userID, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", userID)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func newUserHandlerForUpdate(mockUpdateUC *MockUpdateUserUseCase) *UserHandler {
+	return NewUserHandler(
+		new(MockCreateUserUseCase),
+		new(MockGetUserUseCase),
+		new(MockListUsersUseCase),
+		mockUpdateUC,
+		new(MockDeleteUserUseCase),
+		testUserResourcePath,
+	)
+}
+
+func TestUserHandler_UpdateUser_Success(t *testing.T) {
+	mockUpdateUC := new(MockUpdateUserUseCase)
+	expectedUser := createTestUser()
+	expectedUser.Email = "new@example.com"
+	expectedUser.Version = 2
+
+	mockUpdateUC.On("Execute", mock.Anything, mock.MatchedBy(func(req user.UpdateUserRequest) bool {
+		return req.ID == expectedUser.ID && req.Version == 1 &&
+			req.Email != nil && *req.Email == "new@example.com" &&
+			req.FirstName != nil && *req.FirstName == "John" &&
+			req.LastName != nil && *req.LastName == "Doe"
+	})).Return(user.UpdateUserResponse{User: expectedUser}, nil)
+
+	h := newUserHandlerForUpdate(mockUpdateUC)
+	req := newUserIDRequest(http.MethodPut, string(expectedUser.ID),
+		`{"email":"new@example.com","firstName":"John","lastName":"Doe"}`)
+	req.Header.Set("If-Match", `"1"`)
+	rr := httptest.NewRecorder()
+
+	h.UpdateUser(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	assert.Equal(t, `"2"`, rr.Header().Get("ETag"), "response carries the new version")
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "new@example.com", data["email"])
+
+	mockUpdateUC.AssertExpectations(t)
+}
+
+func TestUserHandler_UpdateUser_MissingField(t *testing.T) {
+	mockUpdateUC := new(MockUpdateUserUseCase)
+	h := newUserHandlerForUpdate(mockUpdateUC)
+
+	req := newUserIDRequest(http.MethodPut, "019400a0-1234-7abc-8def-1234567890ab",
+		`{"email":"new@example.com","firstName":"John"}`)
+	req.Header.Set("If-Match", `"1"`)
+	rr := httptest.NewRecorder()
+
+	h.UpdateUser(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	var problemResp testProblemDetail
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problemResp))
+	require.NotEmpty(t, problemResp.ValidationErrors)
+	assert.Equal(t, "lastName", problemResp.ValidationErrors[0].Field)
+
+	mockUpdateUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestUserHandler_UpdateUser_InvalidID(t *testing.T) {
+	mockUpdateUC := new(MockUpdateUserUseCase)
+	h := newUserHandlerForUpdate(mockUpdateUC)
+
+	req := newUserIDRequest(http.MethodPut, "not-a-uuid",
+		`{"email":"new@example.com","firstName":"John","lastName":"Doe"}`)
+	req.Header.Set("If-Match", `"1"`)
+	rr := httptest.NewRecorder()
+
+	h.UpdateUser(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	mockUpdateUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestUserHandler_UpdateUser_Forbidden(t *testing.T) {
+	mockUpdateUC := new(MockUpdateUserUseCase)
+	mockUpdateUC.On("Execute", mock.Anything, mock.Anything).
+		Return(user.UpdateUserResponse{}, &app.AppError{
+			Op:      user.OpUpdateUser,
+			Code:    app.CodeForbidden,
+			Message: "Access denied",
+		})
+
+	h := newUserHandlerForUpdate(mockUpdateUC)
+	req := newUserIDRequest(http.MethodPut, "019400a0-1234-7abc-8def-1234567890ab",
+		`{"email":"new@example.com","firstName":"John","lastName":"Doe"}`)
+	req.Header.Set("If-Match", `"1"`)
+	rr := httptest.NewRecorder()
+
+	h.UpdateUser(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+}
+
+func TestUserHandler_PatchUser_PartialUpdate(t *testing.T) {
+	mockUpdateUC := new(MockUpdateUserUseCase)
+	expectedUser := createTestUser()
+	expectedUser.FirstName = "Jane"
+
+	mockUpdateUC.On("Execute", mock.Anything, mock.MatchedBy(func(req user.UpdateUserRequest) bool {
+		return req.ID == expectedUser.ID &&
+			req.Email == nil &&
+			req.FirstName != nil && *req.FirstName == "Jane" &&
+			req.LastName == nil
+	})).Return(user.UpdateUserResponse{User: expectedUser}, nil)
+
+	h := newUserHandlerForUpdate(mockUpdateUC)
+	req := newUserIDRequest(http.MethodPatch, string(expectedUser.ID), `{"firstName":"Jane"}`)
+	req.Header.Set("If-Match", `"1"`)
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	rr := httptest.NewRecorder()
+
+	h.PatchUser(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	data := resp["data"].(map[string]interface{})
+	assert.Equal(t, "Jane", data["firstName"])
+
+	mockUpdateUC.AssertExpectations(t)
+}
+
+func TestUserHandler_PatchUser_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantField string
+	}{
+		{name: "explicit null is rejected", body: `{"lastName":null}`, wantField: "lastName"},
+		{name: "invalid email", body: `{"email":"not-an-email"}`, wantField: "email"},
+		{name: "empty first name", body: `{"firstName":""}`, wantField: "firstName"},
+		{name: "unknown field", body: `{"nickname":"JD"}`, wantField: "nickname"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUpdateUC := new(MockUpdateUserUseCase)
+			h := newUserHandlerForUpdate(mockUpdateUC)
+
+			req := newUserIDRequest(http.MethodPatch, "019400a0-1234-7abc-8def-1234567890ab", tt.body)
+			req.Header.Set("If-Match", `"1"`)
+			rr := httptest.NewRecorder()
+
+			h.PatchUser(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+			var problemResp testProblemDetail
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problemResp))
+			require.NotEmpty(t, problemResp.ValidationErrors)
+			assert.Equal(t, tt.wantField, problemResp.ValidationErrors[0].Field)
+
+			mockUpdateUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUserHandler_PatchUser_EmailConflict(t *testing.T) {
+	mockUpdateUC := new(MockUpdateUserUseCase)
+	mockUpdateUC.On("Execute", mock.Anything, mock.Anything).
+		Return(user.UpdateUserResponse{}, &app.AppError{
+			Op:      user.OpUpdateUser,
+			Code:    app.CodeEmailExists,
+			Message: "Email already exists",
+			Err:     domain.ErrEmailAlreadyExists,
+		})
+
+	h := newUserHandlerForUpdate(mockUpdateUC)
+	req := newUserIDRequest(http.MethodPatch, "019400a0-1234-7abc-8def-1234567890ab", `{"email":"taken@example.com"}`)
+	req.Header.Set("If-Match", `"1"`)
+	rr := httptest.NewRecorder()
+
+	h.PatchUser(rr, req)
+
+	assert.Equal(t, http.StatusConflict, rr.Code)
+
+	var problemResp testProblemDetail
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problemResp))
+	assert.Equal(t, contract.CodeUsrEmailExists, problemResp.Code)
+}
+
+func TestUserHandler_UpdateUser_IfMatchPreconditions(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		ifMatch    string
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "PUT without If-Match", method: http.MethodPut, wantStatus: http.StatusPreconditionRequired, wantCode: contract.CodeValPreconditionRequired},
+		{name: "PATCH without If-Match", method: http.MethodPatch, wantStatus: http.StatusPreconditionRequired, wantCode: contract.CodeValPreconditionRequired},
+		{name: "weak ETag never matches", method: http.MethodPut, ifMatch: `W/"1"`, wantStatus: http.StatusPreconditionFailed, wantCode: contract.CodeUsrVersionMismatch},
+		{name: "wildcard is rejected", method: http.MethodPatch, ifMatch: "*", wantStatus: http.StatusPreconditionFailed, wantCode: contract.CodeUsrVersionMismatch},
+		{name: "non-numeric ETag", method: http.MethodPut, ifMatch: `"abc"`, wantStatus: http.StatusPreconditionFailed, wantCode: contract.CodeUsrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUpdateUC := new(MockUpdateUserUseCase)
+			h := newUserHandlerForUpdate(mockUpdateUC)
+
+			req := newUserIDRequest(tt.method, "019400a0-1234-7abc-8def-1234567890ab",
+				`{"email":"new@example.com","firstName":"John","lastName":"Doe"}`)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rr := httptest.NewRecorder()
+
+			if tt.method == http.MethodPut {
+				h.UpdateUser(rr, req)
+			} else {
+				h.PatchUser(rr, req)
+			}
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+
+			var problemResp testProblemDetail
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problemResp))
+			assert.Equal(t, tt.wantCode, problemResp.Code)
+
+			mockUpdateUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUserHandler_PatchUser_StaleVersion(t *testing.T) {
+	mockUpdateUC := new(MockUpdateUserUseCase)
+	mockUpdateUC.On("Execute", mock.Anything, mock.MatchedBy(func(req user.UpdateUserRequest) bool {
+		return req.Version == 3
+	})).Return(user.UpdateUserResponse{}, &app.AppError{
+		Op:      user.OpUpdateUser,
+		Code:    app.CodeVersionMismatch,
+		Message: "User was modified since it was last read",
+		Err:     domain.ErrUserVersionMismatch,
+	})
+
+	h := newUserHandlerForUpdate(mockUpdateUC)
+	req := newUserIDRequest(http.MethodPatch, "019400a0-1234-7abc-8def-1234567890ab", `{"firstName":"Jane"}`)
+	req.Header.Set("If-Match", `"3"`)
+	rr := httptest.NewRecorder()
+
+	h.PatchUser(rr, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, rr.Code)
+
+	var problemResp testProblemDetail
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problemResp))
+	assert.Equal(t, contract.CodeUsrVersionMismatch, problemResp.Code)
+	mockUpdateUC.AssertExpectations(t)
+}
@@ -4,6 +4,7 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strings"
@@ -12,6 +13,8 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 
 	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/lockout"
+	"github.com/iruldev/golang-api-hexagonal/internal/shared/metrics"
 
 	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
 	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
@@ -29,9 +32,33 @@ func isClaimsValidated(ctx context.Context) bool {
 	return ok && val
 }
 
-// AllowedAlgorithm is the only allowed JWT signing method (HS256).
+// AllowedAlgorithm is the only allowed JWT signing method when verifying with
+// a shared Secret (HS256).
 const AllowedAlgorithm = "HS256"
 
+// AsymmetricAlgorithms are the JWT signing methods accepted when verifying
+// with a KeySource. HS256 is never accepted in this mode, so a public key can
+// not be abused as an HMAC secret.
+var AsymmetricAlgorithms = []string{"RS256", "ES256", "EdDSA"}
+
+// KeySource resolves the public key that verifies a token signed by an
+// identity provider, e.g. from a JWKS document.
+type KeySource interface {
+	// Key returns the key for kid (the token's kid header) if it may verify
+	// alg, or an error otherwise. The key must be of the type jwt expects for
+	// alg: *rsa.PublicKey, *ecdsa.PublicKey or ed25519.PublicKey.
+	Key(ctx context.Context, kid, alg string) (any, error)
+}
+
+// RevocationChecker reports whether a verified token has been revoked before
+// its expiry, e.g. from a denylist of jti claims and subject cutoffs.
+type RevocationChecker interface {
+	// IsRevoked reports whether the token with the given tenant_id, jti, sub
+	// and iat claims is revoked by a revocation of that tenant. jti may be
+	// empty; a zero issuedAt means no iat claim.
+	IsRevoked(ctx context.Context, tenantID, jti, subject string, issuedAt time.Time) (bool, error)
+}
+
 // NormalizeRole lowercases and trims a role string for consistent comparisons.
 func NormalizeRole(role string) string {
 	return strings.ToLower(strings.TrimSpace(role))
@@ -40,9 +67,29 @@ func NormalizeRole(role string) string {
 // JWTAuthConfig holds configuration for JWTAuth middleware.
 type JWTAuthConfig struct {
 	// Secret is the key used for JWT signature validation (HS256).
+	// It is ignored when Keys or KeySource is set.
 	Secret []byte
+	// Keys is an ordered HS256 keyring: the primary key first, then previous
+	// keys still accepted while tokens signed with them expire. Tokens are
+	// verified by their kid header, or by trying each key when the kid is
+	// absent or unknown. It is ignored when KeySource is set.
+	Keys []HMACKey
+	// KeySource verifies RS256, ES256 and EdDSA tokens by their kid header.
+	// When nil, tokens are verified with Secret (HS256).
+	KeySource KeySource
+	// Introspector validates tokens as opaque tokens with the authorization
+	// server instead of verifying them as JWTs. When set, Secret, Keys,
+	// KeySource, Issuer, Audience and ClockSkew are ignored.
+	Introspector TokenIntrospector
 	// Logger for authentication events.
 	Logger *slog.Logger
+	// Metrics records which key verified each token. Optional.
+	Metrics metrics.AuthMetrics
+	// Revocations rejects revoked tokens. Optional.
+	Revocations RevocationChecker
+	// Lockout locks out client IPs presenting invalid tokens and subjects
+	// presenting revoked ones. Optional.
+	Lockout LockoutTracker
 	// Now provides the current time for token validation.
 	Now func() time.Time
 	// Issuer is the expected issuer claim. If non-empty, tokens must match.
@@ -51,6 +98,10 @@ type JWTAuthConfig struct {
 	Audience string
 	// ClockSkew is the tolerance for expired tokens.
 	ClockSkew time.Duration
+	// DefaultTenantID is assigned to tokens without a tenant_id claim, for
+	// single-tenant deployments whose identity provider issues none. When
+	// empty (the default), such tokens are rejected with 401.
+	DefaultTenantID string
 }
 
 // Note: test-only helpers for marking claims validated live in _test.go files.
@@ -59,16 +110,31 @@ type JWTAuthConfig struct {
 // JWTAuth returns middleware that validates JWT tokens from the Authorization header.
 //
 // Security considerations:
-//   - Only HS256 algorithm is accepted (prevents algorithm confusion attacks)
+//   - Only HS256 is accepted with a Secret, and only RS256, ES256 and EdDSA with
+//     a KeySource (prevents algorithm confusion attacks)
+//   - With a KeySource, tokens must name their key in the kid header
+//   - With Keys, a token naming a retired key is rejected without trying others
+//   - With an Introspector, only tokens it reports active are accepted; if it
+//     cannot tell, the request fails with 503 (fail closed)
 //   - Expiration (exp) claim is required
+//   - A tenant_id claim is required unless DefaultTenantID is set
 //   - Issuer (iss) and Audience (aud) are validated if configured
 //   - Clock skew tolerance is configurable
+//   - With Revocations, revoked tokens are rejected; if revocation status is
+//     unknown the request fails with 503 (fail closed)
+//   - With Lockout, invalid credentials count as failures of the client IP and
+//     revoked tokens also as failures of their subject; a locked out client IP
+//     gets 429 and a locked out subject 401, both with Retry-After
 //   - No error details are exposed in responses (prevents enumeration/timing attacks)
 //   - Token must be in Authorization: Bearer <token> format
 func JWTAuth(cfg JWTAuthConfig) func(http.Handler) http.Handler {
 	// Build parser options
+	validMethods := []string{AllowedAlgorithm}
+	if cfg.KeySource != nil {
+		validMethods = AsymmetricAlgorithms
+	}
 	parserOptions := []jwt.ParserOption{
-		jwt.WithValidMethods([]string{AllowedAlgorithm}),
+		jwt.WithValidMethods(validMethods),
 		jwt.WithExpirationRequired(), // AC #1: Reject tokens without exp
 		jwt.WithTimeFunc(cfg.Now),
 	}
@@ -90,9 +156,35 @@ func JWTAuth(cfg JWTAuthConfig) func(http.Handler) http.Handler {
 
 	parser := jwt.NewParser(parserOptions...)
 	logger := cfg.Logger
+	keyring := newHMACKeyring(cfg)
+
+	// fail counts a failed attempt of r's client IP and the given subjects.
+	fail := func(r *http.Request, subjects ...string) {
+		if cfg.Lockout == nil {
+			return
+		}
+		keys := []lockout.Key{ipLockoutKey(r)}
+		for _, subject := range subjects {
+			keys = append(keys, subjectLockoutKey(subject))
+		}
+		cfg.Lockout.Failure(r.Context(), keys...)
+	}
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The caller already authenticated, e.g. with an API key.
+			if isClaimsValidated(r.Context()) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if cfg.Lockout != nil {
+				if err := cfg.Lockout.Check(r.Context(), ipLockoutKey(r)); err != nil {
+					writeLockedOut(w, r, err, "Bearer")
+					return
+				}
+			}
+
 			// Extract token from Authorization header (AC #1)
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" {
@@ -106,6 +198,7 @@ func JWTAuth(cfg JWTAuthConfig) func(http.Handler) http.Handler {
 			if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
 				// Story 2.9: Never log Authorization header - it contains sensitive tokens
 				logger.WarnContext(r.Context(), "auth failed: invalid header format")
+				fail(r)
 				writeUnauthorized(w, r)
 				return
 			}
@@ -114,14 +207,29 @@ func JWTAuth(cfg JWTAuthConfig) func(http.Handler) http.Handler {
 
 			// Parse and validate token
 			claims := &ctxutil.Claims{}
-			token, err := parser.ParseWithClaims(tokenString, claims, func(_ *jwt.Token) (interface{}, error) {
-				return cfg.Secret, nil
-			})
+			var keyID, match string
+			var err error
+			switch {
+			case cfg.Introspector != nil:
+				err = introspect(r.Context(), cfg.Introspector, tokenString, claims)
+				var unavailable *introspectionError
+				if errors.As(err, &unavailable) {
+					logger.ErrorContext(r.Context(), "auth failed: token introspection unavailable", "error", err)
+					writeAuthUnavailable(w, r)
+					return
+				}
+				keyID, match = KeyMatchIntrospection, KeyMatchIntrospection
+			case cfg.KeySource != nil:
+				keyID, match, err = verifyWithKeySource(r.Context(), parser, cfg.KeySource, tokenString, claims)
+			default:
+				keyID, match, err = keyring.verify(parser, tokenString, claims)
+			}
 
-			if err != nil || !token.Valid {
+			if err != nil {
 				// Return 401 for any validation failure (malformed, wrong signature, expired, wrong iss/aud)
 				// Do NOT expose the specific reason for failure (security requirement)
 				logger.WarnContext(r.Context(), "auth failed: invalid token", "error", err)
+				fail(r)
 				writeUnauthorized(w, r)
 				return
 			}
@@ -129,13 +237,57 @@ func JWTAuth(cfg JWTAuthConfig) func(http.Handler) http.Handler {
 			// Store claims in context for downstream handlers
 			if strings.TrimSpace(claims.Subject) == "" {
 				logger.WarnContext(r.Context(), "auth failed: empty subject in claims")
+				fail(r)
 				writeUnauthorized(w, r)
 				return
 			}
 
+			// Every request is scoped to a tenant; tokens must not fall into a
+			// shared one unless the deployment opted in.
+			claims.TenantID = strings.TrimSpace(claims.TenantID)
+			if claims.TenantID == "" {
+				if cfg.DefaultTenantID == "" {
+					logger.WarnContext(r.Context(), "auth failed: no tenant in claims", "subject", claims.Subject)
+					writeUnauthorized(w, r)
+					return
+				}
+				claims.TenantID = cfg.DefaultTenantID
+			}
+
+			if cfg.Revocations != nil {
+				var issuedAt time.Time
+				if claims.IssuedAt != nil {
+					issuedAt = claims.IssuedAt.Time
+				}
+				revoked, err := cfg.Revocations.IsRevoked(r.Context(), claims.TenantID, claims.ID, claims.Subject, issuedAt)
+				if err != nil {
+					logger.ErrorContext(r.Context(), "auth failed: revocation status unavailable", "error", err)
+					writeAuthUnavailable(w, r)
+					return
+				}
+				if revoked {
+					logger.WarnContext(r.Context(), "auth failed: token revoked", "subject", claims.Subject)
+					fail(r, claims.Subject)
+					writeUnauthorized(w, r)
+					return
+				}
+			}
+
+			if cfg.Lockout != nil {
+				if err := cfg.Lockout.Check(r.Context(), subjectLockoutKey(claims.Subject)); err != nil {
+					logger.WarnContext(r.Context(), "auth failed: subject locked out", "subject", claims.Subject)
+					writeLockedOut(w, r, err, "Bearer")
+					return
+				}
+			}
+
 			normalizedRole := NormalizeRole(claims.Role)
 			claims.Role = normalizedRole
 
+			if cfg.Metrics != nil {
+				cfg.Metrics.IncTokenVerified(keyID, match)
+			}
+
 			// Only JWTAuth should mark claims validated in production.
 			ctx := ctxutil.SetClaims(r.Context(), claims)
 			ctx = setValidatedClaims(ctx)
@@ -144,10 +296,50 @@ func JWTAuth(cfg JWTAuthConfig) func(http.Handler) http.Handler {
 	}
 }
 
+// verifyWithKeySource parses tokenString into claims with the public key named
+// by its kid header. The parser checks the algorithm against the valid methods
+// before the key is looked up.
+func verifyWithKeySource(ctx context.Context, parser *jwt.Parser, source KeySource, tokenString string, claims *ctxutil.Claims) (keyID, match string, err error) {
+	token, err := parser.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		keyID, _ = token.Header["kid"].(string)
+		if keyID == "" {
+			return nil, errMissingKeyID
+		}
+		return source.Key(ctx, keyID, token.Method.Alg())
+	})
+	if err != nil || !token.Valid {
+		return "", "", invalidTokenError(err)
+	}
+	return keyID, KeyMatchKID, nil
+}
+
+// errMissingKeyID is returned for asymmetric tokens without a kid header.
+var errMissingKeyID = errors.New("token has no kid header")
+
+// writeAuthUnavailable writes a 503 RFC 7807 response when credentials cannot
+// be checked, e.g. when it is unknown whether a token has been revoked.
+func writeAuthUnavailable(w http.ResponseWriter, r *http.Request) {
+	problem := contract.NewProblem(http.StatusServiceUnavailable, "Service Unavailable", "Authentication is temporarily unavailable. Please retry later.")
+	problem.Type = contract.ProblemTypeURL(contract.ProblemTypeServiceUnavailableSlug)
+	problem.Code = contract.CodeSysUnavailable
+	problem.Instance = r.URL.Path
+	problem.RequestID = ctxutil.GetRequestID(r.Context())
+	if traceID := ctxutil.GetTraceID(r.Context()); traceID != "" && traceID != ctxutil.EmptyTraceID {
+		problem.TraceID = traceID
+	}
+	contract.WriteProblem(w, problem)
+}
+
 // writeUnauthorized writes an RFC 7807 error response for authentication failures.
 // It intentionally provides no detail about why authentication failed (security requirement).
 func writeUnauthorized(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("WWW-Authenticate", "Bearer")
+	writeUnauthorizedChallenge(w, r, "Bearer")
+}
+
+// writeUnauthorizedChallenge is writeUnauthorized with the given
+// WWW-Authenticate challenge.
+func writeUnauthorizedChallenge(w http.ResponseWriter, r *http.Request, challenge string) {
+	w.Header().Set("WWW-Authenticate", challenge)
 	contract.WriteProblemJSON(w, r, &app.AppError{
 		Op:      "JWTAuth",
 		Code:    app.CodeUnauthorized,
@@ -0,0 +1,131 @@
+// Package middleware provides HTTP middleware for the transport layer.
+// This file implements API key authentication for callers that cannot use JWTs.
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
+)
+
+// APIKeyHeader is the header carrying an API key.
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyScheme is the Authorization scheme carrying an API key, as in
+// "Authorization: ApiKey <key>".
+const APIKeyScheme = "ApiKey"
+
+// APIKeyAuthenticator resolves API keys presented by callers.
+type APIKeyAuthenticator interface {
+	// Authenticate returns the active key matching key. It returns an
+	// AppError with Code=UNAUTHORIZED for an unknown, revoked or expired key;
+	// any other error means the key could not be checked.
+	Authenticate(ctx context.Context, key string) (domain.APIKey, error)
+}
+
+// APIKeyAuthConfig holds configuration for APIKeyAuth middleware.
+type APIKeyAuthConfig struct {
+	// Authenticator resolves the presented keys.
+	Authenticator APIKeyAuthenticator
+	// Logger for authentication events.
+	Logger *slog.Logger
+	// Lockout locks out client IPs presenting invalid keys. Optional.
+	Lockout LockoutTracker
+}
+
+// APIKeyAuth returns middleware that authenticates requests presenting an API
+// key in the X-API-Key header or as "Authorization: ApiKey <key>".
+//
+// On success it stores claims for the key (subject is the key ID, role and
+// tenant are the key's) exactly like JWTAuth, so JWTAuth lets the request through and
+// AuthContextBridge builds the same app.AuthContext for use cases. Requests
+// without an API key are passed on unchanged for JWTAuth to handle, so
+// APIKeyAuth must run before JWTAuth.
+//
+// An invalid key, or an X-API-Key header combined with an Authorization
+// header, is rejected with 401. If the key cannot be checked the request fails
+// with 503 (fail closed). With Lockout, invalid keys count as failures of the
+// client IP, and a locked out client IP is rejected with 429.
+func APIKeyAuth(cfg APIKeyAuthConfig) func(http.Handler) http.Handler {
+	logger := cfg.Logger
+
+	// fail counts a failed attempt of r's client IP.
+	fail := func(r *http.Request) {
+		if cfg.Lockout != nil {
+			cfg.Lockout.Failure(r.Context(), ipLockoutKey(r))
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key, present, ok := apiKeyFromRequest(r)
+			if !present {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if cfg.Lockout != nil {
+				if err := cfg.Lockout.Check(r.Context(), ipLockoutKey(r)); err != nil {
+					writeLockedOut(w, r, err, APIKeyScheme)
+					return
+				}
+			}
+			if !ok {
+				// Never log the headers - they contain credentials.
+				logger.WarnContext(r.Context(), "auth failed: ambiguous or malformed api key")
+				fail(r)
+				writeUnauthorizedChallenge(w, r, APIKeyScheme)
+				return
+			}
+
+			apiKey, err := cfg.Authenticator.Authenticate(r.Context(), key)
+			if err != nil {
+				var appErr *app.AppError
+				if errors.As(err, &appErr) && appErr.Code == app.CodeUnauthorized {
+					logger.WarnContext(r.Context(), "auth failed: invalid api key")
+					fail(r)
+					writeUnauthorizedChallenge(w, r, APIKeyScheme)
+					return
+				}
+				logger.ErrorContext(r.Context(), "auth failed: api key lookup unavailable", "error", err)
+				writeAuthUnavailable(w, r)
+				return
+			}
+
+			claims := &ctxutil.Claims{
+				RegisteredClaims: jwt.RegisteredClaims{Subject: string(apiKey.ID)},
+				Role:             NormalizeRole(apiKey.Role),
+				Scope:            strings.Join(apiKey.Scopes, " "),
+				TenantID:         apiKey.TenantID,
+			}
+			ctx := ctxutil.SetClaims(r.Context(), claims)
+			ctx = setValidatedClaims(ctx)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// apiKeyFromRequest returns the API key of r. present reports whether r
+// carries an API key at all; ok is false if it does but the key is empty or
+// the request also carries other credentials.
+func apiKeyFromRequest(r *http.Request) (key string, present, ok bool) {
+	authHeader := r.Header.Get("Authorization")
+	if values := r.Header.Values(APIKeyHeader); len(values) > 0 {
+		key = strings.TrimSpace(values[0])
+		return key, true, len(values) == 1 && key != "" && authHeader == ""
+	}
+
+	scheme, credentials, found := strings.Cut(authHeader, " ")
+	if !found || !strings.EqualFold(scheme, APIKeyScheme) {
+		return "", false, false
+	}
+	key = strings.TrimSpace(credentials)
+	return key, true, key != ""
+}
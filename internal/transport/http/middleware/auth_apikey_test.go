@@ -0,0 +1,149 @@
+//go:build !integration
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// API key authentication tests.
+
+const testAPIKey = "ak_partner-key"
+
+// stubAPIKeys accepts testAPIKey and records the keys it was asked about.
+type stubAPIKeys struct {
+	err  error
+	keys []string
+}
+
+func (s *stubAPIKeys) Authenticate(_ context.Context, key string) (domain.APIKey, error) {
+	s.keys = append(s.keys, key)
+	if s.err != nil {
+		return domain.APIKey{}, s.err
+	}
+	if key != testAPIKey {
+		return domain.APIKey{}, &app.AppError{Code: app.CodeUnauthorized, Message: "Invalid API key"}
+	}
+	return domain.APIKey{ID: "key-1", Role: " Admin ", TenantID: "tenant-a", Scopes: []string{"users:read", "api_keys:read"}}, nil
+}
+
+// serveWithAPIKeys runs a request through APIKeyAuth, JWTAuth and
+// AuthContextBridge, as the router does, and returns the resulting
+// AuthContext.
+func serveWithAPIKeys(authenticator APIKeyAuthenticator, header http.Header) (*httptest.ResponseRecorder, *app.AuthContext) {
+	var authCtx *app.AuthContext
+	handler := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		authCtx = app.GetAuthContext(r.Context())
+	})
+
+	chain := APIKeyAuth(APIKeyAuthConfig{Authenticator: authenticator, Logger: noopLogger()})(
+		JWTAuth(testJWTAuthConfig())(AuthContextBridge(handler)))
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	for name, values := range header {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
+	rr := httptest.NewRecorder()
+	chain.ServeHTTP(rr, req)
+	return rr, authCtx
+}
+
+func TestAPIKeyAuth_Accepts(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+	}{
+		{name: "X-API-Key header", header: http.Header{"X-Api-Key": {testAPIKey}}},
+		{name: "Authorization ApiKey", header: http.Header{"Authorization": {"ApiKey " + testAPIKey}}},
+		{name: "scheme is case-insensitive", header: http.Header{"Authorization": {"apikey " + testAPIKey}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := &stubAPIKeys{}
+
+			rr, authCtx := serveWithAPIKeys(keys, tt.header)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			require.NotNil(t, authCtx)
+			assert.Equal(t, "key-1", authCtx.SubjectID)
+			assert.Equal(t, app.RoleAdmin, authCtx.Role)
+			assert.Equal(t, "tenant-a", authCtx.TenantID)
+			assert.Equal(t, []string{"users:read", "api_keys:read"}, authCtx.Scopes, "callers get the key's scopes")
+			assert.Equal(t, []string{testAPIKey}, keys.keys)
+		})
+	}
+}
+
+func TestAPIKeyAuth_Rejects(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     http.Header
+		wantLookup bool
+	}{
+		{name: "unknown key", header: http.Header{"X-Api-Key": {"ak_other"}}, wantLookup: true},
+		{name: "empty X-API-Key", header: http.Header{"X-Api-Key": {" "}}},
+		{name: "empty ApiKey credentials", header: http.Header{"Authorization": {"ApiKey "}}},
+		{name: "repeated X-API-Key", header: http.Header{"X-Api-Key": {testAPIKey, testAPIKey}}},
+		{
+			name:   "X-API-Key with a bearer token",
+			header: http.Header{"X-Api-Key": {testAPIKey}, "Authorization": {"Bearer token"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := &stubAPIKeys{}
+
+			rr, authCtx := serveWithAPIKeys(keys, tt.header)
+
+			assert.Equal(t, http.StatusUnauthorized, rr.Code)
+			assert.Equal(t, APIKeyScheme, rr.Header().Get("WWW-Authenticate"))
+			assert.Nil(t, authCtx)
+			assert.Equal(t, tt.wantLookup, len(keys.keys) > 0)
+		})
+	}
+}
+
+func TestAPIKeyAuth_UnavailableFailsClosed(t *testing.T) {
+	rr, authCtx := serveWithAPIKeys(&stubAPIKeys{err: errors.New("db down")}, http.Header{"X-Api-Key": {testAPIKey}})
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Contains(t, rr.Body.String(), contract.CodeSysUnavailable)
+	assert.Nil(t, authCtx)
+}
+
+func TestAPIKeyAuth_PassesBearerTokensToJWTAuth(t *testing.T) {
+	keys := &stubAPIKeys{}
+
+	rr, authCtx := serveWithAPIKeys(keys, http.Header{"Authorization": {"Bearer " + generateValidToken(t, time.Hour)}})
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.NotNil(t, authCtx)
+	assert.Equal(t, "user-123", authCtx.SubjectID)
+	assert.Empty(t, keys.keys)
+}
+
+func TestAPIKeyAuth_WithoutCredentials(t *testing.T) {
+	keys := &stubAPIKeys{}
+
+	rr, authCtx := serveWithAPIKeys(keys, nil)
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"), "JWTAuth rejects the request")
+	assert.Nil(t, authCtx)
+	assert.Empty(t, keys.keys)
+}
@@ -0,0 +1,174 @@
+//go:build !integration
+
+package middleware
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
+)
+
+// Asymmetric (KeySource) verification tests.
+
+// stubKeySource resolves keys from a map and records the algorithms asked for.
+type stubKeySource struct {
+	keys map[string]stubKey
+	algs []string
+}
+
+type stubKey struct {
+	alg    string
+	public any
+}
+
+func (s *stubKeySource) Key(_ context.Context, kid, alg string) (any, error) {
+	s.algs = append(s.algs, alg)
+	k, ok := s.keys[kid]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+	if k.alg != alg {
+		return nil, errors.New("algorithm mismatch")
+	}
+	return k.public, nil
+}
+
+type asymmetricKeys struct {
+	rsa    *rsa.PrivateKey
+	ec     *ecdsa.PrivateKey
+	ed     ed25519.PrivateKey
+	source *stubKeySource
+}
+
+func newAsymmetricKeys(t *testing.T) asymmetricKeys {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return asymmetricKeys{
+		rsa: rsaKey,
+		ec:  ecKey,
+		ed:  edKey,
+		source: &stubKeySource{keys: map[string]stubKey{
+			"rsa-1": {alg: "RS256", public: &rsaKey.PublicKey},
+			"ec-1":  {alg: "ES256", public: &ecKey.PublicKey},
+			"ed-1":  {alg: "EdDSA", public: edKey.Public()},
+		}},
+	}
+}
+
+func signTestToken(t *testing.T, method jwt.SigningMethod, kid string, key crypto.PrivateKey) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, &ctxutil.Claims{
+		TenantID: testTenantID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-123",
+			ExpiresAt: jwt.NewNumericDate(fixedTime.Add(time.Hour)),
+			IssuedAt:  jwt.NewNumericDate(fixedTime),
+		},
+		Role: "user",
+	})
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	require.NoError(t, err)
+	return s
+}
+
+func serveWithKeySource(source KeySource, token string) (*httptest.ResponseRecorder, *ctxutil.Claims) {
+	var claims *ctxutil.Claims
+	handler := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		claims = ctxutil.GetClaims(r.Context())
+	})
+
+	cfg := testJWTAuthConfig()
+	cfg.KeySource = source
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := httptest.NewRecorder()
+	JWTAuth(cfg)(handler).ServeHTTP(rr, req)
+	return rr, claims
+}
+
+func TestJWTAuth_KeySource_AcceptsAsymmetricAlgorithms(t *testing.T) {
+	keys := newAsymmetricKeys(t)
+
+	tests := []struct {
+		name   string
+		method jwt.SigningMethod
+		kid    string
+		key    crypto.PrivateKey
+	}{
+		{name: "RS256", method: jwt.SigningMethodRS256, kid: "rsa-1", key: keys.rsa},
+		{name: "ES256", method: jwt.SigningMethodES256, kid: "ec-1", key: keys.ec},
+		{name: "EdDSA", method: jwt.SigningMethodEdDSA, kid: "ed-1", key: keys.ed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr, claims := serveWithKeySource(keys.source, signTestToken(t, tt.method, tt.kid, tt.key))
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			require.NotNil(t, claims)
+			assert.Equal(t, "user-123", claims.Subject)
+		})
+	}
+}
+
+func TestJWTAuth_KeySource_Rejects(t *testing.T) {
+	keys := newAsymmetricKeys(t)
+	otherRSA, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "missing kid", token: signTestToken(t, jwt.SigningMethodRS256, "", keys.rsa)},
+		{name: "unknown kid", token: signTestToken(t, jwt.SigningMethodRS256, "rsa-2", keys.rsa)},
+		{name: "wrong key for kid", token: signTestToken(t, jwt.SigningMethodRS256, "rsa-1", otherRSA)},
+		{name: "algorithm of another key type", token: signTestToken(t, jwt.SigningMethodES256, "rsa-1", keys.ec)},
+		{name: "HS256 is not accepted", token: signTestToken(t, jwt.SigningMethodHS256, "rsa-1", testSecret)},
+		{name: "none is not accepted", token: signTestToken(t, jwt.SigningMethodNone, "rsa-1", jwt.UnsafeAllowNoneSignatureType)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr, claims := serveWithKeySource(keys.source, tt.token)
+
+			assert.Equal(t, http.StatusUnauthorized, rr.Code)
+			assert.Nil(t, claims)
+		})
+	}
+	assert.NotContains(t, keys.source.algs, "HS256", "HS256 tokens must be rejected before key lookup")
+}
+
+func TestJWTAuth_WithoutKeySource_RejectsAsymmetricTokens(t *testing.T) {
+	keys := newAsymmetricKeys(t)
+
+	rr, claims := serveWithKeySource(nil, signTestToken(t, jwt.SigningMethodRS256, "rsa-1", keys.rsa))
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Nil(t, claims)
+}
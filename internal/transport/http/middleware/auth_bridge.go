@@ -4,8 +4,10 @@ package middleware
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
 	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
 )
 
@@ -14,7 +16,9 @@ import (
 // It should be applied AFTER JWTAuth middleware on protected routes.
 //
 // The middleware extracts claims set by JWTAuth and creates an app.AuthContext
-// that use cases can access via app.GetAuthContext(ctx).
+// that use cases can access via app.GetAuthContext(ctx). The tenant of the
+// claims is also set as the tenant of the request; claims without a tenant are
+// rejected with 401 (fail closed), as no tenant is assumed for them.
 func AuthContextBridge(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Only bridge claims that were validated by JWTAuth.
@@ -25,12 +29,21 @@ func AuthContextBridge(next http.Handler) http.Handler {
 
 		claims := ctxutil.GetClaims(r.Context())
 		if claims != nil {
+			tenantID := strings.TrimSpace(claims.TenantID)
+			if tenantID == "" {
+				writeUnauthorized(w, r)
+				return
+			}
 			// Convert transport-layer claims to app-layer auth context
 			authCtx := &app.AuthContext{
 				SubjectID: claims.Subject,             // From jwt.RegisteredClaims
 				Role:      NormalizeRole(claims.Role), // Normalize role for consistency
+				Scopes:    claims.Scopes(),            // From the scope and scp claims
+				TenantID:  tenantID,                   // From the tenant_id claim
 			}
 			ctx := app.SetAuthContext(r.Context(), authCtx)
+			// Repositories scope every query to the tenant of the context.
+			ctx = sharedctx.SetTenantID(ctx, authCtx.TenantID)
 			next.ServeHTTP(w, r.WithContext(ctx))
 			return
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	sharedctx "github.com/iruldev/golang-api-hexagonal/internal/shared/context"
 	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
 )
 
@@ -26,11 +27,13 @@ func TestAuthContextBridge_WithClaims(t *testing.T) {
 
 	// Create claims and set them in context
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   "user-123",
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(1 * time.Hour)),
 		},
-		Role: "admin",
+		Role:  "admin",
+		Scope: "users:read users:write",
 	}
 	ctx := markClaimsValidatedTestOnly(context.Background(), claims)
 	ctx = setValidatedClaims(ctx)
@@ -47,6 +50,52 @@ func TestAuthContextBridge_WithClaims(t *testing.T) {
 	assert.Equal(t, "user-123", capturedAuthCtx.SubjectID)
 	assert.Equal(t, "admin", capturedAuthCtx.Role)
 	assert.True(t, capturedAuthCtx.IsAdmin())
+	assert.Equal(t, []string{"users:read", "users:write"}, capturedAuthCtx.Scopes)
+	assert.Equal(t, testTenantID, capturedAuthCtx.TenantID)
+}
+
+func TestAuthContextBridge_SetsTenant(t *testing.T) {
+	var capturedAuthCtx *app.AuthContext
+	var capturedTenant string
+	handler := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		capturedAuthCtx = app.GetAuthContext(r.Context())
+		capturedTenant = sharedctx.GetTenantID(r.Context())
+	})
+
+	claims := &ctxutil.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "user-123"},
+		Role:             "user",
+		TenantID:         " tenant-a ",
+	}
+	ctx := markClaimsValidatedTestOnly(context.Background(), claims)
+	ctx = setValidatedClaims(ctx)
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil).WithContext(ctx)
+	AuthContextBridge(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+	require.NotNil(t, capturedAuthCtx)
+	assert.Equal(t, "tenant-a", capturedAuthCtx.TenantID)
+	assert.Equal(t, "tenant-a", capturedTenant, "repositories read the tenant from the context")
+}
+
+func TestAuthContextBridge_RejectsClaimsWithoutTenant(t *testing.T) {
+	handlerCalled := false
+	handler := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		handlerCalled = true
+	})
+
+	claims := &ctxutil.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "user-123"},
+		Role:             "admin",
+	}
+	ctx := markClaimsValidatedTestOnly(context.Background(), claims)
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+	AuthContextBridge(handler).ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.False(t, handlerCalled, "claims without a tenant must not reach the handler")
 }
 
 func TestAuthContextBridge_WithoutClaims(t *testing.T) {
@@ -135,6 +184,7 @@ func TestAuthContextBridge_PreservesSubject(t *testing.T) {
 			})
 
 			claims := &ctxutil.Claims{
+				TenantID: testTenantID,
 				RegisteredClaims: jwt.RegisteredClaims{
 					Subject: tt.subject,
 				},
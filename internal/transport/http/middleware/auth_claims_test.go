@@ -29,6 +29,7 @@ func TestJWTAuth_MissingExp(t *testing.T) {
 
 	// Create token WITHOUT exp claim
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:  "user-123",
 			IssuedAt: jwt.NewNumericDate(fixedTime),
@@ -60,6 +61,7 @@ func TestJWTAuth_WrongIssuer(t *testing.T) {
 
 	// Create token with wrong issuer
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   "user-123",
 			Issuer:    "wrong-issuer",
@@ -94,6 +96,7 @@ func TestJWTAuth_WrongAudience(t *testing.T) {
 
 	// Create token with wrong audience
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   "user-123",
 			Audience:  jwt.ClaimStrings{"wrong-audience"},
@@ -130,6 +133,7 @@ func TestJWTAuth_ClockSkew(t *testing.T) {
 
 		// Create token expired 10 seconds ago
 		claims := &ctxutil.Claims{
+			TenantID: testTenantID,
 			RegisteredClaims: jwt.RegisteredClaims{
 				Subject:   "user-123",
 				ExpiresAt: jwt.NewNumericDate(fixedTime.Add(-10 * time.Second)),
@@ -162,6 +166,7 @@ func TestJWTAuth_ClockSkew(t *testing.T) {
 
 		// Create token expired 60 seconds ago
 		claims := &ctxutil.Claims{
+			TenantID: testTenantID,
 			RegisteredClaims: jwt.RegisteredClaims{
 				Subject:   "user-123",
 				ExpiresAt: jwt.NewNumericDate(fixedTime.Add(-60 * time.Second)),
@@ -196,6 +201,7 @@ func TestJWTAuth_CorrectIssuerPasses(t *testing.T) {
 	})
 
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   "user-123",
 			Issuer:    "expected-issuer",
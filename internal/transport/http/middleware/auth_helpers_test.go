@@ -17,6 +17,9 @@ import (
 // testSecret is a 32-byte secret for HS256 signing in tests.
 var testSecret = []byte("test-secret-key-32-bytes-long!!")
 
+// testTenantID is the tenant_id claim of test tokens.
+const testTenantID = "acme"
+
 // fixedTime is the fixed time used in all tests for deterministic behavior.
 var fixedTime = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
 
@@ -52,6 +55,7 @@ func testJWTAuthConfigWith(now func() time.Time) JWTAuthConfig {
 func generateValidToken(t *testing.T, expOffset time.Duration) string {
 	t.Helper()
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   "user-123",
 			ExpiresAt: jwt.NewNumericDate(fixedTime.Add(expOffset)),
@@ -0,0 +1,64 @@
+// Package middleware provides HTTP middleware for the transport layer.
+// This file implements validation of opaque tokens by token introspection.
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
+)
+
+// KeyMatchIntrospection is reported to AuthMetrics, as both key ID and match,
+// for tokens validated by a TokenIntrospector.
+const KeyMatchIntrospection = "introspection"
+
+// TokenIntrospector validates opaque access tokens with the authorization
+// server that issued them (RFC 7662), e.g. introspection.Client.
+type TokenIntrospector interface {
+	// Introspect returns the description of token and whether it is active.
+	// An error means the token's status is unknown.
+	Introspect(ctx context.Context, token string) (domain.IntrospectedToken, bool, error)
+}
+
+// errInactiveToken is returned for tokens the introspection endpoint reports
+// as inactive: unknown, expired or revoked by the authorization server.
+var errInactiveToken = errors.New("token is not active")
+
+// introspect fills claims from the introspection of an opaque token: sub,
+// scope, role, tenant_id, jti, iat and exp. It returns errInactiveToken for an
+// inactive token and an *introspectionError if the status is unknown.
+func introspect(ctx context.Context, introspector TokenIntrospector, token string, claims *ctxutil.Claims) error {
+	t, active, err := introspector.Introspect(ctx, token)
+	if err != nil {
+		return &introspectionError{err: err}
+	}
+	if !active {
+		return errInactiveToken
+	}
+
+	claims.ID = t.ID
+	claims.Subject = t.Subject
+	claims.Role = t.Role
+	claims.Scope = t.Scope
+	claims.TenantID = t.TenantID
+	if !t.IssuedAt.IsZero() {
+		claims.IssuedAt = jwt.NewNumericDate(t.IssuedAt)
+	}
+	if !t.ExpiresAt.IsZero() {
+		claims.ExpiresAt = jwt.NewNumericDate(t.ExpiresAt)
+	}
+	return nil
+}
+
+// introspectionError means the introspection endpoint could not tell whether
+// a token is active.
+type introspectionError struct {
+	err error
+}
+
+func (e *introspectionError) Error() string { return "token introspection failed: " + e.err.Error() }
+func (e *introspectionError) Unwrap() error { return e.err }
@@ -0,0 +1,125 @@
+//go:build !integration
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
+)
+
+// Opaque token introspection tests.
+
+// stubIntrospector reports the listed tokens as active.
+type stubIntrospector struct {
+	tokens map[string]domain.IntrospectedToken
+	err    error
+}
+
+func (s *stubIntrospector) Introspect(_ context.Context, token string) (domain.IntrospectedToken, bool, error) {
+	if s.err != nil {
+		return domain.IntrospectedToken{}, false, s.err
+	}
+	t, ok := s.tokens[token]
+	return t, ok, nil
+}
+
+func newStubIntrospector() *stubIntrospector {
+	return &stubIntrospector{tokens: map[string]domain.IntrospectedToken{
+		"opaque-1": {
+			AccessToken: domain.AccessToken{
+				ID:        "jti-1",
+				Subject:   "user-123",
+				Role:      " Admin ",
+				TenantID:  "tenant-1",
+				IssuedAt:  fixedTime.Add(-time.Minute),
+				ExpiresAt: fixedTime.Add(time.Hour),
+			},
+			Scope:    "users:read users:write",
+			ClientID: "client-1",
+		},
+	}}
+}
+
+func serveWithIntrospector(cfg JWTAuthConfig, token string) (*httptest.ResponseRecorder, *ctxutil.Claims) {
+	var claims *ctxutil.Claims
+	handler := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		claims = ctxutil.GetClaims(r.Context())
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := httptest.NewRecorder()
+	JWTAuth(cfg)(handler).ServeHTTP(rr, req)
+	return rr, claims
+}
+
+func TestJWTAuth_Introspection_MapsClaims(t *testing.T) {
+	cfg := testJWTAuthConfig()
+	cfg.Introspector = newStubIntrospector()
+	authMetrics := &recordingAuthMetrics{}
+	cfg.Metrics = authMetrics
+
+	rr, claims := serveWithIntrospector(cfg, "opaque-1")
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.NotNil(t, claims)
+	assert.Equal(t, "user-123", claims.Subject)
+	assert.Equal(t, "jti-1", claims.ID)
+	assert.Equal(t, "admin", claims.Role)
+	assert.Equal(t, "tenant-1", claims.TenantID)
+	assert.Equal(t, []string{"users:read", "users:write"}, claims.Scopes())
+	assert.True(t, fixedTime.Add(-time.Minute).Equal(claims.IssuedAt.Time))
+	assert.True(t, fixedTime.Add(time.Hour).Equal(claims.ExpiresAt.Time))
+	assert.Equal(t, []string{"introspection/introspection"}, authMetrics.verified)
+}
+
+func TestJWTAuth_Introspection_RejectsInactiveToken(t *testing.T) {
+	cfg := testJWTAuthConfig()
+	cfg.Introspector = newStubIntrospector()
+
+	// A JWT valid for the configured secret is still opaque to the introspector.
+	for _, token := range []string{"unknown", generateValidToken(t, time.Hour)} {
+		rr, claims := serveWithIntrospector(cfg, token)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Nil(t, claims)
+		assert.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"))
+	}
+}
+
+func TestJWTAuth_Introspection_FailsClosedWhenUnavailable(t *testing.T) {
+	cfg := testJWTAuthConfig()
+	cfg.Introspector = &stubIntrospector{err: errors.New("circuit breaker is open")}
+
+	rr, claims := serveWithIntrospector(cfg, "opaque-1")
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Nil(t, claims)
+	assert.Contains(t, rr.Body.String(), contract.CodeSysUnavailable)
+}
+
+func TestJWTAuth_Introspection_ChecksRevocationsAndLockout(t *testing.T) {
+	cfg := testJWTAuthConfig()
+	cfg.Introspector = newStubIntrospector()
+	cfg.Revocations = &stubRevocations{jtis: map[string]bool{"jti-1": true}}
+	cfg.Lockout = newTestLockout()
+
+	for i := 0; i < 2; i++ {
+		rr, _ := serveWithIntrospector(cfg, "opaque-1")
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	}
+
+	rr, _ := serveWithIntrospector(cfg, "opaque-1")
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code, "revoked tokens count as failures")
+}
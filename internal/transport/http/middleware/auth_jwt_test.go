@@ -80,6 +80,7 @@ func TestJWTAuth_InvalidSignature(t *testing.T) {
 	// Create token with different secret
 	wrongSecret := []byte("wrong-secret-key-32-bytes-long!!")
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   "user-123",
 			ExpiresAt: jwt.NewNumericDate(fixedTime.Add(1 * time.Hour)),
@@ -228,6 +229,7 @@ func TestJWTAuth_WrongAlgorithm(t *testing.T) {
 
 	// Create token with HS384 (not allowed)
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   "user-123",
 			ExpiresAt: jwt.NewNumericDate(fixedTime.Add(1 * time.Hour)),
@@ -282,6 +284,7 @@ func TestJWTAuth_TimeInjection(t *testing.T) {
 	// Create a token that expires at a specific time
 	expTime := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   "user-123",
 			ExpiresAt: jwt.NewNumericDate(expTime),
@@ -337,6 +340,7 @@ func TestJWTAuth_TimeInjection(t *testing.T) {
 func TestJWTAuth_GetClaimsFromContext(t *testing.T) {
 	// Create token with specific claims
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    "test-issuer",
 			Subject:   "user-456",
@@ -389,6 +393,7 @@ func TestJWTAuth_EnforcesHS256_ConstantTime(t *testing.T) {
 
 	// Create valid token but with HS512 (arguably also constant time, but we want STRICT whitelist)
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   "user-123",
 			ExpiresAt: jwt.NewNumericDate(fixedTime.Add(1 * time.Hour)),
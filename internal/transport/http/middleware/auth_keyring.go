@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
+)
+
+// How the verifying key of a token was selected, reported to AuthMetrics.
+const (
+	// KeyMatchKID means the token's kid header named the verifying key.
+	KeyMatchKID = "kid"
+	// KeyMatchTrial means the token had no known kid and the key was found by
+	// trying each active key in order.
+	KeyMatchTrial = "trial"
+)
+
+// defaultKeyID labels the verifying key in metrics when it has no ID,
+// i.e. when only JWTAuthConfig.Secret is configured.
+const defaultKeyID = "default"
+
+// HMACKey is one HS256 secret of a keyring.
+type HMACKey struct {
+	// ID is the kid issuers put in the token header. Optional for a single key.
+	ID string
+	// Secret is the HMAC key.
+	Secret []byte
+	// NotAfter is when the key stops verifying tokens. Zero means never.
+	NotAfter time.Time
+}
+
+// retired reports whether the key may no longer verify tokens at now.
+func (k HMACKey) retired(now time.Time) bool {
+	return !k.NotAfter.IsZero() && now.After(k.NotAfter)
+}
+
+func (k HMACKey) label() string {
+	if k.ID == "" {
+		return defaultKeyID
+	}
+	return k.ID
+}
+
+var (
+	// errUnknownKeyID is returned by the kid lookup when no key has the token's kid.
+	errUnknownKeyID = errors.New("no key with the token's kid")
+	// errRetiredKey is returned for tokens naming a key past its NotAfter.
+	errRetiredKey = errors.New("token's key is retired")
+)
+
+// hmacKeyring verifies HS256 tokens with an ordered set of secrets: the
+// primary key first, then previous keys that are still accepted during a
+// rotation.
+type hmacKeyring struct {
+	keys []HMACKey
+	now  func() time.Time
+}
+
+// newHMACKeyring returns the keyring for cfg. Without Keys, Secret is the only key.
+func newHMACKeyring(cfg JWTAuthConfig) hmacKeyring {
+	keys := cfg.Keys
+	if len(keys) == 0 {
+		keys = []HMACKey{{Secret: cfg.Secret}}
+	}
+	return hmacKeyring{keys: keys, now: cfg.Now}
+}
+
+// verify parses tokenString into claims. A token whose kid names a key is
+// verified with that key only; a retired key rejects it. Any other token is
+// tried against each active key in order until one verifies its signature.
+// It returns the label of the verifying key and how it was selected.
+func (r hmacKeyring) verify(parser *jwt.Parser, tokenString string, claims *ctxutil.Claims) (keyID, match string, err error) {
+	now := r.now()
+
+	var selected *HMACKey
+	token, err := parser.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		k := r.byID(kid)
+		if k == nil {
+			return nil, errUnknownKeyID
+		}
+		if k.retired(now) {
+			return nil, errRetiredKey
+		}
+		selected = k
+		return k.Secret, nil
+	})
+	if !errors.Is(err, errUnknownKeyID) {
+		if err == nil && token.Valid {
+			return selected.label(), KeyMatchKID, nil
+		}
+		return "", "", invalidTokenError(err)
+	}
+
+	// The signature is checked before the claims, so only a signature
+	// mismatch means another key may still verify the token.
+	for i := range r.keys {
+		k := &r.keys[i]
+		if k.retired(now) {
+			continue
+		}
+		*claims = ctxutil.Claims{}
+		token, err = parser.ParseWithClaims(tokenString, claims, func(*jwt.Token) (interface{}, error) {
+			return k.Secret, nil
+		})
+		if errors.Is(err, jwt.ErrTokenSignatureInvalid) {
+			continue
+		}
+		if err == nil && token.Valid {
+			return k.label(), KeyMatchTrial, nil
+		}
+		return "", "", invalidTokenError(err)
+	}
+	return "", "", invalidTokenError(err)
+}
+
+// byID returns the key with the given non-empty ID, or nil.
+func (r hmacKeyring) byID(kid string) *HMACKey {
+	if kid == "" {
+		return nil
+	}
+	for i := range r.keys {
+		if r.keys[i].ID == kid {
+			return &r.keys[i]
+		}
+	}
+	return nil
+}
+
+// errInvalidToken is returned when the parser reports no error for an invalid token.
+var errInvalidToken = errors.New("token is invalid")
+
+func invalidTokenError(err error) error {
+	if err == nil {
+		return errInvalidToken
+	}
+	return err
+}
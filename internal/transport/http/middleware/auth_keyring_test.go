@@ -0,0 +1,133 @@
+//go:build !integration
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
+)
+
+// HS256 keyring (rotation) tests.
+
+var (
+	currentSecret  = []byte("current-secret-key-32-bytes-long")
+	previousSecret = []byte("previous-secret-key-32-bytes-lon")
+	retiredSecret  = []byte("retired-secret-key-32-bytes-long")
+)
+
+// recordingAuthMetrics records IncTokenVerified calls as "keyID/match".
+type recordingAuthMetrics struct {
+	verified []string
+}
+
+func (m *recordingAuthMetrics) IncTokenVerified(keyID, match string) {
+	m.verified = append(m.verified, keyID+"/"+match)
+}
+
+func testKeyring() []HMACKey {
+	return []HMACKey{
+		{ID: "k2", Secret: currentSecret},
+		{ID: "k1", Secret: previousSecret, NotAfter: fixedTime.Add(24 * time.Hour)},
+		{ID: "k0", Secret: retiredSecret, NotAfter: fixedTime.Add(-time.Hour)},
+	}
+}
+
+func serveWithKeyring(keys []HMACKey, token string) (*httptest.ResponseRecorder, *ctxutil.Claims, *recordingAuthMetrics) {
+	var claims *ctxutil.Claims
+	handler := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		claims = ctxutil.GetClaims(r.Context())
+	})
+
+	recorder := &recordingAuthMetrics{}
+	cfg := testJWTAuthConfig()
+	cfg.Keys = keys
+	cfg.Metrics = recorder
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := httptest.NewRecorder()
+	JWTAuth(cfg)(handler).ServeHTTP(rr, req)
+	return rr, claims, recorder
+}
+
+func TestJWTAuth_Keyring_Accepts(t *testing.T) {
+	tests := []struct {
+		name   string
+		kid    string
+		secret []byte
+		want   string
+	}{
+		{name: "primary by kid", kid: "k2", secret: currentSecret, want: "k2/kid"},
+		{name: "previous key by kid", kid: "k1", secret: previousSecret, want: "k1/kid"},
+		{name: "primary without kid", secret: currentSecret, want: "k2/trial"},
+		{name: "previous key without kid", secret: previousSecret, want: "k1/trial"},
+		{name: "unknown kid falls back to trial", kid: "legacy", secret: previousSecret, want: "k1/trial"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr, claims, recorder := serveWithKeyring(testKeyring(), signTestToken(t, jwt.SigningMethodHS256, tt.kid, tt.secret))
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			require.NotNil(t, claims)
+			assert.Equal(t, "user-123", claims.Subject)
+			assert.Equal(t, []string{tt.want}, recorder.verified)
+		})
+	}
+}
+
+func TestJWTAuth_Keyring_Rejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		kid    string
+		secret []byte
+	}{
+		{name: "retired key by kid", kid: "k0", secret: retiredSecret},
+		{name: "retired key without kid", secret: retiredSecret},
+		{name: "kid names another key", kid: "k2", secret: previousSecret},
+		{name: "kid names a retired key", kid: "k0", secret: currentSecret},
+		{name: "unknown secret", kid: "k9", secret: testSecret},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr, claims, recorder := serveWithKeyring(testKeyring(), signTestToken(t, jwt.SigningMethodHS256, tt.kid, tt.secret))
+
+			assert.Equal(t, http.StatusUnauthorized, rr.Code)
+			assert.Nil(t, claims)
+			assert.Empty(t, recorder.verified)
+		})
+	}
+}
+
+func TestJWTAuth_Keyring_ExpiredTokenIsNotRetried(t *testing.T) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &ctxutil.Claims{
+		TenantID: testTenantID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-123",
+			ExpiresAt: jwt.NewNumericDate(fixedTime.Add(-time.Minute)),
+		},
+	})
+	signed, err := token.SignedString(currentSecret)
+	require.NoError(t, err)
+
+	rr, claims, _ := serveWithKeyring(testKeyring(), signed)
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Nil(t, claims)
+}
+
+func TestJWTAuth_SecretOnly_RecordsDefaultKey(t *testing.T) {
+	rr, claims, recorder := serveWithKeyring(nil, generateValidToken(t, time.Hour))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.NotNil(t, claims)
+	assert.Equal(t, []string{"default/trial"}, recorder.verified)
+}
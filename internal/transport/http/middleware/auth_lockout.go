@@ -0,0 +1,104 @@
+// Package middleware provides HTTP middleware for the transport layer.
+// This file implements lockout of callers that repeatedly fail to authenticate.
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app/lockout"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// LockoutTracker counts authentication failures and rejects the client IPs and
+// subjects that fail too often, e.g. lockout.Tracker.
+type LockoutTracker interface {
+	// Check returns an error wrapping a *lockout.LockedError if one of keys is
+	// locked out.
+	Check(ctx context.Context, keys ...lockout.Key) error
+	// Failure records a failed authentication attempt for each of keys.
+	Failure(ctx context.Context, keys ...lockout.Key)
+	// Success forgets the failures of keys.
+	Success(ctx context.Context, keys ...lockout.Key)
+}
+
+// ipLockoutKey returns the lockout key of r's client IP. It is the rate
+// limiter's key for unauthenticated requests.
+func ipLockoutKey(r *http.Request) lockout.Key {
+	return lockout.Key{Type: lockout.KeyTypeIP, ID: clientIPKey(r)}
+}
+
+// subjectLockoutKey returns the lockout key of subject. It is the rate
+// limiter's key for requests authenticated as subject.
+func subjectLockoutKey(subject string) lockout.Key {
+	return lockout.Key{Type: lockout.KeyTypeSubject, ID: subjectKey(subject)}
+}
+
+// CredentialLockout returns middleware that locks out callers of an endpoint
+// checking credentials itself, such as the OAuth token endpoint. subject
+// returns the identity a request claims, e.g. its client_id, or "" if none,
+// and exists reports whether that identity is known, e.g. a registered client.
+//
+// Requests from a locked out client IP are rejected with 429 and requests for
+// a locked out subject with 401, both with Retry-After, before the handler
+// runs. A 401 response from the handler counts as a failure of the client IP
+// and, if it exists, of the subject, so made-up subjects are not tracked; any
+// 2xx response clears the failures of the subject.
+func CredentialLockout(
+	tracker LockoutTracker,
+	subject func(*http.Request) string,
+	exists func(ctx context.Context, subject string) bool,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			keys := []lockout.Key{ipLockoutKey(r)}
+			id := subject(r)
+			if id != "" {
+				keys = append(keys, subjectLockoutKey(id))
+			}
+			if err := tracker.Check(r.Context(), keys...); err != nil {
+				writeLockedOut(w, r, err, "")
+				return
+			}
+
+			ww := NewResponseWrapper(w)
+			next.ServeHTTP(ww, r)
+
+			switch status := ww.Status(); {
+			case status == http.StatusUnauthorized:
+				if len(keys) > 1 && !exists(r.Context(), id) {
+					keys = keys[:1]
+				}
+				tracker.Failure(r.Context(), keys...)
+			case status >= 200 && status < 300 && len(keys) > 1:
+				tracker.Success(r.Context(), keys[1:]...)
+			}
+		})
+	}
+}
+
+// OAuthClientID returns the client_id of an OAuth token request, from HTTP
+// Basic authentication or the form body, for use as CredentialLockout subject.
+func OAuthClientID(r *http.Request) string {
+	if id, _, ok := r.BasicAuth(); ok {
+		return id
+	}
+	// The handler parses the form again; a parse error leaves it empty there too.
+	return r.PostFormValue("client_id")
+}
+
+// writeLockedOut writes the response for a request rejected by
+// LockoutTracker.Check: 429 for a locked out client IP, 401 with challenge (if
+// set) for a locked out subject, and Retry-After for both.
+func writeLockedOut(w http.ResponseWriter, r *http.Request, err error, challenge string) {
+	var locked *lockout.LockedError
+	if errors.As(err, &locked) {
+		w.Header().Set("Retry-After", strconv.Itoa(locked.RetryAfterSeconds()))
+		if locked.Key.Type == lockout.KeyTypeSubject && challenge != "" {
+			w.Header().Set("WWW-Authenticate", challenge)
+		}
+	}
+	contract.WriteProblemJSON(w, r, err)
+}
@@ -0,0 +1,194 @@
+//go:build !integration
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app/lockout"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// Authentication lockout tests.
+
+// newTestLockout returns a tracker locking a key out after two failures.
+func newTestLockout() *lockout.Tracker {
+	return lockout.NewTracker(lockout.Config{MaxFailures: 2}, nil, nil, nil, nil, noopLogger())
+}
+
+func serveJWTWithLockout(tracker LockoutTracker, revocations RevocationChecker, remoteAddr, token string) *httptest.ResponseRecorder {
+	cfg := testJWTAuthConfig()
+	cfg.Lockout = tracker
+	if revocations != nil {
+		cfg.Revocations = revocations
+	}
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.RemoteAddr = remoteAddr
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := httptest.NewRecorder()
+	JWTAuth(cfg)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})).ServeHTTP(rr, req)
+	return rr
+}
+
+func TestJWTAuth_Lockout_LocksOutClientIP(t *testing.T) {
+	tracker := newTestLockout()
+	valid := generateValidToken(t, time.Hour)
+
+	for i := 0; i < 2; i++ {
+		rr := serveJWTWithLockout(tracker, nil, "192.0.2.1:1234", "invalid.token")
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	}
+
+	rr := serveJWTWithLockout(tracker, nil, "192.0.2.1:5678", valid)
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Contains(t, rr.Body.String(), contract.CodeRateTooManyAuthFailures)
+	assert.Equal(t, "60", rr.Header().Get("Retry-After"))
+
+	rr = serveJWTWithLockout(tracker, nil, "192.0.2.2:1234", valid)
+	assert.Equal(t, http.StatusOK, rr.Code, "other client IPs are not locked out")
+}
+
+func TestJWTAuth_Lockout_LocksOutSubjectOfRevokedToken(t *testing.T) {
+	tracker := newTestLockout()
+	revocations := &stubRevocations{jtis: map[string]bool{"revoked-jti": true}}
+
+	// From different IPs, so only the subject reaches the limit.
+	for _, addr := range []string{"192.0.2.1:1234", "192.0.2.2:1234"} {
+		rr := serveJWTWithLockout(tracker, revocations, addr, signTokenWithJTI(t, "revoked-jti"))
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	}
+
+	rr := serveJWTWithLockout(tracker, revocations, "192.0.2.3:1234", signTokenWithJTI(t, "jti-1"))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Contains(t, rr.Body.String(), contract.CodeAuthAccountLocked)
+	assert.Equal(t, "60", rr.Header().Get("Retry-After"))
+	assert.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"))
+}
+
+func TestJWTAuth_Lockout_MissingHeaderIsNoFailure(t *testing.T) {
+	tracker := newTestLockout()
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+		rr := httptest.NewRecorder()
+		cfg := testJWTAuthConfig()
+		cfg.Lockout = tracker
+		JWTAuth(cfg)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	}
+
+	rr := serveJWTWithLockout(tracker, nil, "192.0.2.1:1234", generateValidToken(t, time.Hour))
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestAPIKeyAuth_Lockout_LocksOutClientIP(t *testing.T) {
+	tracker := newTestLockout()
+	authenticator := &stubAPIKeys{}
+	serve := func(key string) *httptest.ResponseRecorder {
+		handler := APIKeyAuth(APIKeyAuthConfig{Authenticator: authenticator, Logger: noopLogger(), Lockout: tracker})(
+			http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+		req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+		req.Header.Set(APIKeyHeader, key)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, serve("ak_wrong-1").Code)
+	assert.Equal(t, http.StatusUnauthorized, serve("ak_wrong-2").Code)
+
+	rr := serve(testAPIKey)
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Contains(t, rr.Body.String(), contract.CodeRateTooManyAuthFailures)
+	assert.NotEmpty(t, rr.Header().Get("Retry-After"))
+	assert.Len(t, authenticator.keys, 2, "a locked out client IP is not checked")
+}
+
+func TestCredentialLockout(t *testing.T) {
+	// credentials answers 401 unless the secret is "right".
+	credentials := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, secret, _ := r.BasicAuth(); secret != "right" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	// registered knows every client_id but "made-up".
+	registered := func(_ context.Context, clientID string) bool { return clientID != "made-up" }
+	serve := func(handler http.Handler, remoteAddr, clientID, secret string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/oauth/token", nil)
+		req.RemoteAddr = remoteAddr
+		req.SetBasicAuth(clientID, secret)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("locks out the client_id across IPs", func(t *testing.T) {
+		handler := CredentialLockout(newTestLockout(), OAuthClientID, registered)(credentials)
+
+		assert.Equal(t, http.StatusUnauthorized, serve(handler, "192.0.2.1:1234", "client-1", "wrong").Code)
+		assert.Equal(t, http.StatusUnauthorized, serve(handler, "192.0.2.2:1234", "client-1", "wrong").Code)
+
+		rr := serve(handler, "192.0.2.3:1234", "client-1", "right")
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Contains(t, rr.Body.String(), contract.CodeAuthAccountLocked)
+		assert.Equal(t, "60", rr.Header().Get("Retry-After"))
+
+		assert.Equal(t, http.StatusOK, serve(handler, "192.0.2.3:1234", "client-2", "right").Code)
+	})
+
+	t.Run("locks out the client IP across client_ids", func(t *testing.T) {
+		handler := CredentialLockout(newTestLockout(), OAuthClientID, registered)(credentials)
+
+		serve(handler, "192.0.2.1:1234", "client-1", "wrong")
+		serve(handler, "192.0.2.1:1234", "client-2", "wrong")
+
+		rr := serve(handler, "192.0.2.1:1234", "client-3", "right")
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+		assert.Contains(t, rr.Body.String(), contract.CodeRateTooManyAuthFailures)
+	})
+
+	t.Run("success clears the client_id's failures", func(t *testing.T) {
+		handler := CredentialLockout(newTestLockout(), OAuthClientID, registered)(credentials)
+
+		serve(handler, "192.0.2.1:1234", "client-1", "wrong")
+		assert.Equal(t, http.StatusOK, serve(handler, "192.0.2.2:1234", "client-1", "right").Code)
+		serve(handler, "192.0.2.3:1234", "client-1", "wrong")
+
+		assert.Equal(t, http.StatusOK, serve(handler, "192.0.2.4:1234", "client-1", "right").Code)
+	})
+
+	t.Run("does not track unknown client_ids", func(t *testing.T) {
+		handler := CredentialLockout(newTestLockout(), OAuthClientID, registered)(credentials)
+
+		serve(handler, "192.0.2.1:1234", "made-up", "wrong")
+		serve(handler, "192.0.2.2:1234", "made-up", "wrong")
+
+		assert.Equal(t, http.StatusOK, serve(handler, "192.0.2.3:1234", "made-up", "right").Code)
+		rr := serve(handler, "192.0.2.1:1234", "client-1", "right")
+		assert.Equal(t, http.StatusOK, rr.Code, "the client IP still counts one failure")
+	})
+}
+
+func TestOAuthClientID(t *testing.T) {
+	basic := httptest.NewRequest(http.MethodPost, "/oauth/token", nil)
+	basic.SetBasicAuth("client-basic", "secret")
+	assert.Equal(t, "client-basic", OAuthClientID(basic))
+
+	form := httptest.NewRequest(http.MethodPost, "/oauth/token",
+		strings.NewReader(url.Values{"client_id": {"client-form"}, "grant_type": {"client_credentials"}}.Encode()))
+	form.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	assert.Equal(t, "client-form", OAuthClientID(form))
+	assert.Equal(t, "client_credentials", form.PostForm.Get("grant_type"), "the form stays readable for the handler")
+
+	assert.Empty(t, OAuthClientID(httptest.NewRequest(http.MethodPost, "/oauth/token", nil)))
+}
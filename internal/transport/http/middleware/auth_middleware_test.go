@@ -27,6 +27,7 @@ func TestJWTAuth_SetsAuthContext(t *testing.T) {
 	})
 
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   "user-999",
 			ExpiresAt: jwt.NewNumericDate(fixedTime.Add(1 * time.Hour)),
@@ -63,6 +64,7 @@ func TestJWTAuth_NormalizesRoleCase(t *testing.T) {
 	})
 
 	claims := &ctxutil.Claims{
+		TenantID: testTenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   "user-abc",
 			ExpiresAt: jwt.NewNumericDate(fixedTime.Add(1 * time.Hour)),
@@ -89,6 +91,55 @@ func TestJWTAuth_NormalizesRoleCase(t *testing.T) {
 	assert.True(t, validated, "validated marker should be set in request context")
 }
 
+// TestJWTAuth_TokenWithoutTenant verifies tokens without a tenant_id claim are
+// rejected unless a default tenant is configured.
+func TestJWTAuth_TokenWithoutTenant(t *testing.T) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &ctxutil.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-123",
+			ExpiresAt: jwt.NewNumericDate(fixedTime.Add(time.Hour)),
+		},
+		TenantID: "  ",
+	})
+	tokenString, err := token.SignedString(testSecret)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		defaultTenantID string
+		wantStatus      int
+		wantTenant      string
+	}{
+		{name: "rejected by default", wantStatus: http.StatusUnauthorized},
+		{name: "default tenant configured", defaultTenantID: "acme", wantStatus: http.StatusOK, wantTenant: "acme"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotAuth *app.AuthContext
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotAuth = app.GetAuthContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+			cfg := testJWTAuthConfig()
+			cfg.DefaultTenantID = tt.defaultTenantID
+
+			req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+			req.Header.Set("Authorization", "Bearer "+tokenString)
+			rr := httptest.NewRecorder()
+			JWTAuth(cfg)(AuthContextBridge(handler)).ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantTenant == "" {
+				assert.Nil(t, gotAuth, "handler must not run without a tenant")
+				return
+			}
+			require.NotNil(t, gotAuth)
+			assert.Equal(t, tt.wantTenant, gotAuth.TenantID)
+		})
+	}
+}
+
 func TestNormalizeRole(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -0,0 +1,110 @@
+//go:build !integration
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
+)
+
+// Token revocation (denylist) tests.
+
+// stubRevocations revokes listed jtis and records the claims it was asked about.
+type stubRevocations struct {
+	jtis     map[string]bool
+	err      error
+	tenantID string
+	jti      string
+	subject  string
+	issuedAt time.Time
+}
+
+func (s *stubRevocations) IsRevoked(_ context.Context, tenantID, jti, subject string, issuedAt time.Time) (bool, error) {
+	s.tenantID, s.jti, s.subject, s.issuedAt = tenantID, jti, subject, issuedAt
+	return s.jtis[jti], s.err
+}
+
+func signTokenWithJTI(t *testing.T, jti string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &ctxutil.Claims{
+		TenantID: testTenantID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			Subject:   "user-123",
+			ExpiresAt: jwt.NewNumericDate(fixedTime.Add(time.Hour)),
+			IssuedAt:  jwt.NewNumericDate(fixedTime.Add(-time.Minute)),
+		},
+	})
+	signed, err := token.SignedString(testSecret)
+	require.NoError(t, err)
+	return signed
+}
+
+func serveWithRevocations(revocations RevocationChecker, token string) (*httptest.ResponseRecorder, bool) {
+	called := false
+	handler := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		called = true
+	})
+
+	cfg := testJWTAuthConfig()
+	cfg.Revocations = revocations
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := httptest.NewRecorder()
+	JWTAuth(cfg)(handler).ServeHTTP(rr, req)
+	return rr, called
+}
+
+func TestJWTAuth_Revocations_AllowsTokenNotRevoked(t *testing.T) {
+	revocations := &stubRevocations{jtis: map[string]bool{"revoked-jti": true}}
+
+	rr, called := serveWithRevocations(revocations, signTokenWithJTI(t, "jti-1"))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.True(t, called)
+	assert.Equal(t, testTenantID, revocations.tenantID, "revocations are checked within the token's tenant")
+	assert.Equal(t, "jti-1", revocations.jti)
+	assert.Equal(t, "user-123", revocations.subject)
+	assert.True(t, fixedTime.Add(-time.Minute).Equal(revocations.issuedAt))
+}
+
+func TestJWTAuth_Revocations_RejectsRevokedToken(t *testing.T) {
+	revocations := &stubRevocations{jtis: map[string]bool{"jti-1": true}}
+
+	rr, called := serveWithRevocations(revocations, signTokenWithJTI(t, "jti-1"))
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.False(t, called)
+	assert.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"))
+}
+
+func TestJWTAuth_Revocations_UnavailableFailsClosed(t *testing.T) {
+	revocations := &stubRevocations{err: errors.New("db down")}
+
+	rr, called := serveWithRevocations(revocations, signTokenWithJTI(t, "jti-1"))
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.False(t, called)
+	assert.Contains(t, rr.Body.String(), contract.CodeSysUnavailable)
+}
+
+func TestJWTAuth_Revocations_NotConsultedForInvalidToken(t *testing.T) {
+	revocations := &stubRevocations{}
+
+	rr, called := serveWithRevocations(revocations, generateValidToken(t, -time.Hour))
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.False(t, called)
+	assert.Empty(t, revocations.subject)
+}
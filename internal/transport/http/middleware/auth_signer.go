@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
+)
+
+// TokenSigner signs access tokens issued by this service as HS256 JWTs with
+// the claims of ctxutil.Claims, so that JWTAuth accepts them with the same key.
+type TokenSigner struct {
+	key      HMACKey
+	issuer   string
+	audience string
+}
+
+// NewTokenSigner creates a TokenSigner that signs with key, putting key.ID in
+// the kid header when set. issuer and audience become the iss and aud claims
+// when not empty and should match the JWTAuth configuration.
+func NewTokenSigner(key HMACKey, issuer, audience string) *TokenSigner {
+	return &TokenSigner{key: key, issuer: issuer, audience: audience}
+}
+
+// Sign returns the signed JWT for token.
+func (s *TokenSigner) Sign(token domain.AccessToken) (string, error) {
+	if len(s.key.Secret) == 0 {
+		return "", errors.New("sign access token: no signing key")
+	}
+
+	claims := &ctxutil.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        token.ID,
+			Subject:   token.Subject,
+			Issuer:    s.issuer,
+			IssuedAt:  jwt.NewNumericDate(token.IssuedAt),
+			NotBefore: jwt.NewNumericDate(token.IssuedAt),
+			ExpiresAt: jwt.NewNumericDate(token.ExpiresAt),
+		},
+		Role:     token.Role,
+		Scope:    strings.Join(token.Scopes, " "),
+		TenantID: token.TenantID,
+	}
+	if s.audience != "" {
+		claims.Audience = jwt.ClaimStrings{s.audience}
+	}
+
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	if s.key.ID != "" {
+		jwtToken.Header["kid"] = s.key.ID
+	}
+	signed, err := jwtToken.SignedString(s.key.Secret)
+	if err != nil {
+		return "", fmt.Errorf("sign access token: %w", err)
+	}
+	return signed, nil
+}
+
+// Ensure TokenSigner implements domain.AccessTokenSigner at compile time.
+var _ domain.AccessTokenSigner = (*TokenSigner)(nil)
@@ -0,0 +1,116 @@
+//go:build !integration
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
+)
+
+// TokenSigner tests: issued tokens must be accepted by JWTAuth.
+
+func testAccessToken() domain.AccessToken {
+	return domain.AccessToken{
+		ID:        "jti-1",
+		Subject:   "client-1",
+		Role:      "admin",
+		TenantID:  "tenant-a",
+		Scopes:    []string{"users:read", "users:write"},
+		IssuedAt:  fixedTime,
+		ExpiresAt: fixedTime.Add(15 * time.Minute),
+	}
+}
+
+func TestTokenSigner_RoundTripThroughJWTAuth(t *testing.T) {
+	signer := NewTokenSigner(HMACKey{ID: "k2", Secret: currentSecret}, "api.example.com", "api")
+	signed, err := signer.Sign(testAccessToken())
+	require.NoError(t, err)
+
+	cfg := testJWTAuthConfig()
+	cfg.Keys = testKeyring()
+	cfg.Issuer = "api.example.com"
+	cfg.Audience = "api"
+	recorder := &recordingAuthMetrics{}
+	cfg.Metrics = recorder
+
+	var claims *ctxutil.Claims
+	handler := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		claims = ctxutil.GetClaims(r.Context())
+	})
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+signed)
+	rr := httptest.NewRecorder()
+	JWTAuth(cfg)(handler).ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NotNil(t, claims)
+	assert.Equal(t, "client-1", claims.Subject)
+	assert.Equal(t, "admin", claims.Role)
+	assert.Equal(t, "jti-1", claims.ID)
+	assert.Equal(t, []string{"k2/kid"}, recorder.verified)
+}
+
+func TestTokenSigner_Claims(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      HMACKey
+		audience string
+		wantKID  any
+		wantAud  jwt.ClaimStrings
+	}{
+		{name: "with kid and audience", key: HMACKey{ID: "k2", Secret: testSecret}, audience: "api", wantKID: "k2", wantAud: jwt.ClaimStrings{"api"}},
+		{name: "without kid and audience", key: HMACKey{Secret: testSecret}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signed, err := NewTokenSigner(tt.key, "issuer", tt.audience).Sign(testAccessToken())
+			require.NoError(t, err)
+
+			claims := &ctxutil.Claims{}
+			token, err := jwt.ParseWithClaims(signed, claims, func(*jwt.Token) (interface{}, error) {
+				return testSecret, nil
+			}, jwt.WithTimeFunc(func() time.Time { return fixedTime }), jwt.WithValidMethods([]string{"HS256"}))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantKID, token.Header["kid"])
+			assert.Equal(t, "issuer", claims.Issuer)
+			assert.Equal(t, "tenant-a", claims.TenantID)
+			assert.Equal(t, "users:read users:write", claims.Scope)
+			assert.Equal(t, tt.wantAud, claims.Audience)
+			assert.True(t, fixedTime.Equal(claims.IssuedAt.Time))
+			assert.True(t, fixedTime.Equal(claims.NotBefore.Time))
+			assert.True(t, fixedTime.Add(15*time.Minute).Equal(claims.ExpiresAt.Time))
+		})
+	}
+}
+
+func TestTokenSigner_OmitsScopeWithoutScopes(t *testing.T) {
+	token := testAccessToken()
+	token.Scopes = nil
+	signed, err := NewTokenSigner(HMACKey{Secret: testSecret}, "", "").Sign(token)
+	require.NoError(t, err)
+
+	claims := &ctxutil.Claims{}
+	_, err = jwt.ParseWithClaims(signed, claims, func(*jwt.Token) (interface{}, error) {
+		return testSecret, nil
+	}, jwt.WithTimeFunc(func() time.Time { return fixedTime }), jwt.WithValidMethods([]string{"HS256"}))
+	require.NoError(t, err)
+
+	assert.Nil(t, claims.Scopes(), "tokens of clients without scopes are unscoped")
+}
+
+func TestTokenSigner_NoSecret(t *testing.T) {
+	_, err := NewTokenSigner(HMACKey{}, "", "").Sign(testAccessToken())
+
+	assert.Error(t, err)
+}
@@ -10,12 +10,32 @@ import (
 	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
 )
 
+// BodyLimit replaces the limit of BodyLimiter for the requests with Method to
+// Path. Their bodies are not read ahead, so the handler can stream them; a read
+// past MaxBytes fails with *http.MaxBytesError, which the handler must answer.
+type BodyLimit struct {
+	Method   string
+	Path     string
+	MaxBytes int64
+}
+
 // BodyLimiter enforces a maximum request body size and returns RFC 7807 with HTTP 413 when exceeded.
 // It avoids double-writes from MaxBytesReader and bounds memory to maxBytes+1.
-func BodyLimiter(maxBytes int64) func(http.Handler) http.Handler {
+// Requests matching one of routes get that route's limit instead, without buffering.
+func BodyLimiter(maxBytes int64, routes ...BodyLimit) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if maxBytes <= 0 || r.Body == nil {
+			if r.Body == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			for _, route := range routes {
+				if r.Method == route.Method && r.URL.Path == route.Path {
+					streamBodyLimit(w, r, next, route.MaxBytes)
+					return
+				}
+			}
+			if maxBytes <= 0 {
 				next.ServeHTTP(w, r)
 				return
 			}
@@ -57,6 +77,16 @@ func BodyLimiter(maxBytes int64) func(http.Handler) http.Handler {
 	}
 }
 
+// streamBodyLimit passes r to next with its body limited to maxBytes.
+func streamBodyLimit(w http.ResponseWriter, r *http.Request, next http.Handler, maxBytes int64) {
+	if r.ContentLength > maxBytes {
+		handleTooLarge(w, r)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+	next.ServeHTTP(w, r)
+}
+
 func handleTooLarge(w http.ResponseWriter, r *http.Request) {
 	_, _ = io.Copy(io.Discard, r.Body)
 	contract.WriteProblemJSON(w, r, &app.AppError{
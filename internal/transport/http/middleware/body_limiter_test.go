@@ -142,3 +142,55 @@ func TestBodyLimiter_StreamDoesNotBufferFullBody(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rec.Code)
 	assert.Equal(t, len(body), bodyLen)
 }
+
+func TestBodyLimiter_RouteLimit(t *testing.T) {
+	t.Parallel()
+
+	route := BodyLimit{Method: http.MethodPost, Path: "/upload", MaxBytes: 2048}
+	limited := BodyLimiter(256, route)
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		size          int
+		contentLength bool
+		wantStatus    int
+		wantReadErr   bool
+	}{
+		{name: "above default limit within route limit", method: http.MethodPost, path: "/upload", size: 1024, wantStatus: http.StatusOK},
+		{name: "content length above route limit", method: http.MethodPost, path: "/upload", size: 4096, contentLength: true, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "streamed past route limit", method: http.MethodPost, path: "/upload", size: 4096, wantStatus: http.StatusOK, wantReadErr: true},
+		{name: "other method keeps default limit", method: http.MethodPut, path: "/upload", size: 1024, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "other path keeps default limit", method: http.MethodPost, path: "/other", size: 1024, wantStatus: http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var readErr error
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, readErr = io.Copy(io.Discard, r.Body)
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(strings.Repeat("x", tt.size)))
+			req.ContentLength = -1
+			if tt.contentLength {
+				req.ContentLength = int64(tt.size)
+			}
+			rec := httptest.NewRecorder()
+
+			limited(handler).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantReadErr {
+				var maxErr *http.MaxBytesError
+				assert.ErrorAs(t, readErr, &maxErr)
+				return
+			}
+			assert.NoError(t, readErr)
+		})
+	}
+}
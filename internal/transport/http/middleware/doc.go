@@ -33,8 +33,17 @@
 // # Available Middleware
 //
 // Authentication:
-//   - JWTAuth: Validates JWT tokens from Authorization header (HS256 only)
+//   - JWTAuth: Validates JWT tokens from Authorization header (HS256 with a
+//     shared secret or a rotating keyring, or RS256/ES256/EdDSA with a
+//     KeySource such as JWKS), optionally rejecting revoked tokens through a
+//     RevocationChecker
+//   - APIKeyAuth: Authenticates API keys from the X-API-Key header or
+//     "Authorization: ApiKey <key>" before JWTAuth, which then lets the
+//     request through
 //   - AuthContextBridge: Bridges auth context between middleware and handlers
+//   - RequireScopes: Route-level check that the token grants the OAuth scopes
+//     of the route (scope/scp claims), with a 403 listing the required scopes;
+//     credentials without scope claims only pass with ScopeConfig.AllowUnscoped
 //
 // Rate Limiting:
 //   - RateLimit: Token bucket rate limiting with RFC 7807 responses
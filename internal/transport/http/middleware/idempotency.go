@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"io"
 	"net/http"
 	"time"
@@ -221,6 +222,12 @@ func Idempotency(cfg IdempotencyConfig) func(http.Handler) http.Handler {
 			// Read and hash request body
 			body, err := io.ReadAll(r.Body)
 			if err != nil {
+				// Streamed bodies (see BodyLimit) may exceed their limit here
+				var maxErr *http.MaxBytesError
+				if errors.As(err, &maxErr) {
+					handleTooLarge(w, r)
+					return
+				}
 				contract.WriteProblemJSON(w, r, &app.AppError{
 					Op:      "Idempotency.ReadBody",
 					Code:    contract.CodeSysInternal,
@@ -107,16 +107,26 @@ func keyFunc() httprate.KeyFunc {
 		if claims := ctxutil.GetClaims(r.Context()); claims != nil {
 			// Use Subject (sub claim) as the user identifier
 			if strings.TrimSpace(claims.Subject) != "" {
-				return "user:" + claims.Subject, nil
+				return subjectKey(claims.Subject), nil
 			}
 		}
 
 		// Fallback to IP-based limiting (AC #1)
-		ip := resolveClientIP(r)
-		return "ip:" + ip, nil
+		return clientIPKey(r), nil
 	}
 }
 
+// clientIPKey returns the key counting the requests of r's client IP.
+// Authentication lockout counts failures under the same keys.
+func clientIPKey(r *http.Request) string {
+	return "ip:" + resolveClientIP(r)
+}
+
+// subjectKey returns the key counting the requests of an authenticated subject.
+func subjectKey(subject string) string {
+	return "user:" + subject
+}
+
 // resolveClientIP extracts the client IP address from the request.
 // It relies on r.RemoteAddr, which is normalized by the global RealIP middleware
 // if TRUST_PROXY is enabled.
@@ -34,6 +34,8 @@ var panicCounter = promauto.NewCounterVec(
 //   - Increments a Prometheus counter for monitoring
 //   - Returns a safe RFC 7807 response with Code="SYS-001" and status 500
 //   - Includes request_id and trace_id in the error response for correlation
+//   - Re-panics http.ErrAbortHandler so handlers can abort a response that
+//     is already streaming; net/http then closes the connection silently
 //
 // Example usage:
 //
@@ -45,6 +47,10 @@ func Recoverer(logger *slog.Logger) func(http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			defer func() {
 				if rec := recover(); rec != nil {
+					if rec == http.ErrAbortHandler {
+						panic(rec)
+					}
+
 					// Capture context values for logging
 					requestID := ctxutil.GetRequestID(r.Context())
 					traceID := ctxutil.GetTraceID(r.Context())
@@ -251,6 +251,33 @@ func TestRecoverer_DoesNotExposeStackTrace(t *testing.T) {
 	}
 }
 
+func TestRecoverer_RepanicsErrAbortHandler(t *testing.T) {
+	var logBuf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logBuf, nil))
+
+	handler := middleware.Recoverer(logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("partial"))
+		panic(http.ErrAbortHandler)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/users:export", nil)
+	rr := httptest.NewRecorder()
+
+	defer func() {
+		if rec := recover(); rec != http.ErrAbortHandler {
+			t.Fatalf("expected http.ErrAbortHandler to propagate, got %v", rec)
+		}
+		if rr.Body.String() != "partial" {
+			t.Errorf("no problem body must be appended to an aborted stream, got %q", rr.Body.String())
+		}
+		if strings.Contains(logBuf.String(), "panic recovered") {
+			t.Error("aborted response must not be logged as a panic")
+		}
+	}()
+	handler.ServeHTTP(rr, req)
+}
+
 func TestRecoverer_PrometheusMetricsExist(t *testing.T) {
 	// This test verifies the Prometheus counter is registered (no panic on registration)
 	// Actual counter increment testing would require mocking prometheus registry
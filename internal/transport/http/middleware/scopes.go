@@ -0,0 +1,71 @@
+// Package middleware provides HTTP middleware for the transport layer.
+// This file implements route-level OAuth scope checks.
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+)
+
+// ScopeConfig configures RequireScopes.
+type ScopeConfig struct {
+	// AllowUnscoped grants every scope to credentials without scope claims,
+	// such as tokens issued without scope or scp and API keys created before
+	// keys had scopes; they are then only limited by role permissions in the
+	// use cases. Default false: such credentials are rejected on every route
+	// requiring a scope.
+	AllowUnscoped bool
+}
+
+// RequireScopes returns route-level middleware that only lets requests
+// through whose credentials grant all of scopes, e.g.
+//
+//	r.With(middleware.RequireScopes(cfg, "users:read")).Get("/users", h.ListUsers)
+//
+// It must run after AuthContextBridge. Credentials without scope claims are
+// rejected unless cfg.AllowUnscoped is set.
+//
+// Requests lacking a scope, or without an auth context (fail closed), are
+// rejected with 403 INSUFFICIENT_PERMISSIONS listing the required scopes, and
+// an RFC 6750 insufficient_scope challenge.
+func RequireScopes(cfg ScopeConfig, scopes ...string) func(http.Handler) http.Handler {
+	required := append([]string(nil), scopes...)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authCtx := app.GetAuthContext(r.Context())
+			if authCtx == nil {
+				writeInsufficientScope(w, r, required)
+				return
+			}
+			if cfg.AllowUnscoped && authCtx.Unscoped() {
+				next.ServeHTTP(w, r)
+				return
+			}
+			for _, scope := range required {
+				if !authCtx.HasScope(scope) {
+					writeInsufficientScope(w, r, required)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// writeInsufficientScope writes a 403 problem listing the required scopes.
+func writeInsufficientScope(w http.ResponseWriter, r *http.Request, required []string) {
+	scope := strings.Join(required, " ")
+	w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="`+scope+`"`)
+
+	problem := contract.ProblemFromError(r, &app.AppError{
+		Op:      "RequireScopes",
+		Code:    app.CodeInsufficientPermissions,
+		Message: "Insufficient scope, required: " + scope,
+	})
+	problem.RequiredScopes = required
+	contract.WriteProblem(w, problem)
+}
@@ -0,0 +1,124 @@
+//go:build !integration
+
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/contract"
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
+)
+
+// Route-level scope tests.
+
+func serveWithScopes(cfg ScopeConfig, authCtx *app.AuthContext, scopes ...string) (*httptest.ResponseRecorder, bool) {
+	called := false
+	handler := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		called = true
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	if authCtx != nil {
+		req = req.WithContext(app.SetAuthContext(req.Context(), authCtx))
+	}
+	rr := httptest.NewRecorder()
+	RequireScopes(cfg, scopes...)(handler).ServeHTTP(rr, req)
+	return rr, called
+}
+
+func TestRequireScopes_Allows(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    ScopeConfig
+		scopes []string
+	}{
+		{name: "all scopes granted", scopes: []string{"users:read", "users:write", "jobs:read"}},
+		{name: "all scopes granted with unscoped allowed", cfg: ScopeConfig{AllowUnscoped: true}, scopes: []string{"users:read", "users:write"}},
+		{name: "no scope claims with unscoped allowed", cfg: ScopeConfig{AllowUnscoped: true}, scopes: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr, called := serveWithScopes(tt.cfg, &app.AuthContext{SubjectID: "user-1", Role: app.RoleUser, Scopes: tt.scopes},
+				"users:read", "users:write")
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.True(t, called)
+		})
+	}
+}
+
+func TestRequireScopes_Rejects(t *testing.T) {
+	allowUnscoped := ScopeConfig{AllowUnscoped: true}
+	tests := []struct {
+		name    string
+		cfg     ScopeConfig
+		authCtx *app.AuthContext
+	}{
+		{name: "missing one scope", authCtx: &app.AuthContext{SubjectID: "user-1", Role: app.RoleUser, Scopes: []string{"users:read"}}},
+		{name: "empty scope claims", authCtx: &app.AuthContext{SubjectID: "user-1", Role: app.RoleUser, Scopes: []string{}}},
+		{name: "no scope claims", authCtx: &app.AuthContext{SubjectID: "user-1", Role: app.RoleUser}},
+		{name: "no auth context"},
+		{name: "missing one scope with unscoped allowed", cfg: allowUnscoped, authCtx: &app.AuthContext{SubjectID: "user-1", Role: app.RoleUser, Scopes: []string{"users:read"}}},
+		{name: "empty scope claims with unscoped allowed", cfg: allowUnscoped, authCtx: &app.AuthContext{SubjectID: "user-1", Role: app.RoleUser, Scopes: []string{}}},
+		{name: "no auth context with unscoped allowed", cfg: allowUnscoped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr, called := serveWithScopes(tt.cfg, tt.authCtx, "users:read", "users:write")
+
+			assert.Equal(t, http.StatusForbidden, rr.Code)
+			assert.False(t, called)
+			assert.Equal(t, contract.ContentTypeProblemJSON, rr.Header().Get("Content-Type"))
+			assert.Equal(t, `Bearer error="insufficient_scope", scope="users:read users:write"`, rr.Header().Get("WWW-Authenticate"))
+
+			var problem struct {
+				Code           string   `json:"code"`
+				Detail         string   `json:"detail"`
+				RequiredScopes []string `json:"required_scopes"`
+			}
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+			assert.Equal(t, contract.CodeAuthzInsufficientPermissions, problem.Code)
+			assert.Equal(t, []string{"users:read", "users:write"}, problem.RequiredScopes)
+			assert.Contains(t, problem.Detail, "users:read users:write")
+		})
+	}
+}
+
+func TestRequireScopes_WithJWTAuth(t *testing.T) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &ctxutil.Claims{
+		TenantID: testTenantID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-123",
+			ExpiresAt: jwt.NewNumericDate(fixedTime.Add(time.Hour)),
+		},
+		Scope: "users:read",
+		Scp:   jwt.ClaimStrings{"jobs:read"},
+	})
+	signed, err := token.SignedString(testSecret)
+	require.NoError(t, err)
+
+	serve := func(scope string) int {
+		handler := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {})
+		chain := JWTAuth(testJWTAuthConfig())(AuthContextBridge(RequireScopes(ScopeConfig{}, scope)(handler)))
+		req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+		req.Header.Set("Authorization", "Bearer "+signed)
+		rr := httptest.NewRecorder()
+		chain.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve("users:read"), "scope claim")
+	assert.Equal(t, http.StatusOK, serve("jobs:read"), "scp claim")
+	assert.Equal(t, http.StatusForbidden, serve("users:write"))
+}
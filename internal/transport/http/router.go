@@ -2,6 +2,7 @@
 package http
 
 import (
+	"context"
 	"log/slog"
 	stdhttp "net/http"
 	"time"
@@ -21,6 +22,67 @@ type UserRoutes interface {
 	CreateUser(w stdhttp.ResponseWriter, r *stdhttp.Request)
 	GetUser(w stdhttp.ResponseWriter, r *stdhttp.Request)
 	ListUsers(w stdhttp.ResponseWriter, r *stdhttp.Request)
+	UpdateUser(w stdhttp.ResponseWriter, r *stdhttp.Request)
+	PatchUser(w stdhttp.ResponseWriter, r *stdhttp.Request)
+	DeleteUser(w stdhttp.ResponseWriter, r *stdhttp.Request)
+}
+
+// UserAdminRoutes defines the interface for admin-only user lifecycle handlers.
+type UserAdminRoutes interface {
+	RestoreUser(w stdhttp.ResponseWriter, r *stdhttp.Request)
+	PurgeUsers(w stdhttp.ResponseWriter, r *stdhttp.Request)
+}
+
+// UserBatchRoutes defines the interface for bulk user handlers.
+type UserBatchRoutes interface {
+	BatchCreateUsers(w stdhttp.ResponseWriter, r *stdhttp.Request)
+}
+
+// UserExportRoutes defines the interface for streaming user export handlers.
+type UserExportRoutes interface {
+	ExportUsers(w stdhttp.ResponseWriter, r *stdhttp.Request)
+}
+
+// UserImportRoutes defines the interface for asynchronous CSV user import handlers.
+type UserImportRoutes interface {
+	ImportUsers(w stdhttp.ResponseWriter, r *stdhttp.Request)
+	// MaxUploadSize is the body size limit of ImportUsers, in bytes.
+	MaxUploadSize() int64
+}
+
+// JobRoutes defines the interface for background job status handlers.
+type JobRoutes interface {
+	GetJob(w stdhttp.ResponseWriter, r *stdhttp.Request)
+}
+
+// TokenRoutes defines the interface for admin-only access token handlers.
+type TokenRoutes interface {
+	RevokeTokens(w stdhttp.ResponseWriter, r *stdhttp.Request)
+}
+
+// OAuthRoutes defines the interface for the OAuth 2.0 token endpoint and
+// OAuth client registration handlers.
+type OAuthRoutes interface {
+	IssueToken(w stdhttp.ResponseWriter, r *stdhttp.Request)
+	CreateClient(w stdhttp.ResponseWriter, r *stdhttp.Request)
+	// ClientExists reports whether clientID is a registered client; only their
+	// failures count towards a client_id lockout.
+	ClientExists(ctx context.Context, clientID string) bool
+}
+
+// APIKeyRoutes defines the interface for admin-only API key management handlers.
+type APIKeyRoutes interface {
+	CreateAPIKey(w stdhttp.ResponseWriter, r *stdhttp.Request)
+	ListAPIKeys(w stdhttp.ResponseWriter, r *stdhttp.Request)
+	RevokeAPIKey(w stdhttp.ResponseWriter, r *stdhttp.Request)
+	RotateAPIKey(w stdhttp.ResponseWriter, r *stdhttp.Request)
+}
+
+// AuditEventRoutes defines the interface for admin-only audit log handlers.
+type AuditEventRoutes interface {
+	GetAuditEvent(w stdhttp.ResponseWriter, r *stdhttp.Request)
+	ListAuditEvents(w stdhttp.ResponseWriter, r *stdhttp.Request)
+	VerifyAuditChain(w stdhttp.ResponseWriter, r *stdhttp.Request)
 }
 
 // JWTConfig holds JWT authentication configuration for the router.
@@ -29,8 +91,26 @@ type JWTConfig struct {
 	// When false, protected routes are accessible without authentication.
 	Enabled bool
 	// Secret is the key used for JWT signature validation (HS256).
-	// Required when Enabled is true.
+	// Required when Enabled is true and neither Keys nor KeySource is set.
 	Secret []byte
+	// Keys is an ordered HS256 keyring (primary first) used instead of Secret
+	// to accept tokens signed with previous keys during a rotation. Optional.
+	Keys []middleware.HMACKey
+	// KeySource verifies RS256, ES256 and EdDSA tokens instead of Secret,
+	// e.g. with keys from a JWKS document. Optional.
+	KeySource middleware.KeySource
+	// Introspector validates opaque tokens with the authorization server
+	// instead of verifying JWTs, e.g. introspection.Client. Optional.
+	Introspector middleware.TokenIntrospector
+	// Metrics records which key verified each token. Optional.
+	Metrics metrics.AuthMetrics
+	// Revocations rejects revoked tokens, e.g. the token denylist. Optional.
+	Revocations middleware.RevocationChecker
+	// APIKeys accepts API keys as an alternative to tokens. Optional.
+	APIKeys middleware.APIKeyAuthenticator
+	// Lockout locks out client IPs and subjects after repeated authentication
+	// failures, on the API and the OAuth token endpoint. Optional.
+	Lockout middleware.LockoutTracker
 	// Now provides the current time for token validation.
 	// Inject for deterministic testing; defaults to time.Now if nil.
 	Now func() time.Time
@@ -41,6 +121,14 @@ type JWTConfig struct {
 	// ClockSkew is the tolerance for expired tokens (e.g., 30s).
 	// Default: 0 (no tolerance).
 	ClockSkew time.Duration
+	// AllowUnscoped lets credentials without scope claims (tokens without
+	// scope or scp, and API keys and OAuth clients created before they had
+	// scopes) pass every route's scope check. Default: false, such
+	// credentials are rejected with 403 on routes requiring a scope.
+	AllowUnscoped bool
+	// DefaultTenantID is the tenant of tokens without a tenant_id claim.
+	// Default: empty, such tokens are rejected with 401.
+	DefaultTenantID string
 }
 
 // RateLimitConfig holds rate limiting configuration for the router.
@@ -64,7 +152,16 @@ type RouterHandlers struct {
 	StartupHandler   stdhttp.Handler // /startupz
 
 	// Domain handlers
-	UserHandler UserRoutes
+	UserHandler       UserRoutes
+	UserAdminHandler  UserAdminRoutes  // optional
+	UserBatchHandler  UserBatchRoutes  // optional
+	UserExportHandler UserExportRoutes // optional
+	UserImportHandler UserImportRoutes // optional
+	JobHandler        JobRoutes        // optional
+	TokenHandler      TokenRoutes      // optional
+	OAuthHandler      OAuthRoutes      // optional
+	APIKeyHandler     APIKeyRoutes     // optional
+	AuditEventHandler AuditEventRoutes // optional
 }
 
 // NewRouter creates a new chi router with the provided handlers and logger.
@@ -81,10 +178,14 @@ type RouterHandlers struct {
 //  9. Shutdown: Reject requests during shutdown (Story 1.6)
 //
 // Per-route group middleware (applied to /api/v1):
-//  1. JWT Auth (if enabled)
+//  1. API key auth (if enabled with JWT) and JWT Auth (if enabled)
 //  2. RateLimiter
 //  3. Idempotency (if store provided) - Story 2.4
 //
+// Each protected route additionally requires OAuth scopes (RequireScopes) when
+// JWT auth is enabled; credentials without scope claims only pass if
+// JWTConfig.AllowUnscoped is set.
+//
 // JWT middleware is applied per-route group (protected endpoints only).
 func NewRouter(
 	logger *slog.Logger,
@@ -132,7 +233,7 @@ func NewRouter(
 		}
 		r.Use(middleware.Metrics(httpMetrics))
 		r.Use(middleware.RequestLogger(logger))
-		r.Use(middleware.BodyLimiter(maxRequestSize))
+		r.Use(middleware.BodyLimiter(maxRequestSize, streamedBodyLimits(handlers)...))
 
 		// Story 2.3: RFC 7807 Panic Recovery - replaced chi's Recoverer with our implementation
 		// that returns proper RFC 7807 responses with SYS-001 error code
@@ -152,6 +253,20 @@ func NewRouter(
 			r.Get("/readyz", handlers.ReadinessHandler.ServeHTTP)
 		}
 
+		// OAuth 2.0 token endpoint: clients authenticate with their credentials
+		// instead of a token, so it sits outside the JWT-protected API group.
+		// Rate limited per client IP and, with Lockout, locked out per client IP
+		// and registered client_id after repeated failures to slow down secret guessing.
+		if handlers.OAuthHandler != nil {
+			tokenRoute := r.With(middleware.RateLimiter(middleware.RateLimitConfig{
+				RequestsPerSecond: rateLimitConfig.RequestsPerSecond,
+			}))
+			if jwtConfig.Lockout != nil {
+				tokenRoute = tokenRoute.With(middleware.CredentialLockout(jwtConfig.Lockout, middleware.OAuthClientID, handlers.OAuthHandler.ClientExists))
+			}
+			tokenRoute.Post("/oauth/token", handlers.OAuthHandler.IssueToken)
+		}
+
 		// API v1 routes (protected when JWT is enabled)
 		// Note: We use r.Route here which is inside the group, so it inherits the group's middleware
 		if handlers.UserHandler != nil {
@@ -162,13 +277,28 @@ func NewRouter(
 					if now == nil {
 						now = time.Now
 					}
+					// Requests authenticated by an API key skip JWTAuth
+					if jwtConfig.APIKeys != nil {
+						r.Use(middleware.APIKeyAuth(middleware.APIKeyAuthConfig{
+							Authenticator: jwtConfig.APIKeys,
+							Logger:        logger,
+							Lockout:       jwtConfig.Lockout,
+						}))
+					}
 					r.Use(middleware.JWTAuth(middleware.JWTAuthConfig{
-						Secret:    jwtConfig.Secret,
-						Logger:    logger,
-						Now:       now,
-						Issuer:    jwtConfig.Issuer,
-						Audience:  jwtConfig.Audience,
-						ClockSkew: jwtConfig.ClockSkew,
+						Secret:          jwtConfig.Secret,
+						Keys:            jwtConfig.Keys,
+						KeySource:       jwtConfig.KeySource,
+						Introspector:    jwtConfig.Introspector,
+						Logger:          logger,
+						Metrics:         jwtConfig.Metrics,
+						Revocations:     jwtConfig.Revocations,
+						Lockout:         jwtConfig.Lockout,
+						Now:             now,
+						Issuer:          jwtConfig.Issuer,
+						Audience:        jwtConfig.Audience,
+						ClockSkew:       jwtConfig.ClockSkew,
+						DefaultTenantID: jwtConfig.DefaultTenantID,
 					}))
 					r.Use(middleware.AuthContextBridge)
 				}
@@ -187,9 +317,70 @@ func NewRouter(
 					}))
 				}
 
-				r.Post("/users", handlers.UserHandler.CreateUser)
-				r.Get("/users/{id}", handlers.UserHandler.GetUser)
-				r.Get("/users", handlers.UserHandler.ListUsers)
+				// OAuth scopes each route requires of tokens carrying scope claims.
+				// They must match the bearerAuth requirements in docs/openapi.yaml.
+				scopes := func(scopes ...string) func(stdhttp.Handler) stdhttp.Handler {
+					if !jwtConfig.Enabled {
+						return func(next stdhttp.Handler) stdhttp.Handler { return next }
+					}
+					return middleware.RequireScopes(middleware.ScopeConfig{AllowUnscoped: jwtConfig.AllowUnscoped}, scopes...)
+				}
+
+				r.With(scopes("users:write")).Post("/users", handlers.UserHandler.CreateUser)
+				r.With(scopes("users:read")).Get("/users/{id}", handlers.UserHandler.GetUser)
+				r.With(scopes("users:read")).Get("/users", handlers.UserHandler.ListUsers)
+				r.With(scopes("users:write")).Put("/users/{id}", handlers.UserHandler.UpdateUser)
+				r.With(scopes("users:write")).Patch("/users/{id}", handlers.UserHandler.PatchUser)
+				r.With(scopes("users:write")).Delete("/users/{id}", handlers.UserHandler.DeleteUser)
+
+				// Admin-only user lifecycle operations (custom methods)
+				if handlers.UserAdminHandler != nil {
+					r.With(scopes("users:restore")).Post("/users/{id}:restore", handlers.UserAdminHandler.RestoreUser)
+					r.With(scopes("users:purge")).Post("/users:purge", handlers.UserAdminHandler.PurgeUsers)
+				}
+
+				// Bulk operations share the body limit and idempotency of single writes
+				if handlers.UserBatchHandler != nil {
+					r.With(scopes("users:write")).Post("/users:batchCreate", handlers.UserBatchHandler.BatchCreateUsers)
+				}
+
+				// Streaming export replaces the server write deadline with one per flush (see handler)
+				if handlers.UserExportHandler != nil {
+					r.With(scopes("users:export")).Get("/users:export", handlers.UserExportHandler.ExportUsers)
+				}
+
+				// CSV imports are queued and report progress through the jobs resource
+				if handlers.UserImportHandler != nil {
+					r.With(scopes("users:import")).Post("/users:import", handlers.UserImportHandler.ImportUsers)
+				}
+				if handlers.JobHandler != nil {
+					r.With(scopes("jobs:read")).Get("/jobs/{id}", handlers.JobHandler.GetJob)
+				}
+
+				// Revoked tokens are rejected by JWTAuth through the denylist
+				if handlers.TokenHandler != nil {
+					r.With(scopes("tokens:revoke")).Post("/tokens:revoke", handlers.TokenHandler.RevokeTokens)
+				}
+
+				// Admin-only registration of clients for the /oauth/token endpoint
+				if handlers.OAuthHandler != nil {
+					r.With(scopes("oauth_clients:write")).Post("/oauth-clients", handlers.OAuthHandler.CreateClient)
+				}
+
+				// Admin-only management of keys accepted by APIKeyAuth
+				if handlers.APIKeyHandler != nil {
+					r.With(scopes("api_keys:write")).Post("/api-keys", handlers.APIKeyHandler.CreateAPIKey)
+					r.With(scopes("api_keys:read")).Get("/api-keys", handlers.APIKeyHandler.ListAPIKeys)
+					r.With(scopes("api_keys:write")).Post("/api-keys/{id}:revoke", handlers.APIKeyHandler.RevokeAPIKey)
+					r.With(scopes("api_keys:write")).Post("/api-keys/{id}:rotate", handlers.APIKeyHandler.RotateAPIKey)
+				}
+
+				// Admin-only reads of the audit log
+				if handlers.AuditEventHandler != nil {
+					r.With(scopes("audit:read")).Get("/audit-events", handlers.AuditEventHandler.ListAuditEvents)
+					r.With(scopes("audit:read")).Get("/audit-events:verify", handlers.AuditEventHandler.VerifyAuditChain)
+					r.With(scopes("audit:read")).Get("/audit-events/{id}", handlers.AuditEventHandler.GetAuditEvent)
+				}
 			})
 		}
 	})
@@ -197,6 +388,20 @@ func NewRouter(
 	return r
 }
 
+// streamedBodyLimits returns the routes whose handlers stream their bodies
+// under a limit of their own instead of maxRequestSize.
+func streamedBodyLimits(handlers RouterHandlers) []middleware.BodyLimit {
+	var limits []middleware.BodyLimit
+	if handlers.UserHandler != nil && handlers.UserImportHandler != nil {
+		limits = append(limits, middleware.BodyLimit{
+			Method:   stdhttp.MethodPost,
+			Path:     BasePath + "/users:import",
+			MaxBytes: handlers.UserImportHandler.MaxUploadSize(),
+		})
+	}
+	return limits
+}
+
 // NewInternalRouter creates a router for internal endpoints like /metrics.
 // This router should be bound to INTERNAL_PORT for security isolation.
 // Story 2.5b: Separated from public router to protect internal metrics.
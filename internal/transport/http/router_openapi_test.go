@@ -0,0 +1,174 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/ctxutil"
+)
+
+// openAPIPath is the API specification the router is checked against.
+const openAPIPath = "../../../docs/openapi.yaml"
+
+// okRoutes implements every route interface with handlers answering 200.
+type okRoutes struct{}
+
+func (okRoutes) ok(w stdhttp.ResponseWriter) { w.WriteHeader(stdhttp.StatusOK) }
+
+func (h okRoutes) CreateUser(w stdhttp.ResponseWriter, _ *stdhttp.Request)       { h.ok(w) }
+func (h okRoutes) GetUser(w stdhttp.ResponseWriter, _ *stdhttp.Request)          { h.ok(w) }
+func (h okRoutes) ListUsers(w stdhttp.ResponseWriter, _ *stdhttp.Request)        { h.ok(w) }
+func (h okRoutes) UpdateUser(w stdhttp.ResponseWriter, _ *stdhttp.Request)       { h.ok(w) }
+func (h okRoutes) PatchUser(w stdhttp.ResponseWriter, _ *stdhttp.Request)        { h.ok(w) }
+func (h okRoutes) DeleteUser(w stdhttp.ResponseWriter, _ *stdhttp.Request)       { h.ok(w) }
+func (h okRoutes) RestoreUser(w stdhttp.ResponseWriter, _ *stdhttp.Request)      { h.ok(w) }
+func (h okRoutes) PurgeUsers(w stdhttp.ResponseWriter, _ *stdhttp.Request)       { h.ok(w) }
+func (h okRoutes) BatchCreateUsers(w stdhttp.ResponseWriter, _ *stdhttp.Request) { h.ok(w) }
+func (h okRoutes) ExportUsers(w stdhttp.ResponseWriter, _ *stdhttp.Request)      { h.ok(w) }
+func (h okRoutes) ImportUsers(w stdhttp.ResponseWriter, _ *stdhttp.Request)      { h.ok(w) }
+func (h okRoutes) GetJob(w stdhttp.ResponseWriter, _ *stdhttp.Request)           { h.ok(w) }
+func (h okRoutes) RevokeTokens(w stdhttp.ResponseWriter, _ *stdhttp.Request)     { h.ok(w) }
+func (h okRoutes) IssueToken(w stdhttp.ResponseWriter, _ *stdhttp.Request)       { h.ok(w) }
+func (h okRoutes) CreateClient(w stdhttp.ResponseWriter, _ *stdhttp.Request)     { h.ok(w) }
+func (h okRoutes) CreateAPIKey(w stdhttp.ResponseWriter, _ *stdhttp.Request)     { h.ok(w) }
+func (h okRoutes) ListAPIKeys(w stdhttp.ResponseWriter, _ *stdhttp.Request)      { h.ok(w) }
+func (h okRoutes) RevokeAPIKey(w stdhttp.ResponseWriter, _ *stdhttp.Request)     { h.ok(w) }
+func (h okRoutes) RotateAPIKey(w stdhttp.ResponseWriter, _ *stdhttp.Request)     { h.ok(w) }
+func (h okRoutes) GetAuditEvent(w stdhttp.ResponseWriter, _ *stdhttp.Request)    { h.ok(w) }
+func (h okRoutes) ListAuditEvents(w stdhttp.ResponseWriter, _ *stdhttp.Request)  { h.ok(w) }
+func (h okRoutes) VerifyAuditChain(w stdhttp.ResponseWriter, _ *stdhttp.Request) { h.ok(w) }
+func (okRoutes) ClientExists(context.Context, string) bool                       { return true }
+func (okRoutes) MaxUploadSize() int64                                            { return 1 << 20 }
+
+// openAPIBearerScopes returns the scopes of the bearerAuth security
+// requirement of each operation in the spec, keyed by "METHOD /path".
+func openAPIBearerScopes(t *testing.T) map[string][]string {
+	t.Helper()
+	data, err := os.ReadFile(openAPIPath)
+	require.NoError(t, err)
+
+	var spec struct {
+		Paths map[string]map[string]yaml.Node `yaml:"paths"`
+	}
+	require.NoError(t, yaml.Unmarshal(data, &spec))
+
+	scopes := make(map[string][]string)
+	for path, item := range spec.Paths {
+		for method, node := range item {
+			switch method {
+			case "get", "put", "post", "delete", "patch":
+			default:
+				continue
+			}
+			var op struct {
+				Security []map[string][]string `yaml:"security"`
+			}
+			require.NoError(t, node.Decode(&op), "%s %s", method, path)
+			for _, requirement := range op.Security {
+				if bearer, ok := requirement["bearerAuth"]; ok {
+					scopes[strings.ToUpper(method)+" "+path] = bearer
+				}
+			}
+		}
+	}
+	return scopes
+}
+
+func TestNewRouter_ScopesMatchOpenAPI(t *testing.T) {
+	mockMetrics := new(MockHTTPMetrics)
+	mockMetrics.On("IncRequest", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveRequestDuration", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveResponseSize", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	secret := []byte("test-secret-key-32-bytes-long!!!")
+	routes := okRoutes{}
+	router := NewRouter(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		false,
+		prometheus.NewRegistry(),
+		mockMetrics,
+		RouterHandlers{
+			UserHandler:       routes,
+			UserAdminHandler:  routes,
+			UserBatchHandler:  routes,
+			UserExportHandler: routes,
+			UserImportHandler: routes,
+			JobHandler:        routes,
+			TokenHandler:      routes,
+			OAuthHandler:      routes,
+			APIKeyHandler:     routes,
+			AuditEventHandler: routes,
+		},
+		1024,
+		JWTConfig{Enabled: true, Secret: secret},
+		RateLimitConfig{RequestsPerSecond: 100},
+		nil, // shutdownCoord - not tested here
+		nil, // idempotencyStore - not tested here
+		0,   // idempotencyTTL
+	)
+
+	// A token granting no scope a route requires, so each protected route
+	// answers with the scopes it requires.
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &ctxutil.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-123",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Role:     "admin",
+		Scope:    "openapi:test",
+		TenantID: "acme",
+	}).SignedString(secret)
+	require.NoError(t, err)
+
+	documented := openAPIBearerScopes(t)
+	registered := make(map[string]bool)
+
+	err = chi.Walk(router, func(method, route string, _ stdhttp.Handler, _ ...func(stdhttp.Handler) stdhttp.Handler) error {
+		if !strings.HasPrefix(route, BasePath+"/") {
+			return nil
+		}
+		key := method + " " + route
+		registered[key] = true
+
+		t.Run(key, func(t *testing.T) {
+			wantScopes, ok := documented[key]
+			require.True(t, ok, "route is not documented with bearerAuth in openapi.yaml")
+			assert.NotEmpty(t, wantScopes, "openapi.yaml lists no scopes for the route")
+
+			path := strings.ReplaceAll(route, "{id}", "019400a0-1234-7abc-8def-1234567890ab")
+			req := httptest.NewRequest(method, path, nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, stdhttp.StatusForbidden, w.Code, "route does not require scopes")
+			var problem struct {
+				RequiredScopes []string `json:"required_scopes"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+			assert.ElementsMatch(t, wantScopes, problem.RequiredScopes)
+		})
+		return nil
+	})
+	require.NoError(t, err)
+
+	for key := range documented {
+		assert.True(t, registered[key], "%s is documented in openapi.yaml but not registered", key)
+	}
+}
@@ -2,10 +2,12 @@ package http
 
 import (
 	"context"
+	"io"
 	"log/slog"
 	stdhttp "net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +16,9 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iruldev/golang-api-hexagonal/internal/app"
+	"github.com/iruldev/golang-api-hexagonal/internal/app/lockout"
+	"github.com/iruldev/golang-api-hexagonal/internal/domain"
 	"github.com/iruldev/golang-api-hexagonal/internal/transport/http/middleware"
 )
 
@@ -54,6 +59,174 @@ func (m *MockUserRoutes) ListUsers(w stdhttp.ResponseWriter, r *stdhttp.Request)
 	w.WriteHeader(stdhttp.StatusOK)
 }
 
+func (m *MockUserRoutes) UpdateUser(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusOK)
+}
+
+func (m *MockUserRoutes) PatchUser(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusOK)
+}
+
+func (m *MockUserRoutes) DeleteUser(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+type MockUserAdminRoutes struct {
+	mock.Mock
+}
+
+func (m *MockUserAdminRoutes) RestoreUser(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusOK)
+}
+
+func (m *MockUserAdminRoutes) PurgeUsers(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusOK)
+}
+
+type MockUserBatchRoutes struct {
+	mock.Mock
+}
+
+func (m *MockUserBatchRoutes) BatchCreateUsers(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusMultiStatus)
+}
+
+type MockUserExportRoutes struct {
+	mock.Mock
+}
+
+func (m *MockUserExportRoutes) ExportUsers(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusOK)
+}
+
+type MockUserImportRoutes struct {
+	mock.Mock
+}
+
+// testImportMaxUploadSize is the MaxUploadSize of MockUserImportRoutes.
+const testImportMaxUploadSize = 4096
+
+func (m *MockUserImportRoutes) ImportUsers(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	if _, err := io.Copy(io.Discard, r.Body); err != nil {
+		w.WriteHeader(stdhttp.StatusRequestEntityTooLarge)
+		return
+	}
+	w.WriteHeader(stdhttp.StatusAccepted)
+}
+
+func (m *MockUserImportRoutes) MaxUploadSize() int64 {
+	return testImportMaxUploadSize
+}
+
+type MockJobRoutes struct {
+	mock.Mock
+}
+
+func (m *MockJobRoutes) GetJob(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusOK)
+}
+
+type MockTokenRoutes struct {
+	mock.Mock
+}
+
+func (m *MockTokenRoutes) RevokeTokens(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusCreated)
+}
+
+type MockOAuthRoutes struct {
+	mock.Mock
+}
+
+func (m *MockOAuthRoutes) IssueToken(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusOK)
+}
+
+func (m *MockOAuthRoutes) CreateClient(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusCreated)
+}
+
+func (m *MockOAuthRoutes) ClientExists(ctx context.Context, clientID string) bool {
+	return m.Called(ctx, clientID).Bool(0)
+}
+
+// MockAPIKeyRoutes records the AuthContext of each request it serves.
+type MockAPIKeyRoutes struct {
+	mock.Mock
+	authCtx *app.AuthContext
+}
+
+func (m *MockAPIKeyRoutes) serve(method string, w stdhttp.ResponseWriter, r *stdhttp.Request, status int) {
+	m.MethodCalled(method, w, r)
+	m.authCtx = app.GetAuthContext(r.Context())
+	w.WriteHeader(status)
+}
+
+func (m *MockAPIKeyRoutes) CreateAPIKey(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.serve("CreateAPIKey", w, r, stdhttp.StatusCreated)
+}
+
+func (m *MockAPIKeyRoutes) ListAPIKeys(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.serve("ListAPIKeys", w, r, stdhttp.StatusOK)
+}
+
+func (m *MockAPIKeyRoutes) RevokeAPIKey(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.serve("RevokeAPIKey", w, r, stdhttp.StatusOK)
+}
+
+func (m *MockAPIKeyRoutes) RotateAPIKey(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.serve("RotateAPIKey", w, r, stdhttp.StatusCreated)
+}
+
+type MockAuditEventRoutes struct {
+	mock.Mock
+}
+
+func (m *MockAuditEventRoutes) GetAuditEvent(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusOK)
+}
+
+func (m *MockAuditEventRoutes) ListAuditEvents(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusOK)
+}
+
+func (m *MockAuditEventRoutes) VerifyAuditChain(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	m.Called(w, r)
+	w.WriteHeader(stdhttp.StatusOK)
+}
+
+// stubAPIKeyAuthenticator accepts a key granted the api_keys scopes, a
+// read-only key and a key created before keys had scopes.
+type stubAPIKeyAuthenticator struct{}
+
+func (stubAPIKeyAuthenticator) Authenticate(_ context.Context, key string) (domain.APIKey, error) {
+	apiKey := domain.APIKey{ID: "key-1", TenantID: "acme", Role: app.RoleAdmin}
+	switch key {
+	case "ak_valid":
+		apiKey.Scopes = []string{"api_keys:read", "api_keys:write"}
+	case "ak_readonly":
+		apiKey.Scopes = []string{"api_keys:read"}
+	case "ak_legacy":
+	default:
+		return domain.APIKey{}, &app.AppError{Code: app.CodeUnauthorized, Message: "Invalid API key"}
+	}
+	return apiKey, nil
+}
+
 // --- Tests ---
 
 func TestNewRouter_JWTEnabled(t *testing.T) {
@@ -164,6 +337,466 @@ func TestNewRouter_JWTDisabled(t *testing.T) {
 	mockUserHandler.AssertCalled(t, "ListUsers", mock.Anything, mock.Anything)
 }
 
+func TestNewRouter_UserAdminRoutes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	metricsReg := prometheus.NewRegistry()
+	mockMetrics := new(MockHTTPMetrics)
+	mockMetrics.On("IncRequest", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveRequestDuration", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveResponseSize", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	mockUserHandler := new(MockUserRoutes)
+	mockAdminHandler := new(MockUserAdminRoutes)
+	mockAdminHandler.On("RestoreUser", mock.Anything, mock.Anything).Return()
+	mockAdminHandler.On("PurgeUsers", mock.Anything, mock.Anything).Return()
+
+	router := NewRouter(
+		logger,
+		false,
+		metricsReg,
+		mockMetrics,
+		RouterHandlers{
+			LivenessHandler:  stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {}),
+			ReadinessHandler: stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {}),
+			UserHandler:      mockUserHandler,
+			UserAdminHandler: mockAdminHandler,
+		},
+		1024,
+		JWTConfig{Enabled: false},
+		RateLimitConfig{RequestsPerSecond: 100},
+		nil, // shutdownCoord - not tested here
+		nil, // idempotencyStore - not tested here
+		0,   // idempotencyTTL
+	)
+
+	// Custom-method routes must not collide with /users/{id}
+	req := httptest.NewRequest("POST", "/api/v1/users/019400a0-1234-7abc-8def-1234567890ab:restore", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusOK, w.Code)
+
+	req = httptest.NewRequest("POST", "/api/v1/users:purge", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusOK, w.Code)
+
+	mockAdminHandler.AssertExpectations(t)
+	mockUserHandler.AssertNotCalled(t, "CreateUser", mock.Anything, mock.Anything)
+}
+
+func TestNewRouter_UserBatchRoutes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockMetrics := new(MockHTTPMetrics)
+	mockMetrics.On("IncRequest", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveRequestDuration", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveResponseSize", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	mockUserHandler := new(MockUserRoutes)
+	mockBatchHandler := new(MockUserBatchRoutes)
+	mockBatchHandler.On("BatchCreateUsers", mock.Anything, mock.Anything).Return()
+
+	router := NewRouter(
+		logger,
+		false,
+		prometheus.NewRegistry(),
+		mockMetrics,
+		RouterHandlers{
+			UserHandler:      mockUserHandler,
+			UserBatchHandler: mockBatchHandler,
+		},
+		64,
+		JWTConfig{Enabled: false},
+		RateLimitConfig{RequestsPerSecond: 100},
+		nil, // shutdownCoord - not tested here
+		nil, // idempotencyStore - not tested here
+		0,   // idempotencyTTL
+	)
+
+	req := httptest.NewRequest("POST", "/api/v1/users:batchCreate", strings.NewReader(`{"items":[]}`))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusMultiStatus, w.Code)
+
+	// The global body limit applies to batches too
+	req = httptest.NewRequest("POST", "/api/v1/users:batchCreate", strings.NewReader(`{"items":[`+strings.Repeat(" ", 64)+`]}`))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusRequestEntityTooLarge, w.Code)
+
+	mockBatchHandler.AssertNumberOfCalls(t, "BatchCreateUsers", 1)
+	mockUserHandler.AssertNotCalled(t, "CreateUser", mock.Anything, mock.Anything)
+}
+
+func TestNewRouter_UserExportRoutes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockMetrics := new(MockHTTPMetrics)
+	mockMetrics.On("IncRequest", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveRequestDuration", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveResponseSize", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	mockUserHandler := new(MockUserRoutes)
+	mockExportHandler := new(MockUserExportRoutes)
+	mockExportHandler.On("ExportUsers", mock.Anything, mock.Anything).Return()
+
+	router := NewRouter(
+		logger,
+		false,
+		prometheus.NewRegistry(),
+		mockMetrics,
+		RouterHandlers{
+			UserHandler:       mockUserHandler,
+			UserExportHandler: mockExportHandler,
+		},
+		1024,
+		JWTConfig{Enabled: false},
+		RateLimitConfig{RequestsPerSecond: 100},
+		nil, // shutdownCoord - not tested here
+		nil, // idempotencyStore - not tested here
+		0,   // idempotencyTTL
+	)
+
+	req := httptest.NewRequest("GET", "/api/v1/users:export", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusOK, w.Code)
+
+	mockExportHandler.AssertNumberOfCalls(t, "ExportUsers", 1)
+	mockUserHandler.AssertNotCalled(t, "GetUser", mock.Anything, mock.Anything)
+}
+
+func TestNewRouter_UserImportAndJobRoutes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockMetrics := new(MockHTTPMetrics)
+	mockMetrics.On("IncRequest", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveRequestDuration", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveResponseSize", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	mockUserHandler := new(MockUserRoutes)
+	mockImportHandler := new(MockUserImportRoutes)
+	mockImportHandler.On("ImportUsers", mock.Anything, mock.Anything).Return()
+	mockJobHandler := new(MockJobRoutes)
+	mockJobHandler.On("GetJob", mock.Anything, mock.Anything).Return()
+
+	router := NewRouter(
+		logger,
+		false,
+		prometheus.NewRegistry(),
+		mockMetrics,
+		RouterHandlers{
+			UserHandler:       mockUserHandler,
+			UserImportHandler: mockImportHandler,
+			JobHandler:        mockJobHandler,
+		},
+		1024,
+		JWTConfig{Enabled: false},
+		RateLimitConfig{RequestsPerSecond: 100},
+		nil, // shutdownCoord - not tested here
+		nil, // idempotencyStore - not tested here
+		0,   // idempotencyTTL
+	)
+
+	req := httptest.NewRequest("POST", "/api/v1/users:import", strings.NewReader("email,firstName,lastName\n"))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusAccepted, w.Code)
+
+	req = httptest.NewRequest("GET", "/api/v1/jobs/019400a0-5678-7abc-8def-1234567890ab", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusOK, w.Code)
+
+	// Imports have their own body limit, above the router's limit of 1024 bytes
+	req = httptest.NewRequest("POST", "/api/v1/users:import", strings.NewReader(strings.Repeat("x", 2048)))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusAccepted, w.Code)
+
+	req = httptest.NewRequest("POST", "/api/v1/users:import", strings.NewReader(strings.Repeat("x", testImportMaxUploadSize+1)))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusRequestEntityTooLarge, w.Code)
+
+	mockImportHandler.AssertNumberOfCalls(t, "ImportUsers", 2)
+	mockJobHandler.AssertNumberOfCalls(t, "GetJob", 1)
+	mockUserHandler.AssertNotCalled(t, "CreateUser", mock.Anything, mock.Anything)
+}
+
+func TestNewRouter_TokenRoutes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockMetrics := new(MockHTTPMetrics)
+	mockMetrics.On("IncRequest", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveRequestDuration", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveResponseSize", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	mockUserHandler := new(MockUserRoutes)
+	mockTokenHandler := new(MockTokenRoutes)
+	mockTokenHandler.On("RevokeTokens", mock.Anything, mock.Anything).Return()
+
+	router := NewRouter(
+		logger,
+		false,
+		prometheus.NewRegistry(),
+		mockMetrics,
+		RouterHandlers{
+			UserHandler:  mockUserHandler,
+			TokenHandler: mockTokenHandler,
+		},
+		1024,
+		JWTConfig{Enabled: false},
+		RateLimitConfig{RequestsPerSecond: 100},
+		nil, // shutdownCoord - not tested here
+		nil, // idempotencyStore - not tested here
+		0,   // idempotencyTTL
+	)
+
+	req := httptest.NewRequest("POST", "/api/v1/tokens:revoke", strings.NewReader(`{"jti":"jti-1"}`))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusCreated, w.Code)
+
+	mockTokenHandler.AssertNumberOfCalls(t, "RevokeTokens", 1)
+}
+
+func TestNewRouter_OAuthRoutes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockMetrics := new(MockHTTPMetrics)
+	mockMetrics.On("IncRequest", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveRequestDuration", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveResponseSize", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	mockUserHandler := new(MockUserRoutes)
+	mockOAuthHandler := new(MockOAuthRoutes)
+	mockOAuthHandler.On("IssueToken", mock.Anything, mock.Anything).Return()
+
+	router := NewRouter(
+		logger,
+		false,
+		prometheus.NewRegistry(),
+		mockMetrics,
+		RouterHandlers{
+			UserHandler:  mockUserHandler,
+			OAuthHandler: mockOAuthHandler,
+		},
+		1024,
+		JWTConfig{Enabled: true, Secret: []byte("test-secret-key-32-bytes-long!!!")},
+		RateLimitConfig{RequestsPerSecond: 100},
+		nil, // shutdownCoord - not tested here
+		nil, // idempotencyStore - not tested here
+		0,   // idempotencyTTL
+	)
+
+	// The token endpoint authenticates clients itself, not with a JWT.
+	req := httptest.NewRequest("POST", "/oauth/token", strings.NewReader("grant_type=client_credentials"))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusOK, w.Code)
+
+	// Client registration is part of the protected API.
+	req = httptest.NewRequest("POST", "/api/v1/oauth-clients", strings.NewReader(`{"name":"svc","role":"user"}`))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusUnauthorized, w.Code)
+
+	mockOAuthHandler.AssertNumberOfCalls(t, "IssueToken", 1)
+	mockOAuthHandler.AssertNotCalled(t, "CreateClient", mock.Anything, mock.Anything)
+}
+
+func TestNewRouter_AuthLockout(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockMetrics := new(MockHTTPMetrics)
+	mockMetrics.On("IncRequest", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveRequestDuration", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveResponseSize", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	mockUserHandler := new(MockUserRoutes)
+	mockOAuthHandler := new(MockOAuthRoutes)
+	tracker := lockout.NewTracker(lockout.Config{MaxFailures: 2}, nil, nil, nil, nil, logger)
+
+	router := NewRouter(
+		logger,
+		false,
+		prometheus.NewRegistry(),
+		mockMetrics,
+		RouterHandlers{
+			UserHandler:  mockUserHandler,
+			OAuthHandler: mockOAuthHandler,
+		},
+		1024,
+		JWTConfig{Enabled: true, Secret: []byte("test-secret-key-32-bytes-long!!!"), Lockout: tracker},
+		RateLimitConfig{RequestsPerSecond: 100},
+		nil, // shutdownCoord - not tested here
+		nil, // idempotencyStore - not tested here
+		0,   // idempotencyTTL
+	)
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest("GET", "/api/v1/users", nil)
+		req.Header.Set("Authorization", "Bearer invalid.token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, stdhttp.StatusUnauthorized, w.Code)
+	}
+
+	// The client IP is now locked out of the API and the token endpoint.
+	req := httptest.NewRequest("GET", "/api/v1/users", nil)
+	req.Header.Set("Authorization", "Bearer invalid.token")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+
+	req = httptest.NewRequest("POST", "/oauth/token", strings.NewReader("grant_type=client_credentials"))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusTooManyRequests, w.Code)
+
+	mockOAuthHandler.AssertNotCalled(t, "IssueToken", mock.Anything, mock.Anything)
+}
+
+func TestNewRouter_APIKeyRoutes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockMetrics := new(MockHTTPMetrics)
+	mockMetrics.On("IncRequest", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveRequestDuration", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveResponseSize", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	mockAPIKeyHandler := new(MockAPIKeyRoutes)
+	mockAPIKeyHandler.On("CreateAPIKey", mock.Anything, mock.Anything).Return()
+	mockAPIKeyHandler.On("ListAPIKeys", mock.Anything, mock.Anything).Return()
+	mockAPIKeyHandler.On("RevokeAPIKey", mock.Anything, mock.Anything).Return()
+	mockAPIKeyHandler.On("RotateAPIKey", mock.Anything, mock.Anything).Return()
+
+	newRouter := func(allowUnscoped bool) stdhttp.Handler {
+		return NewRouter(
+			logger,
+			false,
+			prometheus.NewRegistry(),
+			mockMetrics,
+			RouterHandlers{
+				UserHandler:   new(MockUserRoutes),
+				APIKeyHandler: mockAPIKeyHandler,
+			},
+			1024,
+			JWTConfig{
+				Enabled:       true,
+				Secret:        []byte("test-secret-key-32-bytes-long!!!"),
+				APIKeys:       stubAPIKeyAuthenticator{},
+				AllowUnscoped: allowUnscoped,
+			},
+			RateLimitConfig{RequestsPerSecond: 100},
+			nil, // shutdownCoord - not tested here
+			nil, // idempotencyStore - not tested here
+			0,   // idempotencyTTL
+		)
+	}
+	router := newRouter(false)
+
+	tests := []struct {
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{"POST", "/api/v1/api-keys", stdhttp.StatusCreated},
+		{"GET", "/api/v1/api-keys", stdhttp.StatusOK},
+		{"POST", "/api/v1/api-keys/019400a0-1234-7abc-8def-1234567890ab:revoke", stdhttp.StatusOK},
+		{"POST", "/api/v1/api-keys/019400a0-1234-7abc-8def-1234567890ab:rotate", stdhttp.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			mockAPIKeyHandler.authCtx = nil
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("X-API-Key", "ak_valid")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			require.NotNil(t, mockAPIKeyHandler.authCtx, "API key callers get the same AuthContext as JWT callers")
+			assert.Equal(t, "key-1", mockAPIKeyHandler.authCtx.SubjectID)
+			assert.True(t, mockAPIKeyHandler.authCtx.IsAdmin())
+			assert.Equal(t, []string{"api_keys:read", "api_keys:write"}, mockAPIKeyHandler.authCtx.Scopes)
+		})
+	}
+
+	// An invalid key is rejected even though JWTAuth would accept no token either.
+	req := httptest.NewRequest("GET", "/api/v1/api-keys", nil)
+	req.Header.Set("X-API-Key", "ak_invalid")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusUnauthorized, w.Code)
+
+	// A key is limited to its own scopes.
+	req = httptest.NewRequest("POST", "/api/v1/api-keys", nil)
+	req.Header.Set("X-API-Key", "ak_readonly")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusForbidden, w.Code)
+	assert.Contains(t, w.Header().Get("WWW-Authenticate"), `error="insufficient_scope"`)
+
+	// A key without scopes is rejected on scoped routes unless unscoped
+	// credentials are allowed.
+	req = httptest.NewRequest("GET", "/api/v1/api-keys", nil)
+	req.Header.Set("X-API-Key", "ak_legacy")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusForbidden, w.Code)
+
+	req = httptest.NewRequest("GET", "/api/v1/api-keys", nil)
+	req.Header.Set("X-API-Key", "ak_legacy")
+	w = httptest.NewRecorder()
+	newRouter(true).ServeHTTP(w, req)
+	assert.Equal(t, stdhttp.StatusOK, w.Code)
+	mockAPIKeyHandler.AssertNumberOfCalls(t, "ListAPIKeys", 2)
+	mockAPIKeyHandler.AssertNumberOfCalls(t, "CreateAPIKey", 1)
+}
+
+func TestNewRouter_AuditEventRoutes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mockMetrics := new(MockHTTPMetrics)
+	mockMetrics.On("IncRequest", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveRequestDuration", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockMetrics.On("ObserveResponseSize", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	mockUserHandler := new(MockUserRoutes)
+	mockAuditHandler := new(MockAuditEventRoutes)
+	mockAuditHandler.On("GetAuditEvent", mock.Anything, mock.Anything).Return()
+	mockAuditHandler.On("ListAuditEvents", mock.Anything, mock.Anything).Return()
+	mockAuditHandler.On("VerifyAuditChain", mock.Anything, mock.Anything).Return()
+
+	router := NewRouter(
+		logger,
+		false,
+		prometheus.NewRegistry(),
+		mockMetrics,
+		RouterHandlers{
+			UserHandler:       mockUserHandler,
+			AuditEventHandler: mockAuditHandler,
+		},
+		1024,
+		JWTConfig{Enabled: false},
+		RateLimitConfig{RequestsPerSecond: 100},
+		nil, // shutdownCoord - not tested here
+		nil, // idempotencyStore - not tested here
+		0,   // idempotencyTTL
+	)
+
+	for _, path := range []string{
+		"/api/v1/audit-events?eventType=user.*",
+		"/api/v1/audit-events/019400a0-1234-7abc-8def-1234567890ab",
+		"/api/v1/audit-events:verify",
+	} {
+		req := httptest.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, stdhttp.StatusOK, w.Code, path)
+	}
+
+	mockAuditHandler.AssertNumberOfCalls(t, "ListAuditEvents", 1)
+	mockAuditHandler.AssertNumberOfCalls(t, "GetAuditEvent", 1)
+	mockAuditHandler.AssertNumberOfCalls(t, "VerifyAuditChain", 1)
+}
+
 func TestNewRouter_LivenessCheck_NoAuth(t *testing.T) {
 	// Setup dependencies
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
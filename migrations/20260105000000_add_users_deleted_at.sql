@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Soft delete for users: DELETE marks the row instead of dropping it.
+-- Rows are hard-deleted later by the purge operation once past the retention window.
+ALTER TABLE users ADD COLUMN deleted_at TIMESTAMPTZ NULL;
+
+-- Email uniqueness only applies to active users so a soft-deleted user's
+-- email can be registered again. The index keeps its name so the repository's
+-- constraint-based error mapping is unchanged.
+DROP INDEX IF EXISTS uniq_users_email;
+CREATE UNIQUE INDEX uniq_users_email ON users(email) WHERE deleted_at IS NULL;
+
+-- Partial index for the purge operation (only soft-deleted rows)
+CREATE INDEX idx_users_deleted_at ON users(deleted_at) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_deleted_at;
+
+-- Soft-deleted rows must be removed first, otherwise restoring the full
+-- unique index can fail on emails that were re-registered.
+DELETE FROM users WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS uniq_users_email;
+CREATE UNIQUE INDEX uniq_users_email ON users(email);
+
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
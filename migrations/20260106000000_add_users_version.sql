@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Optimistic concurrency control for users.
+-- Every write increments version; writers must present the version they read
+-- (exposed to HTTP clients as a strong ETag) and stale writes match no rows.
+ALTER TABLE users ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keyset (cursor) pagination walks lists in (time DESC, id DESC) order and
+-- seeks with a row comparison on the same columns; these indexes serve both
+-- directions without a sort.
+CREATE INDEX idx_users_active_created_at_id ON users(created_at DESC, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX idx_audit_events_entity_time_id ON audit_events(entity_type, entity_id, timestamp DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_audit_events_entity_time_id;
+DROP INDEX IF EXISTS idx_users_active_created_at_id;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Filtering, sorting and free-text search on GET /api/v1/users.
+-- Index expressions must match the predicates built by userListQuery
+-- (internal/infra/postgres/user_filter.go) for the planner to use them.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- ?q= prefix match on names and email (trigram GIN indexes serve LIKE 'x%').
+CREATE INDEX idx_users_first_name_trgm ON users USING gin (lower(first_name) gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX idx_users_last_name_trgm ON users USING gin (lower(last_name) gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX idx_users_email_trgm ON users USING gin (lower(email::text) gin_trgm_ops) WHERE deleted_at IS NULL;
+
+-- ?q= fuzzy match on the full name (similarity operator %).
+CREATE INDEX idx_users_full_name_trgm ON users USING gin ((first_name || ' ' || last_name) gin_trgm_ops) WHERE deleted_at IS NULL;
+
+-- ?sort= on the remaining sortable columns (created_at and email are already indexed).
+CREATE INDEX idx_users_updated_at ON users(updated_at) WHERE deleted_at IS NULL;
+CREATE INDEX idx_users_first_name ON users(first_name) WHERE deleted_at IS NULL;
+CREATE INDEX idx_users_last_name ON users(last_name) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_last_name;
+DROP INDEX IF EXISTS idx_users_first_name;
+DROP INDEX IF EXISTS idx_users_updated_at;
+DROP INDEX IF EXISTS idx_users_full_name_trgm;
+DROP INDEX IF EXISTS idx_users_email_trgm;
+DROP INDEX IF EXISTS idx_users_last_name_trgm;
+DROP INDEX IF EXISTS idx_users_first_name_trgm;
+
+-- Only drop the extension if nothing else depends on it.
+DROP EXTENSION IF EXISTS pg_trgm;
+-- +goose StatementEnd
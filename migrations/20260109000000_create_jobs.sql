@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Background jobs with persisted progress (e.g. CSV user imports).
+-- Workers lease a job by setting locked_until and commit progress together
+-- with the work done, so a job whose worker dies is resumed from next_row by
+-- another worker once the lease expires.
+CREATE TABLE jobs (
+    id              UUID PRIMARY KEY,
+    type            TEXT NOT NULL,
+    status          TEXT NOT NULL CHECK (status IN ('pending', 'running', 'succeeded', 'failed')),
+    created_by      UUID,
+    request_id      TEXT,
+    input           BYTEA,
+    total_rows      INTEGER NOT NULL DEFAULT 0,
+    next_row        INTEGER NOT NULL DEFAULT 0,
+    processed_rows  INTEGER NOT NULL DEFAULT 0,
+    failed_rows     INTEGER NOT NULL DEFAULT 0,
+    errors          JSONB NOT NULL DEFAULT '[]',
+    error           TEXT,
+    locked_until    TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL,
+    updated_at      TIMESTAMPTZ NOT NULL,
+    started_at      TIMESTAMPTZ,
+    finished_at     TIMESTAMPTZ
+);
+
+-- Workers look for the oldest claimable job of a type
+CREATE INDEX idx_jobs_claimable ON jobs(type, created_at) WHERE status IN ('pending', 'running');
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS jobs;
+
+-- +goose StatementEnd
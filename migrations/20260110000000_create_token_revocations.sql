@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Revoked JWTs, checked by the JWT middleware through an in-process cache.
+-- A row revokes either one token by its jti claim, or every token of a
+-- subject issued before issued_before. Rows are kept until expires_at, after
+-- which the revoked tokens have expired anyway, and then removed by a cleaner.
+CREATE TABLE token_revocations (
+    id              UUID PRIMARY KEY,
+    jti             TEXT,
+    subject         TEXT,
+    issued_before   TIMESTAMPTZ,
+    expires_at      TIMESTAMPTZ NOT NULL,
+    revoked_by      TEXT,
+    request_id      TEXT,
+    created_at      TIMESTAMPTZ NOT NULL,
+    CONSTRAINT token_revocations_target_check CHECK ((jti IS NULL) <> (subject IS NULL)),
+    CONSTRAINT token_revocations_subject_check CHECK (subject IS NULL OR issued_before IS NOT NULL)
+);
+
+-- Index for loading active revocations and for the cleanup job
+CREATE INDEX idx_token_revocations_expires_at ON token_revocations(expires_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS token_revocations;
+
+-- +goose StatementEnd
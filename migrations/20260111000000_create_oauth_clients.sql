@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Machine clients of the OAuth 2.0 client_credentials grant (POST /oauth/token).
+-- The id is the client_id. Only a hash of the client secret is stored; the
+-- secret is shown once when the client is created. Disabled clients
+-- (disabled_at set) can no longer obtain tokens.
+CREATE TABLE oauth_clients (
+    id              UUID PRIMARY KEY,
+    name            TEXT NOT NULL,
+    secret_hash     TEXT NOT NULL,
+    role            TEXT NOT NULL,
+    created_by      TEXT,
+    disabled_at     TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS oauth_clients;
+
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- API keys of partner integrations that cannot use JWTs. Keys are high-entropy
+-- random strings, so they are looked up by their SHA-256 digest (key_hash);
+-- the key itself is shown once when it is created. prefix is the start of
+-- the key, kept to recognise it. A key authenticates until it is revoked
+-- (revoked_at) or expires (expires_at, set on the old key by a rotation).
+CREATE TABLE api_keys (
+    id              UUID PRIMARY KEY,
+    name            TEXT NOT NULL,
+    prefix          TEXT NOT NULL,
+    key_hash        TEXT NOT NULL,
+    role            TEXT NOT NULL,
+    created_by      TEXT,
+    created_at      TIMESTAMPTZ NOT NULL,
+    expires_at      TIMESTAMPTZ,
+    revoked_at      TIMESTAMPTZ,
+    last_used_at    TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_api_keys_key_hash ON api_keys(key_hash);
+CREATE INDEX idx_api_keys_created_at ON api_keys(created_at DESC);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS api_keys;
+
+-- +goose StatementEnd
@@ -0,0 +1,128 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Multi-tenancy: every tenant-owned row carries the ID of its tenant. Rows
+-- created before tenants existed belong to the 'default' tenant, which is also
+-- the tenant of tokens without a tenant_id claim.
+ALTER TABLE users ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE audit_events ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE idempotency_keys ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE jobs ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE api_keys ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE oauth_clients ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+-- New rows must name their tenant.
+ALTER TABLE users ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE audit_events ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE idempotency_keys ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE jobs ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE api_keys ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE oauth_clients ALTER COLUMN tenant_id DROP DEFAULT;
+
+-- Emails are unique per tenant.
+DROP INDEX IF EXISTS uniq_users_email;
+CREATE UNIQUE INDEX uniq_users_email ON users(tenant_id, email) WHERE deleted_at IS NULL;
+
+-- Idempotency keys are chosen by clients, so two tenants may use the same key.
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (tenant_id, key);
+
+-- Lists are read within a tenant; lead the keyset indexes with it.
+DROP INDEX IF EXISTS idx_users_active_created_at_id;
+CREATE INDEX idx_users_active_created_at_id ON users(tenant_id, created_at DESC, id DESC) WHERE deleted_at IS NULL;
+DROP INDEX IF EXISTS idx_audit_events_entity_time_id;
+CREATE INDEX idx_audit_events_entity_time_id ON audit_events(tenant_id, entity_type, entity_id, timestamp DESC, id DESC);
+CREATE INDEX idx_api_keys_tenant_created_at ON api_keys(tenant_id, created_at DESC);
+
+-- Row-level security backs the tenant filters of the queries. TxManager.WithTx
+-- sets app.tenant_id with SET LOCAL semantics, so a transaction only sees and
+-- writes rows of its tenant. Outside such a transaction the setting is unset
+-- (or reset to '') and the policies defer to the queries' own tenant filters;
+-- this keeps tenant-independent work such as job claiming, credential lookup
+-- and cleanup possible. FORCE applies the policies to the table owner too;
+-- superusers and BYPASSRLS roles are never subject to them.
+CREATE FUNCTION app_current_tenant() RETURNS TEXT
+    LANGUAGE sql STABLE
+    AS $$ SELECT NULLIF(current_setting('app.tenant_id', true), '') $$;
+
+ALTER TABLE users ENABLE ROW LEVEL SECURITY;
+ALTER TABLE users FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON users
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+
+ALTER TABLE audit_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE audit_events FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON audit_events
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+
+ALTER TABLE idempotency_keys ENABLE ROW LEVEL SECURITY;
+ALTER TABLE idempotency_keys FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON idempotency_keys
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+
+ALTER TABLE jobs ENABLE ROW LEVEL SECURITY;
+ALTER TABLE jobs FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON jobs
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+
+ALTER TABLE api_keys ENABLE ROW LEVEL SECURITY;
+ALTER TABLE api_keys FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON api_keys
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+
+ALTER TABLE oauth_clients ENABLE ROW LEVEL SECURITY;
+ALTER TABLE oauth_clients FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON oauth_clients
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP POLICY IF EXISTS tenant_isolation ON oauth_clients;
+ALTER TABLE oauth_clients NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE oauth_clients DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON api_keys;
+ALTER TABLE api_keys NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE api_keys DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON jobs;
+ALTER TABLE jobs NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE jobs DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON idempotency_keys;
+ALTER TABLE idempotency_keys NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE idempotency_keys DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON audit_events;
+ALTER TABLE audit_events NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE audit_events DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON users;
+ALTER TABLE users NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE users DISABLE ROW LEVEL SECURITY;
+DROP FUNCTION IF EXISTS app_current_tenant();
+
+DROP INDEX IF EXISTS idx_api_keys_tenant_created_at;
+DROP INDEX IF EXISTS idx_audit_events_entity_time_id;
+CREATE INDEX idx_audit_events_entity_time_id ON audit_events(entity_type, entity_id, timestamp DESC, id DESC);
+DROP INDEX IF EXISTS idx_users_active_created_at_id;
+CREATE INDEX idx_users_active_created_at_id ON users(created_at DESC, id DESC) WHERE deleted_at IS NULL;
+
+-- Fails if two tenants used the same idempotency key or email.
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (key);
+DROP INDEX IF EXISTS uniq_users_email;
+CREATE UNIQUE INDEX uniq_users_email ON users(email) WHERE deleted_at IS NULL;
+
+ALTER TABLE oauth_clients DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE jobs DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE audit_events DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE users DROP COLUMN IF EXISTS tenant_id;
+
+-- +goose StatementEnd
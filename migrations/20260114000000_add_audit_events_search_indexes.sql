@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Filtering and keyset pagination on GET /api/v1/audit-events.
+-- Index expressions must match the predicates built by auditListQuery
+-- (internal/infra/postgres/audit_event_filter.go) for the planner to use them.
+-- Entity filters are served by idx_audit_events_entity_time_id.
+
+-- Unfiltered and time-range listings, newest first.
+CREATE INDEX idx_audit_events_tenant_time_id ON audit_events(tenant_id, timestamp DESC, id DESC);
+
+-- ?actorId= listings.
+CREATE INDEX idx_audit_events_actor_time_id ON audit_events(tenant_id, actor_id, timestamp DESC, id DESC) WHERE actor_id IS NOT NULL;
+
+-- ?eventType= exact and prefix (user.*) matches; varchar_pattern_ops serves
+-- LIKE 'x%' whatever the database collation.
+CREATE INDEX idx_audit_events_event_type_pattern ON audit_events(tenant_id, event_type varchar_pattern_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_audit_events_event_type_pattern;
+DROP INDEX IF EXISTS idx_audit_events_actor_time_id;
+DROP INDEX IF EXISTS idx_audit_events_tenant_time_id;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Tamper-evident audit log: each tenant's events form a SHA-256 hash chain.
+-- chain_seq is the position in the tenant's chain (from 1), prev_hash the hash
+-- of the event before it (32 zero bytes for the first) and hash the SHA-256 of
+-- the event's fields and prev_hash (domain.AuditEvent.ChainHash).
+-- AuditEventRepo.Create appends under a per-tenant advisory lock.
+-- Events recorded before this migration stay unchained (all three NULL).
+ALTER TABLE audit_events
+    ADD COLUMN chain_seq bigint,
+    ADD COLUMN prev_hash bytea,
+    ADD COLUMN hash bytea;
+
+ALTER TABLE audit_events ADD CONSTRAINT chk_audit_events_chain CHECK (
+    (chain_seq IS NULL AND prev_hash IS NULL AND hash IS NULL)
+    OR (chain_seq > 0 AND length(prev_hash) = 32 AND length(hash) = 32)
+);
+
+-- One event per chain position: a second writer that missed the lock fails
+-- instead of forking the chain. Also serves the head lookup and verification.
+CREATE UNIQUE INDEX uniq_audit_events_chain_seq ON audit_events(tenant_id, chain_seq);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uniq_audit_events_chain_seq;
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS chk_audit_events_chain;
+ALTER TABLE audit_events
+    DROP COLUMN IF EXISTS hash,
+    DROP COLUMN IF EXISTS prev_hash,
+    DROP COLUMN IF EXISTS chain_seq;
+-- +goose StatementEnd
@@ -0,0 +1,151 @@
+-- +goose Up
+-- +goose StatementBegin
+-- audit_events becomes a table range-partitioned by month on timestamp, so
+-- retention can detach or drop whole months instead of deleting rows.
+-- Partitions are named audit_events_pYYYYMM and cover [first of the month,
+-- first of the next month) in UTC; AuditPartitionMaintainer creates them ahead
+-- of time and removes them past AUDIT_RETENTION. Events outside every monthly
+-- partition land in audit_events_default, which is never removed.
+--
+-- Existing events are copied into the new table, which locks audit_events for
+-- the duration of the migration.
+ALTER TABLE audit_events RENAME TO audit_events_unpartitioned;
+
+CREATE TABLE audit_events (
+    id uuid NOT NULL,
+    event_type varchar(100) NOT NULL,
+    actor_id uuid,  -- NULL for system/unauthenticated events
+    entity_type varchar(50) NOT NULL,
+    entity_id uuid NOT NULL,
+    payload jsonb NOT NULL,
+    timestamp timestamptz NOT NULL,
+    request_id varchar(64),
+    tenant_id TEXT NOT NULL,
+    chain_seq bigint,
+    prev_hash bytea,
+    hash bytea,
+    CONSTRAINT chk_audit_events_chain CHECK (
+        (chain_seq IS NULL AND prev_hash IS NULL AND hash IS NULL)
+        OR (chain_seq > 0 AND length(prev_hash) = 32 AND length(hash) = 32)
+    )
+) PARTITION BY RANGE (timestamp);
+
+CREATE TABLE audit_events_default PARTITION OF audit_events DEFAULT;
+
+-- One partition per month from the oldest event to three months ahead.
+DO $$
+DECLARE
+    m timestamp;
+BEGIN
+    FOR m IN SELECT generate_series(
+        date_trunc('month', LEAST((SELECT min(timestamp) FROM audit_events_unpartitioned), now()) AT TIME ZONE 'UTC'),
+        date_trunc('month', now() AT TIME ZONE 'UTC') + interval '3 months',
+        interval '1 month'
+    ) LOOP
+        EXECUTE format(
+            'CREATE TABLE %I PARTITION OF audit_events FOR VALUES FROM (%L) TO (%L)',
+            'audit_events_p' || to_char(m, 'YYYYMM'),
+            m::text || '+00',
+            (m + interval '1 month')::text || '+00'
+        );
+    END LOOP;
+END $$;
+
+INSERT INTO audit_events (id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash)
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
+FROM audit_events_unpartitioned;
+
+DROP TABLE audit_events_unpartitioned;
+
+-- Unique constraints on a partitioned table must include the partition key.
+ALTER TABLE audit_events ADD PRIMARY KEY (id, timestamp);
+
+-- The tenant-led indexes below serve every query; the single-column indexes
+-- of create_audit_events predate tenants and are not recreated.
+CREATE INDEX idx_audit_events_entity_time_id ON audit_events(tenant_id, entity_type, entity_id, timestamp DESC, id DESC);
+CREATE INDEX idx_audit_events_tenant_time_id ON audit_events(tenant_id, timestamp DESC, id DESC);
+CREATE INDEX idx_audit_events_actor_time_id ON audit_events(tenant_id, actor_id, timestamp DESC, id DESC) WHERE actor_id IS NOT NULL;
+CREATE INDEX idx_audit_events_event_type_pattern ON audit_events(tenant_id, event_type varchar_pattern_ops);
+CREATE INDEX idx_audit_events_request_id ON audit_events(tenant_id, request_id);
+
+-- Serves the chain head lookup and verification. It can no longer be unique
+-- (chain_seq is not the partition key): the advisory lock taken by
+-- AuditEventRepo.Create keeps chain positions unique, and verification
+-- reports a duplicate position as a break.
+CREATE INDEX idx_audit_events_chain_seq ON audit_events(tenant_id, chain_seq);
+
+ALTER TABLE audit_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE audit_events FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON audit_events
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+
+-- Retention removes the oldest links of the hash chains. Before a partition is
+-- removed, the last chained event of each tenant in it is recorded here, so
+-- verification resumes after it and a tenant whose events were all removed
+-- continues its chain instead of starting over.
+CREATE TABLE audit_chain_anchors (
+    tenant_id TEXT PRIMARY KEY,
+    chain_seq bigint NOT NULL CHECK (chain_seq > 0),
+    hash bytea NOT NULL CHECK (length(hash) = 32),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+ALTER TABLE audit_chain_anchors ENABLE ROW LEVEL SECURITY;
+ALTER TABLE audit_chain_anchors FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON audit_chain_anchors
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Removed partitions are not restored, and without their anchors the chains
+-- of tenants with removed events no longer verify from position 1.
+DROP TABLE IF EXISTS audit_chain_anchors;
+
+ALTER TABLE audit_events RENAME TO audit_events_partitioned;
+
+CREATE TABLE audit_events (
+    id uuid NOT NULL,
+    event_type varchar(100) NOT NULL,
+    actor_id uuid,
+    entity_type varchar(50) NOT NULL,
+    entity_id uuid NOT NULL,
+    payload jsonb NOT NULL,
+    timestamp timestamptz NOT NULL,
+    request_id varchar(64),
+    tenant_id TEXT NOT NULL,
+    chain_seq bigint,
+    prev_hash bytea,
+    hash bytea,
+    CONSTRAINT chk_audit_events_chain CHECK (
+        (chain_seq IS NULL AND prev_hash IS NULL AND hash IS NULL)
+        OR (chain_seq > 0 AND length(prev_hash) = 32 AND length(hash) = 32)
+    )
+);
+
+INSERT INTO audit_events (id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash)
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
+FROM audit_events_partitioned;
+
+DROP TABLE audit_events_partitioned;
+
+ALTER TABLE audit_events ADD PRIMARY KEY (id);
+CREATE INDEX idx_audit_events_event_type ON audit_events(event_type);
+CREATE INDEX idx_audit_events_entity_type ON audit_events(entity_type);
+CREATE INDEX idx_audit_events_entity_time ON audit_events(entity_type, entity_id, timestamp DESC);
+CREATE INDEX idx_audit_events_timestamp ON audit_events(timestamp DESC);
+CREATE INDEX idx_audit_events_request_id ON audit_events(request_id);
+CREATE INDEX idx_audit_events_entity_time_id ON audit_events(tenant_id, entity_type, entity_id, timestamp DESC, id DESC);
+CREATE INDEX idx_audit_events_tenant_time_id ON audit_events(tenant_id, timestamp DESC, id DESC);
+CREATE INDEX idx_audit_events_actor_time_id ON audit_events(tenant_id, actor_id, timestamp DESC, id DESC) WHERE actor_id IS NOT NULL;
+CREATE INDEX idx_audit_events_event_type_pattern ON audit_events(tenant_id, event_type varchar_pattern_ops);
+CREATE UNIQUE INDEX uniq_audit_events_chain_seq ON audit_events(tenant_id, chain_seq);
+
+ALTER TABLE audit_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE audit_events FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON audit_events
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+-- +goose StatementEnd
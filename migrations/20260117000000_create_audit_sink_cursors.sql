@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Progress of each audit sink (AUDIT_SINKS) through each tenant's hash chain:
+-- chain_seq is the last event delivered to the sink. Chain positions are
+-- assigned under the tenant's advisory lock and become visible in commit
+-- order, so events after chain_seq are exactly the committed ones not yet
+-- delivered. A sink without a row for a tenant starts at the first event.
+CREATE TABLE audit_sink_cursors (
+    sink varchar(50) NOT NULL,
+    tenant_id TEXT NOT NULL,
+    chain_seq bigint NOT NULL CHECK (chain_seq > 0),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (sink, tenant_id)
+);
+
+ALTER TABLE audit_sink_cursors ENABLE ROW LEVEL SECURITY;
+ALTER TABLE audit_sink_cursors FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON audit_sink_cursors
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_sink_cursors;
+-- +goose StatementEnd
@@ -0,0 +1,70 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- The tenant_isolation policies admitted every row while app.tenant_id was
+-- unset, so a statement that missed its tenant saw all tenants. They now only
+-- admit rows of app.tenant_id, which the connection pool sets for every
+-- pooled query and TxManager.WithTx for every transaction. Work over all
+-- tenants (cleanup, partition maintenance, audit sink delivery, job claiming
+-- and credential lookups) opts in explicitly with app.cross_tenant = 'on'.
+CREATE FUNCTION app_cross_tenant() RETURNS BOOLEAN
+    LANGUAGE sql STABLE
+    AS $$ SELECT COALESCE(current_setting('app.cross_tenant', true), '') = 'on' $$;
+
+ALTER POLICY tenant_isolation ON users
+    USING (tenant_id = app_current_tenant() OR app_cross_tenant())
+    WITH CHECK (tenant_id = app_current_tenant() OR app_cross_tenant());
+ALTER POLICY tenant_isolation ON audit_events
+    USING (tenant_id = app_current_tenant() OR app_cross_tenant())
+    WITH CHECK (tenant_id = app_current_tenant() OR app_cross_tenant());
+ALTER POLICY tenant_isolation ON idempotency_keys
+    USING (tenant_id = app_current_tenant() OR app_cross_tenant())
+    WITH CHECK (tenant_id = app_current_tenant() OR app_cross_tenant());
+ALTER POLICY tenant_isolation ON jobs
+    USING (tenant_id = app_current_tenant() OR app_cross_tenant())
+    WITH CHECK (tenant_id = app_current_tenant() OR app_cross_tenant());
+ALTER POLICY tenant_isolation ON api_keys
+    USING (tenant_id = app_current_tenant() OR app_cross_tenant())
+    WITH CHECK (tenant_id = app_current_tenant() OR app_cross_tenant());
+ALTER POLICY tenant_isolation ON oauth_clients
+    USING (tenant_id = app_current_tenant() OR app_cross_tenant())
+    WITH CHECK (tenant_id = app_current_tenant() OR app_cross_tenant());
+ALTER POLICY tenant_isolation ON audit_chain_anchors
+    USING (tenant_id = app_current_tenant() OR app_cross_tenant())
+    WITH CHECK (tenant_id = app_current_tenant() OR app_cross_tenant());
+ALTER POLICY tenant_isolation ON audit_sink_cursors
+    USING (tenant_id = app_current_tenant() OR app_cross_tenant())
+    WITH CHECK (tenant_id = app_current_tenant() OR app_cross_tenant());
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER POLICY tenant_isolation ON audit_sink_cursors
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+ALTER POLICY tenant_isolation ON audit_chain_anchors
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+ALTER POLICY tenant_isolation ON oauth_clients
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+ALTER POLICY tenant_isolation ON api_keys
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+ALTER POLICY tenant_isolation ON jobs
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+ALTER POLICY tenant_isolation ON idempotency_keys
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+ALTER POLICY tenant_isolation ON audit_events
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+ALTER POLICY tenant_isolation ON users
+    USING (app_current_tenant() IS NULL OR tenant_id = app_current_tenant())
+    WITH CHECK (app_current_tenant() IS NULL OR tenant_id = app_current_tenant());
+DROP FUNCTION IF EXISTS app_cross_tenant();
+
+-- +goose StatementEnd
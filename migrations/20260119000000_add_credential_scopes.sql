@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Scopes granted to API keys and OAuth clients. They become the scope claim of
+-- the key's caller and of the tokens issued to the client, so these
+-- credentials pass RequireScopes like any scoped JWT. Credentials created
+-- before scopes existed keep NULL and are treated as unscoped: they are only
+-- admitted to scoped routes when AUTH_ALLOW_UNSCOPED is set.
+ALTER TABLE api_keys ADD COLUMN scopes TEXT[];
+ALTER TABLE oauth_clients ADD COLUMN scopes TEXT[];
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE oauth_clients DROP COLUMN IF EXISTS scopes;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS scopes;
+
+-- +goose StatementEnd
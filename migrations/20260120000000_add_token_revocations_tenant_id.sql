@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Token revocations belong to the tenant of the caller that revoked the
+-- tokens and only revoke tokens of that tenant, so a tenant admin cannot
+-- revoke another tenant's tokens by their subject or jti. Revocations made
+-- before tenants existed belong to the 'default' tenant.
+ALTER TABLE token_revocations ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE token_revocations ALTER COLUMN tenant_id DROP DEFAULT;
+
+-- The denylist loads and the cleaner deletes revocations of every tenant with
+-- app.cross_tenant = 'on'.
+ALTER TABLE token_revocations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE token_revocations FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON token_revocations
+    USING (tenant_id = app_current_tenant() OR app_cross_tenant())
+    WITH CHECK (tenant_id = app_current_tenant() OR app_cross_tenant());
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP POLICY IF EXISTS tenant_isolation ON token_revocations;
+ALTER TABLE token_revocations NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE token_revocations DISABLE ROW LEVEL SECURITY;
+ALTER TABLE token_revocations DROP COLUMN IF EXISTS tenant_id;
+
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- API key queries for sqlc
+
+-- name: CreateAPIKey :exec
+INSERT INTO api_keys (id, name, prefix, key_hash, role, created_by, created_at, expires_at, tenant_id, scopes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: GetAPIKeyByID :one
+SELECT id, name, prefix, key_hash, role, created_by, created_at, expires_at, revoked_at, last_used_at, tenant_id, scopes
+FROM api_keys
+WHERE tenant_id = $1 AND id = $2;
+
+-- name: GetAPIKeyByHash :one
+-- Authenticates a key of any tenant; the key's tenant_id becomes the caller's tenant.
+SELECT id, name, prefix, key_hash, role, created_by, created_at, expires_at, revoked_at, last_used_at, tenant_id, scopes
+FROM api_keys
+WHERE key_hash = $1;
+
+-- name: ListAPIKeys :many
+SELECT id, name, prefix, key_hash, role, created_by, created_at, expires_at, revoked_at, last_used_at, tenant_id, scopes
+FROM api_keys
+WHERE tenant_id = $1
+ORDER BY created_at DESC, id DESC;
+
+-- name: RevokeAPIKey :execrows
+-- Keeps the first revocation time when the key is already revoked.
+UPDATE api_keys SET revoked_at = COALESCE(revoked_at, $3) WHERE tenant_id = $1 AND id = $2;
+
+-- name: SetAPIKeyExpiresAt :execrows
+UPDATE api_keys SET expires_at = $3 WHERE tenant_id = $1 AND id = $2;
+
+-- name: TouchAPIKeyLastUsed :exec
+UPDATE api_keys SET last_used_at = $3
+WHERE tenant_id = $1 AND id = $2 AND (last_used_at IS NULL OR last_used_at < $3);
@@ -1,27 +1,99 @@
 -- Audit queries for sqlc
 -- Story 5.4: Type-safe SQL queries for Audit module
+-- Every query is scoped to one tenant (tenant_id).
 
 -- name: CreateAuditEvent :exec
-INSERT INTO audit_events (id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+INSERT INTO audit_events (id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
 
 -- name: ListAuditEventsByEntity :many
-SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
 FROM audit_events
-WHERE entity_type = $1 AND entity_id = $2
+WHERE tenant_id = $1 AND entity_type = $2 AND entity_id = $3
 ORDER BY timestamp DESC, id DESC
-LIMIT $3 OFFSET $4;
+LIMIT $4 OFFSET $5;
+
+-- name: ListAuditEventsByEntityAfter :many
+-- Keyset page of an entity's audit events strictly after the cursor, in list order.
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
+FROM audit_events
+WHERE tenant_id = sqlc.arg(tenant_id) AND entity_type = sqlc.arg(entity_type) AND entity_id = sqlc.arg(entity_id)
+  AND (timestamp, id) < (sqlc.arg(cursor_time)::timestamptz, sqlc.arg(cursor_id)::uuid)
+ORDER BY timestamp DESC, id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListAuditEventsByEntityBefore :many
+-- Keyset page of an entity's audit events strictly before the cursor, nearest first (reverse list order).
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
+FROM audit_events
+WHERE tenant_id = sqlc.arg(tenant_id) AND entity_type = sqlc.arg(entity_type) AND entity_id = sqlc.arg(entity_id)
+  AND (timestamp, id) > (sqlc.arg(cursor_time)::timestamptz, sqlc.arg(cursor_id)::uuid)
+ORDER BY timestamp ASC, id ASC
+LIMIT sqlc.arg(row_limit);
 
 -- name: CountAuditEventsByEntity :one
 SELECT COUNT(*) FROM audit_events
-WHERE entity_type = $1 AND entity_id = $2;
+WHERE tenant_id = $1 AND entity_type = $2 AND entity_id = $3;
 
 -- name: GetAuditEventByID :one
-SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id
-FROM audit_events WHERE id = $1;
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
+FROM audit_events WHERE tenant_id = $1 AND id = $2;
 
 -- name: ListAuditEventsByRequestID :many
-SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
 FROM audit_events
-WHERE request_id = $1
+WHERE tenant_id = $1 AND request_id = $2
 ORDER BY timestamp DESC;
+
+-- name: LockAuditChain :exec
+-- Serializes appends to a tenant's hash chain until the transaction ends.
+SELECT pg_advisory_xact_lock(hashtextextended('audit_events:' || sqlc.arg(tenant_id)::text, 0));
+
+-- name: GetAuditChainHead :one
+-- Last link of a tenant's hash chain: its last event, or its anchor when
+-- retention removed every event after it. Only valid under LockAuditChain.
+SELECT chain_seq, hash FROM (
+    (SELECT chain_seq, hash FROM audit_events
+     WHERE tenant_id = sqlc.arg(tenant_id)::text AND chain_seq IS NOT NULL
+     ORDER BY chain_seq DESC
+     LIMIT 1)
+    UNION ALL
+    SELECT chain_seq, hash FROM audit_chain_anchors
+    WHERE tenant_id = sqlc.arg(tenant_id)::text
+) head
+ORDER BY chain_seq DESC
+LIMIT 1;
+
+-- name: NormalizeAuditPayload :one
+-- The payload as jsonb renders it, which is how it is read back and hashed.
+SELECT sqlc.arg(payload)::jsonb;
+
+-- name: ListAuditChain :many
+-- Chained events of a tenant after after_seq, in chain order.
+SELECT id, event_type, actor_id, entity_type, entity_id, payload, timestamp, request_id, tenant_id, chain_seq, prev_hash, hash
+FROM audit_events
+WHERE tenant_id = sqlc.arg(tenant_id) AND chain_seq > sqlc.arg(after_seq)::bigint
+ORDER BY chain_seq
+LIMIT sqlc.arg(row_limit);
+
+-- name: GetAuditChainAnchor :one
+-- Last event of a tenant's chain removed by retention.
+SELECT chain_seq, hash FROM audit_chain_anchors
+WHERE tenant_id = $1;
+
+-- name: AnchorAuditChains :exec
+-- Records the last chained event of each tenant with a timestamp in
+-- [from_time, to_time) as its chain anchor, unless the anchor is further
+-- along. Run in the transaction that removes the events. Not tenant-scoped.
+INSERT INTO audit_chain_anchors (tenant_id, chain_seq, hash)
+SELECT DISTINCT ON (tenant_id) tenant_id, chain_seq, hash
+FROM audit_events
+WHERE timestamp >= sqlc.arg(from_time) AND timestamp < sqlc.arg(to_time) AND chain_seq IS NOT NULL
+ORDER BY tenant_id, chain_seq DESC
+ON CONFLICT (tenant_id) DO UPDATE
+SET chain_seq = EXCLUDED.chain_seq, hash = EXCLUDED.hash, updated_at = now()
+WHERE audit_chain_anchors.chain_seq < EXCLUDED.chain_seq;
+
+-- name: LockAuditPartitions :exec
+-- Serializes audit_events partition maintenance until the transaction ends.
+SELECT pg_advisory_xact_lock(hashtextextended('audit_partitions', 0));
@@ -0,0 +1,41 @@
+-- name: LockAuditSink :one
+-- Claims delivery to a sink until the transaction ends, so only one instance
+-- delivers to it at a time. Returns false if another transaction holds it.
+SELECT pg_try_advisory_xact_lock(hashtextextended('audit_sink:' || sqlc.arg(sink)::text, 0))::boolean AS locked;
+
+-- name: ListUndeliveredAuditEvents :many
+-- Chained events after the sink's cursor, per tenant in chain order. Tenants
+-- are enumerated with a skip scan of idx_audit_events_chain_seq. Not
+-- tenant-scoped.
+WITH RECURSIVE tenants AS (
+    (SELECT audit_events.tenant_id FROM audit_events
+     WHERE chain_seq IS NOT NULL
+     ORDER BY audit_events.tenant_id
+     LIMIT 1)
+    UNION ALL
+    SELECT (SELECT e.tenant_id FROM audit_events e
+            WHERE e.tenant_id > t.tenant_id AND e.chain_seq IS NOT NULL
+            ORDER BY e.tenant_id
+            LIMIT 1)
+    FROM tenants t
+    WHERE t.tenant_id IS NOT NULL
+)
+SELECT e.id, e.event_type, e.actor_id, e.entity_type, e.entity_id, e.payload, e.timestamp, e.request_id, e.tenant_id, e.chain_seq, e.prev_hash, e.hash
+FROM tenants t
+LEFT JOIN audit_sink_cursors c ON c.sink = sqlc.arg(sink)::text AND c.tenant_id = t.tenant_id
+CROSS JOIN LATERAL (
+    SELECT * FROM audit_events
+    WHERE audit_events.tenant_id = t.tenant_id AND audit_events.chain_seq > COALESCE(c.chain_seq, 0)
+    ORDER BY audit_events.chain_seq
+    LIMIT sqlc.arg(row_limit)
+) e
+ORDER BY e.tenant_id, e.chain_seq
+LIMIT sqlc.arg(row_limit);
+
+-- name: SaveAuditSinkCursor :exec
+-- Moves a sink's cursor for a tenant forward to chain_seq.
+INSERT INTO audit_sink_cursors (sink, tenant_id, chain_seq)
+VALUES (sqlc.arg(sink), sqlc.arg(tenant_id), sqlc.arg(chain_seq))
+ON CONFLICT (sink, tenant_id) DO UPDATE
+SET chain_seq = EXCLUDED.chain_seq, updated_at = now()
+WHERE audit_sink_cursors.chain_seq < EXCLUDED.chain_seq;
@@ -0,0 +1,47 @@
+-- Background job queries for sqlc
+
+-- name: CreateJob :exec
+INSERT INTO jobs (id, type, status, created_by, request_id, input, total_rows, created_at, updated_at, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: GetJobByID :one
+-- Returns a tenant's job without its input payload.
+SELECT id, type, status, created_by, request_id, total_rows, next_row, processed_rows, failed_rows,
+       errors, error, locked_until, created_at, updated_at, started_at, finished_at, tenant_id
+FROM jobs
+WHERE tenant_id = $1 AND id = $2;
+
+-- name: ClaimNextJob :one
+-- Leases the oldest pending job of a type, or a running one whose lease expired.
+-- SKIP LOCKED keeps concurrent workers from claiming the same job.
+-- Workers serve all tenants; the job is processed within its tenant_id.
+UPDATE jobs
+SET status = 'running',
+    locked_until = sqlc.arg(lease_until),
+    started_at = COALESCE(started_at, NOW()),
+    updated_at = NOW()
+WHERE id = (
+    SELECT j.id FROM jobs j
+    WHERE j.type = sqlc.arg(type)
+      AND (j.status = 'pending' OR (j.status = 'running' AND j.locked_until < NOW()))
+    ORDER BY j.created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, type, status, created_by, request_id, input, total_rows, next_row, processed_rows, failed_rows,
+          errors, error, locked_until, created_at, updated_at, started_at, finished_at, tenant_id;
+
+-- name: UpdateJobProgress :execrows
+-- Saves progress if the caller still holds the lease; the input is dropped once the job is terminal.
+UPDATE jobs
+SET status = sqlc.arg(status),
+    next_row = sqlc.arg(next_row),
+    processed_rows = sqlc.arg(processed_rows),
+    failed_rows = sqlc.arg(failed_rows),
+    errors = sqlc.arg(errors),
+    error = sqlc.arg(error),
+    finished_at = sqlc.arg(finished_at),
+    locked_until = sqlc.arg(lease_until),
+    updated_at = sqlc.arg(updated_at),
+    input = CASE WHEN sqlc.arg(status) IN ('succeeded', 'failed') THEN NULL ELSE input END
+WHERE tenant_id = sqlc.arg(tenant_id) AND id = sqlc.arg(id) AND locked_until = sqlc.arg(current_lease);
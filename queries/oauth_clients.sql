@@ -0,0 +1,11 @@
+-- OAuth client queries for sqlc
+
+-- name: CreateOAuthClient :exec
+INSERT INTO oauth_clients (id, name, secret_hash, role, created_by, created_at, tenant_id, scopes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetOAuthClientByID :one
+-- Authenticates a client of any tenant; tokens issued to it carry its tenant_id.
+SELECT id, name, secret_hash, role, created_by, disabled_at, created_at, tenant_id, scopes
+FROM oauth_clients
+WHERE id = $1;
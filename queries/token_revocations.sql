@@ -0,0 +1,16 @@
+-- Token revocation (denylist) queries for sqlc
+
+-- name: CreateTokenRevocation :exec
+INSERT INTO token_revocations (id, jti, subject, issued_before, expires_at, revoked_by, request_id, created_at, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: ListActiveTokenRevocations :many
+-- Returns every revocation whose tokens may still be unexpired, of all tenants.
+SELECT id, jti, subject, issued_before, expires_at, revoked_by, request_id, created_at, tenant_id
+FROM token_revocations
+WHERE expires_at > NOW()
+ORDER BY created_at;
+
+-- name: DeleteExpiredTokenRevocations :execrows
+-- Removes revocations whose tokens have expired and returns the count of deleted rows
+DELETE FROM token_revocations WHERE expires_at <= NOW();
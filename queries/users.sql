@@ -1,23 +1,67 @@
 -- Users queries for sqlc
 -- Story 5.3: Type-safe SQL queries
+-- Every query is scoped to one tenant (tenant_id).
 
--- name: CreateUser :exec
-INSERT INTO users (id, email, first_name, last_name, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6);
+-- name: CreateUser :one
+INSERT INTO users (id, email, first_name, last_name, created_at, updated_at, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING version;
 
 -- name: GetUserByID :one
-SELECT id, email, first_name, last_name, created_at, updated_at
-FROM users WHERE id = $1;
+SELECT id, email, first_name, last_name, created_at, updated_at, deleted_at, version, tenant_id
+FROM users WHERE tenant_id = $1 AND id = $2 AND deleted_at IS NULL;
 
 -- name: ListUsers :many
-SELECT id, email, first_name, last_name, created_at, updated_at
+SELECT id, email, first_name, last_name, created_at, updated_at, deleted_at, version, tenant_id
 FROM users
+WHERE tenant_id = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC, id DESC
-LIMIT $1 OFFSET $2;
+LIMIT $2 OFFSET $3;
+
+-- name: ListUsersAfter :many
+-- Keyset page of active users strictly after the cursor, in list order.
+SELECT id, email, first_name, last_name, created_at, updated_at, deleted_at, version, tenant_id
+FROM users
+WHERE tenant_id = sqlc.arg(tenant_id) AND deleted_at IS NULL
+  AND (created_at, id) < (sqlc.arg(cursor_time)::timestamptz, sqlc.arg(cursor_id)::uuid)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListUsersBefore :many
+-- Keyset page of active users strictly before the cursor, nearest first (reverse list order).
+SELECT id, email, first_name, last_name, created_at, updated_at, deleted_at, version, tenant_id
+FROM users
+WHERE tenant_id = sqlc.arg(tenant_id) AND deleted_at IS NULL
+  AND (created_at, id) > (sqlc.arg(cursor_time)::timestamptz, sqlc.arg(cursor_id)::uuid)
+ORDER BY created_at ASC, id ASC
+LIMIT sqlc.arg(row_limit);
 
 -- name: CountUsers :one
-SELECT COUNT(*) FROM users;
+SELECT COUNT(*) FROM users WHERE tenant_id = $1 AND deleted_at IS NULL;
 
 -- name: GetUserByEmail :one
-SELECT id, email, first_name, last_name, created_at, updated_at
-FROM users WHERE email = $1;
+SELECT id, email, first_name, last_name, created_at, updated_at, deleted_at, version, tenant_id
+FROM users WHERE tenant_id = $1 AND email = $2 AND deleted_at IS NULL;
+
+-- name: UpdateUser :one
+-- Applies the update only if the stored version still matches and returns the new version.
+UPDATE users
+SET email = $3, first_name = $4, last_name = $5, updated_at = $6, version = version + 1
+WHERE tenant_id = $1 AND id = $2 AND version = $7 AND deleted_at IS NULL
+RETURNING version;
+
+-- name: SoftDeleteUser :execrows
+UPDATE users
+SET deleted_at = NOW(), updated_at = NOW(), version = version + 1
+WHERE tenant_id = $1 AND id = $2 AND version = $3 AND deleted_at IS NULL;
+
+-- name: RestoreUser :execrows
+UPDATE users
+SET deleted_at = NULL, updated_at = NOW(), version = version + 1
+WHERE tenant_id = $1 AND id = $2 AND deleted_at IS NOT NULL;
+
+-- name: PurgeDeletedUsers :many
+-- Hard-deletes the tenant's users soft-deleted before the cutoff and returns their IDs.
+DELETE FROM users
+WHERE tenant_id = $1 AND deleted_at IS NOT NULL AND deleted_at < $2
+RETURNING id;
@@ -210,8 +210,8 @@ func stateSeedUser(db *sql.DB) (models.ProviderStateResponse, error) {
 	updatedAt, _ := time.Parse(time.RFC3339, "2024-01-01T00:00:00Z")
 
 	res, err := db.Exec(`
-		INSERT INTO users (id, first_name, last_name, email, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO users (id, first_name, last_name, email, created_at, updated_at, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, 'default')
 		ON CONFLICT (id) DO UPDATE 
 		SET first_name = EXCLUDED.first_name, last_name = EXCLUDED.last_name, email = EXCLUDED.email
 	`, id, firstName, lastName, email, createdAt, updatedAt)
@@ -244,8 +244,8 @@ func stateSeedUserForDeletion(db *sql.DB) (models.ProviderStateResponse, error)
 	updatedAt, _ := time.Parse(time.RFC3339, "2024-01-01T00:00:00Z")
 
 	res, err := db.Exec(`
-		INSERT INTO users (id, first_name, last_name, email, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO users (id, first_name, last_name, email, created_at, updated_at, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, 'default')
 		ON CONFLICT (id) DO UPDATE 
 		SET first_name = EXCLUDED.first_name, last_name = EXCLUDED.last_name, email = EXCLUDED.email
 	`, id, firstName, lastName, email, createdAt, updatedAt)